@@ -19,8 +19,12 @@ import (
 	// IBC
 	ibcAnte "github.com/cosmos/ibc-go/v6/modules/core/ante"
 	ibcKeeper "github.com/cosmos/ibc-go/v6/modules/core/keeper"
+	// Pool
+	poolKeeper "github.com/KYVENetwork/chain/x/pool/keeper"
 	// Staking
 	stakingKeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	// Stakers
+	stakersKeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
 )
 
 // https://github.com/cosmos/cosmos-sdk/blob/release/v0.46.x/x/auth/ante/ante.go#L25
@@ -32,16 +36,20 @@ func NewAnteHandler(
 	globalKeeper globalKeeper.Keeper,
 	govKeeper govKeeper.Keeper,
 	ibcKeeper *ibcKeeper.Keeper,
+	poolKeeper poolKeeper.Keeper,
 	stakingKeeper stakingKeeper.Keeper,
+	stakersKeeper stakersKeeper.Keeper,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) (sdk.AnteHandler, error) {
-	deductFeeDecorator := global.NewDeductFeeDecorator(accountKeeper, bankKeeper, feeGrantKeeper, globalKeeper, stakingKeeper)
+	deductFeeDecorator := global.NewDeductFeeDecorator(accountKeeper, bankKeeper, feeGrantKeeper, globalKeeper, stakingKeeper, poolKeeper, stakersKeeper)
 
 	gasAdjustmentDecorator := global.NewGasAdjustmentDecorator(globalKeeper)
 
 	initialDepositDecorator := global.NewInitialDepositDecorator(globalKeeper, govKeeper)
 
+	msgGasDecorator := global.NewMsgGasDecorator()
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		gasAdjustmentDecorator,
@@ -58,6 +66,7 @@ func NewAnteHandler(
 		ante.NewIncrementSequenceDecorator(accountKeeper),
 		ibcAnte.NewRedundantRelayDecorator(ibcKeeper),
 		initialDepositDecorator,
+		msgGasDecorator,
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -69,8 +78,9 @@ func NewPostHandler(
 	bankKeeper bankKeeper.Keeper,
 	feeGrantKeeper feeGrantKeeper.Keeper,
 	globalKeeper globalKeeper.Keeper,
+	poolKeeper poolKeeper.Keeper,
 ) (sdk.AnteHandler, error) {
-	refundFeeDecorator := global.NewRefundFeeDecorator(bankKeeper, feeGrantKeeper, globalKeeper)
+	refundFeeDecorator := global.NewRefundFeeDecorator(bankKeeper, feeGrantKeeper, globalKeeper, poolKeeper)
 
 	postDecorators := []sdk.AnteDecorator{
 		refundFeeDecorator,
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 
 	v1p3 "github.com/KYVENetwork/chain/app/upgrades/v1_3"
+	v1p4 "github.com/KYVENetwork/chain/app/upgrades/v1_4"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -457,6 +458,7 @@ func NewKYVEApp(
 		app.PoolKeeper,
 		app.StakersKeeper,
 		app.DelegationKeeper,
+		app.UpgradeKeeper,
 	)
 
 	// Create IBC Keepers
@@ -760,7 +762,7 @@ func NewKYVEApp(
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 
-	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.configurator = module.NewConfigurator(app.appCodec, global.NewMsgGasRouter(app.MsgServiceRouter()), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
 	// initialize stores
@@ -778,7 +780,9 @@ func NewKYVEApp(
 		app.GlobalKeeper,
 		app.GovKeeper,
 		app.IBCKeeper,
+		app.PoolKeeper,
 		app.StakingKeeper,
+		app.StakersKeeper,
 		ante.DefaultSigVerificationGasConsumer,
 		encodingConfig.TxConfig.SignModeHandler(),
 	)
@@ -790,6 +794,7 @@ func NewKYVEApp(
 		app.BankKeeper,
 		app.FeeGrantKeeper,
 		app.GlobalKeeper,
+		app.PoolKeeper,
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed to create PostHandler: %s", err))
@@ -814,6 +819,18 @@ func NewKYVEApp(
 		),
 	)
 
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v1p4.UpgradeName,
+		v1p4.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+			app.PoolKeeper,
+			app.BundlesKeeper,
+			app.DelegationKeeper,
+			app.GlobalKeeper,
+		),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
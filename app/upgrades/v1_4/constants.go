@@ -0,0 +1,4 @@
+package v1_4
+
+// UpgradeName is the name of this specific software upgrade used on-chain.
+const UpgradeName = "v1.4.0"
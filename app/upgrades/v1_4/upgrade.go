@@ -0,0 +1,144 @@
+package v1_4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	// Bundles
+	bundlesKeeper "github.com/KYVENetwork/chain/x/bundles/keeper"
+	bundlesTypes "github.com/KYVENetwork/chain/x/bundles/types"
+	// Delegation
+	delegationKeeper "github.com/KYVENetwork/chain/x/delegation/keeper"
+	delegationTypes "github.com/KYVENetwork/chain/x/delegation/types"
+	// Global
+	globalKeeper "github.com/KYVENetwork/chain/x/global/keeper"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	// Pool
+	poolKeeper "github.com/KYVENetwork/chain/x/pool/keeper"
+	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
+	// Upgrade
+	upgradeTypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	poolKeeper poolKeeper.Keeper,
+	bundlesKeeper bundlesKeeper.Keeper,
+	delegationKeeper delegationKeeper.Keeper,
+	globalKeeper globalKeeper.Keeper,
+) upgradeTypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradeTypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// Initialise the params which were added since the last upgrade.
+		SetPoolParams(ctx, poolKeeper)
+		SetBundlesParams(ctx, bundlesKeeper)
+		SetDelegationParams(ctx, delegationKeeper)
+		SetGlobalParams(ctx, globalKeeper)
+
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// SetPoolParams initializes the new pool params with their default values.
+// Without this, MaxFunders would be zero and no pool could be funded anymore
+// and the gas sponsorship of a pool could not pay any fees.
+func SetPoolParams(ctx sdk.Context, keeper poolKeeper.Keeper) {
+	params := keeper.GetParams(ctx)
+
+	if params.MaxFunders == 0 {
+		params.MaxFunders = poolTypes.DefaultMaxFunders
+	}
+
+	if params.MaxGasSponsorshipPerBlock == 0 {
+		params.MaxGasSponsorshipPerBlock = poolTypes.DefaultMaxGasSponsorshipPerBlock
+	}
+
+	keeper.SetParams(ctx, params)
+}
+
+// SetBundlesParams initializes the new bundles params with their default values.
+// Without this, finalized bundles could not be challenged, timeouts and missed
+// votes would not add any points anymore and the voting power cap would be unset.
+func SetBundlesParams(ctx sdk.Context, keeper bundlesKeeper.Keeper) {
+	params := keeper.GetParams(ctx)
+
+	if params.VotingPowerCap.IsNil() {
+		params.VotingPowerCap = bundlesTypes.DefaultVotingPowerCap
+	}
+
+	if params.ChallengeWindow == 0 {
+		params.ChallengeWindow = bundlesTypes.DefaultChallengeWindow
+	}
+
+	if params.ChallengeBond == 0 {
+		params.ChallengeBond = bundlesTypes.DefaultChallengeBond
+	}
+
+	if params.ChallengeVotingPeriod == 0 {
+		params.ChallengeVotingPeriod = bundlesTypes.DefaultChallengeVotingPeriod
+	}
+
+	if params.ChallengeReward == 0 {
+		params.ChallengeReward = bundlesTypes.DefaultChallengeReward
+	}
+
+	if params.UploadTimeoutPoints == 0 {
+		params.UploadTimeoutPoints = bundlesTypes.DefaultUploadTimeoutPoints
+	}
+
+	if params.MissedVotePoints == 0 {
+		params.MissedVotePoints = bundlesTypes.DefaultMissedVotePoints
+	}
+
+	keeper.SetParams(ctx, params)
+}
+
+// SetDelegationParams initializes the new delegation params with their default values.
+// Without this, the insurance fund share would be unset and auto-compounding
+// would not re-delegate any rewards.
+func SetDelegationParams(ctx sdk.Context, keeper delegationKeeper.Keeper) {
+	params := keeper.GetParams(ctx)
+
+	if params.InsuranceFundShare.IsNil() {
+		params.InsuranceFundShare = delegationTypes.DefaultInsuranceFundShare
+	}
+
+	if params.MaxAutoCompoundsPerBlock == 0 {
+		params.MaxAutoCompoundsPerBlock = delegationTypes.DefaultMaxAutoCompoundsPerBlock
+	}
+
+	if params.MinAutoCompoundDelegation == 0 {
+		params.MinAutoCompoundDelegation = delegationTypes.DefaultMinAutoCompoundDelegation
+	}
+
+	keeper.SetParams(ctx, params)
+}
+
+// SetGlobalParams initializes the new global params with their default values.
+// Without this, the burn epoch duration would be zero and the base fee could
+// not be enabled by governance without also setting all of its params.
+func SetGlobalParams(ctx sdk.Context, keeper globalKeeper.Keeper) {
+	params := keeper.GetParams(ctx)
+
+	if params.TargetBlockGas == 0 {
+		params.TargetBlockGas = globalTypes.DefaultTargetBlockGas
+	}
+
+	if params.BaseFeeChangeRate.IsNil() || params.BaseFeeChangeRate.IsZero() {
+		params.BaseFeeChangeRate = globalTypes.DefaultBaseFeeChangeRate
+	}
+
+	if params.MinBaseFee.IsNil() || params.MinBaseFee.IsZero() {
+		params.MinBaseFee = globalTypes.DefaultMinBaseFee
+	}
+
+	if params.MaxBaseFee.IsNil() || params.MaxBaseFee.IsZero() {
+		params.MaxBaseFee = globalTypes.DefaultMaxBaseFee
+	}
+
+	if params.BurnEpochDuration == 0 {
+		params.BurnEpochDuration = globalTypes.DefaultBurnEpochDuration
+	}
+
+	keeper.SetParams(ctx, params)
+}
@@ -4,15 +4,20 @@ import (
 	"time"
 
 	"github.com/KYVENetwork/chain/x/bundles"
+	bundlesKeeper "github.com/KYVENetwork/chain/x/bundles/keeper"
 	bundlesTypes "github.com/KYVENetwork/chain/x/bundles/types"
 	"github.com/KYVENetwork/chain/x/delegation"
+	delegationKeeper "github.com/KYVENetwork/chain/x/delegation/keeper"
 	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
 	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	"github.com/KYVENetwork/chain/x/pool"
+	poolKeeper "github.com/KYVENetwork/chain/x/pool/keeper"
 	querytypes "github.com/KYVENetwork/chain/x/query/types"
 	"github.com/KYVENetwork/chain/x/stakers"
+	stakersKeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
 	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
 	"github.com/KYVENetwork/chain/x/team"
+	teamKeeper "github.com/KYVENetwork/chain/x/team/keeper"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	. "github.com/onsi/gomega"
 
@@ -47,8 +52,10 @@ func (suite *KeeperTestSuite) PerformValidityChecks() {
 	suite.VerifyDelegationGenesisImportExport()
 
 	// verify team module
-	// TODO(@troy): implement team funds integrity checks
 	suite.VerifyTeamGenesisImportExport()
+
+	// verify module invariants
+	suite.VerifyInvariants()
 }
 
 // ==================
@@ -64,6 +71,9 @@ func (suite *KeeperTestSuite) VerifyPoolModuleAssetsIntegrity() {
 		for _, funder := range pool.Funders {
 			expectedBalance += funder.Amount
 		}
+
+		// gas sponsorship budgets are also held by the pool module
+		expectedBalance += pool.GasSponsorship
 	}
 
 	moduleAcc := suite.App().AccountKeeper.GetModuleAccount(suite.Ctx(), pooltypes.ModuleName).GetAddress()
@@ -284,6 +294,7 @@ func checkFinalizedBundle(queryBundle querytypes.FinalizedBundle, rawBundle bund
 	Expect(queryBundle.CompressionId).To(Equal(uint64(rawBundle.CompressionId)))
 	Expect(queryBundle.StakeSecurity.ValidVotePower.Uint64()).To(Equal(rawBundle.StakeSecurity.ValidVotePower))
 	Expect(queryBundle.StakeSecurity.TotalVotePower.Uint64()).To(Equal(rawBundle.StakeSecurity.TotalVotePower))
+	Expect(queryBundle.Invalidated).To(Equal(rawBundle.Invalidated))
 }
 
 func (suite *KeeperTestSuite) VerifyBundlesQueries() {
@@ -388,6 +399,9 @@ func (suite *KeeperTestSuite) VerifyDelegationModuleIntegrity() {
 		expectedBalance += suite.App().DelegationKeeper.GetOutstandingRewards(suite.Ctx(), delegator.Staker, delegator.Delegator)
 	}
 
+	// The insurance fund is held by the delegation module as well
+	expectedBalance += suite.App().DelegationKeeper.GetInsuranceFund(suite.Ctx()).Balance
+
 	// Due to rounding errors the delegation module will get a very few nKYVE over the time.
 	// As long as it is guaranteed that it's always the user who gets paid out less in case of
 	// rounding, everything is fine.
@@ -432,6 +446,25 @@ func (suite *KeeperTestSuite) VerifyTeamGenesisImportExport() {
 	team.InitGenesis(suite.Ctx(), suite.App().TeamKeeper, *genState)
 }
 
+// ========================
+// invariant checks
+// ========================
+
+func (suite *KeeperTestSuite) VerifyInvariants() {
+	invariants := []sdk.Invariant{
+		poolKeeper.AllInvariants(suite.App().PoolKeeper),
+		stakersKeeper.AllInvariants(suite.App().StakersKeeper),
+		bundlesKeeper.AllInvariants(suite.App().BundlesKeeper),
+		delegationKeeper.AllInvariants(suite.App().DelegationKeeper),
+		teamKeeper.AllInvariants(suite.App().TeamKeeper),
+	}
+
+	for _, invariant := range invariants {
+		msg, broken := invariant(suite.Ctx())
+		Expect(broken).To(BeFalse(), msg)
+	}
+}
+
 // ========================
 // helpers
 // ========================
@@ -481,6 +514,9 @@ func (suite *KeeperTestSuite) verifyFullStaker(fullStaker querytypes.FullStaker,
 		Expect(poolMembership.Valaddress).To(Equal(valaccount.Valaddress))
 		Expect(poolMembership.IsLeaving).To(Equal(valaccount.IsLeaving))
 		Expect(poolMembership.Points).To(Equal(valaccount.Points))
+		Expect(poolMembership.ConsecutiveVotes).To(Equal(valaccount.ConsecutiveVotes))
+		Expect(poolMembership.Jailed).To(Equal(valaccount.Jailed))
+		Expect(poolMembership.JailedUntil).To(Equal(valaccount.JailedUntil))
 
 		pool, found := suite.App().PoolKeeper.GetPool(suite.Ctx(), valaccount.PoolId)
 		Expect(found).To(BeTrue())
@@ -16,6 +16,15 @@ func (suite *KeeperTestSuite) GetBalanceFromAddress(address string) uint64 {
 	return uint64(balance.Amount.Int64())
 }
 
+func (suite *KeeperTestSuite) GetBalanceOfDenomFromAddress(address string, denom string) uint64 {
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return 0
+	}
+
+	return suite.App().BankKeeper.GetBalance(suite.Ctx(), accAddress, denom).Amount.Uint64()
+}
+
 func (suite *KeeperTestSuite) GetBalanceFromPool(poolId uint64) uint64 {
 	pool, found := suite.App().PoolKeeper.GetPool(suite.Ctx(), poolId)
 	if !found {
@@ -53,6 +53,9 @@ const (
 
 var KYVE_DENOM = globalTypes.Denom
 
+// IBC_DENOM is a non-native denom which can be whitelisted for funding pools
+const IBC_DENOM = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
 func NewCleanChain() *KeeperTestSuite {
 	s := KeeperTestSuite{}
 	s.SetupTest(time.Now().Unix())
@@ -108,7 +111,11 @@ func (suite *KeeperTestSuite) initDummyAccounts() {
 }
 
 func (suite *KeeperTestSuite) Mint(address string, amount uint64) error {
-	coins := sdk.NewCoins(sdk.NewInt64Coin(KYVE_DENOM, int64(amount)))
+	return suite.MintDenom(address, KYVE_DENOM, amount)
+}
+
+func (suite *KeeperTestSuite) MintDenom(address string, denom string, amount uint64) error {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, int64(amount)))
 	err := suite.app.BankKeeper.MintCoins(suite.ctx, mintTypes.ModuleName, coins)
 	if err != nil {
 		return err
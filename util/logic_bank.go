@@ -134,3 +134,66 @@ func TransferFromModuleToTreasury(
 
 	return nil
 }
+
+// TransferCoinsFromAddressToModule sends coins of arbitrary denoms from a specified address to the given module.
+func TransferCoinsFromAddressToModule(
+	bankKeeper BankKeeper,
+	ctx sdk.Context,
+	address string,
+	module string,
+	coins sdk.Coins,
+) error {
+	sender, errAddress := sdk.AccAddressFromBech32(address)
+	if errAddress != nil {
+		return errAddress
+	}
+
+	err := bankKeeper.SendCoinsFromAccountToModule(ctx, sender, module, coins)
+	return err
+}
+
+// TransferCoinsFromModuleToAddress sends coins of arbitrary denoms from the given module to a specified address.
+func TransferCoinsFromModuleToAddress(
+	bankKeeper BankKeeper,
+	ctx sdk.Context,
+	module string,
+	address string,
+	coins sdk.Coins,
+) error {
+	recipient, errAddress := sdk.AccAddressFromBech32(address)
+	if errAddress != nil {
+		return errAddress
+	}
+
+	err := bankKeeper.SendCoinsFromModuleToAccount(ctx, module, recipient, coins)
+	return err
+}
+
+// TransferCoinsFromModuleToModule sends coins of arbitrary denoms from a specified module to the given module.
+func TransferCoinsFromModuleToModule(
+	bankKeeper BankKeeper,
+	ctx sdk.Context,
+	fromModule string,
+	toModule string,
+	coins sdk.Coins,
+) error {
+	err := bankKeeper.SendCoinsFromModuleToModule(ctx, fromModule, toModule, coins)
+	return err
+}
+
+// TransferCoinsFromModuleToTreasury sends coins of arbitrary denoms from a module to the treasury (community spend pool).
+func TransferCoinsFromModuleToTreasury(
+	accountKeeper AccountKeeper,
+	distrKeeper DistrKeeper,
+	ctx sdk.Context,
+	module string,
+	coins sdk.Coins,
+) error {
+	sender := accountKeeper.GetModuleAddress(module)
+
+	if err := distrKeeper.FundCommunityPool(ctx, coins, sender); err != nil {
+		return err
+	}
+
+	return nil
+}
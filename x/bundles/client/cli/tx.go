@@ -22,6 +22,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdSkipUploaderRole())
 	cmd.AddCommand(CmdSubmitBundleProposal())
 	cmd.AddCommand(CmdVoteBundleProposal())
+	cmd.AddCommand(CmdCommitBundleVote())
+	cmd.AddCommand(CmdRevealBundleVote())
+	cmd.AddCommand(CmdChallengeFinalizedBundle())
+	cmd.AddCommand(CmdVoteChallenge())
 
 	return cmd
 }
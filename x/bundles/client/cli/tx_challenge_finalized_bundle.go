@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdChallengeFinalizedBundle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge-finalized-bundle [pool_id] [bundle_id]",
+		Short: "Broadcast message challenge-finalized-bundle",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argBundleId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChallengeFinalizedBundle(
+				clientCtx.GetFromAddress().String(),
+				argPoolId,
+				argBundleId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdCommitBundleVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-bundle-vote [staker] [pool_id] [storage_id] [vote] [salt]",
+		Short: "Broadcast message commit-bundle-vote",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStaker := args[0]
+
+			argPoolId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argStorageId := args[2]
+
+			argVote, err := cast.ToInt32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argSalt := args[4]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCommitBundleVote(
+				clientCtx.GetFromAddress().String(),
+				argStaker,
+				argPoolId,
+				argStorageId,
+				types.GetVoteCommitment(types.VoteType(argVote), argSalt, argStaker),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdVoteChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-challenge [staker] [pool_id] [bundle_id] [vote]",
+		Short: "Broadcast message vote-challenge",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStaker := args[0]
+
+			argPoolId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argBundleId, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argVote, err := cast.ToInt32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteChallenge(
+				clientCtx.GetFromAddress().String(),
+				argStaker,
+				argPoolId,
+				argBundleId,
+				types.VoteType(argVote),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
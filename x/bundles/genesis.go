@@ -23,6 +23,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, entry := range genState.RoundRobinProgressList {
 		k.SetRoundRobinProgress(ctx, entry)
 	}
+
+	for _, entry := range genState.BundleChallengeList {
+		k.SetBundleChallenge(ctx, entry)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -36,5 +40,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.RoundRobinProgressList = k.GetAllRoundRobinProgress(ctx)
 
+	genesis.BundleChallengeList = k.GetAllBundleChallenges(ctx)
+
 	return genesis
 }
@@ -29,8 +29,10 @@ var _ = Describe("abci.go", Ordered, func() {
 		s = i.NewCleanChain()
 
 		s.App().PoolKeeper.SetParams(s.Ctx(), poolTypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0.1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.1"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0.1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.1"),
+			MaxFunders:                poolTypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: poolTypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		s.App().PoolKeeper.AppendPool(s.Ctx(), poolTypes.Pool{
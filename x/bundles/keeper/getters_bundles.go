@@ -139,9 +139,12 @@ func RawBundleToQueryBundle(rawFinalizedBundle types.FinalizedBundle, versionMap
 		FromKey:           rawFinalizedBundle.FromKey,
 		StorageProviderId: uint64(rawFinalizedBundle.StorageProviderId),
 		CompressionId:     uint64(rawFinalizedBundle.CompressionId),
+		Invalidated:       rawFinalizedBundle.Invalidated,
 		StakeSecurity: &queryTypes.StakeSecurity{
-			ValidVotePower: nil,
-			TotalVotePower: nil,
+			ValidVotePower:    nil,
+			TotalVotePower:    nil,
+			RawValidVotePower: nil,
+			RawTotalVotePower: nil,
 		},
 	}
 
@@ -151,6 +154,16 @@ func RawBundleToQueryBundle(rawFinalizedBundle types.FinalizedBundle, versionMap
 		totalPower := cosmossdk_io_math.NewInt(int64(rawFinalizedBundle.StakeSecurity.TotalVotePower))
 		finalizedBundle.StakeSecurity.ValidVotePower = &validPower
 		finalizedBundle.StakeSecurity.TotalVotePower = &totalPower
+
+		// Bundles finalized before the introduction of voting power curves only
+		// stored the linear voting power, which is equal to the raw delegation.
+		rawValidPower, rawTotalPower := validPower, totalPower
+		if rawFinalizedBundle.StakeSecurity.RawTotalVotePower > 0 {
+			rawValidPower = cosmossdk_io_math.NewInt(int64(rawFinalizedBundle.StakeSecurity.RawValidVotePower))
+			rawTotalPower = cosmossdk_io_math.NewInt(int64(rawFinalizedBundle.StakeSecurity.RawTotalVotePower))
+		}
+		finalizedBundle.StakeSecurity.RawValidVotePower = &rawValidPower
+		finalizedBundle.StakeSecurity.RawTotalVotePower = &rawTotalPower
 	}
 
 	return finalizedBundle
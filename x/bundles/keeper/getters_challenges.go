@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetBundleChallenge stores the challenge of a finalized bundle.
+// Open challenges are additionally indexed by their creation time, so that
+// the end block only has to look at the challenges which are due.
+func (k Keeper) SetBundleChallenge(ctx sdk.Context, bundleChallenge types.BundleChallenge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BundleChallengePrefix)
+	b := k.cdc.MustMarshal(&bundleChallenge)
+	store.Set(types.BundleChallengeKey(bundleChallenge.PoolId, bundleChallenge.BundleId), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OpenBundleChallengePrefix)
+	indexKey := types.OpenBundleChallengeKey(bundleChallenge.CreatedAt, bundleChallenge.PoolId, bundleChallenge.BundleId)
+
+	if bundleChallenge.Status == types.CHALLENGE_STATUS_OPEN {
+		indexStore.Set(indexKey, []byte{})
+	} else {
+		indexStore.Delete(indexKey)
+	}
+}
+
+// GetBundleChallenge returns the challenge of a finalized bundle
+func (k Keeper) GetBundleChallenge(ctx sdk.Context, poolId uint64, bundleId uint64) (val types.BundleChallenge, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BundleChallengePrefix)
+
+	b := store.Get(types.BundleChallengeKey(poolId, bundleId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllBundleChallenges returns all challenges of finalized bundles
+func (k Keeper) GetAllBundleChallenges(ctx sdk.Context) (list []types.BundleChallenge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BundleChallengePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BundleChallenge
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetDueBundleChallenges returns all open challenges which were created at or before
+// the given timestamp, ordered by their creation time.
+func (k Keeper) GetDueBundleChallenges(ctx sdk.Context, createdBefore uint64) (list []types.BundleChallenge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OpenBundleChallengePrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(createdBefore+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		poolId := binary.BigEndian.Uint64(iterator.Key()[8:16])
+		bundleId := binary.BigEndian.Uint64(iterator.Key()[16:24])
+
+		if challenge, found := k.GetBundleChallenge(ctx, poolId, bundleId); found {
+			list = append(list, challenge)
+		}
+	}
+
+	return
+}
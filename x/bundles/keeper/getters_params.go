@@ -38,6 +38,51 @@ func (k Keeper) GetMaxPoints(ctx sdk.Context) (res uint64) {
 	return k.GetParams(ctx).MaxPoints
 }
 
+// GetVotingPowerCurve returns the VotingPowerCurve param
+func (k Keeper) GetVotingPowerCurve(ctx sdk.Context) (res types.VotingPowerCurve) {
+	return k.GetParams(ctx).VotingPowerCurve
+}
+
+// GetVotingPowerCap returns the VotingPowerCap param
+func (k Keeper) GetVotingPowerCap(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).VotingPowerCap
+}
+
+// GetChallengeWindow returns the ChallengeWindow param
+func (k Keeper) GetChallengeWindow(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).ChallengeWindow
+}
+
+// GetChallengeBond returns the ChallengeBond param
+func (k Keeper) GetChallengeBond(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).ChallengeBond
+}
+
+// GetChallengeVotingPeriod returns the ChallengeVotingPeriod param
+func (k Keeper) GetChallengeVotingPeriod(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).ChallengeVotingPeriod
+}
+
+// GetChallengeReward returns the ChallengeReward param
+func (k Keeper) GetChallengeReward(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).ChallengeReward
+}
+
+// GetPointsDecayVotes returns the PointsDecayVotes param
+func (k Keeper) GetPointsDecayVotes(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).PointsDecayVotes
+}
+
+// GetUploadTimeoutPoints returns the UploadTimeoutPoints param
+func (k Keeper) GetUploadTimeoutPoints(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).UploadTimeoutPoints
+}
+
+// GetMissedVotePoints returns the MissedVotePoints param
+func (k Keeper) GetMissedVotePoints(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MissedVotePoints
+}
+
 // SetParams sets the x/bundles module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := ctx.KVStore(k.storeKey)
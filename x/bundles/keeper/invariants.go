@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all bundles invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "bundle-proposals", BundleProposalsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the bundles module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return BundleProposalsInvariant(k)(ctx)
+	}
+}
+
+// BundleProposalsInvariant checks that every bundle proposal belongs to an
+// existing pool, that no staker voted more than once on the same proposal
+// and that the uploader of an ongoing proposal voted valid.
+func BundleProposalsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, bundleProposal := range k.GetAllBundleProposals(ctx) {
+			if _, found := k.poolKeeper.GetPool(ctx, bundleProposal.PoolId); !found {
+				broken = true
+				msg += fmt.Sprintf("\tbundle proposal of non-existent pool %d\n", bundleProposal.PoolId)
+			}
+
+			votes := make(map[string]int)
+			for _, voter := range bundleProposal.VotersValid {
+				votes[voter] += 1
+			}
+			for _, voter := range bundleProposal.VotersInvalid {
+				votes[voter] += 1
+			}
+			for _, voter := range bundleProposal.VotersAbstain {
+				votes[voter] += 1
+			}
+
+			for voter, count := range votes {
+				if count > 1 {
+					broken = true
+					msg += fmt.Sprintf("\tpool %d: staker %s voted %d times\n", bundleProposal.PoolId, voter, count)
+				}
+			}
+
+			if bundleProposal.StorageId != "" {
+				uploaderVotedValid := false
+				for _, voter := range bundleProposal.VotersValid {
+					if voter == bundleProposal.Uploader {
+						uploaderVotedValid = true
+						break
+					}
+				}
+
+				if !uploaderVotedValid {
+					broken = true
+					msg += fmt.Sprintf("\tpool %d: uploader %s did not vote valid\n", bundleProposal.PoolId, bundleProposal.Uploader)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "bundle-proposals", msg), broken
+	}
+}
@@ -25,6 +25,7 @@ type (
 		poolKeeper       types.PoolKeeper
 		stakerKeeper     types.StakerKeeper
 		delegationKeeper types.DelegationKeeper
+		upgradeKeeper    types.UpgradeKeeper
 	}
 )
 
@@ -41,6 +42,7 @@ func NewKeeper(
 	poolKeeper types.PoolKeeper,
 	stakerKeeper types.StakerKeeper,
 	delegationKeeper types.DelegationKeeper,
+	upgradeKeeper types.UpgradeKeeper,
 ) *Keeper {
 	return &Keeper{
 		cdc:      cdc,
@@ -55,6 +57,7 @@ func NewKeeper(
 		poolKeeper:       poolKeeper,
 		stakerKeeper:     stakerKeeper,
 		delegationKeeper: delegationKeeper,
+		upgradeKeeper:    upgradeKeeper,
 	}
 }
 
@@ -81,8 +81,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with no funders and 0% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.1"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.1"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -151,8 +153,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with no funders and 10% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0.1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.1"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0.1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.1"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -234,8 +238,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with no funders and 100% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.2"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.2"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -317,8 +323,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with sufficient funders and 0% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.1"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.1"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -411,8 +419,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with sufficient funders and 10% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0.1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.3"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0.1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.3"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -506,8 +516,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with sufficient funders and 100% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.1"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.1"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -601,8 +613,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with insufficient funders and 0% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.1"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.1"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -694,8 +708,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with insufficient funders and 10% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0.1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.3"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0.1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.3"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -789,8 +805,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with insufficient funders and 10% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.1"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.1"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -884,8 +902,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with some insufficient funders and 0% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.1"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.1"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -977,8 +997,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with some insufficient funders and 10% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0.1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.3"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0.1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.3"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
@@ -1072,8 +1094,10 @@ var _ = Describe("inflation splitting", Ordered, func() {
 	It("Produce a valid bundle with some insufficient funders and 10% inflation splitting", func() {
 		// ARRANGE
 		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.1"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.1"),
+			MaxFunders:                pooltypes.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: pooltypes.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		// mine some blocks
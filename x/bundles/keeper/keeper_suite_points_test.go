@@ -3,6 +3,7 @@ package keeper_test
 import (
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
 	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,9 +20,13 @@ TEST CASES - points
 * One validator does not vote for multiple proposals in a row
 * One validator votes after having not voted previously multiple times
 * One validator does not vote for multiple proposals and reaches max points
+* Jailed validator can not vote and does not receive further points
 * One validator does not vote for multiple proposals and submits a bundle proposal
 * One validator does not vote for multiple proposals and skip the uploader role
 * One validator submits a bundle proposal where he reaches max points because he did not vote before
+* One validator does not vote for one proposal with weighted missed vote points
+* One validator votes after having not voted previously multiple times with points decay
+* One validator votes abstain and then valid in the same round with points decay
 
 */
 
@@ -301,13 +306,28 @@ var _ = Describe("points", Ordered, func() {
 
 		// ASSERT
 		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
-		Expect(poolStakers).To(HaveLen(1))
+		Expect(poolStakers).To(HaveLen(2))
+
+		unjailedStakers := s.App().StakersKeeper.GetAllUnjailedStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(unjailedStakers).To(HaveLen(1))
 
 		_, stakerFound := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
 		Expect(stakerFound).To(BeTrue())
 
-		_, valaccountFound := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
-		Expect(valaccountFound).To(BeFalse())
+		valaccount, valaccountFound := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccountFound).To(BeTrue())
+		Expect(valaccount.Jailed).To(BeTrue())
+		Expect(valaccount.JailedUntil).To(Equal(uint64(s.Ctx().BlockTime().Unix()) - 60 + s.App().StakersKeeper.GetJailTime(s.Ctx())))
+		Expect(valaccount.Points).To(BeZero())
+
+		// check if the slash got recorded
+		slashRecords := s.App().DelegationKeeper.GetAllSlashRecords(s.Ctx())
+		Expect(slashRecords).To(HaveLen(1))
+		Expect(slashRecords[0].Staker).To(Equal(i.STAKER_1))
+		Expect(slashRecords[0].SlashType).To(Equal(delegationtypes.SLASH_TYPE_TIMEOUT))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(slashRecords[0].BundleId).To(Equal(pool.TotalBundles - 1))
 
 		// check if voter got slashed
 		slashAmountRatio := s.App().DelegationKeeper.GetTimeoutSlash(s.Ctx())
@@ -316,6 +336,75 @@ var _ = Describe("points", Ordered, func() {
 		Expect(expectedBalance).To(Equal(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_1, i.STAKER_1)))
 	})
 
+	It("Jailed validator can not vote and does not receive further points", func() {
+		// ARRANGE
+		maxPoints := int(s.App().BundlesKeeper.GetMaxPoints(s.Ctx()))
+
+		for r := 1; r <= maxPoints; r++ {
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.CommitAfterSeconds(60)
+
+			// do not vote
+		}
+
+		// ACT
+		_, voteErr := s.RunTx(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     uint64((maxPoints + 1) * 100),
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+
+		// ASSERT
+		Expect(voteErr).To(MatchError(ContainSubstring(stakertypes.ErrValaccountJailed.Error())))
+
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccount.Jailed).To(BeTrue())
+		Expect(valaccount.Points).To(BeZero())
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_0))
+
+		// check if voter got slashed only once
+		slashAmountRatio := s.App().DelegationKeeper.GetTimeoutSlash(s.Ctx())
+		expectedBalance := 50*i.KYVE - uint64(sdk.NewDec(int64(50*i.KYVE)).Mul(slashAmountRatio).TruncateInt64())
+
+		Expect(expectedBalance).To(Equal(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_1, i.STAKER_1)))
+	})
+
 	It("One validator does not vote for multiple proposals and submits a bundle proposal", func() {
 		// ARRANGE
 		for r := 1; r <= 3; r++ {
@@ -468,4 +557,163 @@ var _ = Describe("points", Ordered, func() {
 		// points are instantly 1 because node did not vote on this bundle, too
 		Expect(valaccountVoter.Points).To(Equal(uint64(1)))
 	})
+
+	It("One validator does not vote for one proposal with weighted missed vote points", func() {
+		// ARRANGE
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.MissedVotePoints = 3
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		// do not vote
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+
+		// ASSERT
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccountVoter.Points).To(Equal(uint64(3)))
+		Expect(valaccountVoter.ConsecutiveVotes).To(BeZero())
+	})
+
+	It("One validator votes after having not voted previously multiple times with points decay", func() {
+		// ARRANGE
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.PointsDecayVotes = 2
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		for r := 1; r <= 3; r++ {
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.CommitAfterSeconds(60)
+
+			// do not vote
+		}
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccountVoter.Points).To(Equal(uint64(3)))
+		Expect(valaccountVoter.ConsecutiveVotes).To(Equal(uint64(1)))
+
+		// ACT
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "18SRvVuCrB8vy_OCLBaNbXONMVGeflGcw4gGTZ1oUt4",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     400,
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "18SRvVuCrB8vy_OCLBaNbXONMVGeflGcw4gGTZ1oUt4",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		valaccountVoter, _ = s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccountVoter.Points).To(Equal(uint64(2)))
+		Expect(valaccountVoter.ConsecutiveVotes).To(BeZero())
+	})
+
+	It("One validator votes abstain and then valid in the same round with points decay", func() {
+		// ARRANGE
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.PointsDecayVotes = 2
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		for r := 1; r <= 3; r++ {
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.CommitAfterSeconds(60)
+
+			// do not vote
+		}
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			Vote:      bundletypes.VOTE_TYPE_ABSTAIN,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccountVoter.Points).To(Equal(uint64(3)))
+		Expect(valaccountVoter.ConsecutiveVotes).To(Equal(uint64(1)))
+	})
 })
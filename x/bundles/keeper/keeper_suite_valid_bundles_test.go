@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"bytes"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v1p4 "github.com/KYVENetwork/chain/app/upgrades/v1_4"
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
 	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
@@ -23,6 +26,9 @@ TEST CASES - valid bundles
 * Produce a valid bundle with multiple validators and foreign delegation although some did not vote at all
 * Produce a valid bundle with multiple validators and foreign delegation although some voted abstain
 * Produce a valid bundle with multiple validators and foreign delegation although some voted invalid
+* Produce a valid bundle with one validator, foreign delegations and funders in whitelisted denoms
+* Produce a valid bundle with one validator and a share of the network fee for the insurance fund
+* Produce a valid bundle with delegation params stored without an insurance fund share
 
 */
 
@@ -149,6 +155,8 @@ var _ = Describe("valid bundles", Ordered, func() {
 		Expect(finalizedBundle.FinalizedAt).NotTo(BeZero())
 		Expect(finalizedBundle.StakeSecurity.ValidVotePower).To(Equal(100 * i.KYVE))
 		Expect(finalizedBundle.StakeSecurity.TotalVotePower).To(Equal(100 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawValidVotePower).To(Equal(100 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawTotalVotePower).To(Equal(100 * i.KYVE))
 
 		// check if next bundle proposal got registered
 		bundleProposal, bundleProposalFound := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
@@ -267,6 +275,8 @@ var _ = Describe("valid bundles", Ordered, func() {
 		Expect(finalizedBundle.FinalizedAt).NotTo(BeZero())
 		Expect(finalizedBundle.StakeSecurity.ValidVotePower).To(Equal(400 * i.KYVE))
 		Expect(finalizedBundle.StakeSecurity.TotalVotePower).To(Equal(400 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawValidVotePower).To(Equal(400 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawTotalVotePower).To(Equal(400 * i.KYVE))
 
 		// check if next bundle proposal got registered
 		bundleProposal, bundleProposalFound := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
@@ -407,6 +417,8 @@ var _ = Describe("valid bundles", Ordered, func() {
 		Expect(finalizedBundle.FinalizedAt).NotTo(BeZero())
 		Expect(finalizedBundle.StakeSecurity.ValidVotePower).To(Equal(200 * i.KYVE))
 		Expect(finalizedBundle.StakeSecurity.TotalVotePower).To(Equal(200 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawValidVotePower).To(Equal(200 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawTotalVotePower).To(Equal(200 * i.KYVE))
 
 		// check if next bundle proposal got registered
 		bundleProposal, bundleProposalFound := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
@@ -564,6 +576,8 @@ var _ = Describe("valid bundles", Ordered, func() {
 		Expect(finalizedBundle.FinalizedAt).NotTo(BeZero())
 		Expect(finalizedBundle.StakeSecurity.ValidVotePower).To(Equal(700 * i.KYVE))
 		Expect(finalizedBundle.StakeSecurity.TotalVotePower).To(Equal(700 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawValidVotePower).To(Equal(700 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawTotalVotePower).To(Equal(700 * i.KYVE))
 
 		// check if next bundle proposal got registered
 		bundleProposal, bundleProposalFound := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
@@ -728,6 +742,8 @@ var _ = Describe("valid bundles", Ordered, func() {
 		Expect(finalizedBundle.FinalizedAt).NotTo(BeZero())
 		Expect(finalizedBundle.StakeSecurity.ValidVotePower).To(Equal(400 * i.KYVE))
 		Expect(finalizedBundle.StakeSecurity.TotalVotePower).To(Equal(700 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawValidVotePower).To(Equal(400 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawTotalVotePower).To(Equal(700 * i.KYVE))
 
 		// check if next bundle proposal got registered
 		bundleProposal, bundleProposalFound := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
@@ -894,6 +910,8 @@ var _ = Describe("valid bundles", Ordered, func() {
 		Expect(finalizedBundle.FinalizedAt).NotTo(BeZero())
 		Expect(finalizedBundle.StakeSecurity.ValidVotePower).To(Equal(400 * i.KYVE))
 		Expect(finalizedBundle.StakeSecurity.TotalVotePower).To(Equal(700 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawValidVotePower).To(Equal(400 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawTotalVotePower).To(Equal(700 * i.KYVE))
 
 		// check if next bundle proposal got registered
 		bundleProposal, bundleProposalFound := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
@@ -1060,6 +1078,8 @@ var _ = Describe("valid bundles", Ordered, func() {
 		Expect(finalizedBundle.FinalizedAt).NotTo(BeZero())
 		Expect(finalizedBundle.StakeSecurity.ValidVotePower).To(Equal(400 * i.KYVE))
 		Expect(finalizedBundle.StakeSecurity.TotalVotePower).To(Equal(700 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawValidVotePower).To(Equal(400 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.RawTotalVotePower).To(Equal(700 * i.KYVE))
 
 		// check if next bundle proposal got registered
 		bundleProposal, bundleProposalFound := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
@@ -1142,4 +1162,240 @@ var _ = Describe("valid bundles", Ordered, func() {
 		Expect(pool.Funders).To(HaveLen(1))
 		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(100*i.KYVE - pool.OperatingCost))
 	})
+
+	It("Produce a valid bundle with one validator, foreign delegations and funders in whitelisted denoms", func() {
+		// ARRANGE
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.WhitelistedDenoms = []string{i.IBC_DENOM}
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		_ = s.MintDenom(i.BOB, i.IBC_DENOM, 100*i.KYVE)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.BOB,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.ALICE,
+			Staker:  i.STAKER_0,
+			Amount:  300 * i.KYVE,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     0,
+			BundleSize:    100,
+			FromKey:       "0",
+			ToKey:         "99",
+			BundleSummary: "test_value",
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash2",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "100",
+			ToKey:         "199",
+			BundleSummary: "test_value2",
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalBundles).To(Equal(uint64(1)))
+
+		uploader, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		// calculate rewards in whitelisted denom
+		networkFee := s.App().BundlesKeeper.GetNetworkFee(s.Ctx())
+		treasuryReward := uint64(sdk.NewDec(int64(1 * i.KYVE)).Mul(networkFee).TruncateInt64())
+		totalUploaderReward := 1*i.KYVE - treasuryReward
+
+		uploaderPayoutReward := uint64(sdk.NewDec(int64(totalUploaderReward)).Mul(uploader.Commission).TruncateInt64())
+		totalDelegationReward := totalUploaderReward - uploaderPayoutReward
+
+		// divide with 4 because uploader only has 25% of total delegation
+		uploaderDelegationReward := uint64(sdk.NewDec(int64(totalDelegationReward)).Quo(sdk.NewDec(4)).TruncateInt64())
+		delegatorDelegationReward := uint64(sdk.NewDec(int64(totalDelegationReward)).Quo(sdk.NewDec(4)).Mul(sdk.NewDec(3)).TruncateInt64())
+
+		// assert treasury payout
+		communityPool := s.App().DistributionKeeper.GetFeePoolCommunityCoins(s.Ctx())
+		Expect(communityPool.AmountOf(i.IBC_DENOM).TruncateInt().Uint64()).To(Equal(treasuryReward))
+		// assert uploader payout is added to the commission rewards
+		Expect(s.GetBalanceOfDenomFromAddress(i.STAKER_0, i.IBC_DENOM)).To(BeZero())
+		Expect(uploader.CommissionCoinRewards.AmountOf(i.IBC_DENOM).Uint64()).To(Equal(uploaderPayoutReward))
+		// assert uploader self delegation rewards
+		Expect(s.App().DelegationKeeper.GetOutstandingCoinRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.IBC_DENOM).Uint64()).To(Equal(uploaderDelegationReward))
+		// assert delegator delegation rewards
+		Expect(s.App().DelegationKeeper.GetOutstandingCoinRewards(s.Ctx(), i.STAKER_0, i.ALICE).AmountOf(i.IBC_DENOM).Uint64()).To(Equal(delegatorDelegationReward))
+
+		// check pool funds
+		Expect(pool.Funders).To(HaveLen(2))
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(100*i.KYVE - pool.OperatingCost))
+		Expect(pool.TotalFundsPerDenom.AmountOf(i.IBC_DENOM).Uint64()).To(Equal(99 * i.KYVE))
+
+		// ACT
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgWithdrawRewards{
+			Creator: i.ALICE,
+			Staker:  i.STAKER_0,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgClaimCommissionRewards{
+			Creator: i.STAKER_0,
+			Coins:   uploader.CommissionCoinRewards,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceOfDenomFromAddress(i.ALICE, i.IBC_DENOM)).To(Equal(delegatorDelegationReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingCoinRewards(s.Ctx(), i.STAKER_0, i.ALICE)).To(BeEmpty())
+
+		uploader, _ = s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(uploader.CommissionCoinRewards).To(BeEmpty())
+		Expect(s.GetBalanceOfDenomFromAddress(i.STAKER_0, i.IBC_DENOM)).To(Equal(uploaderPayoutReward))
+	})
+
+	It("Produce a valid bundle with one validator and a share of the network fee for the insurance fund", func() {
+		// ARRANGE
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.InsuranceFundShare = sdk.MustNewDecFromStr("0.5")
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     0,
+			BundleSize:    100,
+			FromKey:       "0",
+			ToKey:         "99",
+			BundleSummary: "test_value",
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash2",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "100",
+			ToKey:         "199",
+			BundleSummary: "test_value2",
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalBundles).To(Equal(uint64(1)))
+
+		uploader, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		// calculate uploader rewards
+		networkFee := uint64(sdk.NewDec(int64(pool.OperatingCost)).Mul(s.App().BundlesKeeper.GetNetworkFee(s.Ctx())).TruncateInt64())
+		insuranceReward := uint64(sdk.NewDec(int64(networkFee)).Mul(params.InsuranceFundShare).TruncateInt64())
+		storageReward := uint64(s.App().BundlesKeeper.GetStorageCost(s.Ctx()).MulInt64(100).TruncateInt64())
+		totalUploaderReward := pool.OperatingCost - networkFee - storageReward
+
+		uploaderPayoutReward := uint64(sdk.NewDec(int64(totalUploaderReward)).Mul(uploader.Commission).TruncateInt64())
+		uploaderDelegationReward := totalUploaderReward - uploaderPayoutReward
+
+		// assert insurance fund
+		insuranceFund := s.App().DelegationKeeper.GetInsuranceFund(s.Ctx())
+		Expect(insuranceReward).To(Equal(networkFee / 2))
+		Expect(insuranceFund.Balance).To(Equal(insuranceReward))
+		Expect(insuranceFund.TotalDeposited).To(Equal(insuranceReward))
+
+		// assert uploader rewards are not affected by the insurance share
+		Expect(uploader.CommissionRewards).To(Equal(uploaderPayoutReward + storageReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(uploaderDelegationReward))
+
+		// check pool funds
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(100*i.KYVE - pool.OperatingCost))
+	})
+
+	It("Produce a valid bundle with delegation params stored without an insurance fund share", func() {
+		// ARRANGE
+		// store the delegation params as they were before the insurance fund share existed,
+		// 0x3a is the tag of the insurance fund share field (field 7, length-delimited)
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.InsuranceFundShare = sdk.MustNewDecFromStr("0.123456789")
+		bz := s.App().AppCodec().MustMarshal(&params)
+
+		share, _ := params.InsuranceFundShare.Marshal()
+		field := append([]byte{0x3a, byte(len(share))}, share...)
+		Expect(bytes.Contains(bz, field)).To(BeTrue())
+
+		store := s.Ctx().KVStore(s.App().GetKey(delegationtypes.StoreKey))
+		store.Set(delegationtypes.ParamsKey, bytes.Replace(bz, field, nil, 1))
+		Expect(s.App().DelegationKeeper.GetParams(s.Ctx()).InsuranceFundShare.IsNil()).To(BeTrue())
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     0,
+			BundleSize:    100,
+			FromKey:       "0",
+			ToKey:         "99",
+			BundleSummary: "test_value",
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash2",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "100",
+			ToKey:         "199",
+			BundleSummary: "test_value2",
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalBundles).To(Equal(uint64(1)))
+
+		// the missing share is treated as zero
+		Expect(s.App().DelegationKeeper.GetInsuranceFundShare(s.Ctx())).To(Equal(sdk.ZeroDec()))
+		Expect(s.App().DelegationKeeper.GetInsuranceFund(s.Ctx()).Balance).To(BeZero())
+
+		// check pool funds
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(100*i.KYVE - pool.OperatingCost))
+
+		// the upgrade handler initialises the missing share
+		v1p4.SetDelegationParams(s.Ctx(), s.App().DelegationKeeper)
+		Expect(s.App().DelegationKeeper.GetParams(s.Ctx()).InsuranceFundShare).To(Equal(delegationtypes.DefaultInsuranceFundShare))
+	})
 })
@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"math/big"
+
 	"cosmossdk.io/errors"
 
 	delegationTypes "github.com/KYVENetwork/chain/x/delegation/types"
+	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakersTypes "github.com/KYVENetwork/chain/x/stakers/types"
 
 	"github.com/KYVENetwork/chain/util"
 	"github.com/KYVENetwork/chain/x/bundles/types"
@@ -29,7 +33,7 @@ func (k Keeper) AssertPoolCanRun(ctx sdk.Context, poolId uint64) error {
 	}
 
 	// Error if min delegation is not reached
-	if k.delegationKeeper.GetDelegationOfPool(ctx, pool.Id) < pool.MinDelegation {
+	if k.getUnjailedDelegationOfPool(ctx, pool.Id) < pool.MinDelegation {
 		return types.ErrMinDelegationNotReached
 	}
 
@@ -39,6 +43,82 @@ func (k Keeper) AssertPoolCanRun(ctx sdk.Context, poolId uint64) error {
 // AssertCanVote checks whether a participant in the network can vote on
 // a bundle proposal in a storage pool
 func (k Keeper) AssertCanVote(ctx sdk.Context, poolId uint64, staker string, voter string, storageId string) error {
+	if err := k.assertCanParticipateInVote(ctx, poolId, staker, voter, storageId); err != nil {
+		return err
+	}
+
+	// Votes of commit-reveal pools have to be committed first
+	pool, _ := k.poolKeeper.GetPoolWithError(ctx, poolId)
+	if pool.VotingMode == poolTypes.VOTING_MODE_COMMIT_REVEAL {
+		return types.ErrCommitRevealRequired
+	}
+
+	return nil
+}
+
+// AssertCanCommitVote checks whether a participant in the network can commit
+// to a hashed vote on a bundle proposal in a commit-reveal storage pool
+func (k Keeper) AssertCanCommitVote(ctx sdk.Context, poolId uint64, staker string, voter string, storageId string) error {
+	if err := k.assertCanParticipateInVote(ctx, poolId, staker, voter, storageId); err != nil {
+		return err
+	}
+
+	pool, _ := k.poolKeeper.GetPoolWithError(ctx, poolId)
+	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
+
+	if pool.VotingMode != poolTypes.VOTING_MODE_COMMIT_REVEAL {
+		return types.ErrCommitRevealDisabled
+	}
+
+	// Check if the commit phase is still ongoing
+	if uint64(ctx.BlockTime().Unix()) >= (bundleProposal.UpdatedAt + pool.UploadInterval) {
+		return errors.Wrapf(types.ErrCommitPhaseOver, "expected %v < %v", ctx.BlockTime().Unix(), bundleProposal.UpdatedAt+pool.UploadInterval)
+	}
+
+	// Check if the sender has already committed to a vote on the bundle
+	if _, found := getVoteCommitment(bundleProposal, staker); found {
+		return types.ErrAlreadyCommitted
+	}
+
+	if util.ContainsString(bundleProposal.VotersAbstain, staker) {
+		return types.ErrAlreadyVotedAbstain
+	}
+
+	return nil
+}
+
+// AssertCanRevealVote checks whether a participant in the network can reveal
+// the vote it committed to on a bundle proposal in a commit-reveal storage pool
+func (k Keeper) AssertCanRevealVote(ctx sdk.Context, poolId uint64, staker string, voter string, storageId string) error {
+	if err := k.assertCanParticipateInVote(ctx, poolId, staker, voter, storageId); err != nil {
+		return err
+	}
+
+	pool, _ := k.poolKeeper.GetPoolWithError(ctx, poolId)
+	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
+
+	if pool.VotingMode != poolTypes.VOTING_MODE_COMMIT_REVEAL {
+		return types.ErrCommitRevealDisabled
+	}
+
+	// Check if the reveal phase is ongoing
+	revealStart := bundleProposal.UpdatedAt + pool.UploadInterval
+	revealEnd := revealStart + pool.GetEffectiveRevealWindow()
+	if now := uint64(ctx.BlockTime().Unix()); now < revealStart || now >= revealEnd {
+		return errors.Wrapf(types.ErrNotInRevealPhase, "expected %v <= %v < %v", revealStart, now, revealEnd)
+	}
+
+	// Check if the sender has committed to a vote on the bundle
+	if _, found := getVoteCommitment(bundleProposal, staker); !found {
+		return types.ErrNoVoteCommitment
+	}
+
+	return nil
+}
+
+// assertCanParticipateInVote checks whether a participant in the network is allowed
+// to take part in the voting on a bundle proposal in a storage pool
+func (k Keeper) assertCanParticipateInVote(ctx sdk.Context, poolId uint64, staker string, voter string, storageId string) error {
 	// Check basic pool configs
 	if err := k.AssertPoolCanRun(ctx, poolId); err != nil {
 		return err
@@ -97,9 +177,10 @@ func (k Keeper) AssertCanPropose(ctx sdk.Context, poolId uint64, staker string,
 		return errors.Wrapf(types.ErrNotDesignatedUploader, "expected %v received %v", bundleProposal.NextUploader, staker)
 	}
 
-	// Check if upload interval has been surpassed
-	if uint64(ctx.BlockTime().Unix()) < (bundleProposal.UpdatedAt + pool.UploadInterval) {
-		return errors.Wrapf(types.ErrUploadInterval, "expected %v < %v", ctx.BlockTime().Unix(), bundleProposal.UpdatedAt+pool.UploadInterval)
+	// Check if upload interval has been surpassed. Pools with commit-reveal
+	// voting additionally have to wait until the reveal window is over
+	if uint64(ctx.BlockTime().Unix()) < (bundleProposal.UpdatedAt + pool.UploadInterval + pool.GetEffectiveRevealWindow()) {
+		return errors.Wrapf(types.ErrUploadInterval, "expected %v < %v", ctx.BlockTime().Unix(), bundleProposal.UpdatedAt+pool.UploadInterval+pool.GetEffectiveRevealWindow())
 	}
 
 	// Check if from_index matches
@@ -110,6 +191,76 @@ func (k Keeper) AssertCanPropose(ctx sdk.Context, poolId uint64, staker string,
 	return nil
 }
 
+// AssertCanChallenge checks whether a staker can challenge a finalized
+// bundle of a storage pool
+func (k Keeper) AssertCanChallenge(ctx sdk.Context, poolId uint64, bundleId uint64, challenger string) error {
+	if err := k.poolKeeper.AssertPoolExists(ctx, poolId); err != nil {
+		return err
+	}
+
+	// Check if sender is a staker
+	if !k.stakerKeeper.DoesStakerExist(ctx, challenger) {
+		return stakersTypes.ErrNoStaker
+	}
+
+	finalizedBundle, found := k.GetFinalizedBundle(ctx, poolId, bundleId)
+	if !found {
+		return errors.Wrapf(types.ErrBundleNotFound, "pool %v bundle %v", poolId, bundleId)
+	}
+
+	// Check if the bundle can still be challenged
+	challengeEnd := finalizedBundle.FinalizedAt.Timestamp + k.GetChallengeWindow(ctx)
+	if uint64(ctx.BlockTime().Unix()) >= challengeEnd {
+		return errors.Wrapf(types.ErrChallengeWindowOver, "expected %v < %v", ctx.BlockTime().Unix(), challengeEnd)
+	}
+
+	// Every bundle can only be challenged once
+	if _, found := k.GetBundleChallenge(ctx, poolId, bundleId); found || finalizedBundle.Invalidated {
+		return types.ErrBundleAlreadyChallenged
+	}
+
+	return nil
+}
+
+// AssertCanVoteOnChallenge checks whether a participant in the network can vote
+// on an open challenge of a finalized bundle
+func (k Keeper) AssertCanVoteOnChallenge(ctx sdk.Context, poolId uint64, bundleId uint64, staker string, voter string) error {
+	// Check if sender is a staker in pool
+	if err := k.stakerKeeper.AssertValaccountAuthorized(ctx, poolId, staker, voter); err != nil {
+		return err
+	}
+
+	challenge, found := k.GetBundleChallenge(ctx, poolId, bundleId)
+	if !found {
+		return errors.Wrapf(types.ErrChallengeNotFound, "pool %v bundle %v", poolId, bundleId)
+	}
+
+	// Check if the voting period of the challenge is still ongoing
+	votingEnd := challenge.CreatedAt + k.GetChallengeVotingPeriod(ctx)
+	if challenge.Status != types.CHALLENGE_STATUS_OPEN || uint64(ctx.BlockTime().Unix()) >= votingEnd {
+		return types.ErrChallengeNotOpen
+	}
+
+	// Check if the sender has already voted on the challenge
+	if util.ContainsString(challenge.VotersValid, staker) || util.ContainsString(challenge.VotersInvalid, staker) {
+		return types.ErrAlreadyVotedOnChallenge
+	}
+
+	// The uploader and the valid voters of the bundle are accused by the challenge
+	finalizedBundle, _ := k.GetFinalizedBundle(ctx, poolId, bundleId)
+	if isAccusedStaker(finalizedBundle, staker) {
+		return types.ErrAccusedCannotVote
+	}
+
+	return nil
+}
+
+// isAccusedStaker returns whether the given staker uploaded or voted valid on the
+// given finalized bundle and is therefore accused by a challenge of that bundle.
+func isAccusedStaker(finalizedBundle types.FinalizedBundle, staker string) bool {
+	return finalizedBundle.Uploader == staker || util.ContainsString(finalizedBundle.VotersValid, staker)
+}
+
 // validateSubmitBundleArgs validates various bundle proposal metadata for correctness and
 // fails if at least one requirement is not met
 func (k Keeper) validateSubmitBundleArgs(ctx sdk.Context, bundleProposal *types.BundleProposal, msg *types.MsgSubmitBundleProposal) error {
@@ -146,10 +297,30 @@ func (k Keeper) validateSubmitBundleArgs(ctx sdk.Context, bundleProposal *types.
 	return nil
 }
 
+// slashDelegatorsAndJailStaker slashes a staker with a certain slashType and all including
+// delegators and jails his valaccount in the storage pool
+func (k Keeper) slashDelegatorsAndJailStaker(ctx sdk.Context, poolId uint64, stakerAddress string, slashType delegationTypes.SlashType) {
+	k.delegationKeeper.SlashDelegators(ctx, poolId, stakerAddress, slashType, k.getCurrentBundleId(ctx, poolId))
+	k.stakerKeeper.JailValaccount(ctx, poolId, stakerAddress)
+}
+
+// getCurrentBundleId returns the id the current bundle proposal of the pool
+// gets once it is finalized
+func (k Keeper) getCurrentBundleId(ctx sdk.Context, poolId uint64) uint64 {
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+	return pool.TotalBundles
+}
+
+// isParticipatingStaker returns true if the staker has a valaccount in the given
+// pool which is not jailed and is therefore allowed to upload and vote
+func (k Keeper) isParticipatingStaker(ctx sdk.Context, poolId uint64, stakerAddress string) bool {
+	return k.stakerKeeper.DoesValaccountExist(ctx, poolId, stakerAddress) && !k.stakerKeeper.IsValaccountJailed(ctx, poolId, stakerAddress)
+}
+
 // slashDelegatorsAndRemoveStaker slashes a staker with a certain slashType and all including
 // delegators and removes him from the storage pool
 func (k Keeper) slashDelegatorsAndRemoveStaker(ctx sdk.Context, poolId uint64, stakerAddress string, slashType delegationTypes.SlashType) {
-	k.delegationKeeper.SlashDelegators(ctx, poolId, stakerAddress, slashType)
+	k.delegationKeeper.SlashDelegators(ctx, poolId, stakerAddress, slashType, k.getCurrentBundleId(ctx, poolId))
 	k.stakerKeeper.LeavePool(ctx, stakerAddress, poolId)
 }
 
@@ -166,11 +337,32 @@ func (k Keeper) resetPoints(ctx sdk.Context, poolId uint64, stakerAddress string
 	}
 }
 
-// addPoint increases the points of a valaccount with one and automatically
-// slashes and removes the staker once he reaches max points
-func (k Keeper) addPoint(ctx sdk.Context, poolId uint64, stakerAddress string) {
-	// Add one point to staker in given pool
-	points := k.stakerKeeper.IncrementPoints(ctx, poolId, stakerAddress)
+// decayPoints is called after a successful vote of a valaccount. If the points decay is
+// disabled the points are reset to zero, else one point is removed after the valaccount
+// voted `PointsDecayVotes` times in a row
+func (k Keeper) decayPoints(ctx sdk.Context, poolId uint64, stakerAddress string) {
+	decayVotes := k.GetPointsDecayVotes(ctx)
+	if decayVotes == 0 {
+		k.resetPoints(ctx, poolId, stakerAddress)
+		return
+	}
+
+	points, decayed := k.stakerKeeper.DecayPoints(ctx, poolId, stakerAddress, decayVotes)
+
+	if decayed {
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventPointDecayed{
+			PoolId:        poolId,
+			Staker:        stakerAddress,
+			CurrentPoints: points,
+		})
+	}
+}
+
+// addPoints increases the points of a valaccount by the given amount and automatically
+// slashes the delegators and jails the staker in the pool once he reaches max points
+func (k Keeper) addPoints(ctx sdk.Context, poolId uint64, stakerAddress string, amount uint64) {
+	// Add the points to staker in given pool
+	points := k.stakerKeeper.IncreasePoints(ctx, poolId, stakerAddress, amount)
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventPointIncreased{
 		PoolId:        poolId,
@@ -179,16 +371,26 @@ func (k Keeper) addPoint(ctx sdk.Context, poolId uint64, stakerAddress string) {
 	})
 
 	if points >= k.GetMaxPoints(ctx) {
-		// slash all delegators with a timeout slash and remove staker from pool.
-		// points are reset due to the valaccount being deleted while leaving the pool
-		k.slashDelegatorsAndRemoveStaker(ctx, poolId, stakerAddress, delegationTypes.SLASH_TYPE_TIMEOUT)
+		// slash all delegators with a timeout slash and jail the staker in the pool.
+		// points are reset while jailing the valaccount
+		k.slashDelegatorsAndJailStaker(ctx, poolId, stakerAddress, delegationTypes.SLASH_TYPE_TIMEOUT)
+	}
+}
+
+// getVoteCommitment returns the vote commitment of the given staker on the bundle proposal
+func getVoteCommitment(bundleProposal types.BundleProposal, staker string) (commitment string, found bool) {
+	for _, voteCommitment := range bundleProposal.VoteCommitments {
+		if voteCommitment.Staker == staker {
+			return voteCommitment.Commitment, true
+		}
 	}
+	return "", false
 }
 
 // handleNonVoters checks if stakers in a pool voted on the current bundle proposal
 // if a staker did not vote at all on a bundle proposal he received points
 // if a staker receives a certain number of points he receives a timeout slash and gets
-// kicked out of a pool
+// jailed in the pool. Jailed stakers are not expected to vote. Commitments which were not revealed do not count as a vote.
 func (k Keeper) handleNonVoters(ctx sdk.Context, poolId uint64) {
 	voters := map[string]bool{}
 	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
@@ -205,16 +407,16 @@ func (k Keeper) handleNonVoters(ctx sdk.Context, poolId uint64) {
 		voters[address] = true
 	}
 
-	for _, staker := range k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, poolId) {
+	for _, staker := range k.stakerKeeper.GetAllUnjailedStakerAddressesOfPool(ctx, poolId) {
 		if !voters[staker] {
-			k.addPoint(ctx, poolId, staker)
+			k.addPoints(ctx, poolId, staker, k.GetMissedVotePoints(ctx))
 		}
 	}
 }
 
 // calculatePayouts calculates the different payouts to treasury, uploader and delegators from the total payout
-// the pool module provides for this bundle round
-func (k Keeper) calculatePayouts(ctx sdk.Context, poolId uint64, totalPayout uint64) (bundleReward types.BundleReward) {
+// and the total payout in non-native denoms the pool module provides for this bundle round
+func (k Keeper) calculatePayouts(ctx sdk.Context, poolId uint64, totalPayout uint64, totalCoinsPayout sdk.Coins) (bundleReward types.BundleReward) {
 	// This method first subtracts the network fee from it
 	// After that the uploader receives the storage rewards. If the total payout does not cover the
 	// storage rewards we pay out the remains, the commission and delegation rewards will be empty
@@ -227,10 +429,17 @@ func (k Keeper) calculatePayouts(ctx sdk.Context, poolId uint64, totalPayout uin
 		return
 	}
 
+	// non-native denoms are split independently of the storage rewards
+	k.calculateCoinPayouts(ctx, bundleProposal.Uploader, totalCoinsPayout, &bundleReward)
+
 	bundleReward.Total = totalPayout
 
-	// calculate share of treasury from total payout
-	bundleReward.Treasury = uint64(sdk.NewDec(int64(totalPayout)).Mul(k.GetNetworkFee(ctx)).TruncateInt64())
+	// calculate network fee from total payout
+	networkFee := uint64(sdk.NewDec(int64(totalPayout)).Mul(k.GetNetworkFee(ctx)).TruncateInt64())
+
+	// a share of the network fee funds the insurance fund, the rest goes to the treasury
+	bundleReward.Insurance = uint64(sdk.NewDec(int64(networkFee)).Mul(k.delegationKeeper.GetInsuranceFundShare(ctx)).TruncateInt64())
+	bundleReward.Treasury = networkFee - bundleReward.Insurance
 
 	// calculate wanted storage reward the uploader should receive
 	storageReward := uint64(k.GetStorageCost(ctx).MulInt64(int64(bundleProposal.DataSize)).TruncateInt64())
@@ -238,15 +447,15 @@ func (k Keeper) calculatePayouts(ctx sdk.Context, poolId uint64, totalPayout uin
 	// if not even the full storage reward can not be paid out we pay out the remains.
 	// in this case the uploader will not earn the commission rewards and delegators not
 	// their delegation rewards because total payout is not high enough
-	if totalPayout-bundleReward.Treasury < storageReward {
-		bundleReward.Uploader = totalPayout - bundleReward.Treasury
+	if totalPayout-networkFee < storageReward {
+		bundleReward.Uploader = totalPayout - networkFee
 		return
 	} else {
 		bundleReward.Uploader = storageReward
 	}
 
 	// remaining rewards to be split between uploader and its delegators
-	totalNodeReward := totalPayout - bundleReward.Treasury - bundleReward.Uploader
+	totalNodeReward := totalPayout - networkFee - bundleReward.Uploader
 
 	// payout delegators
 	if k.delegationKeeper.GetDelegationAmount(ctx, bundleProposal.Uploader) > 0 {
@@ -263,6 +472,38 @@ func (k Keeper) calculatePayouts(ctx sdk.Context, poolId uint64, totalPayout uin
 	return
 }
 
+// calculateCoinPayouts splits the payout in non-native denoms between treasury, uploader and
+// delegators. The storage rewards are only paid out in ukyve, therefore every denom is
+// only divided by the network fee and the commission of the uploader.
+func (k Keeper) calculateCoinPayouts(ctx sdk.Context, uploader string, totalCoinsPayout sdk.Coins, bundleReward *types.BundleReward) {
+	bundleReward.TotalCoins = totalCoinsPayout
+	bundleReward.TreasuryCoins = sdk.NewCoins()
+	bundleReward.UploaderCoins = sdk.NewCoins()
+	bundleReward.DelegationCoins = sdk.NewCoins()
+
+	networkFee := k.GetNetworkFee(ctx)
+	commission := k.stakerKeeper.GetCommission(ctx, uploader)
+	hasDelegation := k.delegationKeeper.GetDelegationAmount(ctx, uploader) > 0
+
+	for _, coin := range totalCoinsPayout {
+		// calculate share of treasury from total payout
+		treasury := sdk.NewDecFromInt(coin.Amount).Mul(networkFee).TruncateInt()
+		bundleReward.TreasuryCoins = bundleReward.TreasuryCoins.Add(sdk.NewCoin(coin.Denom, treasury))
+
+		// remaining rewards to be split between uploader and its delegators
+		totalNodeReward := coin.Amount.Sub(treasury)
+
+		if hasDelegation {
+			commissionRewards := sdk.NewDecFromInt(totalNodeReward).Mul(commission).TruncateInt()
+
+			bundleReward.UploaderCoins = bundleReward.UploaderCoins.Add(sdk.NewCoin(coin.Denom, commissionRewards))
+			bundleReward.DelegationCoins = bundleReward.DelegationCoins.Add(sdk.NewCoin(coin.Denom, totalNodeReward.Sub(commissionRewards)))
+		} else {
+			bundleReward.UploaderCoins = bundleReward.UploaderCoins.Add(sdk.NewCoin(coin.Denom, totalNodeReward))
+		}
+	}
+}
+
 // registerBundleProposalFromUploader handles the registration of the new bundle proposal
 // an uploader has just submitted. With this new bundle proposal other participants
 // can vote on it.
@@ -341,9 +582,12 @@ func (k Keeper) finalizeCurrentBundleProposal(ctx sdk.Context, poolId uint64, vo
 		StorageProviderId: bundleProposal.StorageProviderId,
 		CompressionId:     bundleProposal.CompressionId,
 		StakeSecurity: &types.StakeSecurity{
-			ValidVotePower: voteDistribution.Valid,
-			TotalVotePower: voteDistribution.Total,
+			ValidVotePower:    voteDistribution.Valid,
+			TotalVotePower:    voteDistribution.Total,
+			RawValidVotePower: voteDistribution.RawValid,
+			RawTotalVotePower: voteDistribution.RawTotal,
 		},
+		VotersValid: bundleProposal.VotersValid,
 	}
 
 	k.SetFinalizedBundle(ctx, finalizedBundle)
@@ -365,6 +609,11 @@ func (k Keeper) finalizeCurrentBundleProposal(ctx sdk.Context, poolId uint64, vo
 		FinalizedAt:      uint64(ctx.BlockTime().Unix()),
 		Uploader:         bundleProposal.Uploader,
 		NextUploader:     nextUploader,
+
+		FundersPayoutCoins:    bundleReward.TotalCoins,
+		RewardTreasuryCoins:   bundleReward.TreasuryCoins,
+		RewardUploaderCoins:   bundleReward.UploaderCoins,
+		RewardDelegationCoins: bundleReward.DelegationCoins,
 	})
 
 	// Finalize the proposal, saving useful information.
@@ -406,16 +655,54 @@ func (k Keeper) dropCurrentBundleProposal(ctx sdk.Context, poolId uint64, voteDi
 }
 
 // calculateVotingPower calculates the voting power one staker has in a
-// storage pool based only on the total delegation this staker has
-func (k Keeper) calculateVotingPower(delegation uint64) (votingPower uint64) {
-	// voting power is linear
-	votingPower = delegation
+// storage pool based on the total delegation this staker has. How the
+// delegation translates to voting power is defined by the VotingPowerCurve
+// param. The total delegation of the pool is required for the capped curve.
+func (k Keeper) calculateVotingPower(ctx sdk.Context, delegation uint64, totalDelegation uint64) (votingPower uint64) {
+	switch k.GetVotingPowerCurve(ctx) {
+	case types.VOTING_POWER_CURVE_SQUARE_ROOT:
+		// voting power is the square root of the delegation
+		votingPower = new(big.Int).Sqrt(new(big.Int).SetUint64(delegation)).Uint64()
+	case types.VOTING_POWER_CURVE_CAPPED:
+		// voting power is linear, but can not exceed the cap
+		maxVotingPower := k.GetVotingPowerCap(ctx).MulInt64(int64(totalDelegation)).TruncateInt().Uint64()
+		if delegation > maxVotingPower {
+			votingPower = maxVotingPower
+		} else {
+			votingPower = delegation
+		}
+	default:
+		// voting power is linear
+		votingPower = delegation
+	}
+	return
+}
+
+// getUnjailedDelegationOfPool returns the total delegation of all stakers in the
+// given pool which are not jailed. Jailed stakers can not vote, so their delegation
+// must not count towards the voting power of the pool.
+func (k Keeper) getUnjailedDelegationOfPool(ctx sdk.Context, poolId uint64) (totalDelegation uint64) {
+	for _, staker := range k.stakerKeeper.GetAllUnjailedStakerAddressesOfPool(ctx, poolId) {
+		totalDelegation += k.delegationKeeper.GetDelegationAmount(ctx, staker)
+	}
 	return
 }
 
+// usesStakeWeightedRandomSelection returns whether the given pool selects its uploaders
+// pseudo-randomly weighted by delegation instead of using the round-robin.
+func (k Keeper) usesStakeWeightedRandomSelection(ctx sdk.Context, poolId uint64) bool {
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+	return pool.UploaderSelection == poolTypes.UPLOADER_SELECTION_STAKE_WEIGHTED_RANDOM
+}
+
 // chooseNextUploader selects the next uploader based on a fixed set of stakers in a pool.
 // It is guaranteed that someone is chosen deterministically if the round-robin set itself is not empty.
 func (k Keeper) chooseNextUploader(ctx sdk.Context, poolId uint64, excluded ...string) (nextUploader string) {
+	if k.usesStakeWeightedRandomSelection(ctx, poolId) {
+		vs := k.LoadStakeWeightedValidatorSet(ctx, poolId)
+		return vs.NextProposer(k.GetUploaderSelectionSeed(ctx, poolId), excluded...)
+	}
+
 	vs := k.LoadRoundRobinValidatorSet(ctx, poolId)
 	nextUploader = vs.NextProposer(excluded...)
 	k.SaveRoundRobinValidatorSet(ctx, vs)
@@ -425,13 +712,27 @@ func (k Keeper) chooseNextUploader(ctx sdk.Context, poolId uint64, excluded ...s
 // chooseNextUploader selects the next uploader based on a fixed set of stakers in a pool.
 // It is guaranteed that someone is chosen deterministically if the round-robin set itself is not empty.
 func (k Keeper) chooseNextUploaderFromList(ctx sdk.Context, poolId uint64, included []string) (nextUploader string) {
-	vs := k.LoadRoundRobinValidatorSet(ctx, poolId)
-
 	// Calculate set difference to obtain excluded
 	includedMap := make(map[string]bool)
 	for _, entry := range included {
 		includedMap[entry] = true
 	}
+
+	if k.usesStakeWeightedRandomSelection(ctx, poolId) {
+		vs := k.LoadStakeWeightedValidatorSet(ctx, poolId)
+
+		excluded := make([]string, 0)
+		for _, entry := range vs.Validators {
+			if !includedMap[entry.Address] {
+				excluded = append(excluded, entry.Address)
+			}
+		}
+
+		return vs.NextProposer(k.GetUploaderSelectionSeed(ctx, poolId), excluded...)
+	}
+
+	vs := k.LoadRoundRobinValidatorSet(ctx, poolId)
+
 	excluded := make([]string, 0)
 	for _, entry := range vs.Validators {
 		if !includedMap[entry.Address] {
@@ -452,50 +753,59 @@ func (k Keeper) GetVoteDistribution(ctx sdk.Context, poolId uint64) (voteDistrib
 		return
 	}
 
+	// get total delegation of all stakers which are not jailed in the pool
+	totalDelegation := k.getUnjailedDelegationOfPool(ctx, poolId)
+
 	// get voting power for valid
 	for _, voter := range bundleProposal.VotersValid {
-		// valaccount was found the voter is active in the pool
-		if k.stakerKeeper.DoesValaccountExist(ctx, poolId, voter) {
+		// valaccount was found and not jailed, the voter is active in the pool
+		if k.isParticipatingStaker(ctx, poolId, voter) {
 			delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
-			voteDistribution.Valid += k.calculateVotingPower(delegation)
+			voteDistribution.Valid += k.calculateVotingPower(ctx, delegation, totalDelegation)
+			voteDistribution.RawValid += delegation
 		}
 	}
 
 	// get voting power for invalid
 	for _, voter := range bundleProposal.VotersInvalid {
-		// valaccount was found the voter is active in the pool
-		if k.stakerKeeper.DoesValaccountExist(ctx, poolId, voter) {
+		// valaccount was found and not jailed, the voter is active in the pool
+		if k.isParticipatingStaker(ctx, poolId, voter) {
 			delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
-			voteDistribution.Invalid += k.calculateVotingPower(delegation)
+			voteDistribution.Invalid += k.calculateVotingPower(ctx, delegation, totalDelegation)
 		}
 	}
 
 	// get voting power for abstain
 	for _, voter := range bundleProposal.VotersAbstain {
-		// valaccount was found the voter is active in the pool
-		if k.stakerKeeper.DoesValaccountExist(ctx, poolId, voter) {
+		// valaccount was found and not jailed, the voter is active in the pool
+		if k.isParticipatingStaker(ctx, poolId, voter) {
 			delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
-			voteDistribution.Abstain += k.calculateVotingPower(delegation)
+			voteDistribution.Abstain += k.calculateVotingPower(ctx, delegation, totalDelegation)
 		}
 	}
 
 	// get total voting power
-	for _, staker := range k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, poolId) {
+	for _, staker := range k.stakerKeeper.GetAllUnjailedStakerAddressesOfPool(ctx, poolId) {
 		delegation := k.delegationKeeper.GetDelegationAmount(ctx, staker)
-		voteDistribution.Total += k.calculateVotingPower(delegation)
+		voteDistribution.Total += k.calculateVotingPower(ctx, delegation, totalDelegation)
 	}
+	voteDistribution.RawTotal = totalDelegation
+
+	// get quorums of the pool
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+	total := sdk.NewDecFromInt(sdk.NewIntFromUint64(voteDistribution.Total))
 
 	if voteDistribution.Total == 0 {
 		// if total voting power is zero no quorum can be reached
 		voteDistribution.Status = types.BUNDLE_STATUS_NO_QUORUM
-	} else if voteDistribution.Valid*2 > voteDistribution.Total {
-		// if more than 50% voted for valid quorum is reached
+	} else if sdk.NewDecFromInt(sdk.NewIntFromUint64(voteDistribution.Valid)).GT(total.Mul(pool.GetEffectiveValidQuorum())) {
+		// if more than the valid quorum voted for valid quorum is reached
 		voteDistribution.Status = types.BUNDLE_STATUS_VALID
-	} else if voteDistribution.Invalid*2 >= voteDistribution.Total {
-		// if more or equal than 50% voted for invalid quorum is reached
+	} else if sdk.NewDecFromInt(sdk.NewIntFromUint64(voteDistribution.Invalid)).GTE(total.Mul(pool.GetEffectiveInvalidQuorum())) {
+		// if more or equal than the invalid quorum voted for invalid quorum is reached
 		voteDistribution.Status = types.BUNDLE_STATUS_INVALID
 	} else {
-		// if neither valid nor invalid reached 50% no quorum was reached
+		// if neither valid nor invalid reached their quorum no quorum was reached
 		voteDistribution.Status = types.BUNDLE_STATUS_NO_QUORUM
 	}
 
@@ -3,10 +3,13 @@ package keeper_test
 import (
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	bundlesTypes "github.com/KYVENetwork/chain/x/bundles/types"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
 	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 /*
@@ -16,6 +19,7 @@ TEST CASES - logic_bundles.go
 * Assert pool can run while pool is upgrading
 * Assert pool can run while pool is disabled
 * Assert pool can run while min delegation is not reached
+* Assert pool can run while min delegation is only reached with jailed stakers
 * Assert pool can run
 * Assert pool can run while pool has no funds
 
@@ -32,8 +36,84 @@ TEST CASES - logic_bundles.go
 * Assert can propose if index does not match
 * Assert can propose
 
+* Get vote distribution with default quorums
+* Get vote distribution with custom valid quorum
+* Get vote distribution with custom invalid quorum
+* Get vote distribution with linear voting power curve
+* Get vote distribution with square root voting power curve
+* Get vote distribution with capped voting power curve
+* Get vote distribution with a jailed staker
+
 */
 
+func setVotingPowerCurve(s *i.KeeperTestSuite, curve bundlesTypes.VotingPowerCurve, cap sdk.Dec) {
+	params := s.App().BundlesKeeper.GetParams(s.Ctx())
+	params.VotingPowerCurve = curve
+	params.VotingPowerCap = cap
+	s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+}
+
+func setupVoteDistributionPool(s *i.KeeperTestSuite, validQuorum sdk.Dec, invalidQuorum sdk.Dec) {
+	s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+		Name:           "PoolTest",
+		UploadInterval: 60,
+		OperatingCost:  2 * i.KYVE,
+		MinDelegation:  100 * i.KYVE,
+		MaxBundleSize:  100,
+		ValidQuorum:    validQuorum,
+		InvalidQuorum:  invalidQuorum,
+		Protocol: &pooltypes.Protocol{
+			Version:     "0.0.0",
+			Binaries:    "{}",
+			LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+		},
+		UpgradePlan: &pooltypes.UpgradePlan{},
+	})
+
+	s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+		Creator: i.ALICE,
+		Id:      0,
+		Amount:  100 * i.KYVE,
+	})
+
+	for _, staker := range [][]string{{i.STAKER_0, i.VALADDRESS_0}, {i.STAKER_1, i.VALADDRESS_1}, {i.STAKER_2, i.VALADDRESS_2}} {
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: staker[0],
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    staker[0],
+			PoolId:     0,
+			Valaddress: staker[1],
+			Amount:     0,
+		})
+	}
+
+	s.RunTxBundlesSuccess(&bundlesTypes.MsgClaimUploaderRole{
+		Creator: i.VALADDRESS_0,
+		Staker:  i.STAKER_0,
+		PoolId:  0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	// the uploader automatically votes valid
+	s.RunTxBundlesSuccess(&bundlesTypes.MsgSubmitBundleProposal{
+		Creator:       i.VALADDRESS_0,
+		Staker:        i.STAKER_0,
+		PoolId:        0,
+		StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+		DataSize:      100,
+		DataHash:      "test_hash",
+		FromIndex:     0,
+		BundleSize:    100,
+		FromKey:       "0",
+		ToKey:         "99",
+		BundleSummary: "test_value",
+	})
+}
+
 var _ = Describe("logic_bundles.go", Ordered, func() {
 	s := i.NewCleanChain()
 
@@ -170,6 +250,61 @@ var _ = Describe("logic_bundles.go", Ordered, func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("Assert pool can run while min delegation is only reached with jailed stakers", func() {
+		// ASSERT
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "PoolTest",
+			UploadInterval: 60,
+			OperatingCost:  2 * i.KYVE,
+			MinDelegation:  100 * i.KYVE,
+			MaxBundleSize:  100,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+			Amount:     0,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1,
+			Amount:     0,
+		})
+
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_1)
+
+		// ACT
+		err := s.App().BundlesKeeper.AssertPoolCanRun(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("Assert pool can run", func() {
 		// ASSERT
 		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
@@ -979,4 +1114,149 @@ var _ = Describe("logic_bundles.go", Ordered, func() {
 		// ASSERT
 		Expect(err).NotTo(HaveOccurred())
 	})
+
+	// GET VOTE DISTRIBUTION
+
+	It("Get vote distribution with default quorums", func() {
+		// ARRANGE
+		setupVoteDistributionPool(s, sdk.ZeroDec(), sdk.ZeroDec())
+
+		// ACT
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(voteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Invalid).To(BeZero())
+		Expect(voteDistribution.Total).To(Equal(300 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundlesTypes.BUNDLE_STATUS_NO_QUORUM))
+	})
+
+	It("Get vote distribution with custom valid quorum", func() {
+		// ARRANGE
+		setupVoteDistributionPool(s, sdk.MustNewDecFromStr("0.3"), sdk.MustNewDecFromStr("0.7"))
+
+		// ACT
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(voteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Total).To(Equal(300 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundlesTypes.BUNDLE_STATUS_VALID))
+	})
+
+	It("Get vote distribution with custom invalid quorum", func() {
+		// ARRANGE
+		setupVoteDistributionPool(s, sdk.MustNewDecFromStr("0.7"), sdk.MustNewDecFromStr("0.3"))
+
+		s.RunTxBundlesSuccess(&bundlesTypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundlesTypes.VOTE_TYPE_INVALID,
+		})
+
+		// ACT
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(voteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Invalid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Total).To(Equal(300 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundlesTypes.BUNDLE_STATUS_INVALID))
+	})
+
+	It("Get vote distribution with linear voting power curve", func() {
+		// ARRANGE
+		setVotingPowerCurve(s, bundlesTypes.VOTING_POWER_CURVE_LINEAR, bundlesTypes.DefaultVotingPowerCap)
+		setupVoteDistributionPool(s, sdk.ZeroDec(), sdk.ZeroDec())
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.ALICE,
+			Staker:  i.STAKER_0,
+			Amount:  600 * i.KYVE,
+		})
+
+		// ACT
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(voteDistribution.Valid).To(Equal(700 * i.KYVE))
+		Expect(voteDistribution.Total).To(Equal(900 * i.KYVE))
+		Expect(voteDistribution.RawValid).To(Equal(700 * i.KYVE))
+		Expect(voteDistribution.RawTotal).To(Equal(900 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundlesTypes.BUNDLE_STATUS_VALID))
+	})
+
+	It("Get vote distribution with square root voting power curve", func() {
+		// ARRANGE
+		setVotingPowerCurve(s, bundlesTypes.VOTING_POWER_CURVE_SQUARE_ROOT, bundlesTypes.DefaultVotingPowerCap)
+		setupVoteDistributionPool(s, sdk.ZeroDec(), sdk.ZeroDec())
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.ALICE,
+			Staker:  i.STAKER_0,
+			Amount:  600 * i.KYVE,
+		})
+
+		// ACT
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		// ASSERT
+		// sqrt(700 * 10^9) = 836660 and sqrt(100 * 10^9) = 316227
+		Expect(voteDistribution.Valid).To(Equal(uint64(836660)))
+		Expect(voteDistribution.Total).To(Equal(uint64(836660 + 2*316227)))
+		Expect(voteDistribution.RawValid).To(Equal(700 * i.KYVE))
+		Expect(voteDistribution.RawTotal).To(Equal(900 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundlesTypes.BUNDLE_STATUS_VALID))
+	})
+
+	It("Get vote distribution with capped voting power curve", func() {
+		// ARRANGE
+		setVotingPowerCurve(s, bundlesTypes.VOTING_POWER_CURVE_CAPPED, sdk.MustNewDecFromStr("0.2"))
+		setupVoteDistributionPool(s, sdk.ZeroDec(), sdk.ZeroDec())
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.ALICE,
+			Staker:  i.STAKER_0,
+			Amount:  600 * i.KYVE,
+		})
+
+		// ACT
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		// ASSERT
+		// the voting power of the first staker is capped at 20% of 900 $KYVE
+		Expect(voteDistribution.Valid).To(Equal(180 * i.KYVE))
+		Expect(voteDistribution.Total).To(Equal(380 * i.KYVE))
+		Expect(voteDistribution.RawValid).To(Equal(700 * i.KYVE))
+		Expect(voteDistribution.RawTotal).To(Equal(900 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundlesTypes.BUNDLE_STATUS_NO_QUORUM))
+	})
+
+	It("Get vote distribution with a jailed staker", func() {
+		// ARRANGE
+		setVotingPowerCurve(s, bundlesTypes.VOTING_POWER_CURVE_CAPPED, sdk.MustNewDecFromStr("0.5"))
+		setupVoteDistributionPool(s, sdk.ZeroDec(), sdk.ZeroDec())
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.ALICE,
+			Staker:  i.STAKER_2,
+			Amount:  600 * i.KYVE,
+		})
+
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_2)
+
+		// ACT
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		// ASSERT
+		// the delegation of the jailed staker does not count towards the total,
+		// so the voting power of the uploader is capped at 50% of 200 $KYVE
+		Expect(voteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Total).To(Equal(200 * i.KYVE))
+		Expect(voteDistribution.RawValid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.RawTotal).To(Equal(200 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundlesTypes.BUNDLE_STATUS_NO_QUORUM))
+	})
 })
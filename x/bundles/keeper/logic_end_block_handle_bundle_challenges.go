@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	delegationTypes "github.com/KYVENetwork/chain/x/delegation/types"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HandleBundleChallenges is an end block hook that resolves every open challenge
+// whose voting period is over.
+func (k Keeper) HandleBundleChallenges(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only challenges created before this timestamp have reached the end of their voting period
+	now := uint64(ctx.BlockTime().Unix())
+	votingPeriod := k.GetChallengeVotingPeriod(ctx)
+	if now < votingPeriod {
+		return
+	}
+
+	for _, challenge := range k.GetDueBundleChallenges(ctx, now-votingPeriod) {
+		k.resolveBundleChallenge(ctx, challenge)
+	}
+}
+
+// resolveBundleChallenge tallies the votes of the current pool stakers on the given challenge.
+// If the invalid quorum of the pool is reached the bundle is marked as invalid, the uploader
+// and all valid voters are slashed and the challenger gets the bond back together with a
+// reward from the community pool. Otherwise, the bond is transferred to the treasury.
+func (k Keeper) resolveBundleChallenge(ctx sdk.Context, challenge types.BundleChallenge) {
+	valid, invalid, total := k.getChallengeVoteDistribution(ctx, challenge)
+
+	pool, _ := k.poolKeeper.GetPool(ctx, challenge.PoolId)
+	reward := uint64(0)
+
+	if invalid > 0 && sdk.NewDecFromInt(sdk.NewIntFromUint64(invalid)).GTE(
+		sdk.NewDecFromInt(sdk.NewIntFromUint64(total)).Mul(pool.GetEffectiveInvalidQuorum()),
+	) {
+		challenge.Status = types.CHALLENGE_STATUS_ACCEPTED
+
+		finalizedBundle, _ := k.GetFinalizedBundle(ctx, challenge.PoolId, challenge.BundleId)
+		finalizedBundle.Invalidated = true
+		k.SetFinalizedBundle(ctx, finalizedBundle)
+
+		// slash the uploader and everybody who voted valid on the bundle
+		for _, voter := range finalizedBundle.VotersValid {
+			if voter == finalizedBundle.Uploader {
+				k.delegationKeeper.SlashDelegators(ctx, challenge.PoolId, voter, delegationTypes.SLASH_TYPE_UPLOAD, challenge.BundleId)
+			} else {
+				k.delegationKeeper.SlashDelegators(ctx, challenge.PoolId, voter, delegationTypes.SLASH_TYPE_VOTE, challenge.BundleId)
+			}
+		}
+
+		// return the bond to the challenger
+		if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, challenge.Challenger, challenge.Bond); err != nil {
+			util.PanicHalt(k.upgradeKeeper, ctx, "Not enough money in bundles module - return challenge bond")
+		}
+
+		// reward the challenger from the community pool. If the community pool
+		// can not cover the reward the challenger only gets the bond back
+		if rewardAmount := k.GetChallengeReward(ctx); rewardAmount > 0 {
+			challenger, _ := sdk.AccAddressFromBech32(challenge.Challenger)
+			coins := sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(rewardAmount)))
+
+			if err := k.distrkeeper.DistributeFromFeePool(ctx, coins, challenger); err == nil {
+				reward = rewardAmount
+			}
+		}
+	} else {
+		challenge.Status = types.CHALLENGE_STATUS_REJECTED
+
+		// the bond of an unsuccessful challenge goes to the treasury
+		if err := util.TransferFromModuleToTreasury(k.accountKeeper, k.distrkeeper, ctx, types.ModuleName, challenge.Bond); err != nil {
+			util.PanicHalt(k.upgradeKeeper, ctx, "Not enough money in bundles module - transfer challenge bond to treasury")
+		}
+	}
+
+	k.SetBundleChallenge(ctx, challenge)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventChallengeResolved{
+		PoolId:     challenge.PoolId,
+		BundleId:   challenge.BundleId,
+		Challenger: challenge.Challenger,
+		Status:     challenge.Status,
+		Valid:      valid,
+		Invalid:    invalid,
+		Total:      total,
+		Reward:     reward,
+	})
+}
+
+// getChallengeVoteDistribution returns the voting power of the current pool stakers
+// which voted valid and invalid on the given challenge together with the total voting
+// power of the pool. The accused stakers can not vote and are therefore not part of
+// the total voting power.
+func (k Keeper) getChallengeVoteDistribution(ctx sdk.Context, challenge types.BundleChallenge) (valid uint64, invalid uint64, total uint64) {
+	totalDelegation := k.getUnjailedDelegationOfPool(ctx, challenge.PoolId)
+	finalizedBundle, _ := k.GetFinalizedBundle(ctx, challenge.PoolId, challenge.BundleId)
+
+	for _, voter := range challenge.VotersValid {
+		// only voters which are still active and not jailed in the pool count
+		if k.isParticipatingStaker(ctx, challenge.PoolId, voter) {
+			delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
+			valid += k.calculateVotingPower(ctx, delegation, totalDelegation)
+		}
+	}
+
+	for _, voter := range challenge.VotersInvalid {
+		// only voters which are still active and not jailed in the pool count
+		if k.isParticipatingStaker(ctx, challenge.PoolId, voter) {
+			delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
+			invalid += k.calculateVotingPower(ctx, delegation, totalDelegation)
+		}
+	}
+
+	for _, staker := range k.stakerKeeper.GetAllUnjailedStakerAddressesOfPool(ctx, challenge.PoolId) {
+		if isAccusedStaker(finalizedBundle, staker) {
+			continue
+		}
+
+		delegation := k.delegationKeeper.GetDelegationAmount(ctx, staker)
+		total += k.calculateVotingPower(ctx, delegation, totalDelegation)
+	}
+
+	return
+}
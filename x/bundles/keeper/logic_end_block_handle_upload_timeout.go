@@ -39,8 +39,9 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 			continue
 		}
 
-		// Skip if we haven't reached the upload interval.
-		if uint64(ctx.BlockTime().Unix()) < (bundleProposal.UpdatedAt + pool.UploadInterval) {
+		// Skip if we haven't reached the upload interval (and the reveal window
+		// of pools with commit-reveal voting).
+		if uint64(ctx.BlockTime().Unix()) < (bundleProposal.UpdatedAt + pool.UploadInterval + pool.GetEffectiveRevealWindow()) {
 			continue
 		}
 
@@ -63,16 +64,16 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 		}
 
 		// Skip if we haven't reached the upload timeout.
-		if uint64(ctx.BlockTime().Unix()) < (bundleProposal.UpdatedAt + pool.UploadInterval + k.GetUploadTimeout(ctx)) {
+		if uint64(ctx.BlockTime().Unix()) < (bundleProposal.UpdatedAt + pool.UploadInterval + pool.GetEffectiveRevealWindow() + k.GetUploadTimeout(ctx)) {
 			continue
 		}
 
 		// We now know that the pool is active and the upload timeout has been reached.
 
 		// Now we increase the points of the valaccount
-		// (if he is still participating in the pool) and select a new one.
-		if k.stakerKeeper.DoesValaccountExist(ctx, pool.Id, bundleProposal.NextUploader) {
-			k.addPoint(ctx, pool.Id, bundleProposal.NextUploader)
+		// (if he is still participating in the pool and not jailed) and select a new one.
+		if k.isParticipatingStaker(ctx, pool.Id, bundleProposal.NextUploader) {
+			k.addPoints(ctx, pool.Id, bundleProposal.NextUploader, k.GetUploadTimeoutPoints(ctx))
 		}
 
 		// Update bundle proposal and choose next uploader
@@ -23,6 +23,7 @@ TEST CASES - logic_end_block_handle_upload_timeout.go
 * Staker is next uploader of genesis bundle and upload interval and timeout does not pass
 * Staker is next uploader of genesis bundle and upload timeout does not pass but upload interval passes
 * Staker is next uploader of genesis bundle and upload timeout does pass together with upload interval
+* Staker is next uploader of genesis bundle and upload timeout passes with weighted upload timeout points
 * Staker is next uploader of bundle proposal and upload interval does not pass
 * Staker is next uploader of bundle proposal and upload timeout does not pass
 * Staker is next uploader of bundle proposal and upload timeout passes
@@ -311,6 +312,32 @@ var _ = Describe("logic_end_block_handle_upload_timeout.go", Ordered, func() {
 		Expect(expectedBalance).To(Equal(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)))
 	})
 
+	It("Staker is next uploader of genesis bundle and upload timeout passes with weighted upload timeout points", func() {
+		// ARRANGE
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.UploadTimeoutPoints = 3
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(s.App().BundlesKeeper.GetUploadTimeout(s.Ctx()))
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(HaveLen(1))
+
+		// check if next uploader received the weighted points
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Points).To(Equal(uint64(3)))
+	})
+
 	It("Staker is next uploader of bundle proposal and upload interval does not pass", func() {
 		// ARRANGE
 		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
@@ -525,21 +552,22 @@ var _ = Describe("logic_end_block_handle_upload_timeout.go", Ordered, func() {
 
 		// check if next uploader got not removed from pool
 		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
-		Expect(poolStakers).To(HaveLen(1))
+		Expect(poolStakers).To(HaveLen(2))
 
-		// check if next uploader received a point
-		_, valaccountFound := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
-		Expect(valaccountFound).To(BeFalse())
+		// check if next uploader got jailed
+		valaccount, valaccountFound := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccountFound).To(BeTrue())
+		Expect(valaccount.Jailed).To(BeTrue())
+		Expect(valaccount.Points).To(BeZero())
 
 		_, found := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
 		Expect(found).To(BeTrue())
 
-		Expect(s.App().DelegationKeeper.GetDelegationOfPool(s.Ctx(), 0)).To(Equal(100 * i.KYVE))
-
-		// check if next uploader not got slashed
+		// check if next uploader got slashed
 		slashAmountRatio := s.App().DelegationKeeper.GetTimeoutSlash(s.Ctx())
 		expectedBalance := 50*i.KYVE - uint64(sdk.NewDec(int64(50*i.KYVE)).Mul(slashAmountRatio).TruncateInt64())
 
+		Expect(s.App().DelegationKeeper.GetDelegationOfPool(s.Ctx(), 0)).To(Equal(100*i.KYVE + expectedBalance))
 		Expect(expectedBalance).To(Equal(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_1, i.STAKER_1)))
 	})
 
@@ -974,21 +1002,22 @@ var _ = Describe("logic_end_block_handle_upload_timeout.go", Ordered, func() {
 
 		// check if next uploader got not removed from pool
 		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 1)
-		Expect(poolStakers).To(HaveLen(1))
+		Expect(poolStakers).To(HaveLen(2))
 
-		// check if next uploader received a point
-		_, valaccountFound := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_2)
-		Expect(valaccountFound).To(BeFalse())
+		// check if next uploader got jailed
+		valaccount, valaccountFound := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_2)
+		Expect(valaccountFound).To(BeTrue())
+		Expect(valaccount.Jailed).To(BeTrue())
+		Expect(valaccount.Points).To(BeZero())
 
 		_, found := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_2)
 		Expect(found).To(BeTrue())
 
-		Expect(s.App().DelegationKeeper.GetDelegationOfPool(s.Ctx(), 1)).To(Equal(100 * i.KYVE))
-
-		// check if next uploader not got slashed
+		// check if next uploader got slashed
 		slashAmountRatio := s.App().DelegationKeeper.GetTimeoutSlash(s.Ctx())
 		expectedBalance := 50*i.KYVE - uint64(sdk.NewDec(int64(50*i.KYVE)).Mul(slashAmountRatio).TruncateInt64())
 
+		Expect(s.App().DelegationKeeper.GetDelegationOfPool(s.Ctx(), 1)).To(Equal(100*i.KYVE + expectedBalance))
 		Expect(expectedBalance).To(Equal(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_2, i.STAKER_2)))
 	})
 })
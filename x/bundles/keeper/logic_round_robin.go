@@ -71,17 +71,21 @@ func (k Keeper) LoadRoundRobinValidatorSet(ctx sdk.Context, poolId uint64) Round
 	totalDelegation := int64(0)
 	// Used for calculating the set difference of active validators and existing round-robin set
 	newValidators := make(map[string]bool, 0)
+	// The voting power curve is applied to the delegation, so that the uploader selection
+	// is weighted the same way as the votes
+	totalPoolDelegation := k.getUnjailedDelegationOfPool(ctx, poolId)
 	// Add all current pool validators to the round-robin set
-	for _, address := range k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, poolId) {
+	for _, address := range k.stakerKeeper.GetAllUnjailedStakerAddressesOfPool(ctx, poolId) {
 		delegation := k.delegationKeeper.GetDelegationAmount(ctx, address)
-		if delegation > 0 {
-			// If a validator has no delegation do not add to the round-robin set. Validator is basically non-existent.
+		votingPower := k.calculateVotingPower(ctx, delegation, totalPoolDelegation)
+		if votingPower > 0 {
+			// If a validator has no voting power do not add to the round-robin set. Validator is basically non-existent.
 			vs.Validators = append(vs.Validators, RoundRobinValidatorPower{
 				Address: address,
-				Power:   int64(delegation),
+				Power:   int64(votingPower),
 			})
 			vs.Progress[address] = 0
-			totalDelegation += int64(delegation)
+			totalDelegation += int64(votingPower)
 			newValidators[address] = true
 		}
 	}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+Stake-Weighted Random Uploader Selection
+
+This file implements the uploader selection for pools which do not want a predictable
+round-robin. Every round a seed is derived from the current block and the last bundle proposal
+of the pool. Based on this seed a validator is picked pseudo-randomly, where the chance of
+being selected is proportional to the stake (+ delegation) of the validator.
+
+Let $N$ denote the number of total validators and $s(n)$ the stake of the n-th validator.
+The total stake is given by
+    $S = \sum_{i=1}^N s(i)$
+
+The seed is interpreted as a big number $x$ and the target is given by
+    $t = x \mod S$
+
+The selected validator is the first validator $n$ for which
+    $t < \sum_{i=1}^n s(i)$
+
+Excluded validators are removed from the set before the selection. If the entire set is excluded,
+the algorithm proceeds as if nobody were excluded. In contrast to the round-robin no progress
+is stored, so the outcome of every round is independent of the previous rounds.
+
+*/
+
+// StakeWeightedValidatorPower contains the total delegation of a protocol validator.
+// It only lives inside the memory for the current round.
+type StakeWeightedValidatorPower struct {
+	Address string
+	Power   uint64
+}
+
+// StakeWeightedValidatorSet is the in memory-object for selecting the next uploader
+// pseudo-randomly weighted by the delegation of the validators.
+type StakeWeightedValidatorSet struct {
+	PoolId     uint64
+	Validators []StakeWeightedValidatorPower
+}
+
+// LoadStakeWeightedValidatorSet initialises a validator set for the given pool id.
+// The power of each validator is its delegation with the voting power curve applied.
+// Validators without voting power are not added to the set.
+func (k Keeper) LoadStakeWeightedValidatorSet(ctx sdk.Context, poolId uint64) StakeWeightedValidatorSet {
+	vs := StakeWeightedValidatorSet{}
+	vs.PoolId = poolId
+
+	totalPoolDelegation := k.getUnjailedDelegationOfPool(ctx, poolId)
+	for _, address := range k.stakerKeeper.GetAllUnjailedStakerAddressesOfPool(ctx, poolId) {
+		delegation := k.delegationKeeper.GetDelegationAmount(ctx, address)
+		if votingPower := k.calculateVotingPower(ctx, delegation, totalPoolDelegation); votingPower > 0 {
+			vs.Validators = append(vs.Validators, StakeWeightedValidatorPower{
+				Address: address,
+				Power:   votingPower,
+			})
+		}
+	}
+
+	return vs
+}
+
+// GetUploaderSelectionSeed returns the seed for the next stake-weighted random selection
+// of the given pool. It is derived from the last block hash, the current block height
+// and the data hash of the current bundle proposal of the pool, so that the next uploader
+// can not be predicted several rounds ahead.
+func (k Keeper) GetUploaderSelectionSeed(ctx sdk.Context, poolId uint64) []byte {
+	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
+
+	hash := sha256.New()
+	hash.Write(ctx.BlockHeader().LastBlockId.Hash)
+	hash.Write(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	hash.Write(sdk.Uint64ToBigEndian(poolId))
+	hash.Write([]byte(bundleProposal.DataHash))
+
+	return hash.Sum(nil)
+}
+
+// getTotalDelegation returns the total delegation power of the given validators.
+func (vs *StakeWeightedValidatorSet) getTotalDelegation(validators []StakeWeightedValidatorPower) (total uint64) {
+	for _, vp := range validators {
+		total += vp.Power
+	}
+	return
+}
+
+// NextProposer picks the next uploader from all (non-excluded) validators of the set based on
+// the given seed. Validators with more delegation are proportionally more likely to be selected.
+// If the entire set is excluded then the algorithm proceeds as if nobody were excluded.
+func (vs *StakeWeightedValidatorSet) NextProposer(seed []byte, excludedAddresses ...string) string {
+	mapExcludedAddresses := make(map[string]bool)
+	for _, excluded := range excludedAddresses {
+		mapExcludedAddresses[excluded] = true
+	}
+
+	candidates := make([]StakeWeightedValidatorPower, 0)
+	for _, validator := range vs.Validators {
+		if !mapExcludedAddresses[validator.Address] {
+			candidates = append(candidates, validator)
+		}
+	}
+
+	// If all addresses are excluded, then no address should be excluded
+	if len(candidates) == 0 {
+		candidates = vs.Validators
+	}
+
+	totalDelegation := vs.getTotalDelegation(candidates)
+	if totalDelegation == 0 {
+		return ""
+	}
+
+	target := new(big.Int).Mod(
+		new(big.Int).SetBytes(seed),
+		new(big.Int).SetUint64(totalDelegation),
+	).Uint64()
+
+	sum := uint64(0)
+	for _, validator := range candidates {
+		sum += validator.Power
+		if target < sum {
+			return validator.Address
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_stake_weighted_random.go
+
+* Correctly load stake-weighted set
+* Empty stake-weighted set
+* Partially filled stake-weighted set (one staker with 0 delegation)
+* Same seed selects the same uploader
+* Seed changes with the bundle proposal
+* Frequency analysis
+* Frequency analysis (excluded)
+* Exclude everybody
+* Exclude all but one
+
+*/
+
+func seedOfRound(round uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, round)
+
+	hash := sha256.Sum256(b)
+	return hash[:]
+}
+
+var _ = Describe("logic_stake_weighted_random.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// sort dummy accounts alphabetically
+		sort.Slice(i.DUMMY, func(k, j int) bool {
+			return i.DUMMY[k] < i.DUMMY[j]
+		})
+
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:              "PoolTest",
+			UploadInterval:    60,
+			OperatingCost:     2 * i.KYVE,
+			MinDelegation:     1_000_000 * i.KYVE,
+			MaxBundleSize:     100,
+			UploaderSelection: pooltypes.UPLOADER_SELECTION_STAKE_WEIGHTED_RANDOM,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{
+				Version:     "1.0.0",
+				Binaries:    "{}",
+				ScheduledAt: uint64(s.Ctx().BlockTime().Unix()),
+				Duration:    60,
+			},
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Correctly load stake-weighted set", func() {
+		// ARRANGE
+		joinDummy(s, 0, 100)
+		joinDummy(s, 1, 200)
+		joinDummy(s, 2, 300)
+
+		// ACT
+		swvs := s.App().BundlesKeeper.LoadStakeWeightedValidatorSet(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(swvs.Validators).To(HaveLen(3))
+		Expect(swvs.Validators[0].Address).To(Equal(i.DUMMY[0]))
+		Expect(swvs.Validators[0].Power).To(Equal(100 * i.KYVE))
+		Expect(swvs.Validators[1].Address).To(Equal(i.DUMMY[1]))
+		Expect(swvs.Validators[1].Power).To(Equal(200 * i.KYVE))
+		Expect(swvs.Validators[2].Address).To(Equal(i.DUMMY[2]))
+		Expect(swvs.Validators[2].Power).To(Equal(300 * i.KYVE))
+	})
+
+	It("Empty stake-weighted set", func() {
+		// ARRANGE
+		joinDummy(s, 0, 0)
+		joinDummy(s, 1, 0)
+		joinDummy(s, 2, 0)
+
+		// ACT
+		swvs := s.App().BundlesKeeper.LoadStakeWeightedValidatorSet(s.Ctx(), 0)
+		nextProposer := swvs.NextProposer(seedOfRound(0))
+
+		// ASSERT
+		Expect(swvs.Validators).To(HaveLen(0))
+		Expect(nextProposer).To(BeEmpty())
+	})
+
+	It("Partially filled stake-weighted set (one staker with 0 delegation)", func() {
+		// ARRANGE
+		joinDummy(s, 0, 0)
+		joinDummy(s, 1, 10)
+		joinDummy(s, 2, 5)
+
+		// ACT
+		swvs := s.App().BundlesKeeper.LoadStakeWeightedValidatorSet(s.Ctx(), 0)
+
+		frequency := make(map[string]int, 0)
+		for j := uint64(0); j < 100; j++ {
+			frequency[swvs.NextProposer(seedOfRound(j))] += 1
+		}
+
+		// ASSERT
+		Expect(swvs.Validators).To(HaveLen(2))
+		Expect(frequency[i.DUMMY[0]]).To(BeZero())
+		Expect(frequency[i.DUMMY[1]] + frequency[i.DUMMY[2]]).To(Equal(100))
+	})
+
+	It("Same seed selects the same uploader", func() {
+		// ARRANGE
+		joinDummy(s, 0, 100)
+		joinDummy(s, 1, 200)
+		joinDummy(s, 2, 300)
+
+		// ACT
+		swvs := s.App().BundlesKeeper.LoadStakeWeightedValidatorSet(s.Ctx(), 0)
+		seed := s.App().BundlesKeeper.GetUploaderSelectionSeed(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(seed).To(Equal(s.App().BundlesKeeper.GetUploaderSelectionSeed(s.Ctx(), 0)))
+
+		nextProposer := swvs.NextProposer(seed)
+		for j := 0; j < 10; j++ {
+			Expect(swvs.NextProposer(seed)).To(Equal(nextProposer))
+		}
+	})
+
+	It("Seed changes with the bundle proposal", func() {
+		// ARRANGE
+		seed := s.App().BundlesKeeper.GetUploaderSelectionSeed(s.Ctx(), 0)
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		bundleProposal.PoolId = 0
+		bundleProposal.DataHash = "test_hash"
+
+		// ACT
+		s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+		// ASSERT
+		Expect(s.App().BundlesKeeper.GetUploaderSelectionSeed(s.Ctx(), 0)).NotTo(Equal(seed))
+	})
+
+	It("Frequency analysis", func() {
+		// ARRANGE
+		joinDummy(s, 0, 2)
+		joinDummy(s, 1, 31)
+		joinDummy(s, 2, 67)
+
+		// ACT
+		swvs := s.App().BundlesKeeper.LoadStakeWeightedValidatorSet(s.Ctx(), 0)
+
+		frequency := make(map[string]int, 0)
+		for j := uint64(0); j < 100000; j++ {
+			frequency[swvs.NextProposer(seedOfRound(j))] += 1
+		}
+
+		// ASSERT
+		// the selection is random, so the frequencies only approximately
+		// match the delegation of the validators
+		Expect(frequency[i.DUMMY[0]]).To(BeNumerically("~", 2000, 500))
+		Expect(frequency[i.DUMMY[1]]).To(BeNumerically("~", 31000, 1000))
+		Expect(frequency[i.DUMMY[2]]).To(BeNumerically("~", 67000, 1000))
+	})
+
+	It("Frequency analysis (excluded)", func() {
+		// ARRANGE
+		joinDummy(s, 0, 5)
+		joinDummy(s, 1, 10)
+		joinDummy(s, 2, 15)
+		// Do 10000 rounds, in the first 5000 exclude Dummy0, in the second 5000 exclude Dummy1
+		// Frequencies for all three validators:
+		// P(0) = 1/10000 * (0 + 5000 * 5/(5+15)) = 0.125
+		// P(1) = 1/10000 * (5000 * 10/(10+15) + 0) = 0.2
+		// P(2) = 1/10000 * (5000 * 15/(10+15) + 5000 * 15/(5+15)) = 0.675
+
+		// ACT
+		swvs := s.App().BundlesKeeper.LoadStakeWeightedValidatorSet(s.Ctx(), 0)
+
+		frequency := make(map[string]int, 0)
+		for j := uint64(0); j < 5000; j++ {
+			frequency[swvs.NextProposer(seedOfRound(j), i.DUMMY[0])] += 1
+		}
+		for j := uint64(5000); j < 10000; j++ {
+			frequency[swvs.NextProposer(seedOfRound(j), i.DUMMY[1])] += 1
+		}
+
+		// ASSERT
+		Expect(frequency[i.DUMMY[0]]).To(BeNumerically("~", 1250, 150))
+		Expect(frequency[i.DUMMY[1]]).To(BeNumerically("~", 2000, 150))
+		Expect(frequency[i.DUMMY[2]]).To(BeNumerically("~", 6750, 150))
+	})
+
+	It("Exclude everybody", func() {
+		// ARRANGE
+		joinDummy(s, 0, 5)
+		joinDummy(s, 1, 10)
+		joinDummy(s, 2, 15)
+
+		// ACT
+		swvs := s.App().BundlesKeeper.LoadStakeWeightedValidatorSet(s.Ctx(), 0)
+
+		frequency := make(map[string]int, 0)
+		for j := uint64(0); j < 100; j++ {
+			frequency[swvs.NextProposer(seedOfRound(j), i.DUMMY[0], i.DUMMY[1], i.DUMMY[2])] += 1
+		}
+
+		// ASSERT
+		Expect(frequency[i.DUMMY[0]]).To(BeNumerically(">", 0))
+		Expect(frequency[i.DUMMY[1]]).To(BeNumerically(">", 0))
+		Expect(frequency[i.DUMMY[2]]).To(BeNumerically(">", 0))
+		Expect(frequency[i.DUMMY[0]] + frequency[i.DUMMY[1]] + frequency[i.DUMMY[2]]).To(Equal(100))
+	})
+
+	It("Exclude all but one", func() {
+		// ARRANGE
+		joinDummy(s, 0, 5)
+		joinDummy(s, 1, 10)
+		joinDummy(s, 2, 15)
+
+		// ACT
+		swvs := s.App().BundlesKeeper.LoadStakeWeightedValidatorSet(s.Ctx(), 0)
+
+		// ASSERT
+		for j := uint64(0); j < 100; j++ {
+			Expect(swvs.NextProposer(seedOfRound(j), i.DUMMY[1], i.DUMMY[2])).To(Equal(i.DUMMY[0]))
+		}
+	})
+})
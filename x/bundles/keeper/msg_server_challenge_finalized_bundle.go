@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChallengeFinalizedBundle handles the logic of an SDK message that allows stakers to dispute
+// a finalized bundle within the challenge window. The challenger has to post a bond which
+// is returned (together with a reward) if the stakers of the pool vote the bundle invalid.
+func (k msgServer) ChallengeFinalizedBundle(
+	goCtx context.Context, msg *types.MsgChallengeFinalizedBundle,
+) (*types.MsgChallengeFinalizedBundleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.AssertCanChallenge(ctx, msg.PoolId, msg.BundleId, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// Transfer the bond of the challenger to the module.
+	bond := k.GetChallengeBond(ctx)
+	if err := util.TransferFromAddressToModule(k.bankKeeper, ctx, msg.Creator, types.ModuleName, bond); err != nil {
+		return nil, err
+	}
+
+	k.SetBundleChallenge(ctx, types.BundleChallenge{
+		PoolId:     msg.PoolId,
+		BundleId:   msg.BundleId,
+		Challenger: msg.Creator,
+		Bond:       bond,
+		CreatedAt:  uint64(ctx.BlockTime().Unix()),
+		Status:     types.CHALLENGE_STATUS_OPEN,
+	})
+
+	// Emit a challenge event.
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventBundleChallenged{
+		PoolId:     msg.PoolId,
+		BundleId:   msg.BundleId,
+		Challenger: msg.Creator,
+		Bond:       bond,
+	})
+
+	return &types.MsgChallengeFinalizedBundleResponse{}, nil
+}
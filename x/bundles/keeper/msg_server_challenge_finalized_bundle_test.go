@@ -0,0 +1,503 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_challenge_finalized_bundle.go
+
+* Challenge a finalized bundle
+* Try to challenge a finalized bundle as a non-staker
+* Try to challenge a finalized bundle which does not exist
+* Try to challenge a finalized bundle after the challenge window
+* Try to challenge a finalized bundle twice
+* Vote on a challenge
+* Try to vote on a challenge twice
+* Try to vote on a challenge as the uploader of the bundle
+* Try to vote on a challenge as a valid voter of the bundle
+* Try to vote abstain on a challenge
+* Try to vote on a challenge after the voting period
+* Reject a challenge without invalid quorum
+* Accept a challenge with invalid quorum
+* Only resolve a challenge after its voting period
+
+*/
+
+var _ = Describe("msg_server_challenge_finalized_bundle.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	initialBalanceStaker2 := s.GetBalanceFromAddress(i.STAKER_2)
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "PoolTest",
+			UploadInterval: 60,
+			OperatingCost:  2 * i.KYVE,
+			MinDelegation:  100 * i.KYVE,
+			MaxBundleSize:  100,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		for _, staker := range [][]string{{i.STAKER_0, i.VALADDRESS_0}, {i.STAKER_1, i.VALADDRESS_1}, {i.STAKER_2, i.VALADDRESS_2}} {
+			s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+				Creator: staker[0],
+				Amount:  100 * i.KYVE,
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    staker[0],
+				PoolId:     0,
+				Valaddress: staker[1],
+				Amount:     0,
+			})
+		}
+
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.ChallengeBond = 100 * i.KYVE
+		params.ChallengeReward = 10 * i.KYVE
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     0,
+			BundleSize:    100,
+			FromKey:       "0",
+			ToKey:         "99",
+			BundleSummary: "test_value",
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		nextStaker, nextValaddress := s.GetNextUploader()
+
+		// finalize the first bundle with the uploader and the first staker voting valid
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       nextValaddress,
+			Staker:        nextStaker,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash2",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "100",
+			ToKey:         "199",
+			BundleSummary: "test_value2",
+		})
+
+		initialBalanceStaker2 = s.GetBalanceFromAddress(i.STAKER_2)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Challenge a finalized bundle", func() {
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		// ASSERT
+		challenge, found := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+
+		Expect(challenge.Challenger).To(Equal(i.STAKER_2))
+		Expect(challenge.Bond).To(Equal(100 * i.KYVE))
+		Expect(challenge.CreatedAt).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+		Expect(challenge.Status).To(Equal(bundletypes.CHALLENGE_STATUS_OPEN))
+		Expect(challenge.VotersValid).To(BeEmpty())
+		Expect(challenge.VotersInvalid).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_2)).To(Equal(initialBalanceStaker2 - 100*i.KYVE))
+		Expect(s.GetBalanceFromModule(bundletypes.ModuleName)).To(Equal(100 * i.KYVE))
+
+		finalizedBundle, _ := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(finalizedBundle.VotersValid).To(ConsistOf(i.STAKER_0, i.STAKER_1))
+		Expect(finalizedBundle.Invalidated).To(BeFalse())
+	})
+
+	It("Try to challenge a finalized bundle as a non-staker", func() {
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.ALICE,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		// ASSERT
+		_, found := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to challenge a finalized bundle which does not exist", func() {
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 1,
+		})
+
+		// ASSERT
+		_, found := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 1)
+		Expect(found).To(BeFalse())
+		Expect(s.GetBalanceFromAddress(i.STAKER_2)).To(Equal(initialBalanceStaker2))
+	})
+
+	It("Try to challenge a finalized bundle after the challenge window", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(s.App().BundlesKeeper.GetChallengeWindow(s.Ctx()))
+
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		// ASSERT
+		_, found := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to challenge a finalized bundle twice", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_1,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		// ASSERT
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.Challenger).To(Equal(i.STAKER_2))
+		Expect(s.GetBalanceFromModule(bundletypes.ModuleName)).To(Equal(100 * i.KYVE))
+	})
+
+	It("Vote on a challenge", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteChallenge{
+			Creator:  i.VALADDRESS_2,
+			Staker:   i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+			Vote:     bundletypes.VOTE_TYPE_INVALID,
+		})
+
+		// ASSERT
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.VotersValid).To(BeEmpty())
+		Expect(challenge.VotersInvalid).To(Equal([]string{i.STAKER_2}))
+		Expect(challenge.Status).To(Equal(bundletypes.CHALLENGE_STATUS_OPEN))
+	})
+
+	It("Try to vote on a challenge twice", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteChallenge{
+			Creator:  i.VALADDRESS_2,
+			Staker:   i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+			Vote:     bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgVoteChallenge{
+			Creator:  i.VALADDRESS_2,
+			Staker:   i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+			Vote:     bundletypes.VOTE_TYPE_INVALID,
+		})
+
+		// ASSERT
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.VotersValid).To(Equal([]string{i.STAKER_2}))
+		Expect(challenge.VotersInvalid).To(BeEmpty())
+	})
+
+	It("Try to vote on a challenge as the uploader of the bundle", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgVoteChallenge{
+			Creator:  i.VALADDRESS_0,
+			Staker:   i.STAKER_0,
+			PoolId:   0,
+			BundleId: 0,
+			Vote:     bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(ContainSubstring(bundletypes.ErrAccusedCannotVote.Error())))
+
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.VotersValid).To(BeEmpty())
+		Expect(challenge.VotersInvalid).To(BeEmpty())
+	})
+
+	It("Try to vote on a challenge as a valid voter of the bundle", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgVoteChallenge{
+			Creator:  i.VALADDRESS_1,
+			Staker:   i.STAKER_1,
+			PoolId:   0,
+			BundleId: 0,
+			Vote:     bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(ContainSubstring(bundletypes.ErrAccusedCannotVote.Error())))
+
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.VotersValid).To(BeEmpty())
+		Expect(challenge.VotersInvalid).To(BeEmpty())
+	})
+
+	It("Try to vote abstain on a challenge", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgVoteChallenge{
+			Creator:  i.VALADDRESS_1,
+			Staker:   i.STAKER_1,
+			PoolId:   0,
+			BundleId: 0,
+			Vote:     bundletypes.VOTE_TYPE_ABSTAIN,
+		})
+
+		// ASSERT
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.VotersValid).To(BeEmpty())
+		Expect(challenge.VotersInvalid).To(BeEmpty())
+	})
+
+	It("Try to vote on a challenge after the voting period", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		s.CommitAfterSeconds(s.App().BundlesKeeper.GetChallengeVotingPeriod(s.Ctx()))
+
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgVoteChallenge{
+			Creator:  i.VALADDRESS_2,
+			Staker:   i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+			Vote:     bundletypes.VOTE_TYPE_INVALID,
+		})
+
+		// ASSERT
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.VotersInvalid).To(BeEmpty())
+	})
+
+	It("Reject a challenge without invalid quorum", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteChallenge{
+			Creator:  i.VALADDRESS_2,
+			Staker:   i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+			Vote:     bundletypes.VOTE_TYPE_VALID,
+		})
+
+		communityPool := s.App().DistributionKeeper.GetFeePoolCommunityCoins(s.Ctx()).AmountOf(globalTypes.Denom)
+
+		// ACT
+		s.CommitAfterSeconds(s.App().BundlesKeeper.GetChallengeVotingPeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.Status).To(Equal(bundletypes.CHALLENGE_STATUS_REJECTED))
+
+		finalizedBundle, _ := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(finalizedBundle.Invalidated).To(BeFalse())
+
+		// the bond was transferred to the treasury
+		Expect(s.GetBalanceFromAddress(i.STAKER_2)).To(Equal(initialBalanceStaker2 - 100*i.KYVE))
+		Expect(s.GetBalanceFromModule(bundletypes.ModuleName)).To(BeZero())
+		Expect(s.App().DistributionKeeper.GetFeePoolCommunityCoins(s.Ctx()).AmountOf(globalTypes.Denom).Sub(communityPool).TruncateInt().Uint64()).To(BeNumerically(">=", 100*i.KYVE))
+
+		// nobody got slashed
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(Equal(100 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_1)).To(Equal(100 * i.KYVE))
+	})
+
+	It("Accept a challenge with invalid quorum", func() {
+		// ARRANGE
+		err := s.App().DistributionKeeper.FundCommunityPool(
+			s.Ctx(),
+			sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(100*i.KYVE))),
+			sdk.MustAccAddressFromBech32(i.ALICE),
+		)
+		Expect(err).To(BeNil())
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteChallenge{
+			Creator:  i.VALADDRESS_2,
+			Staker:   i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+			Vote:     bundletypes.VOTE_TYPE_INVALID,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(s.App().BundlesKeeper.GetChallengeVotingPeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.Status).To(Equal(bundletypes.CHALLENGE_STATUS_ACCEPTED))
+
+		finalizedBundle, _ := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(finalizedBundle.Invalidated).To(BeTrue())
+
+		// the challenger got the bond back together with the reward
+		Expect(s.GetBalanceFromAddress(i.STAKER_2)).To(Equal(initialBalanceStaker2 + 10*i.KYVE))
+		Expect(s.GetBalanceFromModule(bundletypes.ModuleName)).To(BeZero())
+
+		// the uploader and the valid voter got slashed
+		uploadSlash := uint64(sdk.NewDec(int64(100 * i.KYVE)).Mul(s.App().DelegationKeeper.GetUploadSlash(s.Ctx())).TruncateInt64())
+		voteSlash := uint64(sdk.NewDec(int64(100 * i.KYVE)).Mul(s.App().DelegationKeeper.GetVoteSlash(s.Ctx())).TruncateInt64())
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(Equal(100*i.KYVE - uploadSlash))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_1)).To(Equal(100*i.KYVE - voteSlash))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_2)).To(Equal(100 * i.KYVE))
+
+		// slashed stakers are not removed from the pool
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+	})
+
+	It("Only resolve a challenge after its voting period", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgChallengeFinalizedBundle{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			BundleId: 0,
+		})
+
+		votingPeriod := s.App().BundlesKeeper.GetChallengeVotingPeriod(s.Ctx())
+
+		// ACT
+		s.CommitAfterSeconds(votingPeriod - 10)
+
+		// ASSERT
+		challenge, _ := s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.Status).To(Equal(bundletypes.CHALLENGE_STATUS_OPEN))
+		Expect(s.App().BundlesKeeper.GetDueBundleChallenges(s.Ctx(), uint64(s.Ctx().BlockTime().Unix()))).To(HaveLen(1))
+
+		// ACT
+		s.CommitAfterSeconds(10)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		challenge, _ = s.App().BundlesKeeper.GetBundleChallenge(s.Ctx(), 0, 0)
+		Expect(challenge.Status).To(Equal(bundletypes.CHALLENGE_STATUS_REJECTED))
+
+		// resolved challenges are no longer in the open challenge index
+		Expect(s.App().BundlesKeeper.GetDueBundleChallenges(s.Ctx(), uint64(s.Ctx().BlockTime().Unix()))).To(BeEmpty())
+		Expect(s.App().BundlesKeeper.GetAllBundleChallenges(s.Ctx())).To(HaveLen(1))
+	})
+})
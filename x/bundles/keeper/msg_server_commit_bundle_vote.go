@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommitBundleVote handles the logic of an SDK message that allows protocol nodes to commit to a hashed vote
+// on a pool's bundle proposal. The vote itself stays hidden until it gets revealed in the reveal window.
+func (k msgServer) CommitBundleVote(
+	goCtx context.Context, msg *types.MsgCommitBundleVote,
+) (*types.MsgCommitBundleVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.AssertCanCommitVote(ctx, msg.PoolId, msg.Staker, msg.Creator, msg.StorageId); err != nil {
+		return nil, err
+	}
+
+	bundleProposal, _ := k.GetBundleProposal(ctx, msg.PoolId)
+	bundleProposal.VoteCommitments = append(bundleProposal.VoteCommitments, &types.VoteCommitment{
+		Staker:     msg.Staker,
+		Commitment: msg.Commitment,
+	})
+
+	k.SetBundleProposal(ctx, bundleProposal)
+
+	// Emit a commit event.
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventBundleVoteCommitted{
+		PoolId:     msg.PoolId,
+		Staker:     msg.Staker,
+		StorageId:  msg.StorageId,
+		Commitment: msg.Commitment,
+	})
+
+	return &types.MsgCommitBundleVoteResponse{}, nil
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_commit_bundle_vote.go
+
+* Commit a vote on bundle proposal
+* Try to commit a vote twice on bundle proposal
+* Try to commit a vote after the commit phase
+* Try to commit a vote with an invalid commitment
+* Try to commit a vote as the uploader
+* Try to commit a vote in a pool without commit-reveal voting
+* Try to vote without commitment in a commit-reveal pool
+
+*/
+
+func setupCommitRevealPool(s *i.KeeperTestSuite) {
+	s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+		Name:           "PoolTest",
+		UploadInterval: 60,
+		OperatingCost:  2 * i.KYVE,
+		MinDelegation:  100 * i.KYVE,
+		MaxBundleSize:  100,
+		VotingMode:     pooltypes.VOTING_MODE_COMMIT_REVEAL,
+		RevealWindow:   30,
+		Protocol: &pooltypes.Protocol{
+			Version:     "0.0.0",
+			Binaries:    "{}",
+			LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+		},
+		UpgradePlan: &pooltypes.UpgradePlan{},
+	})
+
+	s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+		Creator: i.ALICE,
+		Id:      0,
+		Amount:  100 * i.KYVE,
+	})
+
+	for _, staker := range [][]string{{i.STAKER_0, i.VALADDRESS_0}, {i.STAKER_1, i.VALADDRESS_1}, {i.STAKER_2, i.VALADDRESS_2}} {
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: staker[0],
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    staker[0],
+			PoolId:     0,
+			Valaddress: staker[1],
+			Amount:     0,
+		})
+	}
+
+	s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+		Creator: i.VALADDRESS_0,
+		Staker:  i.STAKER_0,
+		PoolId:  0,
+	})
+
+	// the uploader has to wait for the upload interval and the reveal window
+	s.CommitAfterSeconds(90)
+
+	s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+		Creator:       i.VALADDRESS_0,
+		Staker:        i.STAKER_0,
+		PoolId:        0,
+		StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+		DataSize:      100,
+		DataHash:      "test_hash",
+		FromIndex:     0,
+		BundleSize:    100,
+		FromKey:       "0",
+		ToKey:         "99",
+		BundleSummary: "test_value",
+	})
+}
+
+var _ = Describe("msg_server_commit_bundle_vote.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		setupCommitRevealPool(s)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Commit a vote on bundle proposal", func() {
+		// ARRANGE
+		commitment := bundletypes.GetVoteCommitment(bundletypes.VOTE_TYPE_VALID, "salt", i.STAKER_1)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgCommitBundleVote{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Commitment: commitment,
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		Expect(bundleProposal.VoteCommitments).To(HaveLen(1))
+		Expect(bundleProposal.VoteCommitments[0].Staker).To(Equal(i.STAKER_1))
+		Expect(bundleProposal.VoteCommitments[0].Commitment).To(Equal(commitment))
+
+		Expect(bundleProposal.VotersValid).NotTo(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersInvalid).NotTo(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersAbstain).NotTo(ContainElement(i.STAKER_1))
+
+		// the vote is not tallied before it is revealed
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+		Expect(voteDistribution.Valid).To(Equal(100 * i.KYVE))
+	})
+
+	It("Try to commit a vote twice on bundle proposal", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgCommitBundleVote{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Commitment: bundletypes.GetVoteCommitment(bundletypes.VOTE_TYPE_VALID, "salt", i.STAKER_1),
+		})
+
+		// ACT
+		err := s.RunTxError(&bundletypes.MsgCommitBundleVote{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Commitment: bundletypes.GetVoteCommitment(bundletypes.VOTE_TYPE_INVALID, "salt", i.STAKER_1),
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(bundletypes.ErrAlreadyCommitted))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VoteCommitments).To(HaveLen(1))
+	})
+
+	It("Try to commit a vote after the commit phase", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		err := s.RunTxError(&bundletypes.MsgCommitBundleVote{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Commitment: bundletypes.GetVoteCommitment(bundletypes.VOTE_TYPE_VALID, "salt", i.STAKER_1),
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(ContainSubstring(bundletypes.ErrCommitPhaseOver.Error())))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VoteCommitments).To(BeEmpty())
+	})
+
+	It("Try to commit a vote with an invalid commitment", func() {
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgCommitBundleVote{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Commitment: "valid",
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VoteCommitments).To(BeEmpty())
+	})
+
+	It("Try to commit a vote as the uploader", func() {
+		// ACT
+		err := s.RunTxError(&bundletypes.MsgCommitBundleVote{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Commitment: bundletypes.GetVoteCommitment(bundletypes.VOTE_TYPE_INVALID, "salt", i.STAKER_0),
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(bundletypes.ErrAlreadyVotedValid))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VoteCommitments).To(BeEmpty())
+	})
+
+	It("Try to commit a vote in a pool without commit-reveal voting", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.VotingMode = pooltypes.VOTING_MODE_PLAIN
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		err := s.RunTxError(&bundletypes.MsgCommitBundleVote{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Commitment: bundletypes.GetVoteCommitment(bundletypes.VOTE_TYPE_VALID, "salt", i.STAKER_1),
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(bundletypes.ErrCommitRevealDisabled))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VoteCommitments).To(BeEmpty())
+	})
+
+	It("Try to vote without commitment in a commit-reveal pool", func() {
+		// ACT
+		err := s.RunTxError(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(bundletypes.ErrCommitRevealRequired))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).NotTo(ContainElement(i.STAKER_1))
+	})
+})
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RevealBundleVote handles the logic of an SDK message that allows protocol nodes to reveal the vote
+// they committed to on a pool's bundle proposal. Only revealed votes are counted.
+func (k msgServer) RevealBundleVote(
+	goCtx context.Context, msg *types.MsgRevealBundleVote,
+) (*types.MsgRevealBundleVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.AssertCanRevealVote(ctx, msg.PoolId, msg.Staker, msg.Creator, msg.StorageId); err != nil {
+		return nil, err
+	}
+
+	bundleProposal, _ := k.GetBundleProposal(ctx, msg.PoolId)
+
+	// Check if the revealed vote matches the commitment
+	commitment, _ := getVoteCommitment(bundleProposal, msg.Staker)
+	if commitment != types.GetVoteCommitment(msg.Vote, msg.Salt, msg.Staker) {
+		return nil, types.ErrInvalidVoteReveal
+	}
+
+	switch msg.Vote {
+	case types.VOTE_TYPE_VALID:
+		bundleProposal.VotersValid = append(bundleProposal.VotersValid, msg.Staker)
+	case types.VOTE_TYPE_INVALID:
+		bundleProposal.VotersInvalid = append(bundleProposal.VotersInvalid, msg.Staker)
+	case types.VOTE_TYPE_ABSTAIN:
+		bundleProposal.VotersAbstain = append(bundleProposal.VotersAbstain, msg.Staker)
+	default:
+		return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrInvalidVote.Error(), msg.Vote)
+	}
+
+	// remove the commitment as the vote is now public
+	voteCommitments := make([]*types.VoteCommitment, 0)
+	for _, voteCommitment := range bundleProposal.VoteCommitments {
+		if voteCommitment.Staker != msg.Staker {
+			voteCommitments = append(voteCommitments, voteCommitment)
+		}
+	}
+	bundleProposal.VoteCommitments = voteCommitments
+
+	k.SetBundleProposal(ctx, bundleProposal)
+
+	// decay points as user has now proven to be active
+	k.decayPoints(ctx, msg.PoolId, msg.Staker)
+
+	// Emit a vote event.
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventBundleVote{
+		PoolId:    msg.PoolId,
+		Staker:    msg.Staker,
+		StorageId: msg.StorageId,
+		Vote:      msg.Vote,
+	})
+
+	return &types.MsgRevealBundleVoteResponse{}, nil
+}
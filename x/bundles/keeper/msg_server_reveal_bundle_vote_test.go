@@ -0,0 +1,323 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+)
+
+/*
+
+TEST CASES - msg_server_reveal_bundle_vote.go
+
+* Reveal a valid vote on bundle proposal
+* Reveal an invalid vote on bundle proposal
+* Try to reveal a vote during the commit phase
+* Try to reveal a vote after the reveal window
+* Try to reveal a vote with a wrong salt
+* Try to reveal a different vote than committed
+* Try to reveal a vote without commitment
+* Try to reveal a vote twice
+* Try to submit the next bundle proposal before the reveal window is over
+* Unrevealed votes are not tallied and count as non-votes
+
+*/
+
+var _ = Describe("msg_server_reveal_bundle_vote.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		setupCommitRevealPool(s)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgCommitBundleVote{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Commitment: bundletypes.GetVoteCommitment(bundletypes.VOTE_TYPE_VALID, "salt_1", i.STAKER_1),
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgCommitBundleVote{
+			Creator:    i.VALADDRESS_2,
+			Staker:     i.STAKER_2,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Commitment: bundletypes.GetVoteCommitment(bundletypes.VOTE_TYPE_INVALID, "salt_2", i.STAKER_2),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Reveal a valid vote on bundle proposal", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+			Salt:      "salt_1",
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		Expect(bundleProposal.VotersValid).To(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersInvalid).NotTo(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersAbstain).NotTo(ContainElement(i.STAKER_1))
+
+		Expect(bundleProposal.VoteCommitments).To(HaveLen(1))
+		Expect(bundleProposal.VoteCommitments[0].Staker).To(Equal(i.STAKER_2))
+
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+		Expect(voteDistribution.Valid).To(Equal(200 * i.KYVE))
+		Expect(voteDistribution.Invalid).To(BeZero())
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_VALID))
+	})
+
+	It("Reveal an invalid vote on bundle proposal", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_2,
+			Staker:    i.STAKER_2,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_INVALID,
+			Salt:      "salt_2",
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		Expect(bundleProposal.VotersValid).NotTo(ContainElement(i.STAKER_2))
+		Expect(bundleProposal.VotersInvalid).To(ContainElement(i.STAKER_2))
+		Expect(bundleProposal.VotersAbstain).NotTo(ContainElement(i.STAKER_2))
+
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+		Expect(voteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Invalid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_NO_QUORUM))
+	})
+
+	It("Try to reveal a vote during the commit phase", func() {
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+			Salt:      "salt_1",
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(ContainSubstring(bundletypes.ErrNotInRevealPhase.Error())))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).NotTo(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VoteCommitments).To(HaveLen(2))
+	})
+
+	It("Try to reveal a vote after the reveal window", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(90)
+
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+			Salt:      "salt_1",
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).NotTo(ContainElement(i.STAKER_1))
+	})
+
+	It("Try to reveal a vote with a wrong salt", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+			Salt:      "salt_2",
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(bundletypes.ErrInvalidVoteReveal))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).NotTo(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VoteCommitments).To(HaveLen(2))
+	})
+
+	It("Try to reveal a different vote than committed", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_2,
+			Staker:    i.STAKER_2,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+			Salt:      "salt_2",
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(bundletypes.ErrInvalidVoteReveal))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).NotTo(ContainElement(i.STAKER_2))
+		Expect(bundleProposal.VotersInvalid).NotTo(ContainElement(i.STAKER_2))
+	})
+
+	It("Try to reveal a vote without commitment", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_0,
+			Staker:    i.STAKER_0,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_ABSTAIN,
+			Salt:      "salt_0",
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersAbstain).NotTo(ContainElement(i.STAKER_0))
+	})
+
+	It("Try to reveal a vote twice", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+			Salt:      "salt_1",
+		})
+
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+			Salt:      "salt_1",
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).To(Equal([]string{i.STAKER_0, i.STAKER_1}))
+	})
+
+	It("Try to submit the next bundle proposal before the reveal window is over", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(60)
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, bundleProposal.NextUploader)
+
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       valaccount.Valaddress,
+			Staker:        bundleProposal.NextUploader,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash2",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "100",
+			ToKey:         "199",
+			BundleSummary: "test_value2",
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(ContainSubstring(bundletypes.ErrUploadInterval.Error())))
+
+		_, found := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Unrevealed votes are not tallied and count as non-votes", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgRevealBundleVote{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+			Salt:      "salt_1",
+		})
+
+		s.CommitAfterSeconds(30)
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, bundleProposal.NextUploader)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       valaccount.Valaddress,
+			Staker:        bundleProposal.NextUploader,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash2",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "100",
+			ToKey:         "199",
+			BundleSummary: "test_value2",
+		})
+
+		// ASSERT
+		finalizedBundle, found := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+		Expect(finalizedBundle.StakeSecurity.ValidVotePower).To(Equal(200 * i.KYVE))
+		Expect(finalizedBundle.StakeSecurity.TotalVotePower).To(Equal(300 * i.KYVE))
+
+		// the staker who did not reveal receives a point like a non-voter
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_2)
+		Expect(valaccountVoter.Points).To(Equal(uint64(1)))
+
+		valaccountVoter, _ = s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccountVoter.Points).To(BeZero())
+	})
+})
@@ -17,6 +17,7 @@ TEST CASES - msg_server_skip_uploader_role.go
 * Skip uploader on data bundle after uploader role has already been skipped
 * Skip uploader on data bundle if staker is the only staker in pool
 * Skip uploader role on dropped bundle
+* Skip uploader role in a pool with stake-weighted random uploader selection
 
 */
 
@@ -266,4 +267,45 @@ var _ = Describe("msg_server_skip_uploader_role.go", Ordered, func() {
 		// here the next uploader should be always be different after skipping
 		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_1))
 	})
+
+	It("Skip uploader role in a pool with stake-weighted random uploader selection", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.UploaderSelection = pooltypes.UPLOADER_SELECTION_STAKE_WEIGHTED_RANDOM
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		roundRobinProgress, _ := s.App().BundlesKeeper.GetRoundRobinProgress(s.Ctx(), 0)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSkipUploaderRole{
+			Creator:   i.VALADDRESS_0,
+			Staker:    i.STAKER_0,
+			PoolId:    0,
+			FromIndex: 100,
+		})
+
+		// ASSERT
+		bundleProposal, found := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+
+		// the staker who skipped is excluded, so only the other staker can be selected
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_1))
+
+		// the round-robin progress is not touched by the stake-weighted random selection
+		roundRobinProgressAfter, _ := s.App().BundlesKeeper.GetRoundRobinProgress(s.Ctx(), 0)
+		Expect(roundRobinProgressAfter).To(Equal(roundRobinProgress))
+	})
 })
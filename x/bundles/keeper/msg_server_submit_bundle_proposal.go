@@ -56,7 +56,8 @@ func (k msgServer) SubmitBundleProposal(goCtx context.Context, msg *types.MsgSub
 	case types.BUNDLE_STATUS_VALID:
 		// If a bundle is valid the following things happen:
 		// 1. Funders and Inflation Pool are charged. The total payout is divided
-		//    between the uploader, its delegators and the treasury.
+		//    between the uploader, its delegators and the treasury. Payouts in
+		//    non-native denoms are divided the same way.
 		//    The appropriate funds are deducted from the total pool funds
 		// 2. The next uploader is randomly selected based on everybody who
 		//    voted valid on this bundle.
@@ -78,14 +79,25 @@ func (k msgServer) SubmitBundleProposal(goCtx context.Context, msg *types.MsgSub
 			return &types.MsgSubmitBundleProposalResponse{}, err
 		}
 
+		// charge the funders in all non-native denoms
+		fundersCoinsPayout, err := k.poolKeeper.ChargeFunderCoinsOfPool(ctx, msg.PoolId)
+		if err != nil {
+			return &types.MsgSubmitBundleProposalResponse{}, err
+		}
+
 		// calculate payouts to the different stakeholders like treasury, uploader and delegators
-		bundleReward := k.calculatePayouts(ctx, msg.PoolId, fundersPayout+inflationPayout)
+		bundleReward := k.calculatePayouts(ctx, msg.PoolId, fundersPayout+inflationPayout, fundersCoinsPayout)
 
 		// payout rewards to treasury
 		if err := util.TransferFromModuleToTreasury(k.accountKeeper, k.distrkeeper, ctx, poolTypes.ModuleName, bundleReward.Treasury); err != nil {
 			return nil, err
 		}
 
+		// payout share of the network fee to the insurance fund
+		if err := k.delegationKeeper.FundInsurance(ctx, bundleReward.Insurance, poolTypes.ModuleName); err != nil {
+			return nil, err
+		}
+
 		// payout rewards to uploader through commission rewards
 		if err := k.stakerKeeper.IncreaseStakerCommissionRewards(ctx, bundleProposal.Uploader, bundleReward.Uploader); err != nil {
 			return nil, err
@@ -96,6 +108,19 @@ func (k msgServer) SubmitBundleProposal(goCtx context.Context, msg *types.MsgSub
 			return nil, err
 		}
 
+		// payout rewards in non-native denoms to treasury, uploader and delegators
+		if err := util.TransferCoinsFromModuleToTreasury(k.accountKeeper, k.distrkeeper, ctx, poolTypes.ModuleName, bundleReward.TreasuryCoins); err != nil {
+			return nil, err
+		}
+
+		if err := k.stakerKeeper.IncreaseStakerCommissionCoinRewards(ctx, bundleProposal.Uploader, bundleReward.UploaderCoins); err != nil {
+			return nil, err
+		}
+
+		if err := k.delegationKeeper.PayoutCoinRewards(ctx, bundleProposal.Uploader, bundleReward.DelegationCoins, poolTypes.ModuleName); err != nil {
+			return nil, err
+		}
+
 		// slash stakers who voted incorrectly
 		for _, voter := range bundleProposal.VotersInvalid {
 			k.slashDelegatorsAndRemoveStaker(ctx, msg.PoolId, voter, delegationTypes.SLASH_TYPE_VOTE)
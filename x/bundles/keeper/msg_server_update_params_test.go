@@ -36,6 +36,18 @@ TEST CASES - msg_server_update_params.go
 * Update max points
 * Update max points with invalid value
 
+* Update voting power curve
+* Update voting power curve with invalid value
+
+* Update voting power cap
+* Update voting power cap with invalid value
+
+* Update challenge bond
+* Update challenge bond with invalid value
+
+* Update missed vote points
+* Update missed vote points with invalid value
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -68,6 +80,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(params.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(params.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(params.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(params.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(params.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(params.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(params.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(params.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(params.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(params.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(params.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(params.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Invalid authority (transaction)", func() {
@@ -108,7 +129,16 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 			"upload_timeout": 20,
 			"storage_cost": "0.050000000000000000",
 			"network_fee": "0.05",
-			"max_points": 15
+			"max_points": 15,
+			"voting_power_curve": 2,
+			"voting_power_cap": "0.3",
+			"challenge_window": 3600,
+			"challenge_bond": 50000000000,
+			"challenge_voting_period": 600,
+			"challenge_reward": 5000000000,
+			"points_decay_votes": 10,
+			"upload_timeout_points": 2,
+			"missed_vote_points": 3
 		}`
 
 		msg := &types.MsgUpdateParams{
@@ -141,6 +171,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(sdk.MustNewDecFromStr("0.05")))
 		Expect(updatedParams.NetworkFee).To(Equal(sdk.MustNewDecFromStr("0.05")))
 		Expect(updatedParams.MaxPoints).To(Equal(uint64(15)))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.VOTING_POWER_CURVE_CAPPED))
+		Expect(updatedParams.VotingPowerCap).To(Equal(sdk.MustNewDecFromStr("0.3")))
+		Expect(updatedParams.ChallengeWindow).To(Equal(uint64(3600)))
+		Expect(updatedParams.ChallengeBond).To(Equal(50 * i.KYVE))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(uint64(600)))
+		Expect(updatedParams.ChallengeReward).To(Equal(5 * i.KYVE))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(uint64(10)))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(uint64(2)))
+		Expect(updatedParams.MissedVotePoints).To(Equal(uint64(3)))
 	})
 
 	It("Update no params", func() {
@@ -177,6 +216,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Update with invalid formatted payload", func() {
@@ -209,6 +257,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Update upload timeout", func() {
@@ -247,6 +304,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Update upload timeout with invalid value", func() {
@@ -279,6 +345,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Update storage cost", func() {
@@ -317,6 +392,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(sdk.MustNewDecFromStr("0.05")))
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Update storage cost with invalid value", func() {
@@ -349,6 +433,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Update network fee", func() {
@@ -387,6 +480,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(updatedParams.NetworkFee).To(Equal(sdk.MustNewDecFromStr("0.05")))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Update network fee with invalid value", func() {
@@ -419,6 +521,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Update max points", func() {
@@ -457,6 +568,15 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(uint64(15)))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 
 	It("Update max points with invalid value", func() {
@@ -489,5 +609,366 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
+	})
+
+	It("Update voting power curve", func() {
+		// ARRANGE
+		payload := `{
+			"voting_power_curve": 1
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.VOTING_POWER_CURVE_SQUARE_ROOT))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
+	})
+
+	It("Update voting power curve with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"voting_power_curve": 5
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
+	})
+
+	It("Update voting power cap", func() {
+		// ARRANGE
+		payload := `{
+			"voting_power_cap": "0.5"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(sdk.MustNewDecFromStr("0.5")))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
+	})
+
+	It("Update voting power cap with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"voting_power_cap": "1.5"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
+	})
+
+	It("Update challenge bond", func() {
+		// ARRANGE
+		payload := `{
+			"challenge_bond": 500000000000
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(500 * i.KYVE))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
+	})
+
+	It("Update challenge bond with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"challenge_bond": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
+	})
+
+	It("Update missed vote points", func() {
+		// ARRANGE
+		payload := `{
+			"missed_vote_points": 5
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(uint64(5)))
+	})
+
+	It("Update missed vote points with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"missed_vote_points": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCost).To(Equal(types.DefaultStorageCost))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.VotingPowerCurve).To(Equal(types.DefaultVotingPowerCurve))
+		Expect(updatedParams.VotingPowerCap).To(Equal(types.DefaultVotingPowerCap))
+		Expect(updatedParams.ChallengeWindow).To(Equal(types.DefaultChallengeWindow))
+		Expect(updatedParams.ChallengeBond).To(Equal(types.DefaultChallengeBond))
+		Expect(updatedParams.ChallengeVotingPeriod).To(Equal(types.DefaultChallengeVotingPeriod))
+		Expect(updatedParams.ChallengeReward).To(Equal(types.DefaultChallengeReward))
+		Expect(updatedParams.PointsDecayVotes).To(Equal(types.DefaultPointsDecayVotes))
+		Expect(updatedParams.UploadTimeoutPoints).To(Equal(types.DefaultUploadTimeoutPoints))
+		Expect(updatedParams.MissedVotePoints).To(Equal(types.DefaultMissedVotePoints))
 	})
 })
@@ -45,8 +45,11 @@ func (k msgServer) VoteBundleProposal(
 
 	k.SetBundleProposal(ctx, bundleProposal)
 
-	// reset points as user has now proven to be active
-	k.resetPoints(ctx, msg.PoolId, msg.Staker)
+	// decay points as user has now proven to be active, a vote after
+	// an abstain vote is already counted for this round
+	if !hasVotedAbstain {
+		k.decayPoints(ctx, msg.PoolId, msg.Staker)
+	}
 
 	// Emit a vote event.
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventBundleVote{
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// VoteChallenge handles the logic of an SDK message that allows protocol nodes to vote
+// on an open challenge of a finalized bundle. The challenge gets resolved once the
+// voting period is over.
+func (k msgServer) VoteChallenge(
+	goCtx context.Context, msg *types.MsgVoteChallenge,
+) (*types.MsgVoteChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.AssertCanVoteOnChallenge(ctx, msg.PoolId, msg.BundleId, msg.Staker, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	challenge, _ := k.GetBundleChallenge(ctx, msg.PoolId, msg.BundleId)
+
+	// Update and return.
+	switch msg.Vote {
+	case types.VOTE_TYPE_VALID:
+		challenge.VotersValid = append(challenge.VotersValid, msg.Staker)
+	case types.VOTE_TYPE_INVALID:
+		challenge.VotersInvalid = append(challenge.VotersInvalid, msg.Staker)
+	default:
+		return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrInvalidVote.Error(), msg.Vote)
+	}
+
+	k.SetBundleChallenge(ctx, challenge)
+
+	// Emit a vote event.
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventChallengeVote{
+		PoolId:   msg.PoolId,
+		BundleId: msg.BundleId,
+		Staker:   msg.Staker,
+		Vote:     msg.Vote,
+	})
+
+	return &types.MsgVoteChallengeResponse{}, nil
+}
@@ -68,6 +68,43 @@ func (BundleStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_889cf76d77a4de2b, []int{0}
 }
 
+// ChallengeStatus represents the status of a challenge
+// of a finalized bundle.
+type ChallengeStatus int32
+
+const (
+	// CHALLENGE_STATUS_UNSPECIFIED ...
+	CHALLENGE_STATUS_UNSPECIFIED ChallengeStatus = 0
+	// CHALLENGE_STATUS_OPEN is a challenge which is still voted on
+	CHALLENGE_STATUS_OPEN ChallengeStatus = 1
+	// CHALLENGE_STATUS_ACCEPTED is a challenge which proved the bundle to be invalid
+	CHALLENGE_STATUS_ACCEPTED ChallengeStatus = 2
+	// CHALLENGE_STATUS_REJECTED is a challenge which did not prove the bundle to be invalid
+	CHALLENGE_STATUS_REJECTED ChallengeStatus = 3
+)
+
+var ChallengeStatus_name = map[int32]string{
+	0: "CHALLENGE_STATUS_UNSPECIFIED",
+	1: "CHALLENGE_STATUS_OPEN",
+	2: "CHALLENGE_STATUS_ACCEPTED",
+	3: "CHALLENGE_STATUS_REJECTED",
+}
+
+var ChallengeStatus_value = map[string]int32{
+	"CHALLENGE_STATUS_UNSPECIFIED": 0,
+	"CHALLENGE_STATUS_OPEN":        1,
+	"CHALLENGE_STATUS_ACCEPTED":    2,
+	"CHALLENGE_STATUS_REJECTED":    3,
+}
+
+func (x ChallengeStatus) String() string {
+	return proto.EnumName(ChallengeStatus_name, int32(x))
+}
+
+func (ChallengeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_889cf76d77a4de2b, []int{1}
+}
+
 // BundleProposal represents the current bundle proposal
 // of a storage pool
 type BundleProposal struct {
@@ -103,6 +140,8 @@ type BundleProposal struct {
 	StorageProviderId uint32 `protobuf:"varint,15,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
 	// compression_id the id of the compression type with which the data was compressed
 	CompressionId uint32 `protobuf:"varint,16,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
+	// vote_commitments list of all unrevealed vote commitments for the current proposal
+	VoteCommitments []*VoteCommitment `protobuf:"bytes,17,rep,name=vote_commitments,json=voteCommitments,proto3" json:"vote_commitments,omitempty"`
 }
 
 func (m *BundleProposal) Reset()         { *m = BundleProposal{} }
@@ -250,6 +289,69 @@ func (m *BundleProposal) GetCompressionId() uint32 {
 	return 0
 }
 
+func (m *BundleProposal) GetVoteCommitments() []*VoteCommitment {
+	if m != nil {
+		return m.VoteCommitments
+	}
+	return nil
+}
+
+// VoteCommitment is the hashed vote of a staker which has not been
+// revealed yet. It is only used by pools with commit-reveal voting.
+type VoteCommitment struct {
+	// staker is the address of the staker who committed to the vote
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// commitment is the hex encoded sha256 hash of "<vote>|<salt>|<staker>"
+	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *VoteCommitment) Reset()         { *m = VoteCommitment{} }
+func (m *VoteCommitment) String() string { return proto.CompactTextString(m) }
+func (*VoteCommitment) ProtoMessage()    {}
+func (*VoteCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889cf76d77a4de2b, []int{1}
+}
+func (m *VoteCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteCommitment.Merge(m, src)
+}
+func (m *VoteCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteCommitment proto.InternalMessageInfo
+
+func (m *VoteCommitment) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *VoteCommitment) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
 // FinalizedBundle represents a bundle proposal where the majority
 // agreed on its validity
 type FinalizedBundle struct {
@@ -281,13 +383,17 @@ type FinalizedBundle struct {
 	CompressionId uint32 `protobuf:"varint,13,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
 	// stake_security
 	StakeSecurity *StakeSecurity `protobuf:"bytes,14,opt,name=stake_security,json=stakeSecurity,proto3" json:"stake_security,omitempty"`
+	// voters_valid list of all stakers who voted in favor for the bundle
+	VotersValid []string `protobuf:"bytes,15,rep,name=voters_valid,json=votersValid,proto3" json:"voters_valid,omitempty"`
+	// invalidated is true if the bundle was successfully challenged after its finalization
+	Invalidated bool `protobuf:"varint,16,opt,name=invalidated,proto3" json:"invalidated,omitempty"`
 }
 
 func (m *FinalizedBundle) Reset()         { *m = FinalizedBundle{} }
 func (m *FinalizedBundle) String() string { return proto.CompactTextString(m) }
 func (*FinalizedBundle) ProtoMessage()    {}
 func (*FinalizedBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_889cf76d77a4de2b, []int{1}
+	return fileDescriptor_889cf76d77a4de2b, []int{2}
 }
 func (m *FinalizedBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,6 +520,20 @@ func (m *FinalizedBundle) GetStakeSecurity() *StakeSecurity {
 	return nil
 }
 
+func (m *FinalizedBundle) GetVotersValid() []string {
+	if m != nil {
+		return m.VotersValid
+	}
+	return nil
+}
+
+func (m *FinalizedBundle) GetInvalidated() bool {
+	if m != nil {
+		return m.Invalidated
+	}
+	return false
+}
+
 // FinalizedAt ...
 type FinalizedAt struct {
 	// height ...
@@ -426,7 +546,7 @@ func (m *FinalizedAt) Reset()         { *m = FinalizedAt{} }
 func (m *FinalizedAt) String() string { return proto.CompactTextString(m) }
 func (*FinalizedAt) ProtoMessage()    {}
 func (*FinalizedAt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_889cf76d77a4de2b, []int{2}
+	return fileDescriptor_889cf76d77a4de2b, []int{3}
 }
 func (m *FinalizedAt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,17 +591,21 @@ func (m *FinalizedAt) GetTimestamp() uint64 {
 
 // StakeSecurity stores information about total stake and valid votes with which the bundle got finalized.
 type StakeSecurity struct {
-	// valid_vote_power is the total amount of stake of all pool stakers which voted valid for the given bundle.
+	// valid_vote_power is the total effective voting power of all pool stakers which voted valid for the given bundle.
 	ValidVotePower uint64 `protobuf:"varint,1,opt,name=valid_vote_power,json=validVotePower,proto3" json:"valid_vote_power,omitempty"`
-	// total_vote_power is the total amount of stake that was present during the finalization of the bundle
+	// total_vote_power is the total effective voting power that was present during the finalization of the bundle
 	TotalVotePower uint64 `protobuf:"varint,2,opt,name=total_vote_power,json=totalVotePower,proto3" json:"total_vote_power,omitempty"`
+	// raw_valid_vote_power is the total amount of stake of all pool stakers which voted valid for the given bundle.
+	RawValidVotePower uint64 `protobuf:"varint,3,opt,name=raw_valid_vote_power,json=rawValidVotePower,proto3" json:"raw_valid_vote_power,omitempty"`
+	// raw_total_vote_power is the total amount of stake that was present during the finalization of the bundle
+	RawTotalVotePower uint64 `protobuf:"varint,4,opt,name=raw_total_vote_power,json=rawTotalVotePower,proto3" json:"raw_total_vote_power,omitempty"`
 }
 
 func (m *StakeSecurity) Reset()         { *m = StakeSecurity{} }
 func (m *StakeSecurity) String() string { return proto.CompactTextString(m) }
 func (*StakeSecurity) ProtoMessage()    {}
 func (*StakeSecurity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_889cf76d77a4de2b, []int{3}
+	return fileDescriptor_889cf76d77a4de2b, []int{4}
 }
 func (m *StakeSecurity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,6 +648,20 @@ func (m *StakeSecurity) GetTotalVotePower() uint64 {
 	return 0
 }
 
+func (m *StakeSecurity) GetRawValidVotePower() uint64 {
+	if m != nil {
+		return m.RawValidVotePower
+	}
+	return 0
+}
+
+func (m *StakeSecurity) GetRawTotalVotePower() uint64 {
+	if m != nil {
+		return m.RawTotalVotePower
+	}
+	return 0
+}
+
 // BundleVersionEntry ...
 type BundleVersionEntry struct {
 	// height ...
@@ -536,7 +674,7 @@ func (m *BundleVersionEntry) Reset()         { *m = BundleVersionEntry{} }
 func (m *BundleVersionEntry) String() string { return proto.CompactTextString(m) }
 func (*BundleVersionEntry) ProtoMessage()    {}
 func (*BundleVersionEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_889cf76d77a4de2b, []int{4}
+	return fileDescriptor_889cf76d77a4de2b, []int{5}
 }
 func (m *BundleVersionEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +727,7 @@ func (m *BundleVersionMap) Reset()         { *m = BundleVersionMap{} }
 func (m *BundleVersionMap) String() string { return proto.CompactTextString(m) }
 func (*BundleVersionMap) ProtoMessage()    {}
 func (*BundleVersionMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_889cf76d77a4de2b, []int{5}
+	return fileDescriptor_889cf76d77a4de2b, []int{6}
 }
 func (m *BundleVersionMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,7 +775,7 @@ func (m *RoundRobinSingleValidatorProgress) Reset()         { *m = RoundRobinSin
 func (m *RoundRobinSingleValidatorProgress) String() string { return proto.CompactTextString(m) }
 func (*RoundRobinSingleValidatorProgress) ProtoMessage()    {}
 func (*RoundRobinSingleValidatorProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_889cf76d77a4de2b, []int{6}
+	return fileDescriptor_889cf76d77a4de2b, []int{7}
 }
 func (m *RoundRobinSingleValidatorProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,7 +830,7 @@ func (m *RoundRobinProgress) Reset()         { *m = RoundRobinProgress{} }
 func (m *RoundRobinProgress) String() string { return proto.CompactTextString(m) }
 func (*RoundRobinProgress) ProtoMessage()    {}
 func (*RoundRobinProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_889cf76d77a4de2b, []int{7}
+	return fileDescriptor_889cf76d77a4de2b, []int{8}
 }
 func (m *RoundRobinProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,9 +873,121 @@ func (m *RoundRobinProgress) GetProgressList() []*RoundRobinSingleValidatorProgr
 	return nil
 }
 
+// BundleChallenge is a dispute about a finalized bundle which is resolved
+// by a re-vote of the current pool stakers.
+type BundleChallenge struct {
+	// pool_id is the id of the pool of the challenged bundle
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundle_id is the id of the challenged finalized bundle
+	BundleId uint64 `protobuf:"varint,2,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// challenger is the address of the staker who opened the challenge
+	Challenger string `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// bond is the amount of $KYVE the challenger posted
+	Bond uint64 `protobuf:"varint,4,opt,name=bond,proto3" json:"bond,omitempty"`
+	// created_at is the unix time in seconds the challenge was opened
+	CreatedAt uint64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// voters_valid list of all stakers who voted that the bundle is valid
+	VotersValid []string `protobuf:"bytes,6,rep,name=voters_valid,json=votersValid,proto3" json:"voters_valid,omitempty"`
+	// voters_invalid list of all stakers who voted that the bundle is invalid
+	VotersInvalid []string `protobuf:"bytes,7,rep,name=voters_invalid,json=votersInvalid,proto3" json:"voters_invalid,omitempty"`
+	// status is the current status of the challenge
+	Status ChallengeStatus `protobuf:"varint,8,opt,name=status,proto3,enum=kyve.bundles.v1beta1.ChallengeStatus" json:"status,omitempty"`
+}
+
+func (m *BundleChallenge) Reset()         { *m = BundleChallenge{} }
+func (m *BundleChallenge) String() string { return proto.CompactTextString(m) }
+func (*BundleChallenge) ProtoMessage()    {}
+func (*BundleChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889cf76d77a4de2b, []int{9}
+}
+func (m *BundleChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BundleChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleChallenge.Merge(m, src)
+}
+func (m *BundleChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleChallenge proto.InternalMessageInfo
+
+func (m *BundleChallenge) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *BundleChallenge) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
+	}
+	return 0
+}
+
+func (m *BundleChallenge) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *BundleChallenge) GetBond() uint64 {
+	if m != nil {
+		return m.Bond
+	}
+	return 0
+}
+
+func (m *BundleChallenge) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *BundleChallenge) GetVotersValid() []string {
+	if m != nil {
+		return m.VotersValid
+	}
+	return nil
+}
+
+func (m *BundleChallenge) GetVotersInvalid() []string {
+	if m != nil {
+		return m.VotersInvalid
+	}
+	return nil
+}
+
+func (m *BundleChallenge) GetStatus() ChallengeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CHALLENGE_STATUS_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterEnum("kyve.bundles.v1beta1.BundleStatus", BundleStatus_name, BundleStatus_value)
+	proto.RegisterEnum("kyve.bundles.v1beta1.ChallengeStatus", ChallengeStatus_name, ChallengeStatus_value)
 	proto.RegisterType((*BundleProposal)(nil), "kyve.bundles.v1beta1.BundleProposal")
+	proto.RegisterType((*VoteCommitment)(nil), "kyve.bundles.v1beta1.VoteCommitment")
 	proto.RegisterType((*FinalizedBundle)(nil), "kyve.bundles.v1beta1.FinalizedBundle")
 	proto.RegisterType((*FinalizedAt)(nil), "kyve.bundles.v1beta1.FinalizedAt")
 	proto.RegisterType((*StakeSecurity)(nil), "kyve.bundles.v1beta1.StakeSecurity")
@@ -745,6 +995,7 @@ func init() {
 	proto.RegisterType((*BundleVersionMap)(nil), "kyve.bundles.v1beta1.BundleVersionMap")
 	proto.RegisterType((*RoundRobinSingleValidatorProgress)(nil), "kyve.bundles.v1beta1.RoundRobinSingleValidatorProgress")
 	proto.RegisterType((*RoundRobinProgress)(nil), "kyve.bundles.v1beta1.RoundRobinProgress")
+	proto.RegisterType((*BundleChallenge)(nil), "kyve.bundles.v1beta1.BundleChallenge")
 }
 
 func init() {
@@ -752,65 +1003,80 @@ func init() {
 }
 
 var fileDescriptor_889cf76d77a4de2b = []byte{
-	// 918 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdd, 0x6e, 0x1a, 0x47,
-	0x14, 0x66, 0x01, 0xf3, 0x73, 0x60, 0x09, 0x9d, 0xfc, 0x78, 0xed, 0xd4, 0x14, 0x13, 0x55, 0x42,
-	0x55, 0x05, 0x8a, 0x7b, 0xd1, 0x6b, 0x1c, 0x40, 0xdd, 0xc6, 0x21, 0x74, 0x37, 0xa0, 0xa6, 0xaa,
-	0xb4, 0x1a, 0xd8, 0x31, 0x8c, 0x0c, 0x3b, 0xab, 0xdd, 0x81, 0x18, 0x3f, 0x41, 0xa5, 0x4a, 0x55,
-	0xdf, 0xa1, 0x7d, 0x8c, 0x3e, 0x40, 0x2f, 0x73, 0xd9, 0xcb, 0xca, 0x7e, 0x91, 0x6a, 0x66, 0x76,
-	0x31, 0xc4, 0xb8, 0xcd, 0x4d, 0xef, 0x38, 0xdf, 0xf7, 0xcd, 0x99, 0xf3, 0xf3, 0x0d, 0x0b, 0xb5,
-	0x8b, 0xd5, 0x92, 0x34, 0x47, 0x0b, 0xcf, 0x9d, 0x91, 0xb0, 0xb9, 0x7c, 0x3e, 0x22, 0x1c, 0x3f,
-	0x8f, 0xe3, 0x86, 0x1f, 0x30, 0xce, 0xd0, 0x23, 0xa1, 0x69, 0xc4, 0x58, 0xa4, 0x39, 0x7c, 0x34,
-	0x61, 0x13, 0x26, 0x05, 0x4d, 0xf1, 0x4b, 0x69, 0x6b, 0xbf, 0xa7, 0xa1, 0x74, 0x2a, 0x95, 0xfd,
-	0x80, 0xf9, 0x2c, 0xc4, 0x33, 0xb4, 0x0f, 0x59, 0x9f, 0xb1, 0x99, 0x43, 0x5d, 0x43, 0xab, 0x6a,
-	0xf5, 0xb4, 0x95, 0x11, 0xa1, 0xe9, 0xa2, 0x23, 0x80, 0x90, 0xb3, 0x00, 0x4f, 0x88, 0xe0, 0x92,
-	0x55, 0xad, 0x9e, 0xb7, 0xf2, 0x11, 0x62, 0xba, 0xe8, 0x10, 0x72, 0x0b, 0x7f, 0xc6, 0xb0, 0x4b,
-	0x02, 0x23, 0x25, 0xc9, 0x75, 0x8c, 0x9e, 0x81, 0xee, 0x91, 0x4b, 0xee, 0xac, 0x05, 0x69, 0x29,
-	0x28, 0x0a, 0x70, 0x10, 0x8b, 0x9e, 0x42, 0xde, 0xc5, 0x1c, 0x3b, 0x21, 0xbd, 0x22, 0xc6, 0x9e,
-	0xbc, 0x3a, 0x27, 0x00, 0x9b, 0x5e, 0x11, 0xf4, 0x19, 0x14, 0x54, 0x47, 0x8a, 0xce, 0x48, 0x1a,
-	0x14, 0x24, 0x05, 0x8f, 0x21, 0xc3, 0x99, 0x73, 0x41, 0x56, 0x46, 0x56, 0xe6, 0xde, 0xe3, 0xec,
-	0x25, 0x59, 0xa1, 0xcf, 0xa1, 0x14, 0x9f, 0x5b, 0xcc, 0xe7, 0x38, 0x58, 0x19, 0x39, 0x49, 0xeb,
-	0xd1, 0x51, 0x05, 0xae, 0xef, 0x9e, 0xe2, 0x70, 0x6a, 0xe4, 0x55, 0xf5, 0x02, 0xf8, 0x06, 0x87,
-	0x53, 0xd1, 0xf8, 0xc2, 0x77, 0x31, 0x27, 0xae, 0x83, 0xb9, 0x01, 0xf2, 0xea, 0x7c, 0x84, 0xb4,
-	0x38, 0x3a, 0x86, 0xe2, 0x92, 0x71, 0x12, 0x84, 0xce, 0x12, 0xcf, 0xa8, 0x6b, 0x14, 0xaa, 0xa9,
-	0x7a, 0xde, 0x2a, 0x28, 0x6c, 0x28, 0x20, 0x51, 0x45, 0x24, 0xa1, 0x9e, 0x12, 0x15, 0xa5, 0x48,
-	0x57, 0xa8, 0xa9, 0xc0, 0x0d, 0x19, 0x1e, 0x85, 0x1c, 0x53, 0xcf, 0xd0, 0x37, 0x65, 0x2d, 0x05,
-	0xa2, 0x03, 0xc8, 0x9d, 0x07, 0x6c, 0x2e, 0x9b, 0x2d, 0xc9, 0x5a, 0xb3, 0x22, 0x16, 0xed, 0x36,
-	0xe0, 0x61, 0xbc, 0x23, 0x3f, 0x60, 0x4b, 0xea, 0x92, 0x40, 0x2c, 0xeb, 0x41, 0x55, 0xab, 0xeb,
-	0xd6, 0x27, 0x11, 0xd5, 0x8f, 0x18, 0x53, 0xde, 0x38, 0x66, 0x73, 0x3f, 0x20, 0x61, 0x48, 0x99,
-	0x27, 0xa4, 0x65, 0x29, 0xd5, 0x37, 0x50, 0xd3, 0xad, 0xfd, 0x92, 0x86, 0x07, 0x5d, 0xea, 0xe1,
-	0x19, 0xbd, 0x22, 0xae, 0xf2, 0xcb, 0xfd, 0x3e, 0x29, 0x41, 0x32, 0xf2, 0x47, 0xda, 0x4a, 0xd2,
-	0x0f, 0x7d, 0x93, 0xfa, 0x37, 0xdf, 0xa4, 0x3f, 0xf0, 0xcd, 0x11, 0x80, 0xec, 0x94, 0x7a, 0x2e,
-	0xb9, 0x8c, 0x3c, 0x91, 0x17, 0x88, 0x29, 0x00, 0x31, 0x08, 0xce, 0x22, 0x52, 0x39, 0x22, 0xcb,
-	0x99, 0xa2, 0xfe, 0x47, 0x3b, 0xb4, 0xa1, 0x78, 0x1e, 0xcf, 0x22, 0x36, 0x44, 0xe1, 0xe4, 0xb8,
-	0xb1, 0xeb, 0xd9, 0x35, 0xd6, 0x53, 0x6b, 0x71, 0xab, 0x70, 0x7e, 0x1b, 0x6c, 0x2d, 0xb1, 0xf0,
-	0x51, 0x4b, 0x2c, 0x7e, 0xfc, 0x12, 0xf5, 0x1d, 0x4b, 0x44, 0xdf, 0x42, 0x29, 0xe4, 0xf8, 0x82,
-	0x38, 0x21, 0x19, 0x2f, 0x02, 0xca, 0x95, 0x79, 0x0a, 0x27, 0xcf, 0x76, 0x57, 0x6e, 0x0b, 0xad,
-	0x1d, 0x49, 0x2d, 0x3d, 0xdc, 0x0c, 0x6b, 0x2f, 0xa0, 0xb0, 0xd1, 0x19, 0x7a, 0x02, 0x99, 0x29,
-	0xa1, 0x93, 0x29, 0x8f, 0xad, 0xa0, 0x22, 0xf4, 0x29, 0xe4, 0x39, 0x9d, 0x93, 0x90, 0xe3, 0xb9,
-	0x1f, 0x39, 0xe2, 0x16, 0xa8, 0x8d, 0x41, 0xdf, 0xba, 0x04, 0xd5, 0xa1, 0x2c, 0x1f, 0x82, 0x23,
-	0xfc, 0xee, 0xf8, 0xec, 0x1d, 0x09, 0xa2, 0x84, 0x25, 0x89, 0x0f, 0x19, 0x27, 0x7d, 0x81, 0x0a,
-	0x25, 0x67, 0x1c, 0xcf, 0x36, 0x95, 0x2a, 0x7f, 0x49, 0xe2, 0x6b, 0x65, 0xad, 0x0b, 0x48, 0x19,
-	0x76, 0x48, 0x02, 0x31, 0x88, 0x8e, 0xc7, 0x83, 0xd5, 0xbd, 0x05, 0x1b, 0x90, 0x5d, 0x2a, 0x9d,
-	0x4c, 0xb7, 0x67, 0xc5, 0x61, 0xed, 0x7b, 0x28, 0x6f, 0xe5, 0x79, 0x85, 0x7d, 0xd4, 0x86, 0x5c,
-	0x44, 0x87, 0x86, 0x56, 0x4d, 0xd5, 0x0b, 0x27, 0xf5, 0xdd, 0xb3, 0xbc, 0x5b, 0x81, 0xb5, 0x3e,
-	0x59, 0x7b, 0x0b, 0xc7, 0x16, 0x5b, 0x78, 0xae, 0xc5, 0x46, 0xd4, 0xb3, 0xa9, 0x37, 0x99, 0x11,
-	0xf9, 0xaf, 0x81, 0x39, 0x0b, 0xfa, 0x01, 0x9b, 0x88, 0x0d, 0x8a, 0xc2, 0xb0, 0xeb, 0x8a, 0x9f,
-	0xb2, 0xe2, 0xbc, 0x15, 0x87, 0xe2, 0xfd, 0xf8, 0x91, 0x4a, 0xd6, 0x9c, 0xb2, 0xd6, 0x71, 0xed,
-	0x67, 0x0d, 0xd0, 0x6d, 0xee, 0x75, 0xb2, 0x7b, 0x9f, 0xee, 0x8f, 0xa0, 0xc7, 0x67, 0x9d, 0x19,
-	0x0d, 0xb9, 0x91, 0x94, 0x5d, 0x7d, 0xbd, 0xbb, 0xab, 0xff, 0xac, 0xda, 0x2a, 0xc6, 0xd9, 0xce,
-	0x68, 0xc8, 0xbf, 0xf8, 0x43, 0x83, 0xa2, 0x9a, 0x84, 0xcd, 0x31, 0x5f, 0x84, 0xe8, 0x08, 0x0e,
-	0x4e, 0x07, 0xbd, 0xf6, 0x59, 0xc7, 0xb1, 0xdf, 0xb4, 0xde, 0x0c, 0x6c, 0x67, 0xd0, 0xb3, 0xfb,
-	0x9d, 0x17, 0x66, 0xd7, 0xec, 0xb4, 0xcb, 0x09, 0xb4, 0x0f, 0x0f, 0xb7, 0xe9, 0x61, 0xeb, 0xcc,
-	0x6c, 0x97, 0x35, 0x74, 0x00, 0x8f, 0xb7, 0x09, 0xb3, 0xa7, 0xa8, 0x24, 0x3a, 0x84, 0x27, 0xdb,
-	0x54, 0xef, 0xb5, 0xd3, 0x1d, 0xf4, 0xda, 0x76, 0x39, 0x85, 0x9e, 0xc2, 0xfe, 0x1d, 0xee, 0xbb,
-	0xc1, 0x6b, 0x6b, 0xf0, 0xaa, 0x9c, 0xbe, 0x7b, 0xb0, 0x6d, 0xda, 0xad, 0xd3, 0xb3, 0x4e, 0xbb,
-	0xbc, 0x77, 0x98, 0xfe, 0xe9, 0xb7, 0x4a, 0xe2, 0xb4, 0xfb, 0xe7, 0x75, 0x45, 0x7b, 0x7f, 0x5d,
-	0xd1, 0xfe, 0xbe, 0xae, 0x68, 0xbf, 0xde, 0x54, 0x12, 0xef, 0x6f, 0x2a, 0x89, 0xbf, 0x6e, 0x2a,
-	0x89, 0x1f, 0xbe, 0x9c, 0x50, 0x3e, 0x5d, 0x8c, 0x1a, 0x63, 0x36, 0x6f, 0xbe, 0x7c, 0x3b, 0xec,
-	0xf4, 0x08, 0x7f, 0xc7, 0x82, 0x8b, 0xe6, 0x78, 0x8a, 0xa9, 0xd7, 0xbc, 0x5c, 0x7f, 0xaf, 0xf9,
-	0xca, 0x27, 0xe1, 0x28, 0x23, 0x3f, 0xbd, 0x5f, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x45, 0x66,
-	0x7a, 0xef, 0xcc, 0x07, 0x00, 0x00,
+	// 1163 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1a, 0xc7,
+	0x1b, 0xf6, 0x1a, 0x6c, 0xc3, 0xcb, 0x1f, 0x93, 0xc9, 0xbf, 0xb5, 0x93, 0xf0, 0x23, 0xe4, 0x17,
+	0x09, 0x45, 0x15, 0x28, 0xee, 0xa1, 0xa7, 0x1e, 0x30, 0xac, 0x9b, 0x4d, 0x08, 0xa6, 0x8b, 0x41,
+	0x4d, 0x55, 0x69, 0x35, 0xb0, 0x13, 0x58, 0x19, 0x76, 0x56, 0xbb, 0x03, 0x0e, 0xf9, 0x04, 0x95,
+	0x7a, 0xa9, 0xfa, 0x15, 0xf2, 0x35, 0x7a, 0xad, 0xd4, 0x63, 0xa4, 0x5e, 0x7a, 0xac, 0x92, 0xcf,
+	0xd0, 0x7b, 0x35, 0x7f, 0x76, 0x0d, 0x06, 0xa7, 0xb9, 0xf4, 0xb6, 0xef, 0xf3, 0x3c, 0x33, 0xf3,
+	0xbe, 0x33, 0xcf, 0xbc, 0xb3, 0x50, 0x3e, 0x5f, 0xcc, 0x49, 0x6d, 0x30, 0xf3, 0x9c, 0x09, 0x09,
+	0x6b, 0xf3, 0xa7, 0x03, 0xc2, 0xf0, 0xd3, 0x28, 0xae, 0xfa, 0x01, 0x65, 0x14, 0xdd, 0xe2, 0x9a,
+	0x6a, 0x84, 0x29, 0xcd, 0xe1, 0xad, 0x11, 0x1d, 0x51, 0x21, 0xa8, 0xf1, 0x2f, 0xa9, 0x2d, 0xff,
+	0x9d, 0x84, 0xfc, 0xb1, 0x50, 0x76, 0x02, 0xea, 0xd3, 0x10, 0x4f, 0xd0, 0x5d, 0xd8, 0xf3, 0x29,
+	0x9d, 0xd8, 0xae, 0xa3, 0x6b, 0x25, 0xad, 0x92, 0xb4, 0x76, 0x79, 0x68, 0x3a, 0xe8, 0x01, 0x40,
+	0xc8, 0x68, 0x80, 0x47, 0x84, 0x73, 0xdb, 0x25, 0xad, 0x92, 0xb6, 0xd2, 0x0a, 0x31, 0x1d, 0x74,
+	0x08, 0xa9, 0x99, 0x3f, 0xa1, 0xd8, 0x21, 0x81, 0x9e, 0x10, 0x64, 0x1c, 0xa3, 0x47, 0x90, 0xf3,
+	0xc8, 0x1b, 0x66, 0xc7, 0x82, 0xa4, 0x10, 0x64, 0x39, 0xd8, 0x8b, 0x44, 0xf7, 0x20, 0xed, 0x60,
+	0x86, 0xed, 0xd0, 0x7d, 0x4b, 0xf4, 0x1d, 0xb1, 0x74, 0x8a, 0x03, 0x5d, 0xf7, 0x2d, 0x41, 0xff,
+	0x83, 0x8c, 0xac, 0x48, 0xd2, 0xbb, 0x82, 0x06, 0x09, 0x09, 0xc1, 0x6d, 0xd8, 0x65, 0xd4, 0x3e,
+	0x27, 0x0b, 0x7d, 0x4f, 0xcc, 0xbd, 0xc3, 0xe8, 0x0b, 0xb2, 0x40, 0x8f, 0x21, 0x1f, 0x8d, 0x9b,
+	0x4d, 0xa7, 0x38, 0x58, 0xe8, 0x29, 0x41, 0xe7, 0xd4, 0x50, 0x09, 0xc6, 0x6b, 0x8f, 0x71, 0x38,
+	0xd6, 0xd3, 0x32, 0x7b, 0x0e, 0x3c, 0xc3, 0xe1, 0x98, 0x17, 0x3e, 0xf3, 0x1d, 0xcc, 0x88, 0x63,
+	0x63, 0xa6, 0x83, 0x58, 0x3a, 0xad, 0x90, 0x3a, 0x43, 0x0f, 0x21, 0x3b, 0xa7, 0x8c, 0x04, 0xa1,
+	0x3d, 0xc7, 0x13, 0xd7, 0xd1, 0x33, 0xa5, 0x44, 0x25, 0x6d, 0x65, 0x24, 0xd6, 0xe7, 0x10, 0xcf,
+	0x42, 0x49, 0x5c, 0x4f, 0x8a, 0xb2, 0x42, 0x94, 0x93, 0xa8, 0xe9, 0xcd, 0xaf, 0xc8, 0xf0, 0x20,
+	0x64, 0xd8, 0xf5, 0xf4, 0xdc, 0xb2, 0xac, 0x2e, 0x41, 0x74, 0x00, 0xa9, 0xd7, 0x01, 0x9d, 0x8a,
+	0x62, 0xf3, 0x22, 0xd7, 0x3d, 0x1e, 0xf3, 0x72, 0xab, 0x70, 0x33, 0x3a, 0x23, 0x3f, 0xa0, 0x73,
+	0xd7, 0x21, 0x01, 0x3f, 0xac, 0xfd, 0x92, 0x56, 0xc9, 0x59, 0x37, 0x14, 0xd5, 0x51, 0x8c, 0x29,
+	0x56, 0x1c, 0xd2, 0xa9, 0x1f, 0x90, 0x30, 0x74, 0xa9, 0xc7, 0xa5, 0x05, 0x21, 0xcd, 0x2d, 0xa1,
+	0xa6, 0x83, 0x4e, 0xa1, 0xc0, 0x53, 0xb0, 0x87, 0x74, 0x3a, 0x75, 0xd9, 0x94, 0x78, 0x2c, 0xd4,
+	0x6f, 0x94, 0x12, 0x95, 0xcc, 0xd1, 0xff, 0xab, 0x9b, 0xdc, 0x56, 0xed, 0x53, 0x46, 0x1a, 0xb1,
+	0xd8, 0xda, 0x9f, 0xaf, 0xc4, 0x61, 0xf9, 0x19, 0xe4, 0x57, 0x25, 0xe8, 0x0e, 0xec, 0x86, 0x0c,
+	0x9f, 0x93, 0x40, 0xb8, 0x2e, 0x6d, 0xa9, 0x08, 0x15, 0x01, 0x2e, 0x57, 0x55, 0xae, 0x5b, 0x42,
+	0xca, 0x7f, 0x24, 0x61, 0xff, 0xc4, 0xf5, 0xf0, 0xc4, 0x7d, 0x4b, 0x1c, 0x69, 0xe5, 0xeb, 0x2d,
+	0x9c, 0x87, 0x6d, 0x65, 0xdd, 0xa4, 0xb5, 0xed, 0x5e, 0xb5, 0x74, 0xe2, 0x53, 0x96, 0x4e, 0x5e,
+	0xb1, 0xf4, 0x03, 0x00, 0x71, 0x08, 0xae, 0xe7, 0x90, 0x37, 0xca, 0xae, 0x69, 0x8e, 0x98, 0x1c,
+	0xe0, 0x67, 0xc4, 0xa8, 0x22, 0xa5, 0x59, 0xf7, 0x18, 0x95, 0xd4, 0x7f, 0xe8, 0xd4, 0x26, 0x64,
+	0x5f, 0x47, 0x7b, 0x11, 0x79, 0x35, 0x73, 0xf4, 0x70, 0xf3, 0x19, 0xc5, 0xbb, 0x56, 0x67, 0x56,
+	0xe6, 0xf5, 0x65, 0xb0, 0xe2, 0xaf, 0xcc, 0x67, 0xf9, 0x2b, 0xfb, 0xf9, 0xfe, 0xca, 0x6d, 0xf2,
+	0xd7, 0x73, 0xc8, 0x8b, 0xe3, 0xb6, 0x43, 0x32, 0x9c, 0x05, 0x2e, 0x93, 0xbe, 0xce, 0x1c, 0x3d,
+	0xda, 0x9c, 0x79, 0x97, 0x6b, 0xbb, 0x4a, 0x6a, 0xe5, 0xc2, 0xe5, 0x70, 0xed, 0x3a, 0xee, 0xaf,
+	0x5f, 0xc7, 0x12, 0x64, 0xd4, 0x3d, 0xe4, 0x57, 0x58, 0x58, 0x3e, 0x65, 0x2d, 0x43, 0xe5, 0x06,
+	0x64, 0x96, 0xb6, 0x87, 0x9b, 0x73, 0x4c, 0xdc, 0xd1, 0x98, 0x45, 0x7e, 0x92, 0x11, 0xba, 0x0f,
+	0x69, 0xe6, 0x4e, 0x49, 0xc8, 0xf0, 0xd4, 0x57, 0xb6, 0xba, 0x04, 0xca, 0xbf, 0x69, 0x90, 0x5b,
+	0x49, 0x15, 0x55, 0xa0, 0x20, 0xd6, 0xb0, 0xc5, 0x6d, 0xf2, 0xe9, 0x85, 0xb2, 0x7b, 0xd2, 0xca,
+	0x0b, 0x9c, 0xdf, 0x89, 0x0e, 0x47, 0xb9, 0x92, 0x51, 0x86, 0x27, 0xcb, 0x4a, 0xb9, 0x40, 0x5e,
+	0xe0, 0x97, 0xca, 0x1a, 0xdc, 0x0a, 0xf0, 0x85, 0xbd, 0x36, 0x6f, 0x42, 0xa8, 0x6f, 0x04, 0xf8,
+	0xa2, 0xbf, 0x3a, 0xb5, 0x1a, 0xb0, 0x36, 0x7d, 0x32, 0x1e, 0x70, 0xb6, 0xb2, 0x42, 0xf9, 0x04,
+	0x90, 0xbc, 0x58, 0x7d, 0x12, 0xf0, 0x03, 0x33, 0x3c, 0x16, 0x2c, 0xae, 0xdd, 0x13, 0x1d, 0xf6,
+	0xe6, 0x52, 0x27, 0x12, 0xde, 0xb1, 0xa2, 0xb0, 0xfc, 0x1d, 0x14, 0x56, 0xe6, 0x79, 0x89, 0x7d,
+	0xd4, 0x84, 0x94, 0xa2, 0x43, 0x5d, 0x13, 0x1d, 0xa5, 0xb2, 0xf9, 0xcc, 0xd7, 0x33, 0xb0, 0xe2,
+	0x91, 0xe5, 0x57, 0xf0, 0xd0, 0xa2, 0x33, 0xcf, 0xb1, 0xe8, 0xc0, 0xf5, 0xba, 0xae, 0x37, 0x9a,
+	0x90, 0xbe, 0x3c, 0x4b, 0x1a, 0x74, 0x02, 0x3a, 0xe2, 0x4e, 0xe3, 0x89, 0x61, 0xc7, 0xe1, 0x9f,
+	0xaa, 0xc5, 0x44, 0x21, 0xbf, 0xe7, 0xbe, 0x52, 0x89, 0x9c, 0x13, 0x56, 0x1c, 0x97, 0x7f, 0xd2,
+	0x00, 0x5d, 0xce, 0x1d, 0x4f, 0x76, 0x6d, 0x8b, 0xf9, 0x01, 0x72, 0xd1, 0x58, 0x7b, 0xe2, 0x86,
+	0xbc, 0x65, 0xf1, 0xaa, 0xbe, 0xda, 0x5c, 0xd5, 0xbf, 0x66, 0x6d, 0x65, 0xa3, 0xd9, 0x5a, 0x6e,
+	0xc8, 0xca, 0xef, 0xb6, 0x61, 0x5f, 0xee, 0x44, 0x63, 0x8c, 0x27, 0x13, 0xe2, 0x8d, 0x3e, 0xd1,
+	0xed, 0xee, 0x41, 0x5a, 0x75, 0x94, 0xb8, 0xe9, 0xa5, 0x24, 0x60, 0x3a, 0xa2, 0xaf, 0x46, 0x53,
+	0x44, 0x0f, 0xf6, 0x12, 0x82, 0x10, 0x24, 0x07, 0xd4, 0x73, 0x94, 0x2b, 0xc4, 0x37, 0xef, 0x79,
+	0xc3, 0x80, 0x44, 0x0f, 0xa1, 0xea, 0x79, 0x0a, 0xd9, 0xf0, 0x10, 0xee, 0x7e, 0xce, 0x43, 0xb8,
+	0xb7, 0xe9, 0x21, 0xfc, 0x5a, 0x3c, 0x06, 0x6c, 0x16, 0x8a, 0x1e, 0x98, 0x3f, 0x7a, 0xbc, 0x79,
+	0xf7, 0xe2, 0x3d, 0xe8, 0x0a, 0xb1, 0xa5, 0x06, 0x3d, 0xf9, 0x55, 0x83, 0xac, 0xdc, 0x25, 0x49,
+	0xa0, 0x07, 0x70, 0x70, 0xdc, 0x6b, 0x37, 0x5b, 0x86, 0xdd, 0x3d, 0xab, 0x9f, 0xf5, 0xba, 0x76,
+	0xaf, 0xdd, 0xed, 0x18, 0x0d, 0xf3, 0xc4, 0x34, 0x9a, 0x85, 0x2d, 0x74, 0x17, 0x6e, 0xae, 0xd2,
+	0xfd, 0x7a, 0xcb, 0x6c, 0x16, 0x34, 0x74, 0x00, 0xb7, 0x57, 0x09, 0xb3, 0x2d, 0xa9, 0x6d, 0x74,
+	0x08, 0x77, 0x56, 0xa9, 0xf6, 0xa9, 0x7d, 0xd2, 0x6b, 0x37, 0xbb, 0x85, 0x04, 0xba, 0x07, 0x77,
+	0xd7, 0xb8, 0x6f, 0x7b, 0xa7, 0x56, 0xef, 0x65, 0x21, 0xb9, 0x3e, 0xb0, 0x69, 0x76, 0xeb, 0xc7,
+	0x2d, 0xa3, 0x59, 0xd8, 0x39, 0x4c, 0xfe, 0xf8, 0xae, 0xb8, 0xf5, 0xe4, 0x17, 0x0d, 0xf6, 0xaf,
+	0x94, 0x86, 0x4a, 0x70, 0xbf, 0xf1, 0xac, 0xde, 0x6a, 0x19, 0xed, 0x6f, 0xae, 0x29, 0xe2, 0x00,
+	0x6e, 0xaf, 0x29, 0x4e, 0x3b, 0x46, 0xbb, 0xa0, 0xf1, 0xf2, 0xd7, 0xa8, 0x7a, 0xa3, 0x61, 0x74,
+	0xce, 0x0c, 0x5e, 0xca, 0x26, 0xda, 0x32, 0x9e, 0x1b, 0x0d, 0x4e, 0x27, 0x64, 0x52, 0xc7, 0x27,
+	0xbf, 0x7f, 0x28, 0x6a, 0xef, 0x3f, 0x14, 0xb5, 0xbf, 0x3e, 0x14, 0xb5, 0x9f, 0x3f, 0x16, 0xb7,
+	0xde, 0x7f, 0x2c, 0x6e, 0xfd, 0xf9, 0xb1, 0xb8, 0xf5, 0xfd, 0x17, 0x23, 0x97, 0x8d, 0x67, 0x83,
+	0xea, 0x90, 0x4e, 0x6b, 0x2f, 0x5e, 0xf5, 0x8d, 0x36, 0x61, 0x17, 0x34, 0x38, 0xaf, 0x0d, 0xc7,
+	0xd8, 0xf5, 0x6a, 0x6f, 0xe2, 0xbf, 0x55, 0xb6, 0xf0, 0x49, 0x38, 0xd8, 0x15, 0x3f, 0x9e, 0x5f,
+	0xfe, 0x33, 0x00, 0x27, 0xdf, 0x92, 0xb4, 0xca, 0x0a, 0x00, 0x00,
 }
 
 func (m *BundleProposal) Marshal() (dAtA []byte, err error) {
@@ -833,6 +1099,22 @@ func (m *BundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteCommitments) > 0 {
+		for iNdEx := len(m.VoteCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if m.CompressionId != 0 {
 		i = encodeVarintBundles(dAtA, i, uint64(m.CompressionId))
 		i--
@@ -944,6 +1226,43 @@ func (m *BundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FinalizedBundle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -964,6 +1283,27 @@ func (m *FinalizedBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Invalidated {
+		i--
+		if m.Invalidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.VotersValid) > 0 {
+		for iNdEx := len(m.VotersValid) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersValid[iNdEx])
+			copy(dAtA[i:], m.VotersValid[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersValid[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if m.StakeSecurity != nil {
 		{
 			size, err := m.StakeSecurity.MarshalToSizedBuffer(dAtA[:i])
@@ -1116,6 +1456,16 @@ func (m *StakeSecurity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RawTotalVotePower != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.RawTotalVotePower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RawValidVotePower != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.RawValidVotePower))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.TotalVotePower != 0 {
 		i = encodeVarintBundles(dAtA, i, uint64(m.TotalVotePower))
 		i--
@@ -1276,6 +1626,79 @@ func (m *RoundRobinProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BundleChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.VotersInvalid) > 0 {
+		for iNdEx := len(m.VotersInvalid) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersInvalid[iNdEx])
+			copy(dAtA[i:], m.VotersInvalid[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersInvalid[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.VotersValid) > 0 {
+		for iNdEx := len(m.VotersValid) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersValid[iNdEx])
+			copy(dAtA[i:], m.VotersValid[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersValid[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Bond != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Bond))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BundleId != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBundles(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBundles(v)
 	base := offset
@@ -1357,8 +1780,31 @@ func (m *BundleProposal) Size() (n int) {
 	if m.CompressionId != 0 {
 		n += 2 + sovBundles(uint64(m.CompressionId))
 	}
-	return n
-}
+	if len(m.VoteCommitments) > 0 {
+		for _, e := range m.VoteCommitments {
+			l = e.Size()
+			n += 2 + l + sovBundles(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VoteCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	return n
+}
 
 func (m *FinalizedBundle) Size() (n int) {
 	if m == nil {
@@ -1416,6 +1862,15 @@ func (m *FinalizedBundle) Size() (n int) {
 		l = m.StakeSecurity.Size()
 		n += 1 + l + sovBundles(uint64(l))
 	}
+	if len(m.VotersValid) > 0 {
+		for _, s := range m.VotersValid {
+			l = len(s)
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if m.Invalidated {
+		n += 3
+	}
 	return n
 }
 
@@ -1446,6 +1901,12 @@ func (m *StakeSecurity) Size() (n int) {
 	if m.TotalVotePower != 0 {
 		n += 1 + sovBundles(uint64(m.TotalVotePower))
 	}
+	if m.RawValidVotePower != 0 {
+		n += 1 + sovBundles(uint64(m.RawValidVotePower))
+	}
+	if m.RawTotalVotePower != 0 {
+		n += 1 + sovBundles(uint64(m.RawTotalVotePower))
+	}
 	return n
 }
 
@@ -1513,6 +1974,46 @@ func (m *RoundRobinProgress) Size() (n int) {
 	return n
 }
 
+func (m *BundleChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovBundles(uint64(m.PoolId))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovBundles(uint64(m.BundleId))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if m.Bond != 0 {
+		n += 1 + sovBundles(uint64(m.Bond))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovBundles(uint64(m.CreatedAt))
+	}
+	if len(m.VotersValid) > 0 {
+		for _, s := range m.VotersValid {
+			l = len(s)
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if len(m.VotersInvalid) > 0 {
+		for _, s := range m.VotersInvalid {
+			l = len(s)
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovBundles(uint64(m.Status))
+	}
+	return n
+}
+
 func sovBundles(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1982,6 +2483,154 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteCommitments = append(m.VoteCommitments, &VoteCommitment{})
+			if err := m.VoteCommitments[len(m.VoteCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBundles(dAtA[iNdEx:])
@@ -2410,6 +3059,58 @@ func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersValid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersValid = append(m.VotersValid, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invalidated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBundles(dAtA[iNdEx:])
@@ -2586,6 +3287,44 @@ func (m *StakeSecurity) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawValidVotePower", wireType)
+			}
+			m.RawValidVotePower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RawValidVotePower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawTotalVotePower", wireType)
+			}
+			m.RawTotalVotePower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RawTotalVotePower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBundles(dAtA[iNdEx:])
@@ -2983,6 +3722,247 @@ func (m *RoundRobinProgress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BundleChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+			}
+			m.BundleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			m.Bond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bond |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersValid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersValid = append(m.VotersValid, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersInvalid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersInvalid = append(m.VotersInvalid, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ChallengeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBundles(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,6 +12,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgVoteBundleProposal{}, "kyve/bundles/MsgVoteBundleProposal", nil)
 	cdc.RegisterConcrete(&MsgClaimUploaderRole{}, "kyve/bundles/MsgClaimUploaderRole", nil)
 	cdc.RegisterConcrete(&MsgSkipUploaderRole{}, "kyve/bundles/MsgSkipUploaderRole", nil)
+	cdc.RegisterConcrete(&MsgCommitBundleVote{}, "kyve/bundles/MsgCommitBundleVote", nil)
+	cdc.RegisterConcrete(&MsgRevealBundleVote{}, "kyve/bundles/MsgRevealBundleVote", nil)
+	cdc.RegisterConcrete(&MsgChallengeFinalizedBundle{}, "kyve/bundles/MsgChallengeFinalizedBundle", nil)
+	cdc.RegisterConcrete(&MsgVoteChallenge{}, "kyve/bundles/MsgVoteChallenge", nil)
 }
 
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
@@ -19,6 +23,10 @@ func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgVoteBundleProposal{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimUploaderRole{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSkipUploaderRole{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCommitBundleVote{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRevealBundleVote{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgChallengeFinalizedBundle{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgVoteChallenge{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
 }
 
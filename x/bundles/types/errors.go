@@ -23,4 +23,18 @@ var (
 	ErrAlreadyVotedValid       = errors.Register(ModuleName, 1204, "already voted valid on bundle proposal")
 	ErrAlreadyVotedInvalid     = errors.Register(ModuleName, 1205, "already voted invalid on bundle proposal")
 	ErrAlreadyVotedAbstain     = errors.Register(ModuleName, 1206, "already voted abstain on bundle proposal")
+	ErrCommitRevealRequired    = errors.Register(ModuleName, 1207, "pool requires commit-reveal voting")
+	ErrCommitRevealDisabled    = errors.Register(ModuleName, 1208, "pool does not use commit-reveal voting")
+	ErrCommitPhaseOver         = errors.Register(ModuleName, 1209, "commit phase of bundle proposal is over")
+	ErrNotInRevealPhase        = errors.Register(ModuleName, 1210, "bundle proposal is not in reveal phase")
+	ErrAlreadyCommitted        = errors.Register(ModuleName, 1211, "already committed to a vote on bundle proposal")
+	ErrNoVoteCommitment        = errors.Register(ModuleName, 1212, "no vote commitment found on bundle proposal")
+	ErrInvalidVoteReveal       = errors.Register(ModuleName, 1213, "revealed vote does not match commitment")
+	ErrBundleNotFound          = errors.Register(ModuleName, 1214, "finalized bundle not found")
+	ErrChallengeWindowOver     = errors.Register(ModuleName, 1215, "challenge window of finalized bundle is over")
+	ErrBundleAlreadyChallenged = errors.Register(ModuleName, 1216, "finalized bundle was already challenged")
+	ErrChallengeNotFound       = errors.Register(ModuleName, 1217, "challenge not found")
+	ErrChallengeNotOpen        = errors.Register(ModuleName, 1218, "challenge is not open")
+	ErrAlreadyVotedOnChallenge = errors.Register(ModuleName, 1219, "already voted on challenge")
+	ErrAccusedCannotVote       = errors.Register(ModuleName, 1220, "uploader and valid voters of the challenged bundle can not vote on the challenge")
 )
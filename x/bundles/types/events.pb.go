@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -89,7 +91,7 @@ func (m *EventUpdateParams) GetPayload() string {
 }
 
 // EventBundleVote is an event emitted when a protocol node votes on a bundle.
-// emitted_by: MsgVoteBundleProposal
+// emitted_by: MsgVoteBundleProposal, MsgRevealBundleVote
 type EventBundleVote struct {
 	// pool_id is the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -162,6 +164,81 @@ func (m *EventBundleVote) GetVote() VoteType {
 	return VOTE_TYPE_UNSPECIFIED
 }
 
+// EventBundleVoteCommitted is an event emitted when a protocol node commits
+// to a hashed vote on a bundle.
+// emitted_by: MsgCommitBundleVote
+type EventBundleVoteCommitted struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the account staker of the protocol node.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// storage_id is the unique ID of the bundle.
+	StorageId string `protobuf:"bytes,3,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+	// commitment is the hash of the vote the validator committed to
+	Commitment string `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *EventBundleVoteCommitted) Reset()         { *m = EventBundleVoteCommitted{} }
+func (m *EventBundleVoteCommitted) String() string { return proto.CompactTextString(m) }
+func (*EventBundleVoteCommitted) ProtoMessage()    {}
+func (*EventBundleVoteCommitted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02f505e55d81e92, []int{2}
+}
+func (m *EventBundleVoteCommitted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBundleVoteCommitted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBundleVoteCommitted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBundleVoteCommitted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBundleVoteCommitted.Merge(m, src)
+}
+func (m *EventBundleVoteCommitted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBundleVoteCommitted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBundleVoteCommitted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBundleVoteCommitted proto.InternalMessageInfo
+
+func (m *EventBundleVoteCommitted) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventBundleVoteCommitted) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventBundleVoteCommitted) GetStorageId() string {
+	if m != nil {
+		return m.StorageId
+	}
+	return ""
+}
+
+func (m *EventBundleVoteCommitted) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
 // EventBundleProposed is submitted by the MsgSubmitBundleProposal message
 // emitted_by: MsgSubmitBundleProposal
 type EventBundleProposed struct {
@@ -203,7 +280,7 @@ func (m *EventBundleProposed) Reset()         { *m = EventBundleProposed{} }
 func (m *EventBundleProposed) String() string { return proto.CompactTextString(m) }
 func (*EventBundleProposed) ProtoMessage()    {}
 func (*EventBundleProposed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a02f505e55d81e92, []int{2}
+	return fileDescriptor_a02f505e55d81e92, []int{3}
 }
 func (m *EventBundleProposed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,13 +442,21 @@ type EventBundleFinalized struct {
 	Uploader string `protobuf:"bytes,15,opt,name=uploader,proto3" json:"uploader,omitempty"`
 	// next_uploader the address of the next uploader after this bundle
 	NextUploader string `protobuf:"bytes,16,opt,name=next_uploader,json=nextUploader,proto3" json:"next_uploader,omitempty"`
+	// funders_payout_coins are the non-native coins which funders provided to the total bundle reward
+	FundersPayoutCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,17,rep,name=funders_payout_coins,json=fundersPayoutCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funders_payout_coins"`
+	// reward_treasury_coins are the non-native coins transferred to treasury
+	RewardTreasuryCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=reward_treasury_coins,json=rewardTreasuryCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_treasury_coins"`
+	// reward_uploader_coins are the non-native coins added to the commission rewards of the uploader
+	RewardUploaderCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,19,rep,name=reward_uploader_coins,json=rewardUploaderCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_uploader_coins"`
+	// reward_delegation_coins are the non-native coins distributed among all delegators
+	RewardDelegationCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,20,rep,name=reward_delegation_coins,json=rewardDelegationCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_delegation_coins"`
 }
 
 func (m *EventBundleFinalized) Reset()         { *m = EventBundleFinalized{} }
 func (m *EventBundleFinalized) String() string { return proto.CompactTextString(m) }
 func (*EventBundleFinalized) ProtoMessage()    {}
 func (*EventBundleFinalized) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a02f505e55d81e92, []int{3}
+	return fileDescriptor_a02f505e55d81e92, []int{4}
 }
 func (m *EventBundleFinalized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,6 +597,34 @@ func (m *EventBundleFinalized) GetNextUploader() string {
 	return ""
 }
 
+func (m *EventBundleFinalized) GetFundersPayoutCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FundersPayoutCoins
+	}
+	return nil
+}
+
+func (m *EventBundleFinalized) GetRewardTreasuryCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardTreasuryCoins
+	}
+	return nil
+}
+
+func (m *EventBundleFinalized) GetRewardUploaderCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardUploaderCoins
+	}
+	return nil
+}
+
+func (m *EventBundleFinalized) GetRewardDelegationCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardDelegationCoins
+	}
+	return nil
+}
+
 // EventClaimedUploaderRole is an event emitted when an uploader claims the uploader role
 // emitted_by: MsgClaimUploaderRole
 type EventClaimedUploaderRole struct {
@@ -528,7 +641,7 @@ func (m *EventClaimedUploaderRole) Reset()         { *m = EventClaimedUploaderRo
 func (m *EventClaimedUploaderRole) String() string { return proto.CompactTextString(m) }
 func (*EventClaimedUploaderRole) ProtoMessage()    {}
 func (*EventClaimedUploaderRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a02f505e55d81e92, []int{4}
+	return fileDescriptor_a02f505e55d81e92, []int{5}
 }
 func (m *EventClaimedUploaderRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +708,7 @@ func (m *EventSkippedUploaderRole) Reset()         { *m = EventSkippedUploaderRo
 func (m *EventSkippedUploaderRole) String() string { return proto.CompactTextString(m) }
 func (*EventSkippedUploaderRole) ProtoMessage()    {}
 func (*EventSkippedUploaderRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a02f505e55d81e92, []int{5}
+	return fileDescriptor_a02f505e55d81e92, []int{6}
 }
 func (m *EventSkippedUploaderRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +780,7 @@ func (m *EventPointIncreased) Reset()         { *m = EventPointIncreased{} }
 func (m *EventPointIncreased) String() string { return proto.CompactTextString(m) }
 func (*EventPointIncreased) ProtoMessage()    {}
 func (*EventPointIncreased) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a02f505e55d81e92, []int{6}
+	return fileDescriptor_a02f505e55d81e92, []int{7}
 }
 func (m *EventPointIncreased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,6 +830,71 @@ func (m *EventPointIncreased) GetCurrentPoints() uint64 {
 	return 0
 }
 
+// EventPointDecayed is an event emitted when a point of a staker decays
+// emitted_by: MsgVoteBundleProposal, MsgRevealBundleVote
+type EventPointDecayed struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the address of the staker who lost the point
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// current_points is the amount of points the staker has now
+	CurrentPoints uint64 `protobuf:"varint,3,opt,name=current_points,json=currentPoints,proto3" json:"current_points,omitempty"`
+}
+
+func (m *EventPointDecayed) Reset()         { *m = EventPointDecayed{} }
+func (m *EventPointDecayed) String() string { return proto.CompactTextString(m) }
+func (*EventPointDecayed) ProtoMessage()    {}
+func (*EventPointDecayed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02f505e55d81e92, []int{8}
+}
+func (m *EventPointDecayed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPointDecayed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPointDecayed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPointDecayed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPointDecayed.Merge(m, src)
+}
+func (m *EventPointDecayed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPointDecayed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPointDecayed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPointDecayed proto.InternalMessageInfo
+
+func (m *EventPointDecayed) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventPointDecayed) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventPointDecayed) GetCurrentPoints() uint64 {
+	if m != nil {
+		return m.CurrentPoints
+	}
+	return 0
+}
+
 // EventPointIncreased is an event emitted when a staker receives a point
 // emitted_by: MsgSubmitBundleProposal, EndBlock
 type EventPointsReset struct {
@@ -730,7 +908,7 @@ func (m *EventPointsReset) Reset()         { *m = EventPointsReset{} }
 func (m *EventPointsReset) String() string { return proto.CompactTextString(m) }
 func (*EventPointsReset) ProtoMessage()    {}
 func (*EventPointsReset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a02f505e55d81e92, []int{7}
+	return fileDescriptor_a02f505e55d81e92, []int{9}
 }
 func (m *EventPointsReset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,150 +951,431 @@ func (m *EventPointsReset) GetStaker() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventUpdateParams)(nil), "kyve.bundles.v1beta1.EventUpdateParams")
-	proto.RegisterType((*EventBundleVote)(nil), "kyve.bundles.v1beta1.EventBundleVote")
-	proto.RegisterType((*EventBundleProposed)(nil), "kyve.bundles.v1beta1.EventBundleProposed")
-	proto.RegisterType((*EventBundleFinalized)(nil), "kyve.bundles.v1beta1.EventBundleFinalized")
-	proto.RegisterType((*EventClaimedUploaderRole)(nil), "kyve.bundles.v1beta1.EventClaimedUploaderRole")
-	proto.RegisterType((*EventSkippedUploaderRole)(nil), "kyve.bundles.v1beta1.EventSkippedUploaderRole")
-	proto.RegisterType((*EventPointIncreased)(nil), "kyve.bundles.v1beta1.EventPointIncreased")
-	proto.RegisterType((*EventPointsReset)(nil), "kyve.bundles.v1beta1.EventPointsReset")
+// EventBundleChallenged is an event emitted when a finalized bundle gets challenged.
+// emitted_by: MsgChallengeFinalizedBundle
+type EventBundleChallenged struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundle_id is the id of the challenged bundle.
+	BundleId uint64 `protobuf:"varint,2,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// challenger is the address of the staker who challenged the bundle.
+	Challenger string `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// bond is the amount of $KYVE the challenger posted.
+	Bond uint64 `protobuf:"varint,4,opt,name=bond,proto3" json:"bond,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/bundles/v1beta1/events.proto", fileDescriptor_a02f505e55d81e92) }
+func (m *EventBundleChallenged) Reset()         { *m = EventBundleChallenged{} }
+func (m *EventBundleChallenged) String() string { return proto.CompactTextString(m) }
+func (*EventBundleChallenged) ProtoMessage()    {}
+func (*EventBundleChallenged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02f505e55d81e92, []int{10}
+}
+func (m *EventBundleChallenged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBundleChallenged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBundleChallenged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBundleChallenged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBundleChallenged.Merge(m, src)
+}
+func (m *EventBundleChallenged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBundleChallenged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBundleChallenged.DiscardUnknown(m)
+}
 
-var fileDescriptor_a02f505e55d81e92 = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xce, 0xa6, 0x1b, 0x27, 0x7b, 0xfc, 0x27, 0xce, 0x36, 0xbf, 0x1f, 0x4b, 0xa0, 0x6e, 0x62,
-	0x84, 0x08, 0x2a, 0xb2, 0xd5, 0x70, 0xc7, 0x5d, 0x52, 0x5a, 0x61, 0x55, 0x42, 0xd6, 0xa6, 0xad,
-	0x04, 0x37, 0xd6, 0x38, 0x73, 0x6c, 0x8f, 0xbc, 0xde, 0x59, 0xcd, 0xcc, 0xda, 0x71, 0x9e, 0x02,
-	0x09, 0xf1, 0x24, 0x7d, 0x05, 0x2e, 0x7a, 0xd9, 0x4b, 0xae, 0x10, 0x4a, 0x5e, 0x04, 0xcd, 0x9f,
-	0xdd, 0x98, 0xe0, 0x42, 0xcb, 0x9d, 0xcf, 0x77, 0xbe, 0xf9, 0xce, 0xb7, 0xe7, 0x9c, 0x19, 0xc3,
-	0xd1, 0x74, 0x39, 0xc7, 0xee, 0x30, 0x4f, 0x69, 0x82, 0xb2, 0x3b, 0x7f, 0x3c, 0x44, 0x45, 0x1e,
-	0x77, 0x71, 0x8e, 0xa9, 0x92, 0x9d, 0x4c, 0x70, 0xc5, 0xc3, 0x7d, 0x4d, 0xe9, 0x38, 0x4a, 0xc7,
-	0x51, 0x0e, 0xf6, 0xc7, 0x7c, 0xcc, 0x0d, 0xa1, 0xab, 0x7f, 0x59, 0xee, 0x41, 0x7b, 0xad, 0x5c,
-	0x71, 0xd6, 0x72, 0xd6, 0x97, 0xcc, 0x88, 0x20, 0xb3, 0x82, 0xf2, 0x60, 0x2d, 0x45, 0x5d, 0xda,
-	0x74, 0xfb, 0xb5, 0x07, 0x7b, 0x4f, 0xb5, 0xc5, 0x97, 0x19, 0x25, 0x0a, 0xfb, 0xe6, 0x68, 0x78,
-	0x0a, 0xc0, 0x13, 0x3a, 0xb0, 0x42, 0x91, 0x77, 0xe8, 0x1d, 0x57, 0x4f, 0x3e, 0xed, 0xac, 0x33,
-	0xdf, 0xb1, 0x27, 0xce, 0xfc, 0x37, 0xbf, 0x3f, 0xdc, 0x88, 0x03, 0x9e, 0xd0, 0x5b, 0x89, 0x14,
-	0x17, 0x85, 0xc4, 0xe6, 0xfb, 0x4b, 0xa4, 0xb8, 0x70, 0x12, 0x11, 0x6c, 0x67, 0x64, 0x99, 0x70,
-	0x42, 0xa3, 0x7b, 0x87, 0xde, 0x71, 0x10, 0x17, 0x61, 0xfb, 0x17, 0x0f, 0x76, 0x8d, 0xeb, 0x33,
-	0x23, 0xf5, 0x8a, 0x2b, 0x0c, 0x3f, 0x82, 0xed, 0x8c, 0xf3, 0x64, 0xc0, 0xa8, 0x31, 0xec, 0xc7,
-	0x15, 0x1d, 0xf6, 0x68, 0xf8, 0x7f, 0xa8, 0x48, 0x45, 0xa6, 0x28, 0x8c, 0x8b, 0x20, 0x76, 0x51,
-	0xf8, 0x00, 0x40, 0x2a, 0x2e, 0xc8, 0x18, 0xf5, 0x19, 0x5b, 0x21, 0x70, 0x48, 0x8f, 0x86, 0x27,
-	0xe0, 0xcf, 0xb9, 0xc2, 0xc8, 0x3f, 0xf4, 0x8e, 0x1b, 0x27, 0xad, 0xf5, 0xd6, 0x75, 0xe5, 0x17,
-	0xcb, 0x0c, 0x63, 0xc3, 0x6d, 0xff, 0x7a, 0x0f, 0xee, 0xaf, 0xf8, 0xea, 0x0b, 0x9e, 0x71, 0x89,
-	0xf4, 0xdd, 0xde, 0x1a, 0xb0, 0xc9, 0xa8, 0xf1, 0xe5, 0xc7, 0x9b, 0x8c, 0xfe, 0x9b, 0xa7, 0x03,
-	0xd8, 0xc9, 0x33, 0xdd, 0x01, 0x14, 0xc6, 0x57, 0x10, 0x97, 0x71, 0xf8, 0x09, 0x04, 0x94, 0x28,
-	0x32, 0x90, 0xec, 0x0a, 0xa3, 0x2d, 0xa3, 0xb8, 0xa3, 0x81, 0x73, 0x76, 0x85, 0x5a, 0x77, 0x24,
-	0xf8, 0x6c, 0xc0, 0x52, 0x8a, 0x97, 0x51, 0xc5, 0x64, 0x03, 0x8d, 0xf4, 0x34, 0x10, 0x3e, 0x84,
-	0xaa, 0xfd, 0x32, 0x7b, 0x7a, 0xdb, 0xe4, 0xc1, 0x42, 0xe6, 0xfc, 0xc7, 0xb0, 0x63, 0xce, 0x4f,
-	0x71, 0x19, 0xed, 0xd8, 0x59, 0xe8, 0xf8, 0x39, 0x2e, 0xc3, 0xff, 0x41, 0x45, 0x71, 0x93, 0x08,
-	0x4c, 0x62, 0x4b, 0x71, 0x0d, 0x7f, 0x0e, 0x8d, 0x42, 0x32, 0x9f, 0xcd, 0x88, 0x58, 0x46, 0x60,
-	0xd2, 0x75, 0xa7, 0x6a, 0xc1, 0xd2, 0xf5, 0x84, 0xc8, 0x49, 0x54, 0xb5, 0x9f, 0xa4, 0x81, 0xef,
-	0x88, 0x9c, 0x68, 0x5b, 0x99, 0x6b, 0xe1, 0x80, 0xa8, 0xa8, 0x66, 0x6d, 0x15, 0xd0, 0xa9, 0x0a,
-	0x3b, 0x70, 0xbf, 0x68, 0x57, 0x26, 0xf8, 0x9c, 0x51, 0x14, 0xba, 0x6f, 0xf5, 0x43, 0xef, 0xb8,
-	0x1e, 0xef, 0xb9, 0x54, 0xdf, 0x65, 0x7a, 0x54, 0x9b, 0xba, 0xe0, 0xb3, 0x4c, 0xa0, 0x94, 0x8c,
-	0xa7, 0x9a, 0xda, 0x30, 0xd4, 0xfa, 0x0a, 0xda, 0xa3, 0xed, 0xd7, 0x3e, 0xec, 0xaf, 0x8c, 0xf1,
-	0x19, 0x4b, 0x49, 0xc2, 0xae, 0x3e, 0x64, 0x8e, 0xfb, 0xb0, 0x35, 0x27, 0x89, 0x1b, 0xa1, 0x1f,
-	0xdb, 0x40, 0x2f, 0x34, 0x4b, 0x2d, 0xee, 0x1b, 0xbc, 0x08, 0x75, 0x86, 0x0c, 0xa5, 0x22, 0x2c,
-	0x75, 0xa3, 0x2b, 0x42, 0xad, 0xa4, 0xb8, 0x22, 0x89, 0x1b, 0x9a, 0x0d, 0xc2, 0x6f, 0xcc, 0x4e,
-	0xab, 0x5c, 0x9a, 0x59, 0x35, 0x4e, 0xda, 0xeb, 0xd7, 0xd3, 0xfa, 0x3f, 0x37, 0xcc, 0xd8, 0x9d,
-	0xd0, 0x4d, 0x18, 0xe5, 0x29, 0x45, 0x21, 0x07, 0x19, 0x59, 0xf2, 0x5c, 0x99, 0x89, 0xfa, 0x71,
-	0xdd, 0xa1, 0x7d, 0x03, 0x86, 0x5f, 0x42, 0x93, 0xa5, 0xa3, 0x84, 0x28, 0xdd, 0x29, 0x47, 0x0c,
-	0x0c, 0x71, 0xb7, 0xc4, 0x1d, 0xf5, 0x0b, 0xd8, 0x15, 0xb8, 0x20, 0x82, 0x0e, 0x94, 0x40, 0x22,
-	0x73, 0x37, 0x6c, 0x3f, 0x6e, 0x58, 0xf8, 0x85, 0x43, 0x57, 0x88, 0xe5, 0x1a, 0x57, 0x57, 0x89,
-	0x2f, 0x8b, 0x65, 0x7e, 0x04, 0x7b, 0x8e, 0x48, 0x31, 0xc1, 0xb1, 0x29, 0xe6, 0xe6, 0xdf, 0xb4,
-	0x89, 0x6f, 0x4b, 0x3c, 0x3c, 0x82, 0x5a, 0x51, 0xde, 0x74, 0xaa, 0x6e, 0x78, 0x55, 0x57, 0xdb,
-	0xf4, 0xeb, 0x08, 0x6a, 0xa3, 0x62, 0x8a, 0x7a, 0x95, 0x1a, 0x96, 0x52, 0x62, 0xa7, 0xea, 0x2f,
-	0x77, 0x6b, 0xf7, 0xce, 0xdd, 0xfa, 0x0c, 0xea, 0x29, 0x5e, 0xaa, 0x5b, 0xd7, 0x4d, 0x43, 0xa8,
-	0x69, 0xb0, 0xf0, 0xdc, 0x1e, 0x41, 0x64, 0x96, 0xe6, 0x49, 0x42, 0xd8, 0x0c, 0xcb, 0x6f, 0x89,
-	0x79, 0x82, 0xef, 0xbf, 0x38, 0x47, 0x50, 0xd3, 0xcf, 0x66, 0x59, 0xc8, 0x3e, 0x01, 0xd5, 0x14,
-	0x17, 0x65, 0x9d, 0x9f, 0x3d, 0x57, 0xe8, 0x7c, 0xca, 0xb2, 0xec, 0xbf, 0x16, 0x7a, 0x04, 0x7b,
-	0x99, 0xc0, 0x39, 0xe3, 0xb9, 0xbc, 0x5b, 0xad, 0x59, 0x24, 0xca, 0x71, 0xdc, 0x75, 0xe5, 0xff,
-	0xdd, 0xd5, 0xcc, 0xbd, 0x7c, 0x7d, 0xce, 0x52, 0xd5, 0x4b, 0x2f, 0xf4, 0xc8, 0xff, 0xe9, 0xc6,
-	0xbc, 0xeb, 0x55, 0xd6, 0x57, 0x34, 0x17, 0x02, 0x53, 0x35, 0xc8, 0xb4, 0x94, 0x74, 0x57, 0xa8,
-	0xee, 0x50, 0xa3, 0x2f, 0xdb, 0x4f, 0xa0, 0x79, 0x5b, 0x4e, 0xc6, 0x28, 0x51, 0x7d, 0x70, 0xad,
-	0xb3, 0x67, 0x6f, 0xae, 0x5b, 0xde, 0xdb, 0xeb, 0x96, 0xf7, 0xc7, 0x75, 0xcb, 0xfb, 0xe9, 0xa6,
-	0xb5, 0xf1, 0xf6, 0xa6, 0xb5, 0xf1, 0xdb, 0x4d, 0x6b, 0xe3, 0xc7, 0xaf, 0xc6, 0x4c, 0x4d, 0xf2,
-	0x61, 0xe7, 0x82, 0xcf, 0xba, 0xcf, 0x7f, 0x78, 0xf5, 0xf4, 0x7b, 0x54, 0x0b, 0x2e, 0xa6, 0xdd,
-	0x8b, 0x09, 0x61, 0x69, 0xf7, 0xb2, 0xfc, 0x3f, 0x55, 0xcb, 0x0c, 0xe5, 0xb0, 0x62, 0xfe, 0x4b,
-	0xbf, 0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xeb, 0x71, 0xdc, 0x02, 0x08, 0x00, 0x00,
+var xxx_messageInfo_EventBundleChallenged proto.InternalMessageInfo
+
+func (m *EventBundleChallenged) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventBundleChallenged) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventBundleChallenged) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
 }
 
-func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventBundleChallenged) GetBond() uint64 {
+	if m != nil {
+		return m.Bond
 	}
-	{
-		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// EventChallengeVote is an event emitted when a protocol node votes on a challenge.
+// emitted_by: MsgVoteChallenge
+type EventChallengeVote struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundle_id is the id of the challenged bundle.
+	BundleId uint64 `protobuf:"varint,2,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// staker is the account staker of the protocol node.
+	Staker string `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
+	// vote is for what the validator voted with
+	Vote VoteType `protobuf:"varint,4,opt,name=vote,proto3,enum=kyve.bundles.v1beta1.VoteType" json:"vote,omitempty"`
+}
+
+func (m *EventChallengeVote) Reset()         { *m = EventChallengeVote{} }
+func (m *EventChallengeVote) String() string { return proto.CompactTextString(m) }
+func (*EventChallengeVote) ProtoMessage()    {}
+func (*EventChallengeVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02f505e55d81e92, []int{11}
+}
+func (m *EventChallengeVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChallengeVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChallengeVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+}
+func (m *EventChallengeVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChallengeVote.Merge(m, src)
+}
+func (m *EventChallengeVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChallengeVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChallengeVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChallengeVote proto.InternalMessageInfo
+
+func (m *EventChallengeVote) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventBundleVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventChallengeVote) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventBundleVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventChallengeVote) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
 }
 
-func (m *EventBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Vote != 0 {
+func (m *EventChallengeVote) GetVote() VoteType {
+	if m != nil {
+		return m.Vote
+	}
+	return VOTE_TYPE_UNSPECIFIED
+}
+
+// EventChallengeResolved is an event emitted when the voting period of a challenge is over.
+// emitted_by: EndBlock
+type EventChallengeResolved struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundle_id is the id of the challenged bundle.
+	BundleId uint64 `protobuf:"varint,2,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// challenger is the address of the staker who challenged the bundle.
+	Challenger string `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// status is the outcome of the challenge.
+	Status ChallengeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=kyve.bundles.v1beta1.ChallengeStatus" json:"status,omitempty"`
+	// valid is the voting power which voted that the bundle is valid.
+	Valid uint64 `protobuf:"varint,5,opt,name=valid,proto3" json:"valid,omitempty"`
+	// invalid is the voting power which voted that the bundle is invalid.
+	Invalid uint64 `protobuf:"varint,6,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	// total is the total voting power of the pool.
+	Total uint64 `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	// reward is the amount of $KYVE the challenger received on top of the bond.
+	Reward uint64 `protobuf:"varint,8,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (m *EventChallengeResolved) Reset()         { *m = EventChallengeResolved{} }
+func (m *EventChallengeResolved) String() string { return proto.CompactTextString(m) }
+func (*EventChallengeResolved) ProtoMessage()    {}
+func (*EventChallengeResolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02f505e55d81e92, []int{12}
+}
+func (m *EventChallengeResolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChallengeResolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChallengeResolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChallengeResolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChallengeResolved.Merge(m, src)
+}
+func (m *EventChallengeResolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChallengeResolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChallengeResolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChallengeResolved proto.InternalMessageInfo
+
+func (m *EventChallengeResolved) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventChallengeResolved) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
+	}
+	return 0
+}
+
+func (m *EventChallengeResolved) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *EventChallengeResolved) GetStatus() ChallengeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CHALLENGE_STATUS_UNSPECIFIED
+}
+
+func (m *EventChallengeResolved) GetValid() uint64 {
+	if m != nil {
+		return m.Valid
+	}
+	return 0
+}
+
+func (m *EventChallengeResolved) GetInvalid() uint64 {
+	if m != nil {
+		return m.Invalid
+	}
+	return 0
+}
+
+func (m *EventChallengeResolved) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *EventChallengeResolved) GetReward() uint64 {
+	if m != nil {
+		return m.Reward
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventUpdateParams)(nil), "kyve.bundles.v1beta1.EventUpdateParams")
+	proto.RegisterType((*EventBundleVote)(nil), "kyve.bundles.v1beta1.EventBundleVote")
+	proto.RegisterType((*EventBundleVoteCommitted)(nil), "kyve.bundles.v1beta1.EventBundleVoteCommitted")
+	proto.RegisterType((*EventBundleProposed)(nil), "kyve.bundles.v1beta1.EventBundleProposed")
+	proto.RegisterType((*EventBundleFinalized)(nil), "kyve.bundles.v1beta1.EventBundleFinalized")
+	proto.RegisterType((*EventClaimedUploaderRole)(nil), "kyve.bundles.v1beta1.EventClaimedUploaderRole")
+	proto.RegisterType((*EventSkippedUploaderRole)(nil), "kyve.bundles.v1beta1.EventSkippedUploaderRole")
+	proto.RegisterType((*EventPointIncreased)(nil), "kyve.bundles.v1beta1.EventPointIncreased")
+	proto.RegisterType((*EventPointDecayed)(nil), "kyve.bundles.v1beta1.EventPointDecayed")
+	proto.RegisterType((*EventPointsReset)(nil), "kyve.bundles.v1beta1.EventPointsReset")
+	proto.RegisterType((*EventBundleChallenged)(nil), "kyve.bundles.v1beta1.EventBundleChallenged")
+	proto.RegisterType((*EventChallengeVote)(nil), "kyve.bundles.v1beta1.EventChallengeVote")
+	proto.RegisterType((*EventChallengeResolved)(nil), "kyve.bundles.v1beta1.EventChallengeResolved")
+}
+
+func init() { proto.RegisterFile("kyve/bundles/v1beta1/events.proto", fileDescriptor_a02f505e55d81e92) }
+
+var fileDescriptor_a02f505e55d81e92 = []byte{
+	// 1177 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x26, 0x1b, 0xc7, 0x7e, 0x8e, 0x9d, 0x64, 0xe3, 0xb4, 0xdb, 0xf6, 0x5b, 0x27, 0xd9,
+	0xaf, 0x2a, 0x8c, 0x0a, 0x36, 0x0d, 0x37, 0x24, 0x0e, 0x49, 0xda, 0x0a, 0xab, 0x12, 0x8a, 0x36,
+	0x6d, 0x25, 0xb8, 0x58, 0x63, 0xef, 0xc4, 0x1e, 0x79, 0x77, 0x66, 0xb5, 0x33, 0x76, 0xe2, 0x48,
+	0x08, 0x21, 0x6e, 0x70, 0x41, 0x42, 0xf0, 0x47, 0x70, 0xe5, 0xc6, 0x99, 0x43, 0x8f, 0x3d, 0x72,
+	0x02, 0x94, 0xfc, 0x23, 0x68, 0x7e, 0xec, 0xc6, 0x76, 0x4d, 0x68, 0xa3, 0xe4, 0x14, 0xbf, 0xcf,
+	0x7b, 0xf3, 0xde, 0x67, 0xdf, 0x7b, 0x3b, 0x9f, 0x0d, 0x6c, 0xf7, 0x47, 0x43, 0xdc, 0x68, 0x0f,
+	0x68, 0x10, 0x62, 0xde, 0x18, 0x3e, 0x6a, 0x63, 0x81, 0x1e, 0x35, 0xf0, 0x10, 0x53, 0xc1, 0xeb,
+	0x71, 0xc2, 0x04, 0x73, 0x2a, 0x32, 0xa4, 0x6e, 0x42, 0xea, 0x26, 0xe4, 0x6e, 0xb5, 0xc3, 0x78,
+	0xc4, 0x78, 0xa3, 0x8d, 0x38, 0xce, 0xce, 0x75, 0x18, 0xa1, 0xfa, 0xd4, 0xdd, 0x4a, 0x97, 0x75,
+	0x99, 0xfa, 0xd9, 0x90, 0xbf, 0x0c, 0xea, 0xcd, 0x2c, 0x97, 0xe6, 0xd6, 0x31, 0xb3, 0x29, 0xc5,
+	0x28, 0x41, 0x51, 0x1a, 0x72, 0x7f, 0x66, 0x88, 0x38, 0xd1, 0x6e, 0xef, 0x57, 0x0b, 0xd6, 0x9e,
+	0xc8, 0x47, 0x78, 0x11, 0x07, 0x48, 0xe0, 0x03, 0x75, 0xd4, 0xd9, 0x05, 0x60, 0x61, 0xd0, 0xd2,
+	0x89, 0x5c, 0x6b, 0xcb, 0xaa, 0x15, 0x77, 0xfe, 0x57, 0x9f, 0xf5, 0x70, 0x75, 0x7d, 0x62, 0xcf,
+	0x7e, 0xf5, 0xe7, 0xe6, 0x9c, 0x5f, 0x60, 0x61, 0x70, 0x91, 0x82, 0xe2, 0xe3, 0x34, 0xc5, 0xfc,
+	0xdb, 0xa7, 0xa0, 0xf8, 0xd8, 0xa4, 0x70, 0x61, 0x29, 0x46, 0xa3, 0x90, 0xa1, 0xc0, 0x5d, 0xd8,
+	0xb2, 0x6a, 0x05, 0x3f, 0x35, 0xbd, 0x9f, 0x2c, 0x58, 0x51, 0xac, 0xf7, 0x54, 0xaa, 0x97, 0x4c,
+	0x60, 0xe7, 0x36, 0x2c, 0xc5, 0x8c, 0x85, 0x2d, 0x12, 0x28, 0xc2, 0xb6, 0x9f, 0x93, 0x66, 0x33,
+	0x70, 0x6e, 0x41, 0x8e, 0x0b, 0xd4, 0xc7, 0x89, 0x62, 0x51, 0xf0, 0x8d, 0xe5, 0xdc, 0x07, 0xe0,
+	0x82, 0x25, 0xa8, 0x8b, 0x5b, 0x24, 0xad, 0x50, 0x30, 0x48, 0x33, 0x70, 0x76, 0xc0, 0x1e, 0x32,
+	0x81, 0x5d, 0x7b, 0xcb, 0xaa, 0x95, 0x77, 0xaa, 0xb3, 0xa9, 0xcb, 0xca, 0xcf, 0x47, 0x31, 0xf6,
+	0x55, 0xac, 0xf7, 0x9d, 0x05, 0xee, 0x14, 0xaf, 0x7d, 0x16, 0x45, 0x44, 0x08, 0x1c, 0x5c, 0x3b,
+	0xc1, 0x2a, 0x40, 0x47, 0x25, 0x8f, 0x30, 0x15, 0x8a, 0x66, 0xc1, 0x1f, 0x43, 0xbc, 0xdf, 0x17,
+	0x60, 0x7d, 0x8c, 0xcc, 0x41, 0xc2, 0x62, 0xc6, 0x2f, 0xe3, 0x51, 0x86, 0x79, 0x12, 0x28, 0x0e,
+	0xb6, 0x3f, 0x4f, 0x82, 0xff, 0xaa, 0x7f, 0x17, 0xf2, 0x83, 0x58, 0x8e, 0x03, 0x27, 0xa6, 0x7a,
+	0x66, 0x3b, 0xf7, 0xa0, 0x10, 0x20, 0x81, 0x5a, 0x9c, 0x9c, 0x62, 0x77, 0x51, 0x65, 0xcc, 0x4b,
+	0xe0, 0x90, 0x9c, 0x62, 0x99, 0xf7, 0x28, 0x61, 0x51, 0x8b, 0xd0, 0x00, 0x9f, 0xb8, 0x39, 0xe5,
+	0x2d, 0x48, 0xa4, 0x29, 0x01, 0x67, 0x13, 0x8a, 0xba, 0xcd, 0xfa, 0xf4, 0x92, 0xf2, 0x83, 0x86,
+	0xd4, 0xf9, 0x3b, 0x90, 0x57, 0xe7, 0xfb, 0x78, 0xe4, 0xe6, 0xf5, 0x62, 0x48, 0xfb, 0x19, 0x1e,
+	0x39, 0x1b, 0x90, 0x13, 0x4c, 0x39, 0x0a, 0xca, 0xb1, 0x28, 0x98, 0x84, 0x1f, 0x40, 0x39, 0x4d,
+	0x39, 0x88, 0x22, 0x94, 0x8c, 0x5c, 0x50, 0xee, 0x92, 0xc9, 0xaa, 0xc1, 0x8c, 0x75, 0x0f, 0xf1,
+	0x9e, 0x5b, 0xd4, 0x8f, 0x24, 0x81, 0xcf, 0x10, 0xef, 0x49, 0x5a, 0xb1, 0x69, 0x61, 0x0b, 0x09,
+	0x77, 0x59, 0xd3, 0x4a, 0xa1, 0x5d, 0xe1, 0xd4, 0x61, 0x3d, 0x6d, 0x57, 0x9c, 0xb0, 0x21, 0x09,
+	0x70, 0x22, 0xfb, 0x56, 0xda, 0xb2, 0x6a, 0x25, 0x7f, 0xcd, 0xb8, 0x0e, 0x8c, 0xa7, 0x19, 0x48,
+	0x52, 0x1d, 0x16, 0xc5, 0x09, 0xe6, 0x9c, 0x30, 0x2a, 0x43, 0xcb, 0x2a, 0xb4, 0x34, 0x86, 0x36,
+	0x03, 0xef, 0xb7, 0x3c, 0x54, 0xc6, 0xc6, 0xf8, 0x94, 0x50, 0x14, 0x92, 0xd3, 0x77, 0x99, 0x63,
+	0x05, 0x16, 0x87, 0x28, 0x34, 0x23, 0xb4, 0x7d, 0x6d, 0xc8, 0xb7, 0x8b, 0x50, 0x8d, 0xdb, 0x0a,
+	0x4f, 0x4d, 0xe9, 0x41, 0x6d, 0x2e, 0x10, 0xa1, 0x66, 0x74, 0xa9, 0x29, 0x33, 0x09, 0x26, 0x50,
+	0x68, 0x86, 0xa6, 0x0d, 0xe7, 0x13, 0xb5, 0xbf, 0x62, 0xc0, 0xd5, 0xac, 0xca, 0x3b, 0xde, 0xec,
+	0x77, 0x45, 0xf3, 0x3f, 0x54, 0x91, 0xbe, 0x39, 0x21, 0x9b, 0x70, 0x34, 0xa0, 0x01, 0x4e, 0x78,
+	0x2b, 0x46, 0x23, 0x36, 0x10, 0x6a, 0xa2, 0xb6, 0x5f, 0x32, 0xe8, 0x81, 0x02, 0x9d, 0xf7, 0x61,
+	0x95, 0xd0, 0xa3, 0x10, 0x09, 0xd9, 0x29, 0x13, 0x58, 0x50, 0x81, 0x2b, 0x19, 0x6e, 0x42, 0xdf,
+	0x83, 0x95, 0x04, 0x1f, 0xa3, 0x24, 0x68, 0x89, 0x04, 0x23, 0x3e, 0x30, 0xc3, 0xb6, 0xfd, 0xb2,
+	0x86, 0x9f, 0x1b, 0x74, 0x2c, 0x30, 0x5b, 0xe3, 0xe2, 0x78, 0xe0, 0x0b, 0x83, 0x3a, 0x0f, 0x61,
+	0xcd, 0x04, 0x06, 0x38, 0xc4, 0x5d, 0x55, 0xcc, 0xcc, 0x7f, 0x55, 0x3b, 0x1e, 0x67, 0xb8, 0xb3,
+	0x0d, 0xcb, 0x69, 0x79, 0xd5, 0xa9, 0x92, 0x8a, 0x2b, 0x9a, 0xda, 0xaa, 0x5f, 0xdb, 0xb0, 0x7c,
+	0x94, 0x4e, 0x51, 0xae, 0x52, 0x59, 0x87, 0x64, 0xd8, 0xae, 0x98, 0x78, 0xb7, 0x56, 0xa6, 0xde,
+	0xad, 0xff, 0x43, 0x89, 0xe2, 0x13, 0x71, 0xc1, 0x7a, 0x55, 0x05, 0x2c, 0x4b, 0x30, 0xe3, 0xfc,
+	0x15, 0x54, 0x26, 0xfb, 0xda, 0x92, 0x82, 0xc3, 0xdd, 0xb5, 0xad, 0x85, 0x5a, 0x71, 0xe7, 0x4e,
+	0x5d, 0x4b, 0x52, 0x5d, 0x4a, 0x52, 0x36, 0xa0, 0x7d, 0x46, 0xe8, 0xde, 0x47, 0xf2, 0x16, 0xfe,
+	0xe5, 0xaf, 0xcd, 0x5a, 0x97, 0x88, 0xde, 0xa0, 0x5d, 0xef, 0xb0, 0xa8, 0x61, 0xf4, 0x4b, 0xff,
+	0xf9, 0x90, 0x07, 0xfd, 0x86, 0x18, 0xc5, 0x98, 0xab, 0x03, 0xdc, 0x77, 0x26, 0x46, 0xa5, 0x30,
+	0xe7, 0x6b, 0xd8, 0x98, 0x1a, 0x82, 0xa9, 0xef, 0x5c, 0x7f, 0xfd, 0xf5, 0xc9, 0xb9, 0x4e, 0x13,
+	0x48, 0xdb, 0x64, 0x08, 0xac, 0xdf, 0x18, 0x81, 0xb4, 0xf7, 0x9a, 0xc0, 0xb7, 0x16, 0xdc, 0x7e,
+	0x63, 0x6b, 0x0c, 0x87, 0xca, 0xf5, 0x73, 0xd8, 0x98, 0x5e, 0x44, 0x05, 0x7b, 0x47, 0x46, 0x8f,
+	0xf6, 0x43, 0x44, 0x22, 0x9c, 0x51, 0xf4, 0x59, 0x88, 0xdf, 0xfe, 0xfe, 0xd8, 0x86, 0x65, 0x29,
+	0xe5, 0xd9, 0xbe, 0x69, 0x25, 0x28, 0x52, 0x7c, 0x9c, 0xe6, 0xf3, 0x7e, 0x4c, 0x85, 0xef, 0xb0,
+	0x4f, 0xe2, 0xf8, 0xaa, 0x85, 0x1e, 0xc2, 0x5a, 0x9c, 0xe0, 0x21, 0x61, 0x03, 0x3e, 0x5d, 0x6d,
+	0x35, 0x75, 0x64, 0x1b, 0x3e, 0xcd, 0xca, 0x7e, 0x93, 0x55, 0x64, 0x04, 0xf0, 0x80, 0x11, 0x2a,
+	0x9a, 0xb4, 0x23, 0x37, 0xe4, 0x2a, 0x42, 0x2c, 0x6f, 0xea, 0x41, 0x92, 0x60, 0x2a, 0x5a, 0xb1,
+	0x4c, 0xc5, 0xcd, 0x4d, 0x5a, 0x32, 0xa8, 0xca, 0xcf, 0xbd, 0xbe, 0xf9, 0x94, 0x52, 0xe6, 0x63,
+	0xdc, 0x41, 0xa3, 0x1b, 0x2c, 0xb6, 0x0f, 0xab, 0x17, 0xc5, 0xb8, 0x8f, 0x39, 0x16, 0xef, 0x5c,
+	0xcb, 0xfb, 0xc6, 0x82, 0x8d, 0x31, 0x6d, 0xd9, 0xef, 0xa1, 0x30, 0xc4, 0xb4, 0x7b, 0x19, 0xed,
+	0x7b, 0x50, 0x30, 0x52, 0x9a, 0x8d, 0x2e, 0xaf, 0x01, 0xf3, 0x49, 0x92, 0xe6, 0x48, 0x27, 0x37,
+	0x86, 0x38, 0x0e, 0xd8, 0x6d, 0x46, 0x53, 0xc1, 0x51, 0xbf, 0xbd, 0x9f, 0x2d, 0x70, 0xf4, 0x8e,
+	0xa6, 0x71, 0x97, 0x7f, 0xce, 0x5d, 0x4a, 0xe0, 0xe2, 0x41, 0x17, 0x26, 0x9a, 0x7a, 0x95, 0x8f,
+	0xb9, 0xef, 0xe7, 0xe1, 0xd6, 0x24, 0x31, 0x1f, 0x73, 0x16, 0x0e, 0x6f, 0xac, 0x3b, 0x9f, 0x66,
+	0x3a, 0xaa, 0x69, 0x3e, 0x98, 0x4d, 0x33, 0xa3, 0x33, 0x25, 0xa5, 0x99, 0xcc, 0x2f, 0xfe, 0x8b,
+	0xcc, 0xe7, 0x26, 0x65, 0x3e, 0x13, 0xf3, 0xa5, 0x71, 0x31, 0xbf, 0x05, 0x39, 0x7d, 0x95, 0x18,
+	0x21, 0x36, 0xd6, 0xde, 0xd3, 0x57, 0x67, 0x55, 0xeb, 0xf5, 0x59, 0xd5, 0xfa, 0xfb, 0xac, 0x6a,
+	0xfd, 0x70, 0x5e, 0x9d, 0x7b, 0x7d, 0x5e, 0x9d, 0xfb, 0xe3, 0xbc, 0x3a, 0xf7, 0xe5, 0x07, 0x63,
+	0x97, 0xd4, 0xb3, 0x2f, 0x5e, 0x3e, 0xf9, 0x1c, 0x8b, 0x63, 0x96, 0xf4, 0x1b, 0x9d, 0x1e, 0x22,
+	0xb4, 0x71, 0x92, 0xfd, 0xef, 0xa1, 0xae, 0xab, 0x76, 0x4e, 0xfd, 0xdf, 0xf1, 0xf1, 0x3f, 0x03,
+	0x00, 0x8b, 0x2c, 0x30, 0xfb, 0x4e, 0x0d, 0x00, 0x00,
+}
+
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBundleVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBundleVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vote != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Vote))
 		i--
 		dAtA[i] = 0x20
@@ -943,6 +1402,55 @@ func (m *EventBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBundleVoteCommitted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBundleVoteCommitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBundleVoteCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBundleProposed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1068,6 +1576,70 @@ func (m *EventBundleFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardDelegationCoins) > 0 {
+		for iNdEx := len(m.RewardDelegationCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardDelegationCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.RewardUploaderCoins) > 0 {
+		for iNdEx := len(m.RewardUploaderCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardUploaderCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.RewardTreasuryCoins) > 0 {
+		for iNdEx := len(m.RewardTreasuryCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardTreasuryCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.FundersPayoutCoins) > 0 {
+		for iNdEx := len(m.FundersPayoutCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundersPayoutCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if len(m.NextUploader) > 0 {
 		i -= len(m.NextUploader)
 		copy(dAtA[i:], m.NextUploader)
@@ -1284,7 +1856,7 @@ func (m *EventPointIncreased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPointsReset) Marshal() (dAtA []byte, err error) {
+func (m *EventPointDecayed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1294,16 +1866,21 @@ func (m *EventPointsReset) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPointsReset) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPointDecayed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPointsReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPointDecayed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.CurrentPoints != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentPoints))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Staker) > 0 {
 		i -= len(m.Staker)
 		copy(dAtA[i:], m.Staker)
@@ -1319,26 +1896,216 @@ func (m *EventPointsReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventPointsReset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventPointsReset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPointsReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.OldParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.NewParams.Size()
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBundleChallenged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBundleChallenged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBundleChallenged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bond != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Bond))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BundleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChallengeVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChallengeVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChallengeVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vote != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Vote))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BundleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChallengeResolved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChallengeResolved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChallengeResolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reward != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Reward))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Total != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Invalid != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Invalid))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Valid != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Valid))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BundleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.NewParams.Size()
 	n += 1 + l + sovEvents(uint64(l))
 	l = len(m.Payload)
 	if l > 0 {
@@ -1370,6 +2137,30 @@ func (m *EventBundleVote) Size() (n int) {
 	return n
 }
 
+func (m *EventBundleVoteCommitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventBundleProposed) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1483,6 +2274,30 @@ func (m *EventBundleFinalized) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovEvents(uint64(l))
 	}
+	if len(m.FundersPayoutCoins) > 0 {
+		for _, e := range m.FundersPayoutCoins {
+			l = e.Size()
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RewardTreasuryCoins) > 0 {
+		for _, e := range m.RewardTreasuryCoins {
+			l = e.Size()
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RewardUploaderCoins) > 0 {
+		for _, e := range m.RewardUploaderCoins {
+			l = e.Size()
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RewardDelegationCoins) > 0 {
+		for _, e := range m.RewardDelegationCoins {
+			l = e.Size()
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1547,6 +2362,25 @@ func (m *EventPointIncreased) Size() (n int) {
 	return n
 }
 
+func (m *EventPointDecayed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CurrentPoints != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentPoints))
+	}
+	return n
+}
+
 func (m *EventPointsReset) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1563,21 +2397,99 @@ func (m *EventPointsReset) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventBundleChallenged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovEvents(uint64(m.BundleId))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Bond != 0 {
+		n += 1 + sovEvents(uint64(m.Bond))
+	}
+	return n
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventChallengeVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovEvents(uint64(m.BundleId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Vote != 0 {
+		n += 1 + sovEvents(uint64(m.Vote))
+	}
+	return n
 }
-func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+
+func (m *EventChallengeResolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovEvents(uint64(m.BundleId))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.Valid != 0 {
+		n += 1 + sovEvents(uint64(m.Valid))
+	}
+	if m.Invalid != 0 {
+		n += 1 + sovEvents(uint64(m.Invalid))
+	}
+	if m.Total != 0 {
+		n += 1 + sovEvents(uint64(m.Total))
+	}
+	if m.Reward != 0 {
+		n += 1 + sovEvents(uint64(m.Reward))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -1869,6 +2781,171 @@ func (m *EventBundleVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBundleVoteCommitted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBundleVoteCommitted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBundleVoteCommitted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventBundleProposed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2539,11 +3616,672 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 14:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
+			}
+			m.FinalizedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextUploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundersPayoutCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundersPayoutCoins = append(m.FundersPayoutCoins, types.Coin{})
+			if err := m.FundersPayoutCoins[len(m.FundersPayoutCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardTreasuryCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardTreasuryCoins = append(m.RewardTreasuryCoins, types.Coin{})
+			if err := m.RewardTreasuryCoins[len(m.RewardTreasuryCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardUploaderCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardUploaderCoins = append(m.RewardUploaderCoins, types.Coin{})
+			if err := m.RewardUploaderCoins[len(m.RewardUploaderCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDelegationCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDelegationCoins = append(m.RewardDelegationCoins, types.Coin{})
+			if err := m.RewardDelegationCoins[len(m.RewardDelegationCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaimedUploaderRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimedUploaderRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimedUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewUploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewUploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSkippedUploaderRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSkippedUploaderRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSkippedUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousUploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousUploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewUploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewUploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPointIncreased) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPointIncreased: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPointIncreased: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPoints", wireType)
+			}
+			m.CurrentPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPointDecayed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPointDecayed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPointDecayed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.FinalizedAt = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2553,14 +4291,14 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedAt |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2588,13 +4326,13 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uploader = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextUploader", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPoints", wireType)
 			}
-			var stringLen uint64
+			m.CurrentPoints = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2604,24 +4342,11 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CurrentPoints |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextUploader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2643,7 +4368,7 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventClaimedUploaderRole) Unmarshal(dAtA []byte) error {
+func (m *EventPointsReset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2666,10 +4391,10 @@ func (m *EventClaimedUploaderRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventClaimedUploaderRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPointsReset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClaimedUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPointsReset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2692,27 +4417,8 @@ func (m *EventClaimedUploaderRole) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewUploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2740,7 +4446,7 @@ func (m *EventClaimedUploaderRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewUploader = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2763,7 +4469,7 @@ func (m *EventClaimedUploaderRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSkippedUploaderRole) Unmarshal(dAtA []byte) error {
+func (m *EventBundleChallenged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2786,10 +4492,10 @@ func (m *EventSkippedUploaderRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSkippedUploaderRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBundleChallenged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSkippedUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBundleChallenged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2813,9 +4519,9 @@ func (m *EventSkippedUploaderRole) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
 			}
-			m.Id = 0
+			m.BundleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2825,14 +4531,14 @@ func (m *EventSkippedUploaderRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.BundleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousUploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2860,13 +4566,13 @@ func (m *EventSkippedUploaderRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousUploader = string(dAtA[iNdEx:postIndex])
+			m.Challenger = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewUploader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
 			}
-			var stringLen uint64
+			m.Bond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2876,24 +4582,11 @@ func (m *EventSkippedUploaderRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Bond |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewUploader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2915,7 +4608,7 @@ func (m *EventSkippedUploaderRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPointIncreased) Unmarshal(dAtA []byte) error {
+func (m *EventChallengeVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2938,10 +4631,10 @@ func (m *EventPointIncreased) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPointIncreased: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChallengeVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPointIncreased: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChallengeVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2964,6 +4657,25 @@ func (m *EventPointIncreased) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+			}
+			m.BundleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
@@ -2995,11 +4707,11 @@ func (m *EventPointIncreased) Unmarshal(dAtA []byte) error {
 			}
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
-			m.CurrentPoints = 0
+			m.Vote = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3009,7 +4721,7 @@ func (m *EventPointIncreased) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentPoints |= uint64(b&0x7F) << shift
+				m.Vote |= VoteType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3035,7 +4747,7 @@ func (m *EventPointIncreased) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPointsReset) Unmarshal(dAtA []byte) error {
+func (m *EventChallengeResolved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3058,10 +4770,10 @@ func (m *EventPointsReset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPointsReset: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChallengeResolved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPointsReset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChallengeResolved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3084,8 +4796,27 @@ func (m *EventPointsReset) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+			}
+			m.BundleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3113,8 +4844,103 @@ func (m *EventPointsReset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			m.Challenger = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ChallengeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			m.Valid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Valid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
+			}
+			m.Invalid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Invalid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			m.Reward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
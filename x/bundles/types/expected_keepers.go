@@ -14,6 +14,7 @@ type AccountKeeper interface {
 
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -37,27 +38,35 @@ type PoolKeeper interface {
 
 	GetAllPools(ctx sdk.Context) (list []pooltypes.Pool)
 	ChargeFundersOfPool(ctx sdk.Context, poolId uint64, amount uint64) (payout uint64, err error)
+	ChargeFunderCoinsOfPool(ctx sdk.Context, poolId uint64) (payout sdk.Coins, err error)
 	ChargeInflationPool(ctx sdk.Context, poolId uint64) (payout uint64, err error)
 }
 
 type StakerKeeper interface {
 	GetAllStakerAddressesOfPool(ctx sdk.Context, poolId uint64) (stakers []string)
+	GetAllUnjailedStakerAddressesOfPool(ctx sdk.Context, poolId uint64) (stakers []string)
 	GetCommission(ctx sdk.Context, stakerAddress string) sdk.Dec
 	IncreaseStakerCommissionRewards(ctx sdk.Context, address string, amount uint64) error
+	IncreaseStakerCommissionCoinRewards(ctx sdk.Context, address string, coins sdk.Coins) error
 	AssertValaccountAuthorized(ctx sdk.Context, poolId uint64, stakerAddress string, valaddress string) error
 
 	DoesStakerExist(ctx sdk.Context, staker string) bool
 	DoesValaccountExist(ctx sdk.Context, poolId uint64, stakerAddress string) bool
+	IsValaccountJailed(ctx sdk.Context, poolId uint64, stakerAddress string) bool
 
 	LeavePool(ctx sdk.Context, staker string, poolId uint64)
+	JailValaccount(ctx sdk.Context, poolId uint64, stakerAddress string)
 
-	IncrementPoints(ctx sdk.Context, poolId uint64, stakerAddress string) (newPoints uint64)
+	IncreasePoints(ctx sdk.Context, poolId uint64, stakerAddress string, amount uint64) (newPoints uint64)
 	ResetPoints(ctx sdk.Context, poolId uint64, stakerAddress string) (previousPoints uint64)
+	DecayPoints(ctx sdk.Context, poolId uint64, stakerAddress string, decayVotes uint64) (currentPoints uint64, decayed bool)
 }
 
 type DelegationKeeper interface {
 	GetDelegationAmount(ctx sdk.Context, staker string) uint64
-	GetDelegationOfPool(ctx sdk.Context, poolId uint64) uint64
 	PayoutRewards(ctx sdk.Context, staker string, amount uint64, payerModuleName string) error
-	SlashDelegators(ctx sdk.Context, poolId uint64, staker string, slashType delegationTypes.SlashType)
+	PayoutCoinRewards(ctx sdk.Context, staker string, coins sdk.Coins, payerModuleName string) error
+	SlashDelegators(ctx sdk.Context, poolId uint64, staker string, slashType delegationTypes.SlashType, bundleId uint64)
+	FundInsurance(ctx sdk.Context, amount uint64, payerModuleName string) error
+	GetInsuranceFundShare(ctx sdk.Context) (res sdk.Dec)
 }
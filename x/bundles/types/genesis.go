@@ -48,5 +48,19 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Bundle challenges
+	bundleChallengeKey := make(map[string]struct{})
+
+	for _, elem := range gs.BundleChallengeList {
+		index := string(BundleChallengeKey(elem.PoolId, elem.BundleId))
+		if _, ok := bundleChallengeKey[index]; ok {
+			return fmt.Errorf("duplicated index for bundle challenge %v", elem)
+		}
+		if _, ok := finalizedBundleProposals[string(FinalizedBundleKey(elem.PoolId, elem.BundleId))]; !ok {
+			return fmt.Errorf("bundle challenge for non-existent finalized bundle %v", elem)
+		}
+		bundleChallengeKey[index] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
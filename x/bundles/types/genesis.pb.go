@@ -33,6 +33,8 @@ type GenesisState struct {
 	FinalizedBundleList []FinalizedBundle `protobuf:"bytes,3,rep,name=finalized_bundle_list,json=finalizedBundleList,proto3" json:"finalized_bundle_list"`
 	// round_robin_progress_list ...
 	RoundRobinProgressList []RoundRobinProgress `protobuf:"bytes,4,rep,name=round_robin_progress_list,json=roundRobinProgressList,proto3" json:"round_robin_progress_list"`
+	// bundle_challenge_list ...
+	BundleChallengeList []BundleChallenge `protobuf:"bytes,5,rep,name=bundle_challenge_list,json=bundleChallengeList,proto3" json:"bundle_challenge_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +98,13 @@ func (m *GenesisState) GetRoundRobinProgressList() []RoundRobinProgress {
 	return nil
 }
 
+func (m *GenesisState) GetBundleChallengeList() []BundleChallenge {
+	if m != nil {
+		return m.BundleChallengeList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.bundles.v1beta1.GenesisState")
 }
@@ -105,29 +114,30 @@ func init() {
 }
 
 var fileDescriptor_21c07b409d3bb015 = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4f, 0x4b, 0x02, 0x41,
-	0x18, 0xc6, 0x77, 0x55, 0x3c, 0xac, 0x9d, 0x36, 0x0b, 0x93, 0xd8, 0x4c, 0x0a, 0x3c, 0xc4, 0x0e,
-	0xda, 0xad, 0xa3, 0x90, 0x1d, 0x8a, 0x10, 0x83, 0xa0, 0x08, 0x96, 0x59, 0x1d, 0xd7, 0xc1, 0x75,
-	0x66, 0x99, 0x19, 0x2d, 0xfb, 0x14, 0x7d, 0x2c, 0x8f, 0x1e, 0x3b, 0x45, 0xb8, 0x5f, 0xa1, 0x0f,
-	0x10, 0xf3, 0xc7, 0x40, 0x9a, 0xdb, 0xee, 0xbb, 0xbf, 0xe7, 0xf9, 0xbd, 0xcb, 0xeb, 0x35, 0xa7,
-	0xcb, 0x05, 0x02, 0xf1, 0x9c, 0x8c, 0x52, 0xc4, 0xc1, 0xa2, 0x1d, 0x23, 0x01, 0xdb, 0x20, 0x41,
-	0x04, 0x71, 0xcc, 0xc3, 0x8c, 0x51, 0x41, 0xfd, 0xaa, 0x64, 0x42, 0xc3, 0x84, 0x86, 0xa9, 0x57,
-	0x13, 0x9a, 0x50, 0x05, 0x00, 0xf9, 0xa4, 0xd9, 0xba, 0xbd, 0x6f, 0x9b, 0xd5, 0xcc, 0xa9, 0x95,
-	0xc9, 0x20, 0x83, 0x33, 0x83, 0x34, 0x7f, 0x0a, 0xde, 0xde, 0x8d, 0x5e, 0xe2, 0x41, 0x40, 0x81,
-	0xfc, 0x2b, 0xaf, 0xac, 0x81, 0x9a, 0xdb, 0x70, 0x5b, 0x95, 0xce, 0x71, 0x68, 0x5b, 0x2a, 0xec,
-	0x2b, 0xa6, 0x5b, 0x5a, 0x7d, 0x9d, 0x38, 0x03, 0x93, 0xf0, 0x5f, 0xbc, 0xaa, 0xe6, 0xa2, 0x8c,
-	0xd1, 0x8c, 0x72, 0x98, 0x46, 0x29, 0xe6, 0xa2, 0x56, 0x68, 0x14, 0x5b, 0x95, 0xce, 0x99, 0xbd,
-	0xa9, 0xab, 0xde, 0xfb, 0x26, 0x60, 0x1a, 0xfd, 0x78, 0x67, 0x7a, 0x87, 0xb9, 0xf0, 0x23, 0xef,
-	0x60, 0x8c, 0x09, 0x4c, 0xf1, 0x3b, 0x1a, 0x45, 0xc6, 0xa3, 0xea, 0x8b, 0xaa, 0xfe, 0xdc, 0x5e,
-	0xdf, 0xdb, 0x46, 0xb4, 0xc7, 0xf4, 0xef, 0x8f, 0x77, 0xc7, 0x4a, 0x80, 0xbd, 0x23, 0x46, 0xe7,
-	0x64, 0x14, 0x31, 0x1a, 0x63, 0x22, 0xff, 0x21, 0x61, 0x88, 0x73, 0x2d, 0x29, 0x29, 0x49, 0xcb,
-	0x2e, 0x19, 0xc8, 0xd8, 0x40, 0xa6, 0xfa, 0x26, 0x64, 0x3c, 0x87, 0xec, 0xdf, 0x17, 0xa9, 0xea,
-	0xf6, 0x56, 0x9b, 0xc0, 0x5d, 0x6f, 0x02, 0xf7, 0x7b, 0x13, 0xb8, 0x1f, 0x79, 0xe0, 0xac, 0xf3,
-	0xc0, 0xf9, 0xcc, 0x03, 0xe7, 0xf9, 0x22, 0xc1, 0x62, 0x32, 0x8f, 0xc3, 0x21, 0x9d, 0x81, 0xdb,
-	0xa7, 0xc7, 0xeb, 0x7b, 0x24, 0x5e, 0x29, 0x9b, 0x82, 0xe1, 0x04, 0x62, 0x02, 0xde, 0xfe, 0xae,
-	0x29, 0x96, 0x19, 0xe2, 0x71, 0x59, 0x5d, 0xf1, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xc8,
-	0xa7, 0xad, 0x5e, 0x02, 0x00, 0x00,
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x4b, 0xc3, 0x30,
+	0x1c, 0xc5, 0x5b, 0x37, 0x77, 0xe8, 0x3c, 0xd5, 0x29, 0x73, 0x48, 0x9d, 0x43, 0x61, 0x07, 0x69,
+	0xd8, 0xbc, 0x79, 0x9c, 0x38, 0x0f, 0x8a, 0x8c, 0x09, 0x82, 0x22, 0x94, 0x64, 0xcb, 0xba, 0xb0,
+	0x2e, 0x29, 0x49, 0x36, 0x9d, 0x9f, 0xc2, 0x8f, 0xb5, 0xe3, 0x2e, 0x82, 0x27, 0x91, 0xed, 0x8b,
+	0x48, 0x93, 0x54, 0x28, 0x16, 0x6f, 0xed, 0x3f, 0xbf, 0xf7, 0xde, 0xff, 0xc1, 0xdf, 0x69, 0x4c,
+	0x16, 0x73, 0x0c, 0xd0, 0x8c, 0x0e, 0x23, 0x2c, 0xc0, 0xbc, 0x85, 0xb0, 0x84, 0x2d, 0x10, 0x62,
+	0x8a, 0x05, 0x11, 0x7e, 0xcc, 0x99, 0x64, 0x6e, 0x25, 0x61, 0x7c, 0xc3, 0xf8, 0x86, 0xa9, 0x55,
+	0x42, 0x16, 0x32, 0x05, 0x80, 0xe4, 0x4b, 0xb3, 0xb5, 0x7c, 0xbf, 0x54, 0xab, 0x99, 0xe3, 0x5c,
+	0x26, 0x86, 0x1c, 0x4e, 0x0d, 0xd2, 0xf8, 0x28, 0x38, 0x3b, 0xd7, 0x7a, 0x89, 0x7b, 0x09, 0x25,
+	0x76, 0x2f, 0x9c, 0x92, 0x06, 0xaa, 0x76, 0xdd, 0x6e, 0x96, 0xdb, 0x87, 0x7e, 0xde, 0x52, 0x7e,
+	0x4f, 0x31, 0x9d, 0xe2, 0xf2, 0xeb, 0xc8, 0xea, 0x1b, 0x85, 0xfb, 0xec, 0x54, 0x34, 0x17, 0xc4,
+	0x9c, 0xc5, 0x4c, 0xc0, 0x28, 0x88, 0x88, 0x90, 0xd5, 0xad, 0x7a, 0xa1, 0x59, 0x6e, 0x9f, 0xe4,
+	0x3b, 0x75, 0xd4, 0x7f, 0xcf, 0x08, 0x8c, 0xa3, 0x8b, 0x32, 0xd3, 0x5b, 0x22, 0xa4, 0x1b, 0x38,
+	0x7b, 0x23, 0x42, 0x61, 0x44, 0xde, 0xf0, 0x30, 0x30, 0x39, 0xca, 0xbe, 0xa0, 0xec, 0x4f, 0xf3,
+	0xed, 0xbb, 0xa9, 0x44, 0xe7, 0x18, 0xff, 0xdd, 0x51, 0x76, 0xac, 0x02, 0x88, 0x73, 0xc0, 0xd9,
+	0x8c, 0x0e, 0x03, 0xce, 0x10, 0xa1, 0x49, 0x87, 0x90, 0x63, 0x21, 0x74, 0x48, 0x51, 0x85, 0x34,
+	0xf3, 0x43, 0xfa, 0x89, 0xac, 0x9f, 0xa8, 0x7a, 0x46, 0x64, 0x72, 0xf6, 0xf9, 0x9f, 0x97, 0xb4,
+	0x8b, 0x69, 0x30, 0x18, 0xc3, 0x28, 0xc2, 0x34, 0x34, 0x5d, 0xb6, 0xff, 0xeb, 0xa2, 0x77, 0xbd,
+	0x4c, 0x15, 0x69, 0x17, 0x94, 0x1d, 0x27, 0x01, 0x9d, 0xee, 0x72, 0xed, 0xd9, 0xab, 0xb5, 0x67,
+	0x7f, 0xaf, 0x3d, 0xfb, 0x7d, 0xe3, 0x59, 0xab, 0x8d, 0x67, 0x7d, 0x6e, 0x3c, 0xeb, 0xe9, 0x2c,
+	0x24, 0x72, 0x3c, 0x43, 0xfe, 0x80, 0x4d, 0xc1, 0xcd, 0xe3, 0xc3, 0xd5, 0x1d, 0x96, 0x2f, 0x8c,
+	0x4f, 0xc0, 0x60, 0x0c, 0x09, 0x05, 0xaf, 0xbf, 0xe7, 0x22, 0x17, 0x31, 0x16, 0xa8, 0xa4, 0xce,
+	0xe4, 0xfc, 0x67, 0x00, 0x10, 0x0b, 0x47, 0x6e, 0xbf, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -150,6 +160,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BundleChallengeList) > 0 {
+		for iNdEx := len(m.BundleChallengeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundleChallengeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.RoundRobinProgressList) > 0 {
 		for iNdEx := len(m.RoundRobinProgressList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -242,6 +266,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BundleChallengeList) > 0 {
+		for _, e := range m.BundleChallengeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -415,6 +445,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleChallengeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleChallengeList = append(m.BundleChallengeList, BundleChallenge{})
+			if err := m.BundleChallengeList[len(m.BundleChallengeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -29,6 +29,10 @@ var (
 	FinalizedBundleVersionMapKey = []byte{3}
 	// RoundRobinProgressPrefix ...
 	RoundRobinProgressPrefix = []byte{4}
+	// BundleChallengePrefix ...
+	BundleChallengePrefix = []byte{5}
+	// OpenBundleChallengePrefix ...
+	OpenBundleChallengePrefix = []byte{6}
 
 	FinalizedBundleByIndexPrefix = []byte{11}
 )
@@ -52,3 +56,13 @@ func RoundRobinProgressKey(poolId uint64) []byte {
 func FinalizedBundleByIndexKey(poolId uint64, height uint64) []byte {
 	return util.GetByteKey(poolId, height)
 }
+
+// BundleChallengeKey ...
+func BundleChallengeKey(poolId uint64, bundleId uint64) []byte {
+	return util.GetByteKey(poolId, bundleId)
+}
+
+// OpenBundleChallengeKey ...
+func OpenBundleChallengeKey(createdAt uint64, poolId uint64, bundleId uint64) []byte {
+	return util.GetByteKey(createdAt, poolId, bundleId)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgChallengeFinalizedBundle{}
+	_ sdk.Msg            = &MsgChallengeFinalizedBundle{}
+)
+
+func NewMsgChallengeFinalizedBundle(creator string, poolId uint64, bundleId uint64) *MsgChallengeFinalizedBundle {
+	return &MsgChallengeFinalizedBundle{
+		Creator:  creator,
+		PoolId:   poolId,
+		BundleId: bundleId,
+	}
+}
+
+func (msg *MsgChallengeFinalizedBundle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgChallengeFinalizedBundle) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgChallengeFinalizedBundle) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgChallengeFinalizedBundle) Type() string {
+	return "kyve/bundles/MsgChallengeFinalizedBundle"
+}
+
+func (msg *MsgChallengeFinalizedBundle) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgCommitBundleVote{}
+	_ sdk.Msg            = &MsgCommitBundleVote{}
+)
+
+func NewMsgCommitBundleVote(creator string, staker string, poolId uint64, storageId string, commitment string) *MsgCommitBundleVote {
+	return &MsgCommitBundleVote{
+		Creator:    creator,
+		Staker:     staker,
+		PoolId:     poolId,
+		StorageId:  storageId,
+		Commitment: commitment,
+	}
+}
+
+func (msg *MsgCommitBundleVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCommitBundleVote) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCommitBundleVote) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCommitBundleVote) Type() string {
+	return "kyve/bundles/MsgCommitBundleVote"
+}
+
+func (msg *MsgCommitBundleVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if commitment, err := hex.DecodeString(msg.Commitment); err != nil || len(commitment) != sha256.Size {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid commitment (%s)", msg.Commitment)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgRevealBundleVote{}
+	_ sdk.Msg            = &MsgRevealBundleVote{}
+)
+
+func NewMsgRevealBundleVote(creator string, staker string, poolId uint64, storageId string, vote VoteType, salt string) *MsgRevealBundleVote {
+	return &MsgRevealBundleVote{
+		Creator:   creator,
+		Staker:    staker,
+		PoolId:    poolId,
+		StorageId: storageId,
+		Vote:      vote,
+		Salt:      salt,
+	}
+}
+
+func (msg *MsgRevealBundleVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevealBundleVote) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevealBundleVote) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevealBundleVote) Type() string {
+	return "kyve/bundles/MsgRevealBundleVote"
+}
+
+func (msg *MsgRevealBundleVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgVoteChallenge{}
+	_ sdk.Msg            = &MsgVoteChallenge{}
+)
+
+func NewMsgVoteChallenge(creator string, staker string, poolId uint64, bundleId uint64, vote VoteType) *MsgVoteChallenge {
+	return &MsgVoteChallenge{
+		Creator:  creator,
+		Staker:   staker,
+		PoolId:   poolId,
+		BundleId: bundleId,
+		Vote:     vote,
+	}
+}
+
+func (msg *MsgVoteChallenge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgVoteChallenge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgVoteChallenge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgVoteChallenge) Type() string {
+	return "kyve/bundles/MsgVoteChallenge"
+}
+
+func (msg *MsgVoteChallenge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// Challenges can only be voted valid or invalid
+	if msg.Vote != VOTE_TYPE_VALID && msg.Vote != VOTE_TYPE_INVALID {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid vote (%v)", msg.Vote)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/KYVENetwork/chain/util"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,18 +19,63 @@ var DefaultNetworkFee = sdk.MustNewDecFromStr("0.01")
 // DefaultMaxPoints ...
 var DefaultMaxPoints = uint64(24)
 
+// DefaultVotingPowerCurve ...
+var DefaultVotingPowerCurve = VOTING_POWER_CURVE_LINEAR
+
+// DefaultVotingPowerCap ...
+var DefaultVotingPowerCap = sdk.MustNewDecFromStr("0.2")
+
+// DefaultChallengeWindow ...
+var DefaultChallengeWindow = uint64(86400)
+
+// DefaultChallengeBond ...
+var DefaultChallengeBond = uint64(1_000_000_000_000)
+
+// DefaultChallengeVotingPeriod ...
+var DefaultChallengeVotingPeriod = uint64(3600)
+
+// DefaultChallengeReward ...
+var DefaultChallengeReward = uint64(100_000_000_000)
+
+// DefaultPointsDecayVotes ...
+var DefaultPointsDecayVotes = uint64(0)
+
+// DefaultUploadTimeoutPoints ...
+var DefaultUploadTimeoutPoints = uint64(1)
+
+// DefaultMissedVotePoints ...
+var DefaultMissedVotePoints = uint64(1)
+
 // NewParams creates a new Params instance
 func NewParams(
 	uploadTimeout uint64,
 	storageCost sdk.Dec,
 	networkFee sdk.Dec,
 	maxPoints uint64,
+	votingPowerCurve VotingPowerCurve,
+	votingPowerCap sdk.Dec,
+	challengeWindow uint64,
+	challengeBond uint64,
+	challengeVotingPeriod uint64,
+	challengeReward uint64,
+	pointsDecayVotes uint64,
+	uploadTimeoutPoints uint64,
+	missedVotePoints uint64,
 ) Params {
 	return Params{
-		UploadTimeout: uploadTimeout,
-		StorageCost:   storageCost,
-		NetworkFee:    networkFee,
-		MaxPoints:     maxPoints,
+		UploadTimeout:         uploadTimeout,
+		StorageCost:           storageCost,
+		NetworkFee:            networkFee,
+		MaxPoints:             maxPoints,
+		VotingPowerCurve:      votingPowerCurve,
+		VotingPowerCap:        votingPowerCap,
+		ChallengeWindow:       challengeWindow,
+		ChallengeBond:         challengeBond,
+		ChallengeVotingPeriod: challengeVotingPeriod,
+		ChallengeReward:       challengeReward,
+		PointsDecayVotes:      pointsDecayVotes,
+		UploadTimeoutPoints:   uploadTimeoutPoints,
+		MissedVotePoints:      missedVotePoints,
 	}
 }
 
@@ -39,6 +86,15 @@ func DefaultParams() Params {
 		DefaultStorageCost,
 		DefaultNetworkFee,
 		DefaultMaxPoints,
+		DefaultVotingPowerCurve,
+		DefaultVotingPowerCap,
+		DefaultChallengeWindow,
+		DefaultChallengeBond,
+		DefaultChallengeVotingPeriod,
+		DefaultChallengeReward,
+		DefaultPointsDecayVotes,
+		DefaultUploadTimeoutPoints,
+		DefaultMissedVotePoints,
 	)
 }
 
@@ -60,5 +116,45 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if _, ok := VotingPowerCurve_name[int32(p.VotingPowerCurve)]; !ok {
+		return fmt.Errorf("invalid voting power curve: %v", p.VotingPowerCurve)
+	}
+
+	if err := util.ValidatePercentage(p.VotingPowerCap); err != nil {
+		return err
+	}
+
+	if p.VotingPowerCap.IsZero() {
+		return fmt.Errorf("voting power cap must be positive")
+	}
+
+	if err := util.ValidateNumber(p.ChallengeWindow); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePositiveNumber(p.ChallengeBond); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePositiveNumber(p.ChallengeVotingPeriod); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.ChallengeReward); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.PointsDecayVotes); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePositiveNumber(p.UploadTimeoutPoints); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePositiveNumber(p.MissedVotePoints); err != nil {
+		return err
+	}
+
 	return nil
 }
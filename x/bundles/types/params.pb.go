@@ -24,6 +24,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VotingPowerCurve defines how the voting power of a staker
+// is calculated from its delegation
+type VotingPowerCurve int32
+
+const (
+	// VOTING_POWER_CURVE_LINEAR uses the delegation as voting power
+	VOTING_POWER_CURVE_LINEAR VotingPowerCurve = 0
+	// VOTING_POWER_CURVE_SQUARE_ROOT uses the square root of the
+	// delegation as voting power
+	VOTING_POWER_CURVE_SQUARE_ROOT VotingPowerCurve = 1
+	// VOTING_POWER_CURVE_CAPPED uses the delegation as voting power,
+	// but caps it at a percentage of the total pool delegation
+	VOTING_POWER_CURVE_CAPPED VotingPowerCurve = 2
+)
+
+var VotingPowerCurve_name = map[int32]string{
+	0: "VOTING_POWER_CURVE_LINEAR",
+	1: "VOTING_POWER_CURVE_SQUARE_ROOT",
+	2: "VOTING_POWER_CURVE_CAPPED",
+}
+
+var VotingPowerCurve_value = map[string]int32{
+	"VOTING_POWER_CURVE_LINEAR":      0,
+	"VOTING_POWER_CURVE_SQUARE_ROOT": 1,
+	"VOTING_POWER_CURVE_CAPPED":      2,
+}
+
+func (x VotingPowerCurve) String() string {
+	return proto.EnumName(VotingPowerCurve_name, int32(x))
+}
+
+func (VotingPowerCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cfd3a74b72a01aaa, []int{0}
+}
+
 // Params defines the bundles module parameters.
 type Params struct {
 	// upload_timeout ...
@@ -34,6 +69,24 @@ type Params struct {
 	NetworkFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=network_fee,json=networkFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"network_fee"`
 	// max_points ...
 	MaxPoints uint64 `protobuf:"varint,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// voting_power_curve ...
+	VotingPowerCurve VotingPowerCurve `protobuf:"varint,5,opt,name=voting_power_curve,json=votingPowerCurve,proto3,enum=kyve.bundles.v1beta1.VotingPowerCurve" json:"voting_power_curve,omitempty"`
+	// voting_power_cap ...
+	VotingPowerCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=voting_power_cap,json=votingPowerCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power_cap"`
+	// challenge_window ...
+	ChallengeWindow uint64 `protobuf:"varint,7,opt,name=challenge_window,json=challengeWindow,proto3" json:"challenge_window,omitempty"`
+	// challenge_bond ...
+	ChallengeBond uint64 `protobuf:"varint,8,opt,name=challenge_bond,json=challengeBond,proto3" json:"challenge_bond,omitempty"`
+	// challenge_voting_period ...
+	ChallengeVotingPeriod uint64 `protobuf:"varint,9,opt,name=challenge_voting_period,json=challengeVotingPeriod,proto3" json:"challenge_voting_period,omitempty"`
+	// challenge_reward ...
+	ChallengeReward uint64 `protobuf:"varint,10,opt,name=challenge_reward,json=challengeReward,proto3" json:"challenge_reward,omitempty"`
+	// points_decay_votes ...
+	PointsDecayVotes uint64 `protobuf:"varint,11,opt,name=points_decay_votes,json=pointsDecayVotes,proto3" json:"points_decay_votes,omitempty"`
+	// upload_timeout_points ...
+	UploadTimeoutPoints uint64 `protobuf:"varint,12,opt,name=upload_timeout_points,json=uploadTimeoutPoints,proto3" json:"upload_timeout_points,omitempty"`
+	// missed_vote_points ...
+	MissedVotePoints uint64 `protobuf:"varint,13,opt,name=missed_vote_points,json=missedVotePoints,proto3" json:"missed_vote_points,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -83,33 +136,107 @@ func (m *Params) GetMaxPoints() uint64 {
 	return 0
 }
 
+func (m *Params) GetVotingPowerCurve() VotingPowerCurve {
+	if m != nil {
+		return m.VotingPowerCurve
+	}
+	return VOTING_POWER_CURVE_LINEAR
+}
+
+func (m *Params) GetChallengeWindow() uint64 {
+	if m != nil {
+		return m.ChallengeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeBond() uint64 {
+	if m != nil {
+		return m.ChallengeBond
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeVotingPeriod() uint64 {
+	if m != nil {
+		return m.ChallengeVotingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeReward() uint64 {
+	if m != nil {
+		return m.ChallengeReward
+	}
+	return 0
+}
+
+func (m *Params) GetPointsDecayVotes() uint64 {
+	if m != nil {
+		return m.PointsDecayVotes
+	}
+	return 0
+}
+
+func (m *Params) GetUploadTimeoutPoints() uint64 {
+	if m != nil {
+		return m.UploadTimeoutPoints
+	}
+	return 0
+}
+
+func (m *Params) GetMissedVotePoints() uint64 {
+	if m != nil {
+		return m.MissedVotePoints
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("kyve.bundles.v1beta1.VotingPowerCurve", VotingPowerCurve_name, VotingPowerCurve_value)
 	proto.RegisterType((*Params)(nil), "kyve.bundles.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("kyve/bundles/v1beta1/params.proto", fileDescriptor_cfd3a74b72a01aaa) }
 
 var fileDescriptor_cfd3a74b72a01aaa = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x1d, 0x83, 0x65, 0xea, 0xa1, 0xec, 0x50, 0x04, 0xb3, 0x29, 0x28, 0x3b, 0x68,
-	0xc2, 0xf0, 0x0d, 0xa6, 0xee, 0x22, 0xe8, 0x1c, 0x22, 0xe8, 0xa5, 0xa4, 0xdd, 0xdf, 0xae, 0x6c,
-	0xe9, 0xbf, 0x34, 0xe9, 0xdc, 0xde, 0xc2, 0xc7, 0xda, 0x71, 0x47, 0xf1, 0x30, 0x64, 0x7b, 0x03,
-	0x9f, 0x40, 0x96, 0x16, 0xf1, 0xec, 0x29, 0xe1, 0xe3, 0x97, 0xdf, 0x47, 0x3e, 0x7a, 0x3c, 0x9e,
-	0x4f, 0x41, 0x04, 0x79, 0x32, 0x9c, 0x80, 0x16, 0xd3, 0x4e, 0x00, 0x46, 0x76, 0x44, 0x2a, 0x33,
-	0xa9, 0x34, 0x4f, 0x33, 0x34, 0xe8, 0x36, 0xb6, 0x08, 0x2f, 0x11, 0x5e, 0x22, 0x87, 0x8d, 0x08,
-	0x23, 0xb4, 0x80, 0xd8, 0xde, 0x0a, 0xf6, 0xe4, 0x9b, 0xd0, 0x6a, 0xdf, 0x3e, 0x76, 0x4f, 0xe9,
-	0x41, 0x9e, 0x4e, 0x50, 0x0e, 0x7d, 0x13, 0x2b, 0xc0, 0xdc, 0x78, 0xa4, 0x45, 0xda, 0x95, 0xc1,
-	0x7e, 0x91, 0x3e, 0x16, 0xa1, 0xfb, 0x40, 0xf7, 0xb4, 0xc1, 0x4c, 0x46, 0xe0, 0x87, 0xa8, 0x8d,
-	0xb7, 0xd3, 0x22, 0xed, 0x5a, 0x97, 0x2f, 0x56, 0x4d, 0xe7, 0x73, 0xd5, 0x3c, 0x8b, 0x62, 0x33,
-	0xca, 0x03, 0x1e, 0xa2, 0x12, 0x21, 0x6a, 0x85, 0xba, 0x3c, 0x2e, 0xf4, 0x70, 0x2c, 0xcc, 0x3c,
-	0x05, 0xcd, 0xaf, 0x21, 0x1c, 0xd4, 0x4b, 0xc7, 0x15, 0x6a, 0xe3, 0xde, 0xd3, 0x7a, 0x02, 0xe6,
-	0x0d, 0xb3, 0xb1, 0xff, 0x0a, 0xe0, 0xed, 0xfe, 0xcb, 0x48, 0x4b, 0x45, 0x0f, 0xc0, 0x3d, 0xa2,
-	0x54, 0xc9, 0x99, 0x9f, 0x62, 0x9c, 0x18, 0xed, 0x55, 0xec, 0x37, 0x6a, 0x4a, 0xce, 0xfa, 0x36,
-	0xe8, 0xf6, 0x16, 0x6b, 0x46, 0x96, 0x6b, 0x46, 0xbe, 0xd6, 0x8c, 0xbc, 0x6f, 0x98, 0xb3, 0xdc,
-	0x30, 0xe7, 0x63, 0xc3, 0x9c, 0x97, 0xf3, 0x3f, 0x65, 0xb7, 0xcf, 0x4f, 0x37, 0x77, 0x85, 0x53,
-	0x84, 0x23, 0x19, 0x27, 0x62, 0xf6, 0xbb, 0xbb, 0xad, 0x0d, 0xaa, 0x76, 0xc3, 0xcb, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x35, 0x55, 0xa9, 0x94, 0x01, 0x00, 0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x93, 0xb1, 0x0d, 0xe6, 0x6e, 0x25, 0x32, 0x9b, 0x08, 0x93, 0x96, 0x95, 0x49, 0x4c,
+	0x05, 0x95, 0x44, 0x1b, 0x12, 0xf7, 0xfe, 0xc9, 0xd0, 0x04, 0x6a, 0xb3, 0xd0, 0x75, 0xc0, 0xc5,
+	0x72, 0x13, 0x93, 0x46, 0x6d, 0xe2, 0x28, 0x76, 0xff, 0xf1, 0x09, 0x38, 0xf2, 0x1d, 0xf8, 0x32,
+	0x3b, 0xee, 0x88, 0x38, 0x4c, 0xa8, 0x3d, 0xf0, 0x35, 0x50, 0x9c, 0xb4, 0x5b, 0xab, 0x71, 0xd9,
+	0x29, 0xd1, 0xf3, 0xfc, 0xfc, 0xd8, 0xaf, 0xdf, 0xd7, 0xe0, 0x79, 0x77, 0x3c, 0x20, 0x46, 0xbb,
+	0x1f, 0xba, 0x3d, 0xc2, 0x8c, 0xc1, 0x51, 0x9b, 0x70, 0x7c, 0x64, 0x44, 0x38, 0xc6, 0x01, 0xd3,
+	0xa3, 0x98, 0x72, 0x0a, 0xb7, 0x13, 0x44, 0xcf, 0x10, 0x3d, 0x43, 0x76, 0xb7, 0x3d, 0xea, 0x51,
+	0x01, 0x18, 0xc9, 0x5f, 0xca, 0x1e, 0xfc, 0x5d, 0x03, 0xeb, 0x96, 0x58, 0x0c, 0x5f, 0x80, 0x7c,
+	0x3f, 0xea, 0x51, 0xec, 0x22, 0xee, 0x07, 0x84, 0xf6, 0xb9, 0x2a, 0x17, 0xe4, 0xe2, 0xaa, 0xbd,
+	0x95, 0xaa, 0xcd, 0x54, 0x84, 0x67, 0x60, 0x93, 0x71, 0x1a, 0x63, 0x8f, 0x20, 0x87, 0x32, 0xae,
+	0xae, 0x14, 0xe4, 0xe2, 0x46, 0x45, 0xbf, 0xbc, 0xde, 0x97, 0x7e, 0x5f, 0xef, 0x1f, 0x7a, 0x3e,
+	0xef, 0xf4, 0xdb, 0xba, 0x43, 0x03, 0xc3, 0xa1, 0x2c, 0xa0, 0x2c, 0xfb, 0xbc, 0x66, 0x6e, 0xd7,
+	0xe0, 0xe3, 0x88, 0x30, 0xbd, 0x46, 0x1c, 0x3b, 0x97, 0x65, 0x54, 0x29, 0xe3, 0xb0, 0x01, 0x72,
+	0x21, 0xe1, 0x43, 0x1a, 0x77, 0xd1, 0x57, 0x42, 0xd4, 0x07, 0xf7, 0x4a, 0x04, 0x59, 0xc4, 0x09,
+	0x21, 0x70, 0x0f, 0x80, 0x00, 0x8f, 0x50, 0x44, 0xfd, 0x90, 0x33, 0x75, 0x55, 0x94, 0xb1, 0x11,
+	0xe0, 0x91, 0x25, 0x04, 0xd8, 0x04, 0x70, 0x40, 0xb9, 0x1f, 0x7a, 0x28, 0xa2, 0x43, 0x12, 0x23,
+	0xa7, 0x1f, 0x0f, 0x88, 0xba, 0x56, 0x90, 0x8b, 0xf9, 0xe3, 0x43, 0xfd, 0xae, 0xdb, 0xd3, 0x5b,
+	0x82, 0xb7, 0x12, 0xbc, 0x9a, 0xd0, 0xb6, 0x32, 0x58, 0x52, 0xe0, 0x27, 0xa0, 0x2c, 0xa6, 0xe2,
+	0x48, 0x5d, 0xbf, 0x57, 0x29, 0xf9, 0xdb, 0xd9, 0x38, 0x82, 0x2f, 0x81, 0xe2, 0x74, 0x70, 0xaf,
+	0x47, 0x42, 0x8f, 0xa0, 0xa1, 0x1f, 0xba, 0x74, 0xa8, 0x3e, 0x14, 0x45, 0x3d, 0x9e, 0xeb, 0x17,
+	0x42, 0x4e, 0x9a, 0x78, 0x83, 0xb6, 0x69, 0xe8, 0xaa, 0x8f, 0xd2, 0x26, 0xce, 0xd5, 0x0a, 0x0d,
+	0x5d, 0xf8, 0x16, 0x3c, 0xbd, 0xc1, 0x66, 0xa7, 0x26, 0xb1, 0x4f, 0x5d, 0x75, 0x43, 0xf0, 0x3b,
+	0x73, 0x3b, 0xab, 0x5c, 0x98, 0x8b, 0x27, 0x89, 0xc9, 0x10, 0xc7, 0xae, 0x0a, 0x96, 0x4e, 0x62,
+	0x0b, 0x19, 0x96, 0x00, 0x4c, 0xef, 0x1f, 0xb9, 0xc4, 0xc1, 0xe3, 0x64, 0x17, 0xc2, 0xd4, 0x9c,
+	0x80, 0x95, 0xd4, 0xa9, 0x25, 0x46, 0x2b, 0xd1, 0xe1, 0x31, 0xd8, 0x59, 0x1c, 0xbe, 0x59, 0xf3,
+	0x36, 0xc5, 0x82, 0x27, 0x0b, 0x33, 0x98, 0xb5, 0xb1, 0x04, 0x60, 0xe0, 0x33, 0x46, 0x5c, 0x91,
+	0x3d, 0x5b, 0xb0, 0x95, 0xee, 0x90, 0x3a, 0x49, 0x78, 0x4a, 0xbf, 0xfa, 0x06, 0x94, 0xe5, 0x26,
+	0xc2, 0x3d, 0xf0, 0xac, 0xd5, 0x68, 0x9e, 0xd6, 0xdf, 0x21, 0xab, 0x71, 0x61, 0xda, 0xa8, 0x7a,
+	0x6e, 0xb7, 0x4c, 0xf4, 0xe1, 0xb4, 0x6e, 0x96, 0x6d, 0x45, 0x82, 0x07, 0x40, 0xbb, 0xc3, 0xfe,
+	0x78, 0x76, 0x5e, 0xb6, 0x4d, 0x64, 0x37, 0x1a, 0x4d, 0x45, 0xfe, 0x4f, 0x44, 0xb5, 0x6c, 0x59,
+	0x66, 0x4d, 0x59, 0xd9, 0x5d, 0xfd, 0xfe, 0x53, 0x93, 0x2a, 0x27, 0x97, 0x13, 0x4d, 0xbe, 0x9a,
+	0x68, 0xf2, 0x9f, 0x89, 0x26, 0xff, 0x98, 0x6a, 0xd2, 0xd5, 0x54, 0x93, 0x7e, 0x4d, 0x35, 0xe9,
+	0x4b, 0xe9, 0xd6, 0x48, 0xbc, 0xff, 0xdc, 0x32, 0xeb, 0xe9, 0x10, 0x1b, 0x4e, 0x07, 0xfb, 0xa1,
+	0x31, 0x9a, 0x3f, 0x74, 0x31, 0x1c, 0xed, 0x75, 0xf1, 0x68, 0xdf, 0xfc, 0x1b, 0x00, 0x79, 0x36,
+	0x6d, 0x26, 0x05, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -132,6 +259,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MissedVotePoints != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MissedVotePoints))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.UploadTimeoutPoints != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UploadTimeoutPoints))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PointsDecayVotes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PointsDecayVotes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ChallengeReward != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeReward))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChallengeVotingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeVotingPeriod))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ChallengeBond != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeBond))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ChallengeWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.VotingPowerCap.Size()
+		i -= size
+		if _, err := m.VotingPowerCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.VotingPowerCurve != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VotingPowerCurve))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.MaxPoints != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxPoints))
 		i--
@@ -192,6 +369,32 @@ func (m *Params) Size() (n int) {
 	if m.MaxPoints != 0 {
 		n += 1 + sovParams(uint64(m.MaxPoints))
 	}
+	if m.VotingPowerCurve != 0 {
+		n += 1 + sovParams(uint64(m.VotingPowerCurve))
+	}
+	l = m.VotingPowerCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ChallengeWindow != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeWindow))
+	}
+	if m.ChallengeBond != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeBond))
+	}
+	if m.ChallengeVotingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeVotingPeriod))
+	}
+	if m.ChallengeReward != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeReward))
+	}
+	if m.PointsDecayVotes != 0 {
+		n += 1 + sovParams(uint64(m.PointsDecayVotes))
+	}
+	if m.UploadTimeoutPoints != 0 {
+		n += 1 + sovParams(uint64(m.UploadTimeoutPoints))
+	}
+	if m.MissedVotePoints != 0 {
+		n += 1 + sovParams(uint64(m.MissedVotePoints))
+	}
 	return n
 }
 
@@ -336,6 +539,192 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerCurve", wireType)
+			}
+			m.VotingPowerCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPowerCurve |= VotingPowerCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPowerCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeWindow", wireType)
+			}
+			m.ChallengeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeBond", wireType)
+			}
+			m.ChallengeBond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeBond |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeVotingPeriod", wireType)
+			}
+			m.ChallengeVotingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeVotingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeReward", wireType)
+			}
+			m.ChallengeReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsDecayVotes", wireType)
+			}
+			m.PointsDecayVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointsDecayVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTimeoutPoints", wireType)
+			}
+			m.UploadTimeoutPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadTimeoutPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotePoints", wireType)
+			}
+			m.MissedVotePoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotePoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
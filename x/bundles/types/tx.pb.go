@@ -568,26 +568,32 @@ func (m *MsgSkipUploaderRoleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSkipUploaderRoleResponse proto.InternalMessageInfo
 
-// MsgUpdateParams defines a SDK message for updating the module parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// payload defines the x/bundles parameters to update.
-	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+// MsgCommitBundleVote defines a SDK message for committing to a hashed vote on a bundle proposal.
+type MsgCommitBundleVote struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// storage_id ...
+	StorageId string `protobuf:"bytes,4,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+	// commitment ...
+	Commitment string `protobuf:"bytes,5,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgCommitBundleVote) Reset()         { *m = MsgCommitBundleVote{} }
+func (m *MsgCommitBundleVote) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBundleVote) ProtoMessage()    {}
+func (*MsgCommitBundleVote) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9ed52bfae1633bf9, []int{8}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCommitBundleVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCommitBundleVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCommitBundleVote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -597,48 +603,69 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCommitBundleVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBundleVote.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCommitBundleVote) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCommitBundleVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBundleVote.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCommitBundleVote proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCommitBundleVote) GetCreator() string {
 	if m != nil {
-		return m.Authority
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetPayload() string {
+func (m *MsgCommitBundleVote) GetStaker() string {
 	if m != nil {
-		return m.Payload
+		return m.Staker
 	}
 	return ""
 }
 
-// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
-type MsgUpdateParamsResponse struct {
+func (m *MsgCommitBundleVote) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCommitBundleVote) GetStorageId() string {
+	if m != nil {
+		return m.StorageId
+	}
+	return ""
+}
+
+func (m *MsgCommitBundleVote) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+// MsgCommitBundleVoteResponse defines the Msg/CommitBundleVote response type.
+type MsgCommitBundleVoteResponse struct {
+}
+
+func (m *MsgCommitBundleVoteResponse) Reset()         { *m = MsgCommitBundleVoteResponse{} }
+func (m *MsgCommitBundleVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBundleVoteResponse) ProtoMessage()    {}
+func (*MsgCommitBundleVoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9ed52bfae1633bf9, []int{9}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCommitBundleVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCommitBundleVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCommitBundleVoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -648,906 +675,2979 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCommitBundleVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBundleVoteResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCommitBundleVoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCommitBundleVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBundleVoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCommitBundleVoteResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("kyve.bundles.v1beta1.VoteType", VoteType_name, VoteType_value)
-	proto.RegisterType((*MsgSubmitBundleProposal)(nil), "kyve.bundles.v1beta1.MsgSubmitBundleProposal")
-	proto.RegisterType((*MsgSubmitBundleProposalResponse)(nil), "kyve.bundles.v1beta1.MsgSubmitBundleProposalResponse")
-	proto.RegisterType((*MsgVoteBundleProposal)(nil), "kyve.bundles.v1beta1.MsgVoteBundleProposal")
-	proto.RegisterType((*MsgVoteBundleProposalResponse)(nil), "kyve.bundles.v1beta1.MsgVoteBundleProposalResponse")
-	proto.RegisterType((*MsgClaimUploaderRole)(nil), "kyve.bundles.v1beta1.MsgClaimUploaderRole")
-	proto.RegisterType((*MsgClaimUploaderRoleResponse)(nil), "kyve.bundles.v1beta1.MsgClaimUploaderRoleResponse")
-	proto.RegisterType((*MsgSkipUploaderRole)(nil), "kyve.bundles.v1beta1.MsgSkipUploaderRole")
-	proto.RegisterType((*MsgSkipUploaderRoleResponse)(nil), "kyve.bundles.v1beta1.MsgSkipUploaderRoleResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "kyve.bundles.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kyve.bundles.v1beta1.MsgUpdateParamsResponse")
+// MsgRevealBundleVote defines a SDK message for revealing a committed vote on a bundle proposal.
+type MsgRevealBundleVote struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// storage_id ...
+	StorageId string `protobuf:"bytes,4,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+	// vote ...
+	Vote VoteType `protobuf:"varint,5,opt,name=vote,proto3,enum=kyve.bundles.v1beta1.VoteType" json:"vote,omitempty"`
+	// salt ...
+	Salt string `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/bundles/v1beta1/tx.proto", fileDescriptor_9ed52bfae1633bf9) }
-
-var fileDescriptor_9ed52bfae1633bf9 = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x5f, 0x4f, 0xda, 0x5e,
-	0x18, 0xa6, 0x8a, 0x08, 0xaf, 0xbf, 0x9f, 0x62, 0x85, 0x59, 0xea, 0xa8, 0x8e, 0xc4, 0xc4, 0xb9,
-	0x09, 0x11, 0xb3, 0xdd, 0x83, 0x62, 0xd6, 0x38, 0x18, 0x01, 0x24, 0x71, 0x37, 0xe4, 0x40, 0xcf,
-	0x4a, 0x03, 0xe5, 0x34, 0x3d, 0x07, 0x66, 0xcd, 0x92, 0xdd, 0xee, 0x72, 0xdf, 0x61, 0x5f, 0x61,
-	0xfb, 0x0e, 0x5e, 0x9a, 0x5d, 0x2c, 0xbb, 0x5c, 0xf4, 0x8b, 0x2c, 0x3d, 0x85, 0xfa, 0x07, 0x48,
-	0x34, 0xd9, 0xb2, 0x3b, 0xde, 0xe7, 0x79, 0xde, 0xbf, 0xbc, 0x6f, 0x0f, 0x24, 0x3b, 0xce, 0x00,
-	0x67, 0x9a, 0xfd, 0x9e, 0xd6, 0xc5, 0x34, 0x33, 0xd8, 0x6d, 0x62, 0x86, 0x76, 0x33, 0xec, 0x34,
-	0x6d, 0xd9, 0x84, 0x11, 0x31, 0xe6, 0xd2, 0xe9, 0x21, 0x9d, 0x1e, 0xd2, 0x72, 0xa2, 0x45, 0xa8,
-	0x49, 0x68, 0x83, 0x6b, 0x32, 0x9e, 0xe1, 0x39, 0xc8, 0x31, 0x9d, 0xe8, 0xc4, 0xc3, 0xdd, 0x5f,
-	0x1e, 0x9a, 0xfa, 0x31, 0x03, 0xab, 0x45, 0xaa, 0x57, 0xfb, 0x4d, 0xd3, 0x60, 0x79, 0x1e, 0xad,
-	0x6c, 0x13, 0x8b, 0x50, 0xd4, 0x15, 0x25, 0x98, 0x6f, 0xd9, 0x18, 0x31, 0x62, 0x4b, 0xc2, 0x86,
-	0xb0, 0x15, 0xa9, 0x8c, 0x4c, 0xf1, 0x11, 0x84, 0x28, 0x43, 0x1d, 0x6c, 0x4b, 0x33, 0x9c, 0x18,
-	0x5a, 0xe2, 0x2a, 0xcc, 0x5b, 0x84, 0x74, 0x1b, 0x86, 0x26, 0xcd, 0x6e, 0x08, 0x5b, 0xc1, 0x4a,
-	0xc8, 0x35, 0x55, 0x4d, 0x4c, 0x02, 0x50, 0x46, 0x6c, 0xa4, 0x63, 0x97, 0x0b, 0x72, 0xa7, 0xc8,
-	0x10, 0x51, 0x35, 0x71, 0x0d, 0x22, 0x1a, 0x62, 0xa8, 0x41, 0x8d, 0x33, 0x2c, 0xcd, 0x71, 0xcf,
-	0xb0, 0x0b, 0x54, 0x8d, 0x33, 0xec, 0x93, 0x6d, 0x44, 0xdb, 0x52, 0x88, 0xbb, 0x72, 0xf2, 0x15,
-	0xa2, 0x6d, 0x37, 0xf0, 0x3b, 0x9b, 0x98, 0x0d, 0xa3, 0xa7, 0xe1, 0x53, 0x69, 0x9e, 0xbb, 0x46,
-	0x5c, 0x44, 0x75, 0x01, 0x71, 0x1d, 0x16, 0xbc, 0x11, 0x79, 0xa1, 0xc3, 0x9c, 0x07, 0x0f, 0xe2,
-	0xc1, 0x13, 0x10, 0xe6, 0xfe, 0x1d, 0xec, 0x48, 0x11, 0xaf, 0x49, 0xd7, 0x3e, 0xc2, 0x8e, 0x18,
-	0x87, 0x10, 0x23, 0x9c, 0x00, 0x4e, 0xcc, 0x31, 0xe2, 0xc2, 0x9b, 0xb0, 0x38, 0x0a, 0xd9, 0x37,
-	0x4d, 0x64, 0x3b, 0xd2, 0x02, 0xa7, 0xff, 0x1f, 0x46, 0xf5, 0xc0, 0xd4, 0x13, 0x58, 0x9f, 0x32,
-	0xd7, 0x0a, 0xa6, 0x16, 0xe9, 0x51, 0x9c, 0xfa, 0x26, 0x40, 0xbc, 0x48, 0xf5, 0x3a, 0x61, 0xf8,
-	0x9f, 0x4d, 0x3e, 0x0b, 0xc1, 0x01, 0x61, 0xde, 0xd0, 0x17, 0xb3, 0x4a, 0x7a, 0xd2, 0x56, 0xa5,
-	0xdd, 0x0a, 0x6b, 0x8e, 0x85, 0x2b, 0x5c, 0x9b, 0x5a, 0x87, 0xe4, 0xc4, 0xb2, 0xfd, 0xc6, 0x10,
-	0xc4, 0x8a, 0x54, 0xdf, 0xef, 0x22, 0xc3, 0x3c, 0xb6, 0xba, 0x04, 0x69, 0xd8, 0xae, 0x90, 0x2e,
-	0xfe, 0x83, 0x6d, 0xa5, 0x14, 0x78, 0x3c, 0x29, 0x85, 0x5f, 0xc2, 0x47, 0x58, 0x71, 0xc7, 0xdf,
-	0x31, 0xac, 0xbf, 0x54, 0xc1, 0x9d, 0xcd, 0x0b, 0xde, 0xd9, 0xbc, 0x54, 0x12, 0xd6, 0x26, 0x14,
-	0xe0, 0xd7, 0xd7, 0x82, 0xa5, 0x22, 0xd5, 0x8f, 0x2d, 0x0d, 0x31, 0x5c, 0x46, 0x36, 0x32, 0xa9,
-	0xf8, 0x12, 0x22, 0xa8, 0xcf, 0xda, 0xc4, 0x36, 0x98, 0xe3, 0x55, 0x97, 0x97, 0xbe, 0x7f, 0xdd,
-	0x89, 0x0d, 0xaf, 0x38, 0xa7, 0x69, 0x36, 0xa6, 0xb4, 0xca, 0x6c, 0xa3, 0xa7, 0x57, 0xae, 0xa5,
-	0x6e, 0x4f, 0x16, 0x72, 0xdc, 0x1c, 0xc3, 0xd2, 0x47, 0x66, 0x2a, 0xc1, 0x6f, 0xfb, 0x66, 0x92,
-	0x51, 0xfe, 0xed, 0x1e, 0x84, 0x47, 0xff, 0xaa, 0x98, 0x80, 0x78, 0xfd, 0x4d, 0xad, 0xd0, 0xa8,
-	0x9d, 0x94, 0x0b, 0x8d, 0xe3, 0x52, 0xb5, 0x5c, 0xd8, 0x57, 0x0f, 0xd5, 0xc2, 0x41, 0x34, 0x20,
-	0xae, 0xc0, 0xd2, 0x35, 0x55, 0xcf, 0xbd, 0x56, 0x0f, 0xa2, 0x82, 0x18, 0x87, 0xe5, 0x6b, 0x50,
-	0x2d, 0x79, 0xf0, 0xcc, 0x6d, 0x38, 0x97, 0xaf, 0xd6, 0x72, 0x6a, 0x29, 0x3a, 0x2b, 0x07, 0x3f,
-	0x7d, 0x51, 0x02, 0xd9, 0xf3, 0x20, 0xcc, 0x16, 0xa9, 0x2e, 0x7e, 0x80, 0xd8, 0xc4, 0x6f, 0xcd,
-	0xce, 0xe4, 0xcd, 0x9b, 0x72, 0x42, 0xf2, 0x8b, 0x07, 0xc9, 0x47, 0x5d, 0x8b, 0x03, 0x10, 0x27,
-	0x5c, 0xdb, 0xb3, 0xa9, 0xc1, 0xc6, 0xc5, 0xf2, 0xde, 0x03, 0xc4, 0x7e, 0x5e, 0x0a, 0xcb, 0xe3,
-	0xd7, 0xb0, 0x3d, 0x35, 0xd2, 0x98, 0x56, 0xce, 0xde, 0x5f, 0xeb, 0x27, 0xb5, 0x20, 0x3a, 0xb6,
-	0xff, 0x4f, 0xa7, 0xcf, 0xed, 0x8e, 0x54, 0xde, 0xbd, 0xb7, 0xd4, 0xcf, 0xa8, 0xc1, 0x7f, 0xb7,
-	0x36, 0x7a, 0x73, 0x6a, 0x88, 0x9b, 0x32, 0x79, 0xe7, 0x5e, 0xb2, 0x51, 0x96, 0xfc, 0xe1, 0xf9,
-	0xa5, 0x22, 0x5c, 0x5c, 0x2a, 0xc2, 0xaf, 0x4b, 0x45, 0xf8, 0x7c, 0xa5, 0x04, 0x2e, 0xae, 0x94,
-	0xc0, 0xcf, 0x2b, 0x25, 0xf0, 0xf6, 0xb9, 0x6e, 0xb0, 0x76, 0xbf, 0x99, 0x6e, 0x11, 0x33, 0x73,
-	0x74, 0x52, 0x2f, 0x94, 0x30, 0x7b, 0x4f, 0xec, 0x4e, 0xa6, 0xd5, 0x46, 0x46, 0x2f, 0x73, 0xea,
-	0x3f, 0xa6, 0xcc, 0xb1, 0x30, 0x6d, 0x86, 0xf8, 0x0b, 0xb8, 0xf7, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xde, 0x89, 0xbd, 0x79, 0x69, 0x07, 0x00, 0x00,
+func (m *MsgRevealBundleVote) Reset()         { *m = MsgRevealBundleVote{} }
+func (m *MsgRevealBundleVote) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBundleVote) ProtoMessage()    {}
+func (*MsgRevealBundleVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SubmitBundleProposal ...
-	SubmitBundleProposal(ctx context.Context, in *MsgSubmitBundleProposal, opts ...grpc.CallOption) (*MsgSubmitBundleProposalResponse, error)
-	// VoteBundleProposal ...
-	VoteBundleProposal(ctx context.Context, in *MsgVoteBundleProposal, opts ...grpc.CallOption) (*MsgVoteBundleProposalResponse, error)
-	// ClaimUploaderRole ...
-	ClaimUploaderRole(ctx context.Context, in *MsgClaimUploaderRole, opts ...grpc.CallOption) (*MsgClaimUploaderRoleResponse, error)
-	// SkipUploaderRole ...
-	SkipUploaderRole(ctx context.Context, in *MsgSkipUploaderRole, opts ...grpc.CallOption) (*MsgSkipUploaderRoleResponse, error)
-	// UpdateParams defines a governance operation for updating the x/bundles module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgRevealBundleVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRevealBundleVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBundleVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRevealBundleVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBundleVote.Merge(m, src)
+}
+func (m *MsgRevealBundleVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBundleVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBundleVote.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitBundleProposal(ctx context.Context, in *MsgSubmitBundleProposal, opts ...grpc.CallOption) (*MsgSubmitBundleProposalResponse, error) {
-	out := new(MsgSubmitBundleProposalResponse)
-	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/SubmitBundleProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevealBundleVote proto.InternalMessageInfo
+
+func (m *MsgRevealBundleVote) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) VoteBundleProposal(ctx context.Context, in *MsgVoteBundleProposal, opts ...grpc.CallOption) (*MsgVoteBundleProposalResponse, error) {
-	out := new(MsgVoteBundleProposalResponse)
-	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/VoteBundleProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBundleVote) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClaimUploaderRole(ctx context.Context, in *MsgClaimUploaderRole, opts ...grpc.CallOption) (*MsgClaimUploaderRoleResponse, error) {
-	out := new(MsgClaimUploaderRoleResponse)
-	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/ClaimUploaderRole", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBundleVote) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SkipUploaderRole(ctx context.Context, in *MsgSkipUploaderRole, opts ...grpc.CallOption) (*MsgSkipUploaderRoleResponse, error) {
-	out := new(MsgSkipUploaderRoleResponse)
-	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/SkipUploaderRole", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBundleVote) GetStorageId() string {
+	if m != nil {
+		return m.StorageId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBundleVote) GetVote() VoteType {
+	if m != nil {
+		return m.Vote
 	}
-	return out, nil
+	return VOTE_TYPE_UNSPECIFIED
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SubmitBundleProposal ...
-	SubmitBundleProposal(context.Context, *MsgSubmitBundleProposal) (*MsgSubmitBundleProposalResponse, error)
-	// VoteBundleProposal ...
-	VoteBundleProposal(context.Context, *MsgVoteBundleProposal) (*MsgVoteBundleProposalResponse, error)
-	// ClaimUploaderRole ...
-	ClaimUploaderRole(context.Context, *MsgClaimUploaderRole) (*MsgClaimUploaderRoleResponse, error)
-	// SkipUploaderRole ...
-	SkipUploaderRole(context.Context, *MsgSkipUploaderRole) (*MsgSkipUploaderRoleResponse, error)
-	// UpdateParams defines a governance operation for updating the x/bundles module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgRevealBundleVote) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgRevealBundleVoteResponse defines the Msg/RevealBundleVote response type.
+type MsgRevealBundleVoteResponse struct {
 }
 
-func (*UnimplementedMsgServer) SubmitBundleProposal(ctx context.Context, req *MsgSubmitBundleProposal) (*MsgSubmitBundleProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitBundleProposal not implemented")
+func (m *MsgRevealBundleVoteResponse) Reset()         { *m = MsgRevealBundleVoteResponse{} }
+func (m *MsgRevealBundleVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBundleVoteResponse) ProtoMessage()    {}
+func (*MsgRevealBundleVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{11}
 }
-func (*UnimplementedMsgServer) VoteBundleProposal(ctx context.Context, req *MsgVoteBundleProposal) (*MsgVoteBundleProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteBundleProposal not implemented")
+func (m *MsgRevealBundleVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ClaimUploaderRole(ctx context.Context, req *MsgClaimUploaderRole) (*MsgClaimUploaderRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimUploaderRole not implemented")
+func (m *MsgRevealBundleVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBundleVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SkipUploaderRole(ctx context.Context, req *MsgSkipUploaderRole) (*MsgSkipUploaderRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SkipUploaderRole not implemented")
+func (m *MsgRevealBundleVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBundleVoteResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRevealBundleVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBundleVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBundleVoteResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRevealBundleVoteResponse proto.InternalMessageInfo
+
+// MsgChallengeFinalizedBundle defines a SDK message for challenging a finalized bundle.
+type MsgChallengeFinalizedBundle struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundle_id ...
+	BundleId uint64 `protobuf:"varint,3,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
 }
 
-func _Msg_SubmitBundleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitBundleProposal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitBundleProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.bundles.v1beta1.Msg/SubmitBundleProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitBundleProposal(ctx, req.(*MsgSubmitBundleProposal))
+func (m *MsgChallengeFinalizedBundle) Reset()         { *m = MsgChallengeFinalizedBundle{} }
+func (m *MsgChallengeFinalizedBundle) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeFinalizedBundle) ProtoMessage()    {}
+func (*MsgChallengeFinalizedBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{12}
+}
+func (m *MsgChallengeFinalizedBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeFinalizedBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeFinalizedBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgChallengeFinalizedBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeFinalizedBundle.Merge(m, src)
+}
+func (m *MsgChallengeFinalizedBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeFinalizedBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeFinalizedBundle.DiscardUnknown(m)
 }
 
-func _Msg_VoteBundleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteBundleProposal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteBundleProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.bundles.v1beta1.Msg/VoteBundleProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteBundleProposal(ctx, req.(*MsgVoteBundleProposal))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgChallengeFinalizedBundle proto.InternalMessageInfo
 
-func _Msg_ClaimUploaderRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimUploaderRole)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimUploaderRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.bundles.v1beta1.Msg/ClaimUploaderRole",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimUploaderRole(ctx, req.(*MsgClaimUploaderRole))
+func (m *MsgChallengeFinalizedBundle) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SkipUploaderRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSkipUploaderRole)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SkipUploaderRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.bundles.v1beta1.Msg/SkipUploaderRole",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SkipUploaderRole(ctx, req.(*MsgSkipUploaderRole))
+func (m *MsgChallengeFinalizedBundle) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.bundles.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgChallengeFinalizedBundle) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kyve.bundles.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitBundleProposal",
-			Handler:    _Msg_SubmitBundleProposal_Handler,
-		},
-		{
-			MethodName: "VoteBundleProposal",
-			Handler:    _Msg_VoteBundleProposal_Handler,
-		},
-		{
-			MethodName: "ClaimUploaderRole",
-			Handler:    _Msg_ClaimUploaderRole_Handler,
-		},
-		{
-			MethodName: "SkipUploaderRole",
-			Handler:    _Msg_SkipUploaderRole_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kyve/bundles/v1beta1/tx.proto",
+// MsgChallengeFinalizedBundleResponse defines the Msg/ChallengeFinalizedBundle response type.
+type MsgChallengeFinalizedBundleResponse struct {
 }
 
-func (m *MsgSubmitBundleProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChallengeFinalizedBundleResponse) Reset()         { *m = MsgChallengeFinalizedBundleResponse{} }
+func (m *MsgChallengeFinalizedBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeFinalizedBundleResponse) ProtoMessage()    {}
+func (*MsgChallengeFinalizedBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{13}
+}
+func (m *MsgChallengeFinalizedBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeFinalizedBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeFinalizedBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgChallengeFinalizedBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeFinalizedBundleResponse.Merge(m, src)
+}
+func (m *MsgChallengeFinalizedBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeFinalizedBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeFinalizedBundleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitBundleProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChallengeFinalizedBundleResponse proto.InternalMessageInfo
+
+// MsgVoteChallenge defines a SDK message for voting on a challenge of a finalized bundle.
+type MsgVoteChallenge struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundle_id ...
+	BundleId uint64 `protobuf:"varint,4,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// vote ...
+	Vote VoteType `protobuf:"varint,5,opt,name=vote,proto3,enum=kyve.bundles.v1beta1.VoteType" json:"vote,omitempty"`
 }
 
-func (m *MsgSubmitBundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BundleSummary) > 0 {
-		i -= len(m.BundleSummary)
-		copy(dAtA[i:], m.BundleSummary)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BundleSummary)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.ToKey) > 0 {
-		i -= len(m.ToKey)
-		copy(dAtA[i:], m.ToKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToKey)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.FromKey) > 0 {
-		i -= len(m.FromKey)
-		copy(dAtA[i:], m.FromKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromKey)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.BundleSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BundleSize))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.FromIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FromIndex))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.DataHash) > 0 {
-		i -= len(m.DataHash)
-		copy(dAtA[i:], m.DataHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DataHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.DataSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DataSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.StorageId) > 0 {
-		i -= len(m.StorageId)
-		copy(dAtA[i:], m.StorageId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgVoteChallenge) Reset()         { *m = MsgVoteChallenge{} }
+func (m *MsgVoteChallenge) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteChallenge) ProtoMessage()    {}
+func (*MsgVoteChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{14}
+}
+func (m *MsgVoteChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgVoteChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteChallenge.Merge(m, src)
+}
+func (m *MsgVoteChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteChallenge.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitBundleProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgVoteChallenge proto.InternalMessageInfo
+
+func (m *MsgVoteChallenge) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitBundleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteChallenge) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
 }
 
-func (m *MsgSubmitBundleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgVoteChallenge) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *MsgVoteBundleProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteChallenge) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgVoteBundleProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgVoteBundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Vote != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Vote))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.StorageId) > 0 {
-		i -= len(m.StorageId)
-		copy(dAtA[i:], m.StorageId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgVoteChallenge) GetVote() VoteType {
+	if m != nil {
+		return m.Vote
 	}
-	return len(dAtA) - i, nil
+	return VOTE_TYPE_UNSPECIFIED
 }
 
-func (m *MsgVoteBundleProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgVoteChallengeResponse defines the Msg/VoteChallenge response type.
+type MsgVoteChallengeResponse struct {
 }
 
-func (m *MsgVoteBundleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteChallengeResponse) Reset()         { *m = MsgVoteChallengeResponse{} }
+func (m *MsgVoteChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteChallengeResponse) ProtoMessage()    {}
+func (*MsgVoteChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{15}
 }
-
-func (m *MsgVoteBundleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgVoteChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgClaimUploaderRole) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVoteChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgClaimUploaderRole) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVoteChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteChallengeResponse.Merge(m, src)
 }
-
-func (m *MsgClaimUploaderRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgVoteChallengeResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgClaimUploaderRoleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgVoteChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteChallengeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimUploaderRoleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgVoteChallengeResponse proto.InternalMessageInfo
 
-func (m *MsgClaimUploaderRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateParams defines a SDK message for updating the module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// payload defines the x/bundles parameters to update.
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (m *MsgSkipUploaderRole) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{16}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSkipUploaderRole) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgSkipUploaderRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FromIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FromIndex))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSkipUploaderRoleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetPayload() string {
+	if m != nil {
+		return m.Payload
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSkipUploaderRoleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgSkipUploaderRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{17}
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("kyve.bundles.v1beta1.VoteType", VoteType_name, VoteType_value)
+	proto.RegisterType((*MsgSubmitBundleProposal)(nil), "kyve.bundles.v1beta1.MsgSubmitBundleProposal")
+	proto.RegisterType((*MsgSubmitBundleProposalResponse)(nil), "kyve.bundles.v1beta1.MsgSubmitBundleProposalResponse")
+	proto.RegisterType((*MsgVoteBundleProposal)(nil), "kyve.bundles.v1beta1.MsgVoteBundleProposal")
+	proto.RegisterType((*MsgVoteBundleProposalResponse)(nil), "kyve.bundles.v1beta1.MsgVoteBundleProposalResponse")
+	proto.RegisterType((*MsgClaimUploaderRole)(nil), "kyve.bundles.v1beta1.MsgClaimUploaderRole")
+	proto.RegisterType((*MsgClaimUploaderRoleResponse)(nil), "kyve.bundles.v1beta1.MsgClaimUploaderRoleResponse")
+	proto.RegisterType((*MsgSkipUploaderRole)(nil), "kyve.bundles.v1beta1.MsgSkipUploaderRole")
+	proto.RegisterType((*MsgSkipUploaderRoleResponse)(nil), "kyve.bundles.v1beta1.MsgSkipUploaderRoleResponse")
+	proto.RegisterType((*MsgCommitBundleVote)(nil), "kyve.bundles.v1beta1.MsgCommitBundleVote")
+	proto.RegisterType((*MsgCommitBundleVoteResponse)(nil), "kyve.bundles.v1beta1.MsgCommitBundleVoteResponse")
+	proto.RegisterType((*MsgRevealBundleVote)(nil), "kyve.bundles.v1beta1.MsgRevealBundleVote")
+	proto.RegisterType((*MsgRevealBundleVoteResponse)(nil), "kyve.bundles.v1beta1.MsgRevealBundleVoteResponse")
+	proto.RegisterType((*MsgChallengeFinalizedBundle)(nil), "kyve.bundles.v1beta1.MsgChallengeFinalizedBundle")
+	proto.RegisterType((*MsgChallengeFinalizedBundleResponse)(nil), "kyve.bundles.v1beta1.MsgChallengeFinalizedBundleResponse")
+	proto.RegisterType((*MsgVoteChallenge)(nil), "kyve.bundles.v1beta1.MsgVoteChallenge")
+	proto.RegisterType((*MsgVoteChallengeResponse)(nil), "kyve.bundles.v1beta1.MsgVoteChallengeResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "kyve.bundles.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kyve.bundles.v1beta1.MsgUpdateParamsResponse")
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("kyve/bundles/v1beta1/tx.proto", fileDescriptor_9ed52bfae1633bf9) }
+
+var fileDescriptor_9ed52bfae1633bf9 = []byte{
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xa6, 0xae, 0x63, 0xbf, 0xd2, 0xd6, 0x9d, 0x3a, 0x64, 0xb3, 0x21, 0x9b, 0x62, 0x14,
+	0x54, 0x0a, 0xb1, 0xe5, 0x54, 0x20, 0x71, 0x4c, 0x52, 0x47, 0xac, 0x8a, 0x43, 0x64, 0x27, 0x91,
+	0xca, 0xc5, 0x1a, 0x7b, 0x87, 0xf5, 0xca, 0xbb, 0x3b, 0xab, 0x9d, 0xb1, 0x89, 0x23, 0x24, 0xae,
+	0x3d, 0xf2, 0x0f, 0x40, 0xe2, 0xce, 0x09, 0x7e, 0x03, 0x1c, 0x2b, 0x0e, 0x88, 0x23, 0x4a, 0xfe,
+	0x08, 0xda, 0xd9, 0xf5, 0xc4, 0x5e, 0x7b, 0x23, 0xa7, 0x2a, 0xf4, 0xe6, 0x79, 0xef, 0x7b, 0xef,
+	0xfb, 0xbe, 0xe7, 0xf1, 0x9b, 0x04, 0x36, 0xfa, 0xa3, 0x21, 0xa9, 0x76, 0x06, 0x9e, 0xe9, 0x10,
+	0x56, 0x1d, 0xd6, 0x3a, 0x84, 0xe3, 0x5a, 0x95, 0x9f, 0x55, 0xfc, 0x80, 0x72, 0x8a, 0x4a, 0x61,
+	0xba, 0x12, 0xa7, 0x2b, 0x71, 0x5a, 0x5b, 0xeb, 0x52, 0xe6, 0x52, 0xd6, 0x16, 0x98, 0x6a, 0x74,
+	0x88, 0x0a, 0xb4, 0x92, 0x45, 0x2d, 0x1a, 0xc5, 0xc3, 0x4f, 0x51, 0xb4, 0xfc, 0xd7, 0x12, 0xac,
+	0x36, 0x98, 0xd5, 0x1a, 0x74, 0x5c, 0x9b, 0xef, 0x89, 0x6e, 0x47, 0x01, 0xf5, 0x29, 0xc3, 0x0e,
+	0x52, 0x61, 0xb9, 0x1b, 0x10, 0xcc, 0x69, 0xa0, 0x2a, 0x8f, 0x94, 0xc7, 0x85, 0xe6, 0xf8, 0x88,
+	0xde, 0x85, 0x1c, 0xe3, 0xb8, 0x4f, 0x02, 0x75, 0x49, 0x24, 0xe2, 0x13, 0x5a, 0x85, 0x65, 0x9f,
+	0x52, 0xa7, 0x6d, 0x9b, 0xea, 0xad, 0x47, 0xca, 0xe3, 0x6c, 0x33, 0x17, 0x1e, 0x0d, 0x13, 0x6d,
+	0x00, 0x30, 0x4e, 0x03, 0x6c, 0x91, 0x30, 0x97, 0x15, 0x45, 0x85, 0x38, 0x62, 0x98, 0x68, 0x1d,
+	0x0a, 0x26, 0xe6, 0xb8, 0xcd, 0xec, 0x73, 0xa2, 0xde, 0x16, 0x95, 0xf9, 0x30, 0xd0, 0xb2, 0xcf,
+	0x89, 0x4c, 0xf6, 0x30, 0xeb, 0xa9, 0x39, 0x51, 0x2a, 0x92, 0x5f, 0x60, 0xd6, 0x0b, 0x1b, 0x7f,
+	0x13, 0x50, 0xb7, 0x6d, 0x7b, 0x26, 0x39, 0x53, 0x97, 0x45, 0x69, 0x21, 0x8c, 0x18, 0x61, 0x00,
+	0x6d, 0xc2, 0x9d, 0x68, 0x44, 0x51, 0xeb, 0xbc, 0xc8, 0x43, 0x14, 0x12, 0xcd, 0xd7, 0x20, 0x2f,
+	0xea, 0xfb, 0x64, 0xa4, 0x16, 0x22, 0x93, 0xe1, 0xf9, 0x39, 0x19, 0xa1, 0x15, 0xc8, 0x71, 0x2a,
+	0x12, 0x20, 0x12, 0xb7, 0x39, 0x0d, 0xc3, 0x5b, 0x70, 0x6f, 0xdc, 0x72, 0xe0, 0xba, 0x38, 0x18,
+	0xa9, 0x77, 0x44, 0xfa, 0x6e, 0xdc, 0x35, 0x0a, 0x96, 0xdf, 0x87, 0xcd, 0x94, 0xb9, 0x36, 0x09,
+	0xf3, 0xa9, 0xc7, 0x48, 0xf9, 0x37, 0x05, 0x56, 0x1a, 0xcc, 0x3a, 0xa5, 0x9c, 0xbc, 0xb5, 0xc9,
+	0xef, 0x40, 0x76, 0x48, 0x79, 0x34, 0xf4, 0x7b, 0x3b, 0x7a, 0x65, 0xde, 0xad, 0xaa, 0x84, 0x0a,
+	0x8f, 0x47, 0x3e, 0x69, 0x0a, 0x6c, 0x79, 0x13, 0x36, 0xe6, 0xca, 0x96, 0xc6, 0x30, 0x94, 0x1a,
+	0xcc, 0xda, 0x77, 0xb0, 0xed, 0x9e, 0xf8, 0x0e, 0xc5, 0x26, 0x09, 0x9a, 0xd4, 0x21, 0x6f, 0xd0,
+	0x56, 0x59, 0x87, 0xf7, 0xe6, 0x51, 0x48, 0x09, 0xdf, 0xc3, 0xc3, 0x70, 0xfc, 0x7d, 0xdb, 0xff,
+	0x8f, 0x14, 0x24, 0x6e, 0x5e, 0x36, 0x71, 0xf3, 0xca, 0x1b, 0xb0, 0x3e, 0x47, 0x80, 0xd4, 0xf7,
+	0xa3, 0x22, 0x04, 0xee, 0x53, 0x57, 0xde, 0x8f, 0x70, 0xa0, 0xff, 0xe3, 0x37, 0xaf, 0x03, 0x74,
+	0x05, 0xbb, 0x4b, 0x3c, 0x2e, 0xbe, 0xff, 0x42, 0x73, 0x22, 0x12, 0x1b, 0x48, 0x0a, 0x94, 0x06,
+	0x7e, 0x8f, 0x0c, 0x34, 0xc9, 0x90, 0x60, 0xe7, 0xad, 0x18, 0x78, 0x8d, 0xab, 0x8b, 0x10, 0x64,
+	0x19, 0x76, 0x78, 0xbc, 0x46, 0xc4, 0xe7, 0xd8, 0x68, 0xd2, 0x88, 0x34, 0xea, 0x46, 0x73, 0xe8,
+	0x61, 0xc7, 0x21, 0x9e, 0x45, 0x0e, 0x6c, 0x0f, 0x3b, 0xf6, 0x39, 0x31, 0x23, 0xe8, 0x35, 0x7e,
+	0x27, 0x7c, 0x2d, 0x4d, 0xf9, 0x5a, 0x87, 0x42, 0xbc, 0x41, 0xa4, 0xe5, 0x7c, 0x14, 0x30, 0xcc,
+	0xf2, 0x16, 0x7c, 0x70, 0x0d, 0x9d, 0x54, 0xf5, 0x8b, 0x02, 0xc5, 0xf8, 0x47, 0x28, 0xb1, 0x6f,
+	0x72, 0xf6, 0x53, 0x1a, 0xb3, 0xd3, 0x1a, 0x5f, 0x6b, 0x69, 0x68, 0xa0, 0x26, 0xf5, 0x4a, 0x33,
+	0x5d, 0xb8, 0xdf, 0x60, 0xd6, 0x89, 0x6f, 0x62, 0x4e, 0x8e, 0x70, 0x80, 0x5d, 0x86, 0x3e, 0x83,
+	0x02, 0x1e, 0xf0, 0x1e, 0x0d, 0x6c, 0x3e, 0x8a, 0xcc, 0xec, 0xa9, 0x7f, 0xfe, 0xba, 0x5d, 0x8a,
+	0x9f, 0xb4, 0x5d, 0xd3, 0x0c, 0x08, 0x63, 0x2d, 0x1e, 0xd8, 0x9e, 0xd5, 0xbc, 0x82, 0x86, 0x23,
+	0xf0, 0xf1, 0x28, 0xfc, 0xc1, 0xc5, 0x4e, 0xc7, 0xc7, 0xf2, 0x1a, 0xac, 0x26, 0x48, 0xc6, 0xfc,
+	0x4f, 0x3c, 0xc8, 0x8f, 0xd5, 0xa2, 0x35, 0x58, 0x39, 0xfd, 0xea, 0xb8, 0xde, 0x3e, 0x7e, 0x71,
+	0x54, 0x6f, 0x9f, 0x1c, 0xb6, 0x8e, 0xea, 0xfb, 0xc6, 0x81, 0x51, 0x7f, 0x56, 0xcc, 0xa0, 0x87,
+	0x70, 0xff, 0x2a, 0x75, 0xba, 0xfb, 0xa5, 0xf1, 0xac, 0xa8, 0xa0, 0x15, 0x78, 0x70, 0x15, 0x34,
+	0x0e, 0xa3, 0xf0, 0xd2, 0x74, 0x78, 0x77, 0xaf, 0x75, 0xbc, 0x6b, 0x1c, 0x16, 0x6f, 0x69, 0xd9,
+	0x97, 0x3f, 0xeb, 0x99, 0x9d, 0x9f, 0xf2, 0x70, 0xab, 0xc1, 0x2c, 0xf4, 0x1d, 0x94, 0xe6, 0x3e,
+	0xbc, 0xdb, 0xf3, 0x27, 0x9a, 0xf2, 0x9e, 0x68, 0x9f, 0xde, 0x08, 0x3e, 0x76, 0x8d, 0x86, 0x80,
+	0xe6, 0x3c, 0x3d, 0x1f, 0xa7, 0x36, 0x9b, 0x05, 0x6b, 0x4f, 0x6f, 0x00, 0x96, 0xbc, 0x0c, 0x1e,
+	0xcc, 0x3e, 0x0d, 0x4f, 0x52, 0x3b, 0xcd, 0x60, 0xb5, 0x9d, 0xc5, 0xb1, 0x92, 0xd4, 0x87, 0xe2,
+	0xcc, 0x63, 0xf0, 0x51, 0xfa, 0xdc, 0x12, 0x50, 0xad, 0xb6, 0x30, 0x74, 0x92, 0x71, 0x66, 0xbb,
+	0xa7, 0x33, 0x26, 0xa1, 0x5a, 0x6d, 0x61, 0xe8, 0x24, 0xe3, 0xcc, 0x3a, 0x4e, 0x67, 0x4c, 0x42,
+	0xb5, 0xda, 0xc2, 0x50, 0xc9, 0xf8, 0x52, 0x01, 0x35, 0x75, 0x33, 0x5e, 0xe3, 0x20, 0xa5, 0x44,
+	0xfb, 0xfc, 0xc6, 0x25, 0x52, 0x8a, 0x05, 0x77, 0xa7, 0x97, 0xe1, 0x87, 0xd7, 0xde, 0x4d, 0x89,
+	0xd3, 0x2a, 0x8b, 0xe1, 0x24, 0x91, 0x09, 0xef, 0x4c, 0x6d, 0xaa, 0xad, 0xd4, 0xfa, 0x49, 0x98,
+	0xb6, 0xbd, 0x10, 0x6c, 0xcc, 0xb2, 0x77, 0xf0, 0xc7, 0x85, 0xae, 0xbc, 0xba, 0xd0, 0x95, 0x7f,
+	0x2e, 0x74, 0xe5, 0x87, 0x4b, 0x3d, 0xf3, 0xea, 0x52, 0xcf, 0xfc, 0x7d, 0xa9, 0x67, 0xbe, 0xfe,
+	0xc4, 0xb2, 0x79, 0x6f, 0xd0, 0xa9, 0x74, 0xa9, 0x5b, 0x7d, 0xfe, 0xe2, 0xb4, 0x7e, 0x48, 0xf8,
+	0xb7, 0x34, 0xe8, 0x57, 0xbb, 0x3d, 0x6c, 0x7b, 0xd5, 0x33, 0xf9, 0x1f, 0x03, 0x1f, 0xf9, 0x84,
+	0x75, 0x72, 0xe2, 0xcf, 0xfc, 0xa7, 0xff, 0x0e, 0x00, 0xfa, 0x70, 0xd4, 0x4b, 0x4e, 0x0c, 0x00,
+	0x00,
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SubmitBundleProposal ...
+	SubmitBundleProposal(ctx context.Context, in *MsgSubmitBundleProposal, opts ...grpc.CallOption) (*MsgSubmitBundleProposalResponse, error)
+	// VoteBundleProposal ...
+	VoteBundleProposal(ctx context.Context, in *MsgVoteBundleProposal, opts ...grpc.CallOption) (*MsgVoteBundleProposalResponse, error)
+	// ClaimUploaderRole ...
+	ClaimUploaderRole(ctx context.Context, in *MsgClaimUploaderRole, opts ...grpc.CallOption) (*MsgClaimUploaderRoleResponse, error)
+	// SkipUploaderRole ...
+	SkipUploaderRole(ctx context.Context, in *MsgSkipUploaderRole, opts ...grpc.CallOption) (*MsgSkipUploaderRoleResponse, error)
+	// CommitBundleVote ...
+	CommitBundleVote(ctx context.Context, in *MsgCommitBundleVote, opts ...grpc.CallOption) (*MsgCommitBundleVoteResponse, error)
+	// RevealBundleVote ...
+	RevealBundleVote(ctx context.Context, in *MsgRevealBundleVote, opts ...grpc.CallOption) (*MsgRevealBundleVoteResponse, error)
+	// ChallengeFinalizedBundle ...
+	ChallengeFinalizedBundle(ctx context.Context, in *MsgChallengeFinalizedBundle, opts ...grpc.CallOption) (*MsgChallengeFinalizedBundleResponse, error)
+	// VoteChallenge ...
+	VoteChallenge(ctx context.Context, in *MsgVoteChallenge, opts ...grpc.CallOption) (*MsgVoteChallengeResponse, error)
+	// UpdateParams defines a governance operation for updating the x/bundles module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
-func (m *MsgSubmitBundleProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DataSize != 0 {
-		n += 1 + sovTx(uint64(m.DataSize))
-	}
-	l = len(m.DataHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FromIndex != 0 {
-		n += 1 + sovTx(uint64(m.FromIndex))
-	}
-	if m.BundleSize != 0 {
-		n += 1 + sovTx(uint64(m.BundleSize))
-	}
-	l = len(m.FromKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BundleSummary)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) SubmitBundleProposal(ctx context.Context, in *MsgSubmitBundleProposal, opts ...grpc.CallOption) (*MsgSubmitBundleProposalResponse, error) {
+	out := new(MsgSubmitBundleProposalResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/SubmitBundleProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitBundleProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) VoteBundleProposal(ctx context.Context, in *MsgVoteBundleProposal, opts ...grpc.CallOption) (*MsgVoteBundleProposalResponse, error) {
+	out := new(MsgVoteBundleProposalResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/VoteBundleProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgVoteBundleProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Vote != 0 {
-		n += 1 + sovTx(uint64(m.Vote))
+func (c *msgClient) ClaimUploaderRole(ctx context.Context, in *MsgClaimUploaderRole, opts ...grpc.CallOption) (*MsgClaimUploaderRoleResponse, error) {
+	out := new(MsgClaimUploaderRoleResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/ClaimUploaderRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgVoteBundleProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SkipUploaderRole(ctx context.Context, in *MsgSkipUploaderRole, opts ...grpc.CallOption) (*MsgSkipUploaderRoleResponse, error) {
+	out := new(MsgSkipUploaderRoleResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/SkipUploaderRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimUploaderRole) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) CommitBundleVote(ctx context.Context, in *MsgCommitBundleVote, opts ...grpc.CallOption) (*MsgCommitBundleVoteResponse, error) {
+	out := new(MsgCommitBundleVoteResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/CommitBundleVote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RevealBundleVote(ctx context.Context, in *MsgRevealBundleVote, opts ...grpc.CallOption) (*MsgRevealBundleVoteResponse, error) {
+	out := new(MsgRevealBundleVoteResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/RevealBundleVote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	return out, nil
+}
+
+func (c *msgClient) ChallengeFinalizedBundle(ctx context.Context, in *MsgChallengeFinalizedBundle, opts ...grpc.CallOption) (*MsgChallengeFinalizedBundleResponse, error) {
+	out := new(MsgChallengeFinalizedBundleResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/ChallengeFinalizedBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimUploaderRoleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) VoteChallenge(ctx context.Context, in *MsgVoteChallenge, opts ...grpc.CallOption) (*MsgVoteChallengeResponse, error) {
+	out := new(MsgVoteChallengeResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/VoteChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSkipUploaderRole) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SubmitBundleProposal ...
+	SubmitBundleProposal(context.Context, *MsgSubmitBundleProposal) (*MsgSubmitBundleProposalResponse, error)
+	// VoteBundleProposal ...
+	VoteBundleProposal(context.Context, *MsgVoteBundleProposal) (*MsgVoteBundleProposalResponse, error)
+	// ClaimUploaderRole ...
+	ClaimUploaderRole(context.Context, *MsgClaimUploaderRole) (*MsgClaimUploaderRoleResponse, error)
+	// SkipUploaderRole ...
+	SkipUploaderRole(context.Context, *MsgSkipUploaderRole) (*MsgSkipUploaderRoleResponse, error)
+	// CommitBundleVote ...
+	CommitBundleVote(context.Context, *MsgCommitBundleVote) (*MsgCommitBundleVoteResponse, error)
+	// RevealBundleVote ...
+	RevealBundleVote(context.Context, *MsgRevealBundleVote) (*MsgRevealBundleVoteResponse, error)
+	// ChallengeFinalizedBundle ...
+	ChallengeFinalizedBundle(context.Context, *MsgChallengeFinalizedBundle) (*MsgChallengeFinalizedBundleResponse, error)
+	// VoteChallenge ...
+	VoteChallenge(context.Context, *MsgVoteChallenge) (*MsgVoteChallengeResponse, error)
+	// UpdateParams defines a governance operation for updating the x/bundles module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SubmitBundleProposal(ctx context.Context, req *MsgSubmitBundleProposal) (*MsgSubmitBundleProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBundleProposal not implemented")
+}
+func (*UnimplementedMsgServer) VoteBundleProposal(ctx context.Context, req *MsgVoteBundleProposal) (*MsgVoteBundleProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteBundleProposal not implemented")
+}
+func (*UnimplementedMsgServer) ClaimUploaderRole(ctx context.Context, req *MsgClaimUploaderRole) (*MsgClaimUploaderRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimUploaderRole not implemented")
+}
+func (*UnimplementedMsgServer) SkipUploaderRole(ctx context.Context, req *MsgSkipUploaderRole) (*MsgSkipUploaderRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipUploaderRole not implemented")
+}
+func (*UnimplementedMsgServer) CommitBundleVote(ctx context.Context, req *MsgCommitBundleVote) (*MsgCommitBundleVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBundleVote not implemented")
+}
+func (*UnimplementedMsgServer) RevealBundleVote(ctx context.Context, req *MsgRevealBundleVote) (*MsgRevealBundleVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBundleVote not implemented")
+}
+func (*UnimplementedMsgServer) ChallengeFinalizedBundle(ctx context.Context, req *MsgChallengeFinalizedBundle) (*MsgChallengeFinalizedBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengeFinalizedBundle not implemented")
+}
+func (*UnimplementedMsgServer) VoteChallenge(ctx context.Context, req *MsgVoteChallenge) (*MsgVoteChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteChallenge not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitBundleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBundleProposal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBundleProposal(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/SubmitBundleProposal",
 	}
-	if m.FromIndex != 0 {
-		n += 1 + sovTx(uint64(m.FromIndex))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBundleProposal(ctx, req.(*MsgSubmitBundleProposal))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSkipUploaderRoleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_VoteBundleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteBundleProposal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).VoteBundleProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/VoteBundleProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteBundleProposal(ctx, req.(*MsgVoteBundleProposal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClaimUploaderRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimUploaderRole)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimUploaderRole(ctx, in)
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/ClaimUploaderRole",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimUploaderRole(ctx, req.(*MsgClaimUploaderRole))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SkipUploaderRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSkipUploaderRole)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SkipUploaderRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/SkipUploaderRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SkipUploaderRole(ctx, req.(*MsgSkipUploaderRole))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Msg_CommitBundleVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitBundleVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitBundleVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/CommitBundleVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitBundleVote(ctx, req.(*MsgCommitBundleVote))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Msg_RevealBundleVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBundleVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBundleVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/RevealBundleVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBundleVote(ctx, req.(*MsgRevealBundleVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChallengeFinalizedBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChallengeFinalizedBundle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChallengeFinalizedBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/ChallengeFinalizedBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChallengeFinalizedBundle(ctx, req.(*MsgChallengeFinalizedBundle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteChallenge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/VoteChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteChallenge(ctx, req.(*MsgVoteChallenge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kyve.bundles.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitBundleProposal",
+			Handler:    _Msg_SubmitBundleProposal_Handler,
+		},
+		{
+			MethodName: "VoteBundleProposal",
+			Handler:    _Msg_VoteBundleProposal_Handler,
+		},
+		{
+			MethodName: "ClaimUploaderRole",
+			Handler:    _Msg_ClaimUploaderRole_Handler,
+		},
+		{
+			MethodName: "SkipUploaderRole",
+			Handler:    _Msg_SkipUploaderRole_Handler,
+		},
+		{
+			MethodName: "CommitBundleVote",
+			Handler:    _Msg_CommitBundleVote_Handler,
+		},
+		{
+			MethodName: "RevealBundleVote",
+			Handler:    _Msg_RevealBundleVote_Handler,
+		},
+		{
+			MethodName: "ChallengeFinalizedBundle",
+			Handler:    _Msg_ChallengeFinalizedBundle_Handler,
+		},
+		{
+			MethodName: "VoteChallenge",
+			Handler:    _Msg_VoteChallenge_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyve/bundles/v1beta1/tx.proto",
+}
+
+func (m *MsgSubmitBundleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBundleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BundleSummary) > 0 {
+		i -= len(m.BundleSummary)
+		copy(dAtA[i:], m.BundleSummary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BundleSummary)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ToKey) > 0 {
+		i -= len(m.ToKey)
+		copy(dAtA[i:], m.ToKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToKey)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.FromKey) > 0 {
+		i -= len(m.FromKey)
+		copy(dAtA[i:], m.FromKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BundleSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BundleSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FromIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FromIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DataHash) > 0 {
+		i -= len(m.DataHash)
+		copy(dAtA[i:], m.DataHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DataHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DataSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DataSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBundleProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBundleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBundleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteBundleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteBundleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteBundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vote != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Vote))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteBundleProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteBundleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteBundleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimUploaderRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimUploaderRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimUploaderRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimUploaderRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimUploaderRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimUploaderRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSkipUploaderRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSkipUploaderRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSkipUploaderRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FromIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSkipUploaderRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSkipUploaderRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSkipUploaderRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBundleVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBundleVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBundleVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBundleVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBundleVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBundleVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBundleVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Vote != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Vote))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBundleVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBundleVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBundleVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChallengeFinalizedBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChallengeFinalizedBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChallengeFinalizedBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BundleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChallengeFinalizedBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChallengeFinalizedBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChallengeFinalizedBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vote != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Vote))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BundleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitBundleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DataSize != 0 {
+		n += 1 + sovTx(uint64(m.DataSize))
+	}
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FromIndex != 0 {
+		n += 1 + sovTx(uint64(m.FromIndex))
+	}
+	if m.BundleSize != 0 {
+		n += 1 + sovTx(uint64(m.BundleSize))
+	}
+	l = len(m.FromKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BundleSummary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBundleProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteBundleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Vote != 0 {
+		n += 1 + sovTx(uint64(m.Vote))
+	}
+	return n
+}
+
+func (m *MsgVoteBundleProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimUploaderRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgClaimUploaderRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSkipUploaderRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.FromIndex != 0 {
+		n += 1 + sovTx(uint64(m.FromIndex))
+	}
+	return n
+}
+
+func (m *MsgSkipUploaderRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitBundleVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitBundleVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealBundleVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Vote != 0 {
+		n += 1 + sovTx(uint64(m.Vote))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealBundleVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChallengeFinalizedBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovTx(uint64(m.BundleId))
+	}
+	return n
+}
+
+func (m *MsgChallengeFinalizedBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovTx(uint64(m.BundleId))
+	}
+	if m.Vote != 0 {
+		n += 1 + sovTx(uint64(m.Vote))
+	}
+	return n
+}
+
+func (m *MsgVoteChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBundleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSize", wireType)
+			}
+			m.DataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromIndex", wireType)
+			}
+			m.FromIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleSize", wireType)
+			}
+			m.BundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleSummary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleSummary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBundleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			m.Vote = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vote |= VoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBundleProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBundleProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUploaderRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSkipUploaderRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSkipUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromIndex", wireType)
+			}
+			m.FromIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSkipUploaderRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitBundleVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1570,10 +3670,10 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitBundleVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitBundleVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1692,161 +3792,8 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 			m.StorageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSize", wireType)
-			}
-			m.DataSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromIndex", wireType)
-			}
-			m.FromIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FromIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleSize", wireType)
-			}
-			m.BundleSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BundleSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FromKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleSummary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1874,7 +3821,7 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleSummary = string(dAtA[iNdEx:postIndex])
+			m.Commitment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1897,7 +3844,7 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitBundleVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1920,10 +3867,10 @@ func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitBundleVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitBundleVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1947,7 +3894,7 @@ func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealBundleVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1970,10 +3917,10 @@ func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBundleProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealBundleVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealBundleVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2110,6 +4057,38 @@ func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2131,7 +4110,7 @@ func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBundleProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealBundleVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2154,10 +4133,10 @@ func (m *MsgVoteBundleProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBundleProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealBundleVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealBundleVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2181,7 +4160,7 @@ func (m *MsgVoteBundleProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
+func (m *MsgChallengeFinalizedBundle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2204,10 +4183,10 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUploaderRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChallengeFinalizedBundle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChallengeFinalizedBundle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2243,10 +4222,10 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2256,29 +4235,16 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
 			}
-			m.PoolId = 0
+			m.BundleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2288,7 +4254,7 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.BundleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2314,7 +4280,7 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChallengeFinalizedBundleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2337,10 +4303,10 @@ func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChallengeFinalizedBundleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChallengeFinalizedBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2364,7 +4330,7 @@ func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteChallenge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2387,10 +4353,10 @@ func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSkipUploaderRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteChallenge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSkipUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2478,9 +4444,9 @@ func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
 			}
-			m.FromIndex = 0
+			m.BundleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2490,7 +4456,26 @@ func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromIndex |= uint64(b&0x7F) << shift
+				m.BundleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			m.Vote = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vote |= VoteType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2516,7 +4501,7 @@ func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSkipUploaderRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2539,10 +4524,10 @@ func (m *MsgSkipUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
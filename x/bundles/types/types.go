@@ -1,5 +1,13 @@
 package types
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 type VoteDistribution struct {
 	// valid ...
 	Valid uint64
@@ -9,6 +17,12 @@ type VoteDistribution struct {
 	Abstain uint64
 	// total ...
 	Total uint64
+	// raw_valid is the delegation which voted valid before the
+	// voting power curve was applied
+	RawValid uint64
+	// raw_total is the total delegation before the voting power
+	// curve was applied
+	RawTotal uint64
 	// status ...
 	Status BundleStatus
 }
@@ -16,12 +30,22 @@ type VoteDistribution struct {
 type BundleReward struct {
 	// treasury ...
 	Treasury uint64
+	// insurance ...
+	Insurance uint64
 	// uploader ...
 	Uploader uint64
 	// delegation ...
 	Delegation uint64
 	// total ...
 	Total uint64
+	// treasury_coins ...
+	TreasuryCoins sdk.Coins
+	// uploader_coins ...
+	UploaderCoins sdk.Coins
+	// delegation_coins ...
+	DelegationCoins sdk.Coins
+	// total_coins ...
+	TotalCoins sdk.Coins
 }
 
 // GetMap converts to array to a go map which return the upgrade-height for each version.
@@ -35,3 +59,10 @@ func (bundleVersionMap BundleVersionMap) GetMap() (versionMap map[int32]uint64)
 	}
 	return
 }
+
+// GetVoteCommitment returns the hex encoded sha256 hash of "<vote>|<salt>|<staker>"
+// which stakers of commit-reveal pools have to commit to before revealing their vote.
+func GetVoteCommitment(vote VoteType, salt string, staker string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d|%s|%s", vote, salt, staker)))
+	return hex.EncodeToString(hash[:])
+}
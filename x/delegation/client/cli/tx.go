@@ -21,8 +21,13 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdDelegate())
 	cmd.AddCommand(CmdUndelegate())
+	cmd.AddCommand(CmdCancelUndelegation())
 	cmd.AddCommand(CmdRedelegate())
 	cmd.AddCommand(CmdWithdrawRewards())
+	cmd.AddCommand(CmdWithdrawAllRewards())
+	cmd.AddCommand(CmdSetAutoCompound())
+	cmd.AddCommand(CmdSetWithdrawAddress())
+	cmd.AddCommand(CmdSetInsuranceOptIn())
 
 	return cmd
 }
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set_auto_compound [staker] [enabled]",
+		Short: "Enable or disable the auto-compounding of the rewards from staker",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argEnabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetAutoCompound{
+				Creator: clientCtx.GetFromAddress().String(),
+				Staker:  args[0],
+				Enabled: argEnabled,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetInsuranceOptIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set_insurance_opt_in [enabled]",
+		Short: "Opt in or out of the reimbursement of the delegators by the insurance fund",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argEnabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetInsuranceOptIn{
+				Creator: clientCtx.GetFromAddress().String(),
+				Enabled: argEnabled,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -37,6 +37,26 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetRedelegationCooldown(ctx, entry)
 	}
 
+	for _, entry := range genState.SlashRecordList {
+		k.SetSlashRecord(ctx, entry)
+	}
+
+	k.SetSlashRecordCount(ctx, genState.SlashRecordCount)
+
+	k.SetInsuranceFund(ctx, genState.InsuranceFund)
+
+	for _, entry := range genState.AutoCompoundList {
+		k.SetAutoCompoundEntry(ctx, entry)
+	}
+
+	for _, entry := range genState.WithdrawAddressList {
+		k.SetWithdrawAddressEntry(ctx, entry)
+	}
+
+	for _, entry := range genState.InsuranceOptInList {
+		k.SetInsuranceOptInEntry(ctx, entry)
+	}
+
 	k.InitMemStore(ctx)
 }
 
@@ -60,5 +80,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.RedelegationCooldownList = k.GetAllRedelegationCooldownEntries(ctx)
 
+	genesis.SlashRecordList = k.GetAllSlashRecords(ctx)
+
+	genesis.SlashRecordCount = k.GetSlashRecordCount(ctx)
+
+	genesis.InsuranceFund = k.GetInsuranceFund(ctx)
+
+	genesis.AutoCompoundList = k.GetAllAutoCompounds(ctx)
+
+	genesis.WithdrawAddressList = k.GetAllWithdrawAddresses(ctx)
+
+	genesis.InsuranceOptInList = k.GetAllInsuranceOptIns(ctx)
+
 	return genesis
 }
@@ -69,9 +69,37 @@ func (k Keeper) PayoutRewards(ctx sdk.Context, staker string, amount uint64, pay
 	return nil
 }
 
+// PayoutCoinRewards transfers non-native `coins` from the `payerModuleName`-module to the delegation module.
+// It then awards these coins internally to all delegators of staker `staker`.
+// Delegators receive these rewards together with their $KYVE rewards if they call the `withdraw`-transaction.
+// If the staker has no delegators or the module to module transfer fails the method fails and
+// returns the error.
+func (k Keeper) PayoutCoinRewards(ctx sdk.Context, staker string, coins sdk.Coins, payerModuleName string) error {
+	// Assert there are coins
+	if coins.IsZero() {
+		return nil
+	}
+
+	// Assert there are delegators
+	if !k.DoesDelegationDataExist(ctx, staker) {
+		return errors.Wrapf(sdkErrors.ErrLogic, "Staker has no delegators which can be paid out.")
+	}
+
+	// Add coins to the rewards pool
+	k.AddCoinsToDelegationRewards(ctx, staker, coins)
+
+	// Transfer coins to the delegation module
+	if err := util.TransferCoinsFromModuleToModule(k.bankKeeper, ctx, payerModuleName, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // SlashDelegators reduces the delegation of all delegators of `staker` by fraction
-// and transfers the amount to the Treasury.
-func (k Keeper) SlashDelegators(ctx sdk.Context, poolId uint64, staker string, slashType types.SlashType) {
+// and transfers the amount to the Treasury. Every slash is stored as a slash record
+// together with the id of the bundle in the given pool the slash is related to.
+func (k Keeper) SlashDelegators(ctx sdk.Context, poolId uint64, staker string, slashType types.SlashType, bundleId uint64) {
 	// Only slash if staker has delegators
 	if k.DoesDelegationDataExist(ctx, staker) {
 
@@ -80,13 +108,33 @@ func (k Keeper) SlashDelegators(ctx sdk.Context, poolId uint64, staker string, s
 		defer k.SetStakerIndex(ctx, staker)
 
 		// Perform F1-slash and get slashed amount in ukyve
-		slashedAmount := k.f1Slash(ctx, staker, k.getSlashFraction(ctx, slashType))
+		fraction := k.getSlashFraction(ctx, poolId, slashType)
+		slashedAmount := k.f1Slash(ctx, staker, fraction)
 
 		// Transfer tokens to the Treasury
 		if err := util.TransferFromModuleToTreasury(k.accountKeeper, k.distrKeeper, ctx, types.ModuleName, slashedAmount); err != nil {
 			util.PanicHalt(k.upgradeKeeper, ctx, "Not enough tokens in module")
 		}
 
+		// Reimburse delegators from the insurance fund for non-malicious downtime
+		// if the staker opted in to the insurance
+		insurancePayout := uint64(0)
+		if slashType == types.SLASH_TYPE_TIMEOUT && k.IsInsuranceOptedIn(ctx, staker) {
+			insurancePayout = k.claimInsurance(ctx, poolId, staker, slashedAmount)
+		}
+
+		// Store slash record for the slash history of the staker and pool
+		k.AppendSlashRecord(ctx, types.SlashRecord{
+			Staker:          staker,
+			PoolId:          poolId,
+			SlashType:       slashType,
+			Fraction:        fraction,
+			Amount:          slashedAmount,
+			Height:          uint64(ctx.BlockHeight()),
+			BundleId:        bundleId,
+			InsurancePayout: insurancePayout,
+		})
+
 		// Emit slash event
 		_ = ctx.EventManager().EmitTypedEvent(&types.EventSlash{
 			PoolId:    poolId,
@@ -97,8 +145,34 @@ func (k Keeper) SlashDelegators(ctx sdk.Context, poolId uint64, staker string, s
 	}
 }
 
+// FundInsurance transfers `amount` from the `payerModuleName`-module to the delegation
+// module and adds it to the insurance fund, which reimburses delegators after
+// timeout slashes.
+func (k Keeper) FundInsurance(ctx sdk.Context, amount uint64, payerModuleName string) error {
+	if amount == 0 {
+		return nil
+	}
+
+	k.depositToInsuranceFund(ctx, amount)
+
+	// Transfer tokens to the delegation module
+	if err := util.TransferFromModuleToModule(k.bankKeeper, ctx, payerModuleName, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetOutstandingRewards calculates the current rewards a delegator has collected for
 // the given staker.
 func (k Keeper) GetOutstandingRewards(ctx sdk.Context, staker string, delegator string) uint64 {
-	return k.f1GetOutstandingRewards(ctx, staker, delegator)
+	rewards, _ := k.f1GetOutstandingRewards(ctx, staker, delegator)
+	return rewards
+}
+
+// GetOutstandingCoinRewards calculates the current rewards in non-native denoms
+// a delegator has collected for the given staker.
+func (k Keeper) GetOutstandingCoinRewards(ctx sdk.Context, staker string, delegator string) sdk.Coins {
+	_, coinRewards := k.f1GetOutstandingRewards(ctx, staker, delegator)
+	return coinRewards
 }
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoCompoundEntry enables auto-compounding for the delegation of `delegator` to `staker`
+func (k Keeper) SetAutoCompoundEntry(ctx sdk.Context, autoCompound types.AutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+	b := k.cdc.MustMarshal(&autoCompound)
+	store.Set(types.AutoCompoundKey(
+		autoCompound.Staker,
+		autoCompound.Delegator,
+	), b)
+}
+
+// IsAutoCompoundEnabled checks if the delegation of `delegator` to `staker` gets compounded automatically
+func (k Keeper) IsAutoCompoundEnabled(ctx sdk.Context, stakerAddress string, delegatorAddress string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+	return store.Has(types.AutoCompoundKey(stakerAddress, delegatorAddress))
+}
+
+// RemoveAutoCompoundEntry disables auto-compounding for the delegation of `delegator` to `staker`
+func (k Keeper) RemoveAutoCompoundEntry(ctx sdk.Context, stakerAddress string, delegatorAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+	store.Delete(types.AutoCompoundKey(stakerAddress, delegatorAddress))
+}
+
+// GetAutoCompoundDelegatorsOfStaker returns all delegators of the given staker
+// which enabled auto-compounding
+func (k Keeper) GetAutoCompoundDelegatorsOfStaker(ctx sdk.Context, stakerAddress string) (delegators []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.AutoCompoundKeyPrefix, util.GetByteKey(stakerAddress)...))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		delegators = append(delegators, val.Delegator)
+	}
+	return
+}
+
+// GetAutoCompoundsFrom returns at most `limit` delegations with auto-compounding starting
+// at the given key. The returned key points to the next delegation and is nil if the
+// end of the store was reached.
+func (k Keeper) GetAutoCompoundsFrom(ctx sdk.Context, start []byte, limit uint64) (list []types.AutoCompound, next []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+	iterator := store.Iterator(start, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(list)) >= limit {
+			return list, iterator.Key()
+		}
+
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list, nil
+}
+
+// SetAutoCompoundCursor stores the key of the next delegation which gets auto-compounded
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if cursor == nil {
+		store.Delete(types.AutoCompoundCursorKey)
+	} else {
+		store.Set(types.AutoCompoundCursorKey, cursor)
+	}
+}
+
+// GetAutoCompoundCursor returns the key of the next delegation which gets auto-compounded
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.AutoCompoundCursorKey)
+}
+
+// GetAllAutoCompounds returns all delegations with auto-compounding
+func (k Keeper) GetAllAutoCompounds(ctx sdk.Context) (list []types.AutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
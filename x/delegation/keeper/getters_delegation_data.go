@@ -21,6 +21,16 @@ func (k Keeper) AddAmountToDelegationRewards(ctx sdk.Context, stakerAddress stri
 	}
 }
 
+// AddCoinsToDelegationRewards adds the specified non-native coins to the current delegationData object.
+// This is needed by the F1-algorithm to calculate to outstanding coin rewards
+func (k Keeper) AddCoinsToDelegationRewards(ctx sdk.Context, stakerAddress string, coins sdk.Coins) {
+	delegationData, found := k.GetDelegationData(ctx, stakerAddress)
+	if found {
+		delegationData.CurrentCoinRewards = delegationData.CurrentCoinRewards.Add(coins...)
+		k.SetDelegationData(ctx, delegationData)
+	}
+}
+
 // SetDelegationData set a specific delegationPoolData in the store from its index
 func (k Keeper) SetDelegationData(ctx sdk.Context, delegationData types.DelegationData) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationDataKeyPrefix)
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The `InsuranceFund` keeps track of the part of the delegation module
+// balance which belongs to the insurance fund. It is funded by a share of
+// the network fee and reimburses delegators after timeout slashes.
+
+// GetInsuranceFund returns the current state of the insurance fund
+func (k Keeper) GetInsuranceFund(ctx sdk.Context) (insuranceFund types.InsuranceFund) {
+	b := ctx.KVStore(k.storeKey).Get(types.InsuranceFundKey)
+	if b == nil {
+		return insuranceFund
+	}
+
+	k.cdc.MustUnmarshal(b, &insuranceFund)
+	return
+}
+
+// SetInsuranceFund saves the state of the insurance fund
+func (k Keeper) SetInsuranceFund(ctx sdk.Context, insuranceFund types.InsuranceFund) {
+	b := k.cdc.MustMarshal(&insuranceFund)
+	ctx.KVStore(k.storeKey).Set(types.InsuranceFundKey, b)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetInsuranceOptInEntry opts the given staker in to the insurance
+func (k Keeper) SetInsuranceOptInEntry(ctx sdk.Context, insuranceOptIn types.InsuranceOptIn) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InsuranceOptInKeyPrefix)
+	b := k.cdc.MustMarshal(&insuranceOptIn)
+	store.Set(types.InsuranceOptInKey(insuranceOptIn.Staker), b)
+}
+
+// IsInsuranceOptedIn checks if the delegators of `staker` get reimbursed by the insurance fund
+func (k Keeper) IsInsuranceOptedIn(ctx sdk.Context, stakerAddress string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InsuranceOptInKeyPrefix)
+	return store.Has(types.InsuranceOptInKey(stakerAddress))
+}
+
+// RemoveInsuranceOptInEntry opts the given staker out of the insurance
+func (k Keeper) RemoveInsuranceOptInEntry(ctx sdk.Context, stakerAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InsuranceOptInKeyPrefix)
+	store.Delete(types.InsuranceOptInKey(stakerAddress))
+}
+
+// GetAllInsuranceOptIns returns all stakers which opted in to the insurance
+func (k Keeper) GetAllInsuranceOptIns(ctx sdk.Context) (list []types.InsuranceOptIn) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InsuranceOptInKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.InsuranceOptIn
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
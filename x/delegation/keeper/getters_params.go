@@ -48,15 +48,53 @@ func (k Keeper) GetTimeoutSlash(ctx sdk.Context) (res sdk.Dec) {
 	return k.GetParams(ctx).TimeoutSlash
 }
 
-func (k Keeper) getSlashFraction(ctx sdk.Context, slashType types.SlashType) (slashAmountRatio sdk.Dec) {
-	// Retrieve slash fraction from params
+// GetInsuranceFundShare returns the InsuranceFundShare param.
+// Params stored before the insurance fund existed have no share, which is zero.
+func (k Keeper) GetInsuranceFundShare(ctx sdk.Context) (res sdk.Dec) {
+	res = k.GetParams(ctx).InsuranceFundShare
+	if res.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return res
+}
+
+// GetInsuranceMaxPayout returns the InsuranceMaxPayout param
+func (k Keeper) GetInsuranceMaxPayout(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).InsuranceMaxPayout
+}
+
+// GetMaxAutoCompoundsPerBlock returns the MaxAutoCompoundsPerBlock param
+func (k Keeper) GetMaxAutoCompoundsPerBlock(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MaxAutoCompoundsPerBlock
+}
+
+// GetMinAutoCompoundDelegation returns the MinAutoCompoundDelegation param
+func (k Keeper) GetMinAutoCompoundDelegation(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MinAutoCompoundDelegation
+}
+
+// getSlashFraction returns the slash fraction of the given slash type. If the pool
+// overrides the slash its value is used, even if it is zero, otherwise the global param.
+func (k Keeper) getSlashFraction(ctx sdk.Context, poolId uint64, slashType types.SlashType) (slashAmountRatio sdk.Dec) {
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+
+	// Retrieve slash fraction from pool or params
 	switch slashType {
 	case types.SLASH_TYPE_TIMEOUT:
 		slashAmountRatio = k.GetTimeoutSlash(ctx)
+		if pool.TimeoutSlash != nil {
+			slashAmountRatio = *pool.TimeoutSlash
+		}
 	case types.SLASH_TYPE_VOTE:
 		slashAmountRatio = k.GetVoteSlash(ctx)
+		if pool.VoteSlash != nil {
+			slashAmountRatio = *pool.VoteSlash
+		}
 	case types.SLASH_TYPE_UPLOAD:
 		slashAmountRatio = k.GetUploadSlash(ctx)
+		if pool.UploadSlash != nil {
+			slashAmountRatio = *pool.UploadSlash
+		}
 	}
 	return
 }
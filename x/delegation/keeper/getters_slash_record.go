@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The `SlashRecord` entry stores the full context of every slash that happened
+// to a staker. It is not needed by the F1-Fee algorithm, but allows delegators
+// to query the slash history of stakers and pools.
+
+// GetSlashRecordCount returns the total number of slash records
+func (k Keeper) GetSlashRecordCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.SlashRecordCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetSlashRecordCount sets the total number of slash records
+func (k Keeper) SetSlashRecordCount(ctx sdk.Context, count uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	ctx.KVStore(k.storeKey).Set(types.SlashRecordCountKey, bz)
+}
+
+// AppendSlashRecord stores the given slash record with a new id and updates the count
+func (k Keeper) AppendSlashRecord(ctx sdk.Context, slashRecord types.SlashRecord) uint64 {
+	count := k.GetSlashRecordCount(ctx)
+	slashRecord.Id = count
+
+	k.SetSlashRecord(ctx, slashRecord)
+	k.SetSlashRecordCount(ctx, count+1)
+
+	return count
+}
+
+// SetSlashRecord stores the given slash record together with its staker and pool index
+func (k Keeper) SetSlashRecord(ctx sdk.Context, slashRecord types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefix)
+	b := k.cdc.MustMarshal(&slashRecord)
+	store.Set(types.SlashRecordKey(slashRecord.Id), b)
+
+	indexStore2 := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefixIndex2)
+	indexStore2.Set(types.SlashRecordKeyIndex2(
+		slashRecord.Staker,
+		slashRecord.Id,
+	), []byte{1})
+
+	indexStore3 := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefixIndex3)
+	indexStore3.Set(types.SlashRecordKeyIndex3(
+		slashRecord.PoolId,
+		slashRecord.Id,
+	), []byte{1})
+}
+
+// GetSlashRecord returns the slash record with the given id
+func (k Keeper) GetSlashRecord(ctx sdk.Context, id uint64) (val types.SlashRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefix)
+
+	b := store.Get(types.SlashRecordKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSlashRecords returns all slash records (of all stakers)
+func (k Keeper) GetAllSlashRecords(ctx sdk.Context) (list []types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all delegation invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegation-data", DelegationDataInvariant(k))
+}
+
+// AllInvariants runs all invariants of the delegation module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := DelegationDataInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ModuleAccountInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the delegation module account covers
+// all delegations, the insurance fund and all outstanding F1 rewards in every denom. Pending undelegations are
+// still part of the total delegation of a staker until the queue entry
+// is processed, so they are covered as well.
+// Due to rounding the module balance is allowed to be slightly higher.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalDelegation := uint64(0)
+		for _, delegationData := range k.GetAllDelegationData(ctx) {
+			totalDelegation += delegationData.TotalDelegation
+		}
+
+		outstandingRewards := uint64(0)
+		outstandingCoinRewards := sdk.NewCoins()
+		for _, delegator := range k.GetAllDelegators(ctx) {
+			rewards, coinRewards := k.f1GetOutstandingRewards(ctx, delegator.Staker, delegator.Delegator)
+			outstandingRewards += rewards
+			outstandingCoinRewards = outstandingCoinRewards.Add(coinRewards...)
+		}
+
+		insuranceFund := k.GetInsuranceFund(ctx).Balance
+
+		moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balance := k.bankKeeper.GetBalance(ctx, moduleAddress, globalTypes.Denom).Amount.Uint64()
+		balanceCoins := k.bankKeeper.GetAllBalances(ctx, moduleAddress)
+
+		broken := balance < totalDelegation+outstandingRewards+insuranceFund || !balanceCoins.IsAllGTE(outstandingCoinRewards)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account",
+			fmt.Sprintf(
+				"\tdelegation module balance: %d\n\ttotal delegation: %d\n\toutstanding rewards: %d\n\tinsurance fund: %d\n\toutstanding coin rewards: %s\n",
+				balance, totalDelegation, outstandingRewards, insuranceFund, outstandingCoinRewards,
+			),
+		), broken
+	}
+}
+
+// DelegationDataInvariant checks that the delegation metadata of every staker
+// matches its delegators. The delegator count must be equal to the number of
+// delegators and the sum of all current delegations must not exceed the
+// total delegation. Due to rounding the total delegation is allowed to be
+// slightly higher: every current delegation is rounded down and every slash
+// and undelegation leaves less than one ukyve, which is bounded by the number
+// of F1 periods.
+func DelegationDataInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		delegatorCount := make(map[string]uint64)
+		currentDelegation := make(map[string]uint64)
+
+		for _, delegator := range k.GetAllDelegators(ctx) {
+			if !k.DoesDelegationDataExist(ctx, delegator.Staker) {
+				broken = true
+				msg += fmt.Sprintf("\tdelegator %s of staker %s has no delegation data\n", delegator.Delegator, delegator.Staker)
+				continue
+			}
+
+			delegatorCount[delegator.Staker] += 1
+			currentDelegation[delegator.Staker] += k.f1GetCurrentDelegation(ctx, delegator.Staker, delegator.Delegator)
+		}
+
+		for _, delegationData := range k.GetAllDelegationData(ctx) {
+			if delegationData.DelegatorCount != delegatorCount[delegationData.Staker] {
+				broken = true
+				msg += fmt.Sprintf(
+					"\tstaker %s: delegator count %d, actual delegators %d\n",
+					delegationData.Staker, delegationData.DelegatorCount, delegatorCount[delegationData.Staker],
+				)
+			}
+
+			if currentDelegation[delegationData.Staker] > delegationData.TotalDelegation ||
+				delegationData.TotalDelegation-currentDelegation[delegationData.Staker] > delegationData.DelegatorCount+delegationData.LatestIndexK {
+				broken = true
+				msg += fmt.Sprintf(
+					"\tstaker %s: total delegation %d, sum of delegations %d\n",
+					delegationData.Staker, delegationData.TotalDelegation, currentDelegation[delegationData.Staker],
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "delegation-data", msg), broken
+	}
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/keeper"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - invariants.go
+
+* Invariants hold for a valid state
+* Invariants hold with rounding errors after multiple slashes
+* Delegation data invariant breaks with a wrong delegator count
+* Delegation data invariant breaks with a total delegation lower than the sum of delegations
+* Delegation data invariant breaks with a total delegation far higher than the sum of delegations
+* Delegation data invariant breaks for a delegator without delegation data
+* Module account invariant breaks if the module balance does not cover all delegations
+
+*/
+
+var _ = Describe("invariants.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.BOB,
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+	})
+
+	It("Invariants hold for a valid state", func() {
+		// ACT
+		_, broken := keeper.AllInvariants(s.App().DelegationKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeFalse())
+	})
+
+	It("Invariants hold with rounding errors after multiple slashes", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.CHARLIE,
+			Staker:  i.ALICE,
+			Amount:  7,
+		})
+
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.UploadSlash = sdk.MustNewDecFromStr("0.333333333333333333")
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		for n := uint64(0); n < 5; n++ {
+			s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, n)
+		}
+
+		// ASSERT
+		_, broken := keeper.DelegationDataInvariant(s.App().DelegationKeeper)(s.Ctx())
+		Expect(broken).To(BeFalse())
+
+		s.PerformValidityChecks()
+	})
+
+	It("Delegation data invariant breaks with a wrong delegator count", func() {
+		// ARRANGE
+		delegationData, _ := s.App().DelegationKeeper.GetDelegationData(s.Ctx(), i.ALICE)
+		delegationData.DelegatorCount += 1
+		s.App().DelegationKeeper.SetDelegationData(s.Ctx(), delegationData)
+
+		// ACT
+		msg, broken := keeper.DelegationDataInvariant(s.App().DelegationKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("delegator count"))
+	})
+
+	It("Delegation data invariant breaks with a total delegation lower than the sum of delegations", func() {
+		// ARRANGE
+		delegationData, _ := s.App().DelegationKeeper.GetDelegationData(s.Ctx(), i.ALICE)
+		delegationData.TotalDelegation -= 1
+		s.App().DelegationKeeper.SetDelegationData(s.Ctx(), delegationData)
+
+		// ACT
+		msg, broken := keeper.DelegationDataInvariant(s.App().DelegationKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("sum of delegations"))
+	})
+
+	It("Delegation data invariant breaks with a total delegation far higher than the sum of delegations", func() {
+		// ARRANGE
+		delegationData, _ := s.App().DelegationKeeper.GetDelegationData(s.Ctx(), i.ALICE)
+		delegationData.TotalDelegation += 1 * i.KYVE
+		s.App().DelegationKeeper.SetDelegationData(s.Ctx(), delegationData)
+
+		// ACT
+		msg, broken := keeper.DelegationDataInvariant(s.App().DelegationKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("sum of delegations"))
+	})
+
+	It("Delegation data invariant breaks for a delegator without delegation data", func() {
+		// ARRANGE
+		s.App().DelegationKeeper.RemoveDelegationData(s.Ctx(), i.ALICE)
+
+		// ACT
+		msg, broken := keeper.DelegationDataInvariant(s.App().DelegationKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("has no delegation data"))
+	})
+
+	It("Module account invariant breaks if the module balance does not cover all delegations", func() {
+		// ARRANGE
+		err := s.App().BankKeeper.SendCoinsFromModuleToAccount(
+			s.Ctx(),
+			types.ModuleName,
+			sdk.MustAccAddressFromBech32(i.CHARLIE),
+			sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, 1)),
+		)
+		Expect(err).To(BeNil())
+
+		// ACT
+		msg, broken := keeper.ModuleAccountInvariant(s.App().DelegationKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("delegation module balance"))
+
+		_, broken = keeper.AllInvariants(s.App().DelegationKeeper)(s.Ctx())
+		Expect(broken).To(BeTrue())
+	})
+})
@@ -48,26 +48,88 @@ func (k Keeper) performUndelegation(ctx sdk.Context, stakerAddress string, deleg
 		redelegation = undelegatedAmount - amount
 		// ... create a new delegator entry with the remaining amount
 		k.f1CreateDelegator(ctx, stakerAddress, delegatorAddress, redelegation)
+	} else {
+		// the delegation is gone, therefore there is nothing to compound anymore
+		k.RemoveAutoCompoundEntry(ctx, stakerAddress, delegatorAddress)
 	}
 
 	return undelegatedAmount - redelegation
 }
 
-// performWithdrawal withdraws all pending rewards from a user and transfers it.
-// The amount is returned by the function.
+// performWithdrawal withdraws all pending rewards from a user and transfers it
+// to the withdraw address of the user. The amount is returned by the function.
 func (k Keeper) performWithdrawal(ctx sdk.Context, stakerAddress, delegatorAddress string) uint64 {
-	reward := k.f1WithdrawRewards(ctx, stakerAddress, delegatorAddress)
-	err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, delegatorAddress, reward)
+	reward, coinReward := k.f1WithdrawRewards(ctx, stakerAddress, delegatorAddress)
+	withdrawAddress := k.GetWithdrawAddress(ctx, delegatorAddress)
+
+	err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, withdrawAddress, reward)
 	if err != nil {
 		util.PanicHalt(k.upgradeKeeper, ctx, "no money left in module")
 	}
 
+	err = util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, withdrawAddress, coinReward)
+	if err != nil {
+		util.PanicHalt(k.upgradeKeeper, ctx, "no coins left in module")
+	}
+
 	// Emit withdraw event.
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventWithdrawRewards{
 		Address: delegatorAddress,
 		Staker:  stakerAddress,
 		Amount:  reward,
+		Coins:   coinReward,
 	})
 
 	return reward
 }
+
+// ProcessAutoCompounds is called in the end block and re-delegates the outstanding
+// $KYVE rewards of at most `MaxAutoCompoundsPerBlock` delegations with auto-compounding.
+// Every block continues where the previous block stopped, so that all delegations get
+// compounded periodically while the cost of a single block stays bounded.
+func (k Keeper) ProcessAutoCompounds(ctx sdk.Context) {
+	autoCompounds, next := k.GetAutoCompoundsFrom(ctx, k.GetAutoCompoundCursor(ctx), k.GetMaxAutoCompoundsPerBlock(ctx))
+
+	for _, autoCompound := range autoCompounds {
+		k.performAutoCompound(ctx, autoCompound.Staker, autoCompound.Delegator)
+	}
+
+	// Start from the beginning again once the end was reached
+	k.SetAutoCompoundCursor(ctx, next)
+}
+
+// performAutoCompound re-delegates the outstanding $KYVE rewards of the given
+// delegator to the given staker, which starts a new F1-period. Rewards in non-native
+// denoms can not be delegated and are therefore transferred to the withdraw address
+// of the delegator.
+func (k Keeper) performAutoCompound(ctx sdk.Context, stakerAddress string, delegatorAddress string) {
+	if k.GetOutstandingRewards(ctx, stakerAddress, delegatorAddress) == 0 &&
+		k.GetOutstandingCoinRewards(ctx, stakerAddress, delegatorAddress).IsZero() {
+		return
+	}
+
+	// Update in-memory staker index for efficient queries
+	k.RemoveStakerIndex(ctx, stakerAddress)
+	defer k.SetStakerIndex(ctx, stakerAddress)
+
+	reward, coinReward := k.f1WithdrawRewards(ctx, stakerAddress, delegatorAddress)
+
+	err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, k.GetWithdrawAddress(ctx, delegatorAddress), coinReward)
+	if err != nil {
+		util.PanicHalt(k.upgradeKeeper, ctx, "no coins left in module")
+	}
+
+	// The rewards are already held by the delegation module, so they only
+	// need to be added to the delegation of the delegator
+	if reward > 0 {
+		delegationAmount := k.f1RemoveDelegator(ctx, stakerAddress, delegatorAddress)
+		k.f1CreateDelegator(ctx, stakerAddress, delegatorAddress, delegationAmount+reward)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventCompoundRewards{
+		Address: delegatorAddress,
+		Staker:  stakerAddress,
+		Amount:  reward,
+		Coins:   coinReward,
+	})
+}
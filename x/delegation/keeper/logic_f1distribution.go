@@ -31,35 +31,43 @@ func (k Keeper) f1StartNewPeriod(ctx sdk.Context, staker string, delegationData
 	previousEntry, found := k.GetDelegationEntry(ctx, staker, delegationData.LatestIndexK)
 	if !found {
 		previousEntry.Value = sdk.NewDec(0)
+		previousEntry.CoinValues = sdk.NewDecCoins()
 	}
 
 	// Calculate quotient of current period
 	// If totalDelegation is zero the quotient is also zero
 	currentPeriodValue := sdk.NewDec(0)
+	currentPeriodCoinValues := sdk.NewDecCoins()
 	if delegationData.TotalDelegation != 0 {
 		decCurrentRewards := sdk.NewDec(int64(delegationData.CurrentRewards))
 		decTotalDelegation := sdk.NewDec(int64(delegationData.TotalDelegation))
 
 		// F1: $T_f / n_f$
 		currentPeriodValue = decCurrentRewards.Quo(decTotalDelegation)
+
+		// Non-native rewards are tracked the same way for every denom
+		currentPeriodCoinValues = sdk.NewDecCoinsFromCoins(delegationData.CurrentCoinRewards...).QuoDec(decTotalDelegation)
 	}
 
 	// Add previous entry to current one
 	currentPeriodValue = currentPeriodValue.Add(previousEntry.Value)
+	currentPeriodCoinValues = currentPeriodCoinValues.Add(previousEntry.CoinValues...)
 
 	// Increment index for the next period
 	indexF := delegationData.LatestIndexK + 1
 
 	// Add entry for new period to KV-Store
 	k.SetDelegationEntry(ctx, types.DelegationEntry{
-		Value:  currentPeriodValue,
-		Staker: staker,
-		KIndex: indexF,
+		Value:      currentPeriodValue,
+		Staker:     staker,
+		KIndex:     indexF,
+		CoinValues: currentPeriodCoinValues,
 	})
 
 	// Reset the rewards for the next period back to zero
 	// and update to the new index
 	delegationData.CurrentRewards = 0
+	delegationData.CurrentCoinRewards = sdk.NewCoins()
 	delegationData.LatestIndexK = indexF
 
 	if delegationData.LatestIndexWasUndelegation {
@@ -176,10 +184,11 @@ func (k Keeper) f1Slash(ctx sdk.Context, stakerAddress string, fraction sdk.Dec)
 
 // f1WithdrawRewards calculates all outstanding rewards and withdraws them from
 // the f1-logic. A new period starts.
-func (k Keeper) f1WithdrawRewards(ctx sdk.Context, stakerAddress string, delegatorAddress string) (rewards uint64) {
+// The rewards in non-native denoms are returned as coinRewards.
+func (k Keeper) f1WithdrawRewards(ctx sdk.Context, stakerAddress string, delegatorAddress string) (rewards uint64, coinRewards sdk.Coins) {
 	delegator, found := k.GetDelegator(ctx, stakerAddress, delegatorAddress)
 	if !found {
-		return 0
+		return 0, sdk.NewCoins()
 	}
 
 	// Fetch metadata
@@ -197,6 +206,7 @@ func (k Keeper) f1WithdrawRewards(ctx sdk.Context, stakerAddress string, delegat
 	// To incorporate slashing one needs to iterate all slashes and calculate the reward for every period
 	// separately and then sum it.
 	reward := sdk.NewDec(0)
+	coinReward := sdk.NewDecCoins()
 	k.f1IterateConstantDelegationPeriods(ctx, stakerAddress, delegatorAddress, delegator.KIndex, endIndex,
 		func(startIndex uint64, endIndex uint64, delegation sdk.Dec) {
 			// entry difference
@@ -205,6 +215,11 @@ func (k Keeper) f1WithdrawRewards(ctx sdk.Context, stakerAddress string, delegat
 			periodReward := difference.Mul(delegation)
 
 			reward = reward.Add(periodReward)
+
+			// same for all non-native denoms
+			coinDifference := k.f1GetCoinEntryDifference(ctx, stakerAddress, startIndex, endIndex)
+
+			coinReward = coinReward.Add(coinDifference.MulDec(delegation)...)
 		})
 
 	// Delete Delegator entry as he has no outstanding rewards anymore.
@@ -215,7 +230,9 @@ func (k Keeper) f1WithdrawRewards(ctx sdk.Context, stakerAddress string, delegat
 	delegator.InitialAmount = k.f1GetCurrentDelegation(ctx, delegator.Staker, delegator.Delegator)
 	k.SetDelegator(ctx, delegator)
 
-	return reward.TruncateInt().Uint64()
+	coinRewards, _ = coinReward.TruncateDecimal()
+
+	return reward.TruncateInt().Uint64(), coinRewards
 }
 
 // f1IterateConstantDelegationPeriods iterates all periods between minIndex and maxIndex (both inclusive)
@@ -268,11 +285,12 @@ func (k Keeper) f1GetCurrentDelegation(ctx sdk.Context, stakerAddress string, de
 }
 
 // f1GetOutstandingRewards calculates the current outstanding rewards without modifying the f1-state.
+// The outstanding rewards in non-native denoms are returned as coinRewards.
 // This method can be used for queries.
-func (k Keeper) f1GetOutstandingRewards(ctx sdk.Context, stakerAddress string, delegatorAddress string) uint64 {
+func (k Keeper) f1GetOutstandingRewards(ctx sdk.Context, stakerAddress string, delegatorAddress string) (rewards uint64, coinRewards sdk.Coins) {
 	delegator, found := k.GetDelegator(ctx, stakerAddress, delegatorAddress)
 	if !found {
-		return 0
+		return 0, sdk.NewCoins()
 	}
 
 	// Fetch metadata
@@ -289,6 +307,7 @@ func (k Keeper) f1GetOutstandingRewards(ctx sdk.Context, stakerAddress string, d
 	// To incorporate slashing one needs to iterate all slashes and calculate the reward for every period
 	// separately and then sum it.
 	reward := sdk.NewDec(0)
+	coinReward := sdk.NewDecCoins()
 	latestBalance := sdk.NewDec(int64(delegator.InitialAmount))
 	k.f1IterateConstantDelegationPeriods(ctx, stakerAddress, delegatorAddress, delegator.KIndex, endIndex,
 		func(startIndex uint64, endIndex uint64, delegation sdk.Dec) {
@@ -298,6 +317,10 @@ func (k Keeper) f1GetOutstandingRewards(ctx sdk.Context, stakerAddress string, d
 			// Add to total rewards
 			reward = reward.Add(periodReward)
 
+			// same for all non-native denoms
+			coinDifference := k.f1GetCoinEntryDifference(ctx, stakerAddress, startIndex, endIndex)
+			coinReward = coinReward.Add(coinDifference.MulDec(delegation)...)
+
 			// For calculating the last (ongoing) period
 			latestBalance = delegation
 		})
@@ -310,18 +333,24 @@ func (k Keeper) f1GetOutstandingRewards(ctx sdk.Context, stakerAddress string, d
 	_ = entry
 
 	currentPeriodValue := sdk.NewDec(0)
+	currentPeriodCoinValues := sdk.NewDecCoins()
 	if delegationData.TotalDelegation != 0 {
 		decCurrentRewards := sdk.NewDec(int64(delegationData.CurrentRewards))
 		decTotalDelegation := sdk.NewDec(int64(delegationData.TotalDelegation))
 
 		// F1: $T_f / n_f$
 		currentPeriodValue = decCurrentRewards.Quo(decTotalDelegation)
+		currentPeriodCoinValues = sdk.NewDecCoinsFromCoins(delegationData.CurrentCoinRewards...).QuoDec(decTotalDelegation)
 	}
 
 	ongoingPeriodReward := currentPeriodValue.Mul(latestBalance)
 
 	reward = reward.Add(ongoingPeriodReward)
-	return reward.TruncateInt().Uint64()
+	coinReward = coinReward.Add(currentPeriodCoinValues.MulDec(latestBalance)...)
+
+	coinRewards, _ = coinReward.TruncateDecimal()
+
+	return reward.TruncateInt().Uint64(), coinRewards
 }
 
 func (k Keeper) f1GetEntryDifference(ctx sdk.Context, stakerAddress string, lowIndex uint64, highIndex uint64) sdk.Dec {
@@ -338,3 +367,18 @@ func (k Keeper) f1GetEntryDifference(ctx sdk.Context, stakerAddress string, lowI
 
 	return secondEntry.Value.Sub(firstEntry.Value)
 }
+
+func (k Keeper) f1GetCoinEntryDifference(ctx sdk.Context, stakerAddress string, lowIndex uint64, highIndex uint64) sdk.DecCoins {
+	// entry difference
+	firstEntry, found := k.GetDelegationEntry(ctx, stakerAddress, lowIndex)
+	if !found {
+		util.PanicHalt(k.upgradeKeeper, ctx, "Entry 1 does not exist")
+	}
+
+	secondEntry, found := k.GetDelegationEntry(ctx, stakerAddress, highIndex)
+	if !found {
+		util.PanicHalt(k.upgradeKeeper, ctx, "Entry 2 does not exist")
+	}
+
+	return secondEntry.CoinValues.Sub(firstEntry.CoinValues)
+}
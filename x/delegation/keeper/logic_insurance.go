@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// depositToInsuranceFund increases the balance of the insurance fund. The tokens
+// need to be transferred to the delegation module by the caller.
+func (k Keeper) depositToInsuranceFund(ctx sdk.Context, amount uint64) {
+	insuranceFund := k.GetInsuranceFund(ctx)
+
+	insuranceFund.Balance += amount
+	insuranceFund.TotalDeposited += amount
+
+	k.SetInsuranceFund(ctx, insuranceFund)
+}
+
+// claimInsurance reimburses the delegators of the given staker for a timeout slash.
+// The payout is limited by the slashed amount, the `InsuranceMaxPayout` param and
+// the balance of the insurance fund. As the tokens are already held by the
+// delegation module they are only moved from the insurance fund to the F1-rewards
+// of the staker, where the delegators can claim them proportionally to their
+// delegation. Returns the amount which got reimbursed.
+func (k Keeper) claimInsurance(ctx sdk.Context, poolId uint64, staker string, slashedAmount uint64) (payout uint64) {
+	insuranceFund := k.GetInsuranceFund(ctx)
+
+	payout = slashedAmount
+	if maxPayout := k.GetInsuranceMaxPayout(ctx); payout > maxPayout {
+		payout = maxPayout
+	}
+	if payout > insuranceFund.Balance {
+		payout = insuranceFund.Balance
+	}
+
+	// rewards can only be claimed if the staker has remaining delegation
+	delegationData, _ := k.GetDelegationData(ctx, staker)
+	if payout == 0 || delegationData.TotalDelegation == 0 {
+		return 0
+	}
+
+	insuranceFund.Balance -= payout
+	insuranceFund.TotalClaimed += payout
+	k.SetInsuranceFund(ctx, insuranceFund)
+
+	k.AddAmountToDelegationRewards(ctx, staker, payout)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventInsuranceClaim{
+		PoolId: poolId,
+		Staker: staker,
+		Amount: payout,
+	})
+
+	return payout
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - logic_insurance.go
+
+* Fund the insurance fund
+* Timeout slash without insurance fund balance
+* Timeout slash gets fully reimbursed by the insurance fund
+* Timeout slash reimbursement is limited by the max payout
+* Timeout slash reimbursement is limited by the insurance fund balance
+* Upload and vote slashes do not get reimbursed
+* Timeout slash of a staker who opted out does not get reimbursed
+
+*/
+
+func FundInsurance(s *i.KeeperTestSuite, amount uint64) {
+	_, err := s.App().PoolKeeper.ChargeFundersOfPool(s.Ctx(), 0, amount)
+	Expect(err).To(BeNil())
+	err = s.App().DelegationKeeper.FundInsurance(s.Ctx(), amount, pooltypes.ModuleName)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+var _ = Describe("logic_insurance.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	const aliceSelfDelegation = 100 * i.KYVE
+	const bobDelegation = 100 * i.KYVE
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  aliceSelfDelegation,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.ALICE,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.BOB,
+			Staker:  i.ALICE,
+			Amount:  bobDelegation,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgSetInsuranceOptIn{
+			Creator: i.ALICE,
+			Enabled: true,
+		})
+
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.TimeoutSlash = sdk.MustNewDecFromStr("0.1")
+		params.InsuranceMaxPayout = 100 * i.KYVE
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Fund the insurance fund", func() {
+		// ACT
+		FundInsurance(s, 10*i.KYVE)
+
+		// ASSERT
+		insuranceFund := s.App().DelegationKeeper.GetInsuranceFund(s.Ctx())
+
+		Expect(insuranceFund.Balance).To(Equal(10 * i.KYVE))
+		Expect(insuranceFund.TotalDeposited).To(Equal(10 * i.KYVE))
+		Expect(insuranceFund.TotalClaimed).To(BeZero())
+	})
+
+	It("Timeout slash without insurance fund balance", func() {
+		// ACT
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_TIMEOUT, 0)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(180 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.ALICE)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.BOB)).To(BeZero())
+
+		slashRecord, found := s.App().DelegationKeeper.GetSlashRecord(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(slashRecord.Amount).To(Equal(20 * i.KYVE))
+		Expect(slashRecord.InsurancePayout).To(BeZero())
+	})
+
+	It("Timeout slash gets fully reimbursed by the insurance fund", func() {
+		// ARRANGE
+		FundInsurance(s, 50*i.KYVE)
+
+		// ACT
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_TIMEOUT, 0)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(180 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.ALICE)).To(BeNumerically("~", 10*i.KYVE, 1))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.BOB)).To(BeNumerically("~", 10*i.KYVE, 1))
+
+		insuranceFund := s.App().DelegationKeeper.GetInsuranceFund(s.Ctx())
+		Expect(insuranceFund.Balance).To(Equal(30 * i.KYVE))
+		Expect(insuranceFund.TotalDeposited).To(Equal(50 * i.KYVE))
+		Expect(insuranceFund.TotalClaimed).To(Equal(20 * i.KYVE))
+
+		slashRecord, _ := s.App().DelegationKeeper.GetSlashRecord(s.Ctx(), 0)
+		Expect(slashRecord.InsurancePayout).To(Equal(20 * i.KYVE))
+
+		// delegators can withdraw their reimbursement
+		balanceBefore := s.GetBalanceFromAddress(i.BOB)
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawRewards{
+			Creator: i.BOB,
+			Staker:  i.ALICE,
+		})
+		Expect(s.GetBalanceFromAddress(i.BOB)).To(BeNumerically("~", balanceBefore+10*i.KYVE, 1))
+	})
+
+	It("Timeout slash reimbursement is limited by the max payout", func() {
+		// ARRANGE
+		FundInsurance(s, 50*i.KYVE)
+
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.InsuranceMaxPayout = 5 * i.KYVE
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_TIMEOUT, 0)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.BOB)).To(BeNumerically("~", 5*i.KYVE/2, 1))
+
+		insuranceFund := s.App().DelegationKeeper.GetInsuranceFund(s.Ctx())
+		Expect(insuranceFund.Balance).To(Equal(45 * i.KYVE))
+		Expect(insuranceFund.TotalClaimed).To(Equal(5 * i.KYVE))
+
+		slashRecord, _ := s.App().DelegationKeeper.GetSlashRecord(s.Ctx(), 0)
+		Expect(slashRecord.InsurancePayout).To(Equal(5 * i.KYVE))
+	})
+
+	It("Timeout slash reimbursement is limited by the insurance fund balance", func() {
+		// ARRANGE
+		FundInsurance(s, 8*i.KYVE)
+
+		// ACT
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_TIMEOUT, 0)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_TIMEOUT, 1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.BOB)).To(BeNumerically("~", 4*i.KYVE, 1))
+
+		insuranceFund := s.App().DelegationKeeper.GetInsuranceFund(s.Ctx())
+		Expect(insuranceFund.Balance).To(BeZero())
+		Expect(insuranceFund.TotalClaimed).To(Equal(8 * i.KYVE))
+
+		firstSlashRecord, _ := s.App().DelegationKeeper.GetSlashRecord(s.Ctx(), 0)
+		Expect(firstSlashRecord.InsurancePayout).To(Equal(8 * i.KYVE))
+
+		secondSlashRecord, _ := s.App().DelegationKeeper.GetSlashRecord(s.Ctx(), 1)
+		Expect(secondSlashRecord.InsurancePayout).To(BeZero())
+	})
+
+	It("Upload and vote slashes do not get reimbursed", func() {
+		// ARRANGE
+		FundInsurance(s, 50*i.KYVE)
+
+		// ACT
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_VOTE, 1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.BOB)).To(BeZero())
+
+		insuranceFund := s.App().DelegationKeeper.GetInsuranceFund(s.Ctx())
+		Expect(insuranceFund.Balance).To(Equal(50 * i.KYVE))
+		Expect(insuranceFund.TotalClaimed).To(BeZero())
+	})
+
+	It("Timeout slash of a staker who opted out does not get reimbursed", func() {
+		// ARRANGE
+		FundInsurance(s, 50*i.KYVE)
+
+		s.RunTxDelegatorSuccess(&types.MsgSetInsuranceOptIn{
+			Creator: i.ALICE,
+			Enabled: false,
+		})
+
+		// ACT
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_TIMEOUT, 0)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(180 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.BOB)).To(BeZero())
+
+		insuranceFund := s.App().DelegationKeeper.GetInsuranceFund(s.Ctx())
+		Expect(insuranceFund.Balance).To(Equal(50 * i.KYVE))
+		Expect(insuranceFund.TotalClaimed).To(BeZero())
+
+		slashRecord, _ := s.App().DelegationKeeper.GetSlashRecord(s.Ctx(), 0)
+		Expect(slashRecord.InsurancePayout).To(BeZero())
+	})
+})
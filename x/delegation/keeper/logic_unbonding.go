@@ -59,12 +59,12 @@ func (k Keeper) ProcessDelegatorUnbondingQueue(ctx sdk.Context) {
 			// Perform undelegation and save undelegated amount to then transfer back to the user
 			undelegatedAmount := k.performUndelegation(ctx, undelegationEntry.Staker, undelegationEntry.Delegator, undelegationEntry.Amount)
 
-			// Transfer the money
+			// Transfer the money to the withdraw address of the delegator
 			if err := util.TransferFromModuleToAddress(
 				k.bankKeeper,
 				ctx,
 				types.ModuleName,
-				undelegationEntry.Delegator,
+				k.GetWithdrawAddress(ctx, undelegationEntry.Delegator),
 				undelegatedAmount,
 			); err != nil {
 				util.PanicHalt(k.upgradeKeeper, ctx, "Not enough money in delegation module - logic_unbonding")
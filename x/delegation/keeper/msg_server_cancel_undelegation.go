@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	sdkErrors "cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CancelUndelegation cancels all or part of a pending undelegation. As the tokens
+// stay delegated during the unbonding time, the queue entry only gets reduced or
+// removed. The pending rewards are paid out and a new F1-period is started, which
+// is only possible if the staker still exists.
+func (k msgServer) CancelUndelegation(
+	goCtx context.Context,
+	msg *types.MsgCancelUndelegation,
+) (*types.MsgCancelUndelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the undelegation exists and belongs to the sender
+	undelegationEntry, found := k.GetUndelegationQueueEntry(ctx, msg.Index)
+	if !found || undelegationEntry.Delegator != msg.Creator {
+		return nil, sdkErrors.Wrapf(errorsTypes.ErrNotFound, types.ErrUndelegationNotFound.Error(), msg.Index, msg.Creator)
+	}
+
+	// Do not allow to cancel more than currently undelegating
+	if msg.Amount > undelegationEntry.Amount {
+		return nil, types.ErrNotEnoughUndelegation.Wrapf("%d > %d", msg.Amount, undelegationEntry.Amount)
+	}
+
+	if !k.stakersKeeper.DoesStakerExist(ctx, undelegationEntry.Staker) {
+		return nil, sdkErrors.WithType(types.ErrStakerDoesNotExist, undelegationEntry.Staker)
+	}
+
+	if !k.DoesDelegatorExist(ctx, undelegationEntry.Staker, msg.Creator) {
+		return nil, sdkErrors.Wrapf(types.ErrNotADelegator, "%s does not delegate to %s", msg.Creator, undelegationEntry.Staker)
+	}
+
+	// Reduce or remove the queue entry. The queue processing skips removed entries.
+	if msg.Amount == undelegationEntry.Amount {
+		k.RemoveUndelegationQueueEntry(ctx, &undelegationEntry)
+	} else {
+		undelegationEntry.Amount -= msg.Amount
+		k.SetUndelegationQueueEntry(ctx, undelegationEntry)
+	}
+
+	// Pay out the pending rewards and start a new F1-period with the remaining delegation
+	k.performDelegation(ctx, undelegationEntry.Staker, msg.Creator, 0)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventCancelUndelegation{
+		Address: msg.Creator,
+		Staker:  undelegationEntry.Staker,
+		Index:   msg.Index,
+		Amount:  msg.Amount,
+	})
+
+	return &types.MsgCancelUndelegationResponse{}, nil
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_cancel_undelegation.go
+
+* Cancel a non-existing undelegation
+* Cancel the undelegation of another delegator
+* Cancel more than undelegating
+* Cancel the entire undelegation
+* Cancel part of the undelegation
+* Cancel the undelegation with outstanding rewards
+* Cancel one of multiple undelegations
+
+*/
+
+var _ = Describe("msg_server_cancel_undelegation.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	const aliceSelfDelegation = 100 * i.KYVE
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  aliceSelfDelegation,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgUndelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  6 * i.KYVE,
+		})
+
+		s.CommitAfterSeconds(1)
+
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Index).To(Equal(uint64(1)))
+	})
+
+	AfterEach(func() {
+		CheckAndContinueChainForOneMonth(s)
+	})
+
+	It("Cancel a non-existing undelegation", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgCancelUndelegation{
+			Creator: i.DUMMY[0],
+			Index:   2,
+			Amount:  6 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(HaveLen(1))
+	})
+
+	It("Cancel the undelegation of another delegator", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgCancelUndelegation{
+			Creator: i.DUMMY[1],
+			Index:   1,
+			Amount:  6 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Amount).To(Equal(6 * i.KYVE))
+	})
+
+	It("Cancel more than undelegating", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgCancelUndelegation{
+			Creator: i.DUMMY[0],
+			Index:   1,
+			Amount:  7 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Amount).To(Equal(6 * i.KYVE))
+	})
+
+	It("Cancel the entire undelegation", func() {
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgCancelUndelegation{
+			Creator: i.DUMMY[0],
+			Index:   1,
+			Amount:  6 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(990 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(aliceSelfDelegation + 10*i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+	})
+
+	It("Cancel part of the undelegation", func() {
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgCancelUndelegation{
+			Creator: i.DUMMY[0],
+			Index:   1,
+			Amount:  4 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Index).To(Equal(uint64(1)))
+		Expect(unbondingEntries[0].Amount).To(Equal(2 * i.KYVE))
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(992 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(aliceSelfDelegation + 8*i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(8 * i.KYVE))
+	})
+
+	It("Cancel the undelegation with outstanding rewards", func() {
+		// ARRANGE
+		PayoutRewards(s, i.ALICE, 11*i.KYVE)
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(1 * i.KYVE))
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgCancelUndelegation{
+			Creator: i.DUMMY[0],
+			Index:   1,
+			Amount:  6 * i.KYVE,
+		})
+
+		// ASSERT
+		// rewards are paid out as a new F1-period was started
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(991 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.ALICE)).To(Equal(10 * i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+	})
+
+	It("Cancel one of multiple undelegations", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgUndelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  4 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgCancelUndelegation{
+			Creator: i.DUMMY[0],
+			Index:   1,
+			Amount:  6 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Index).To(Equal(uint64(2)))
+		Expect(unbondingEntries[0].Amount).To(Equal(4 * i.KYVE))
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(994 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(6 * i.KYVE))
+	})
+})
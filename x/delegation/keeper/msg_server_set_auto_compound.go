@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdkErrors "cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the rewards the
+// sender receives from the given staker. If enabled, the $KYVE rewards get
+// periodically re-delegated to the staker at the end of a block. Enabling
+// requires a delegation of at least the MinAutoCompoundDelegation param.
+func (k msgServer) SetAutoCompound(
+	goCtx context.Context,
+	msg *types.MsgSetAutoCompound,
+) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the sender has delegated to the given staker
+	if !k.DoesDelegatorExist(ctx, msg.Staker, msg.Creator) {
+		return nil, sdkErrors.Wrapf(types.ErrNotADelegator, "%s does not delegate to %s", msg.Creator, msg.Staker)
+	}
+
+	if msg.Enabled {
+		// Only delegations above the minimum can be compounded automatically
+		delegation := k.GetDelegationAmountOfDelegator(ctx, msg.Staker, msg.Creator)
+		if minDelegation := k.GetMinAutoCompoundDelegation(ctx); delegation < minDelegation {
+			return nil, sdkErrors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrAutoCompoundDelegationTooLow.Error(), delegation, minDelegation)
+		}
+
+		k.SetAutoCompoundEntry(ctx, types.AutoCompound{
+			Staker:    msg.Staker,
+			Delegator: msg.Creator,
+		})
+	} else {
+		k.RemoveAutoCompoundEntry(ctx, msg.Staker, msg.Creator)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventSetAutoCompound{
+		Address: msg.Creator,
+		Staker:  msg.Staker,
+		Enabled: msg.Enabled,
+	})
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
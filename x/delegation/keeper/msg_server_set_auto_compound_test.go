@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_set_auto_compound.go
+
+* Enable auto-compounding without a delegation
+* Enable auto-compounding and payout rewards
+* Only compound the rewards of delegators which enabled auto-compounding
+* Disable auto-compounding
+* Compound rewards of multiple payouts
+* Undelegate everything and await unbonding removes auto-compounding
+* Try to enable auto-compounding with a delegation below the minimum
+* Only compound a limited number of delegations per block
+
+*/
+
+var _ = Describe("msg_server_set_auto_compound.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	const aliceSelfDelegation = 100 * i.KYVE
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  aliceSelfDelegation,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[1],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.CommitAfterSeconds(7)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Enable auto-compounding without a delegation", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[2],
+			Staker:  i.ALICE,
+			Enabled: true,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.IsAutoCompoundEnabled(s.Ctx(), i.ALICE, i.DUMMY[2])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetAllAutoCompounds(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Enable auto-compounding and payout rewards", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Enabled: true,
+		})
+
+		Expect(s.App().DelegationKeeper.IsAutoCompoundEnabled(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeTrue())
+
+		balanceBefore := s.GetBalanceFromAddress(i.DUMMY[0])
+
+		// ACT
+		PayoutRewards(s, i.ALICE, 30*i.KYVE)
+
+		// rewards are compounded at the end of the block
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(110 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(310 * i.KYVE))
+
+		// rewards are not transferred to the delegator
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(balanceBefore))
+	})
+
+	It("Only compound the rewards of delegators which enabled auto-compounding", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Enabled: true,
+		})
+
+		// ACT
+		PayoutRewards(s, i.ALICE, 30*i.KYVE)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(110 * i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.ALICE)).To(Equal(100 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.ALICE)).To(Equal(10 * i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(100 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(10 * i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetAutoCompoundDelegatorsOfStaker(s.Ctx(), i.ALICE)).To(Equal([]string{i.DUMMY[0]}))
+	})
+
+	It("Disable auto-compounding", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Enabled: true,
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Enabled: false,
+		})
+
+		PayoutRewards(s, i.ALICE, 30*i.KYVE)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.IsAutoCompoundEnabled(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(100 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+	})
+
+	It("Compound rewards of multiple payouts", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Enabled: true,
+		})
+
+		// ACT
+		PayoutRewards(s, i.ALICE, 30*i.KYVE)
+		s.CommitAfterSeconds(1)
+		PayoutRewards(s, i.ALICE, 31*i.KYVE)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		// 110 / 310 of the second payout get compounded
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeNumerically("~", 121*i.KYVE, 1))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeZero())
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(BeNumerically("~", 20*i.KYVE, 1))
+	})
+
+	It("Undelegate everything and await unbonding removes auto-compounding", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Enabled: true,
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgUndelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		// auto-compounding stays active during the unbonding
+		Expect(s.App().DelegationKeeper.IsAutoCompoundEnabled(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeTrue())
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.IsAutoCompoundEnabled(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetAutoCompoundDelegatorsOfStaker(s.Ctx(), i.ALICE)).To(BeEmpty())
+	})
+
+	It("Try to enable auto-compounding with a delegation below the minimum", func() {
+		// ARRANGE
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.MinAutoCompoundDelegation = 101 * i.KYVE
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.RunTxDelegatorError(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Enabled: true,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.IsAutoCompoundEnabled(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetAllAutoCompounds(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Only compound a limited number of delegations per block", func() {
+		// ARRANGE
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.MaxAutoCompoundsPerBlock = 1
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxDelegatorSuccess(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Enabled: true,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgSetAutoCompound{
+			Creator: i.DUMMY[1],
+			Staker:  i.ALICE,
+			Enabled: true,
+		})
+
+		// ACT
+		PayoutRewards(s, i.ALICE, 30*i.KYVE)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		// only one of the two delegations got compounded in the first block
+		compounded := s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]) +
+			s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])
+		Expect(compounded).To(Equal(10 * i.KYVE))
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(110 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(110 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(BeZero())
+	})
+})
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdkErrors "cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetInsuranceOptIn opts the sender in or out of the insurance. Only the
+// delegators of stakers which opted in get reimbursed by the insurance fund
+// after a timeout slash.
+func (k msgServer) SetInsuranceOptIn(
+	goCtx context.Context,
+	msg *types.MsgSetInsuranceOptIn,
+) (*types.MsgSetInsuranceOptInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only stakers can opt in to the insurance
+	if !k.stakersKeeper.DoesStakerExist(ctx, msg.Creator) {
+		return nil, sdkErrors.Wrapf(errorsTypes.ErrNotFound, types.ErrNotAStaker.Error(), msg.Creator)
+	}
+
+	if msg.Enabled {
+		k.SetInsuranceOptInEntry(ctx, types.InsuranceOptIn{
+			Staker: msg.Creator,
+		})
+	} else {
+		k.RemoveInsuranceOptInEntry(ctx, msg.Creator)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventSetInsuranceOptIn{
+		Staker:  msg.Creator,
+		Enabled: msg.Enabled,
+	})
+
+	return &types.MsgSetInsuranceOptInResponse{}, nil
+}
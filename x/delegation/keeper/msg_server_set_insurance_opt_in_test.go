@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_set_insurance_opt_in.go
+
+* Opt in to the insurance
+* Opt out of the insurance
+* Try to opt in to the insurance as a delegator
+
+*/
+
+var _ = Describe("msg_server_set_insurance_opt_in.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Opt in to the insurance", func() {
+		// ARRANGE
+		Expect(s.App().DelegationKeeper.IsInsuranceOptedIn(s.Ctx(), i.ALICE)).To(BeFalse())
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgSetInsuranceOptIn{
+			Creator: i.ALICE,
+			Enabled: true,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.IsInsuranceOptedIn(s.Ctx(), i.ALICE)).To(BeTrue())
+		Expect(s.App().DelegationKeeper.GetAllInsuranceOptIns(s.Ctx())).To(Equal([]types.InsuranceOptIn{
+			{Staker: i.ALICE},
+		}))
+	})
+
+	It("Opt out of the insurance", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetInsuranceOptIn{
+			Creator: i.ALICE,
+			Enabled: true,
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgSetInsuranceOptIn{
+			Creator: i.ALICE,
+			Enabled: false,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.IsInsuranceOptedIn(s.Ctx(), i.ALICE)).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetAllInsuranceOptIns(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Try to opt in to the insurance as a delegator", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgSetInsuranceOptIn{
+			Creator: i.DUMMY[0],
+			Enabled: true,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.IsInsuranceOptedIn(s.Ctx(), i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetAllInsuranceOptIns(s.Ctx())).To(BeEmpty())
+	})
+})
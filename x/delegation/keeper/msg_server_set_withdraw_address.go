@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetWithdrawAddress sets the address which receives the delegation rewards,
+// the commission rewards and the undelegated tokens of the sender.
+// Setting the withdraw address to the sender itself resets it.
+func (k msgServer) SetWithdrawAddress(
+	goCtx context.Context,
+	msg *types.MsgSetWithdrawAddress,
+) (*types.MsgSetWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.WithdrawAddress == msg.Creator {
+		k.RemoveWithdrawAddressEntry(ctx, msg.Creator)
+	} else {
+		k.SetWithdrawAddressEntry(ctx, types.WithdrawAddress{
+			Address:         msg.Creator,
+			WithdrawAddress: msg.WithdrawAddress,
+		})
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventSetWithdrawAddress{
+		Address:         msg.Creator,
+		WithdrawAddress: msg.WithdrawAddress,
+	})
+
+	return &types.MsgSetWithdrawAddressResponse{}, nil
+}
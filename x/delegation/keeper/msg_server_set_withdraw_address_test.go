@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_set_withdraw_address.go
+
+* Set a withdraw address
+* Reset the withdraw address
+* Withdraw rewards to the withdraw address
+* Withdraw all rewards to the withdraw address
+* Undelegate to the withdraw address
+* Delegate more with a withdraw address
+
+*/
+
+var _ = Describe("msg_server_set_withdraw_address.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Set a withdraw address", func() {
+		// ARRANGE
+		Expect(s.App().DelegationKeeper.GetWithdrawAddress(s.Ctx(), i.DUMMY[0])).To(Equal(i.DUMMY[0]))
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetWithdrawAddress(s.Ctx(), i.DUMMY[0])).To(Equal(i.DUMMY[1]))
+		Expect(s.App().DelegationKeeper.GetAllWithdrawAddresses(s.Ctx())).To(HaveLen(1))
+
+		// other addresses are not affected
+		Expect(s.App().DelegationKeeper.GetWithdrawAddress(s.Ctx(), i.ALICE)).To(Equal(i.ALICE))
+	})
+
+	It("Reset the withdraw address", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[0],
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetWithdrawAddress(s.Ctx(), i.DUMMY[0])).To(Equal(i.DUMMY[0]))
+		Expect(s.App().DelegationKeeper.GetAllWithdrawAddresses(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Withdraw rewards to the withdraw address", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		PayoutRewards(s, i.ALICE, 20*i.KYVE)
+
+		delegatorBalance := s.GetBalanceFromAddress(i.DUMMY[0])
+		withdrawBalance := s.GetBalanceFromAddress(i.DUMMY[1])
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawRewards{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(delegatorBalance))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(withdrawBalance + 10*i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeZero())
+	})
+
+	It("Withdraw all rewards to the withdraw address", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		PayoutRewards(s, i.ALICE, 20*i.KYVE)
+
+		delegatorBalance := s.GetBalanceFromAddress(i.DUMMY[0])
+		withdrawBalance := s.GetBalanceFromAddress(i.DUMMY[1])
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawAllRewards{
+			Creator: i.DUMMY[0],
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(delegatorBalance))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(withdrawBalance + 10*i.KYVE))
+	})
+
+	It("Undelegate to the withdraw address", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		PayoutRewards(s, i.ALICE, 20*i.KYVE)
+
+		delegatorBalance := s.GetBalanceFromAddress(i.DUMMY[0])
+		withdrawBalance := s.GetBalanceFromAddress(i.DUMMY[1])
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgUndelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+
+		// rewards and undelegated tokens are transferred to the withdraw address
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(delegatorBalance))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(withdrawBalance + 110*i.KYVE))
+	})
+
+	It("Delegate more with a withdraw address", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		PayoutRewards(s, i.ALICE, 20*i.KYVE)
+
+		delegatorBalance := s.GetBalanceFromAddress(i.DUMMY[0])
+		withdrawBalance := s.GetBalanceFromAddress(i.DUMMY[1])
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  50 * i.KYVE,
+		})
+
+		// ASSERT
+		// the delegation is paid by the delegator, the pending rewards go to the withdraw address
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(delegatorBalance - 50*i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(withdrawBalance + 10*i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(150 * i.KYVE))
+	})
+})
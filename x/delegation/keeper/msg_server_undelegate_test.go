@@ -25,6 +25,8 @@ TEST CASES - msg_server_undelegate.go
 * Undelegate all after rewards and slashing
 * JoinA, Slash, JoinB, PayoutReward
 * Slash twice
+* Slash with a pool specific slash fraction
+* Slash with a zero pool specific slash fraction
 * Start unbonding, slash twice, payout, await undelegation
 
 TODO(@max): joinA slash joinB slash -> remaining delegation
@@ -247,7 +249,7 @@ var _ = Describe("msg_server_undelegate.go", Ordered, func() {
 		params := s.App().DelegationKeeper.GetParams(s.Ctx())
 		params.UploadSlash = sdk.MustNewDecFromStr("0.1")
 		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
 
 		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(9 * i.KYVE))
 
@@ -416,7 +418,7 @@ var _ = Describe("msg_server_undelegate.go", Ordered, func() {
 		params := s.App().DelegationKeeper.GetParams(s.Ctx())
 		params.UploadSlash = sdk.MustNewDecFromStr("0.1")
 		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
 
 		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(9 * i.KYVE))
 		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(18 * i.KYVE))
@@ -470,7 +472,7 @@ var _ = Describe("msg_server_undelegate.go", Ordered, func() {
 		s.PerformValidityChecks()
 
 		// Slash 50%
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
 
 		s.RunTxDelegatorSuccess(&types.MsgDelegate{
 			Creator: i.DUMMY[1],
@@ -517,8 +519,8 @@ var _ = Describe("msg_server_undelegate.go", Ordered, func() {
 		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
 
 		// Slash 50% twice
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
 
 		// ASSERT
 		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(25*i.KYVE + uint64(2_500_000_000+5_000_000_000)))
@@ -526,6 +528,76 @@ var _ = Describe("msg_server_undelegate.go", Ordered, func() {
 		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(uint64(5_000_000_000)))
 	})
 
+	It("Slash with a pool specific slash fraction", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.UploadSlash = sdk.MustNewDecFromStr("0.1")
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		uploadSlash := sdk.MustNewDecFromStr("0.5")
+		pool.UploadSlash = &uploadSlash
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.PerformValidityChecks()
+
+		// ACT
+		// Slash 50% with the override of pool 0
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(100 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(50 * i.KYVE))
+
+		// ACT
+		// Slash 10% with the global param as pool 1 has no override
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 1, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(90 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(45 * i.KYVE))
+
+		slashRecord, _ := s.App().DelegationKeeper.GetSlashRecord(s.Ctx(), 0)
+		Expect(slashRecord.Fraction).To(Equal(sdk.MustNewDecFromStr("0.5")))
+	})
+
+	It("Slash with a zero pool specific slash fraction", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.UploadSlash = sdk.MustNewDecFromStr("0.1")
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		uploadSlash := sdk.ZeroDec()
+		pool.UploadSlash = &uploadSlash
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.PerformValidityChecks()
+
+		// ACT
+		// Nothing gets slashed with the zero override of pool 0
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(200 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(100 * i.KYVE))
+
+		slashRecord, _ := s.App().DelegationKeeper.GetSlashRecord(s.Ctx(), 0)
+		Expect(slashRecord.Fraction).To(Equal(sdk.ZeroDec()))
+	})
+
 	It("Start unbonding, slash twice, payout, await undelegation", func() {
 		// ARRANGE
 		s.RunTxDelegatorSuccess(&types.MsgDelegate{
@@ -560,8 +632,8 @@ var _ = Describe("msg_server_undelegate.go", Ordered, func() {
 		params := s.App().DelegationKeeper.GetParams(s.Ctx())
 		params.UploadSlash = sdk.MustNewDecFromStr("0.5")
 		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD, 0)
 
 		// Alice: 25    25 / 32.5 * 1e10 = 7_692_307_692
 		// Dummy0: 2.5  2.5 / 32.5 * 1e10 = 769_230_769
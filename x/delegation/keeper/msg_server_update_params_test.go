@@ -44,6 +44,15 @@ TEST CASES - msg_server_update_params.go
 * Update timeout slash
 * Update timeout slash with invalid value
 
+* Update insurance fund share
+* Update insurance fund share with invalid value
+
+* Update insurance max payout
+* Update insurance max payout with invalid value
+* Update max auto compounds per block
+* Update max auto compounds per block with invalid value
+* Update min auto compound delegation
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -78,6 +87,10 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(params.VoteSlash).To(Equal(types.DefaultVoteSlash))
 		Expect(params.UploadSlash).To(Equal(types.DefaultUploadSlash))
 		Expect(params.TimeoutSlash).To(Equal(types.DefaultTimeoutSlash))
+		Expect(params.InsuranceFundShare).To(Equal(types.DefaultInsuranceFundShare))
+		Expect(params.InsuranceMaxPayout).To(Equal(types.DefaultInsuranceMaxPayout))
+		Expect(params.MaxAutoCompoundsPerBlock).To(Equal(types.DefaultMaxAutoCompoundsPerBlock))
+		Expect(params.MinAutoCompoundDelegation).To(Equal(types.DefaultMinAutoCompoundDelegation))
 	})
 
 	It("Invalid authority (transaction)", func() {
@@ -120,7 +133,9 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 			"redelegation_max_amount": 1,
 			"vote_slash": "0.05",
 			"upload_slash": "0.05",
-			"timeout_slash": "0.05"
+			"timeout_slash": "0.05",
+			"insurance_fund_share": "0.5",
+			"insurance_max_payout": 1000
 		}`
 
 		msg := &types.MsgUpdateParams{
@@ -149,6 +164,8 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(submitErr).NotTo(HaveOccurred())
 		Expect(voteErr).NotTo(HaveOccurred())
 
+		Expect(updatedParams.InsuranceFundShare).To(Equal(sdk.MustNewDecFromStr("0.5")))
+		Expect(updatedParams.InsuranceMaxPayout).To(Equal(uint64(1000)))
 		Expect(updatedParams.UnbondingDelegationTime).To(Equal(uint64(3600)))
 		Expect(updatedParams.RedelegationCooldown).To(Equal(uint64(3600)))
 		Expect(updatedParams.RedelegationMaxAmount).To(Equal(uint64(1)))
@@ -673,4 +690,249 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.TimeoutSlash).To(Equal(types.DefaultTimeoutSlash))
 		Expect(updatedParams.VoteSlash).To(Equal(types.DefaultVoteSlash))
 	})
+
+	It("Update insurance fund share", func() {
+		// ARRANGE
+		payload := `{
+			"insurance_fund_share": "0.25"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().DelegationKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.UnbondingDelegationTime).To(Equal(types.DefaultUnbondingDelegationTime))
+		Expect(updatedParams.TimeoutSlash).To(Equal(types.DefaultTimeoutSlash))
+		Expect(updatedParams.InsuranceFundShare).To(Equal(sdk.MustNewDecFromStr("0.25")))
+		Expect(updatedParams.InsuranceMaxPayout).To(Equal(types.DefaultInsuranceMaxPayout))
+	})
+
+	It("Update insurance fund share with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"insurance_fund_share": "1.5"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().DelegationKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.UnbondingDelegationTime).To(Equal(types.DefaultUnbondingDelegationTime))
+		Expect(updatedParams.TimeoutSlash).To(Equal(types.DefaultTimeoutSlash))
+		Expect(updatedParams.InsuranceFundShare).To(Equal(types.DefaultInsuranceFundShare))
+		Expect(updatedParams.InsuranceMaxPayout).To(Equal(types.DefaultInsuranceMaxPayout))
+	})
+
+	It("Update insurance max payout", func() {
+		// ARRANGE
+		payload := `{
+			"insurance_max_payout": 1000
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().DelegationKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.UnbondingDelegationTime).To(Equal(types.DefaultUnbondingDelegationTime))
+		Expect(updatedParams.TimeoutSlash).To(Equal(types.DefaultTimeoutSlash))
+		Expect(updatedParams.InsuranceFundShare).To(Equal(types.DefaultInsuranceFundShare))
+		Expect(updatedParams.InsuranceMaxPayout).To(Equal(uint64(1000)))
+	})
+
+	It("Update insurance max payout with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"insurance_max_payout": -1
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().DelegationKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.UnbondingDelegationTime).To(Equal(types.DefaultUnbondingDelegationTime))
+		Expect(updatedParams.TimeoutSlash).To(Equal(types.DefaultTimeoutSlash))
+		Expect(updatedParams.InsuranceFundShare).To(Equal(types.DefaultInsuranceFundShare))
+		Expect(updatedParams.InsuranceMaxPayout).To(Equal(types.DefaultInsuranceMaxPayout))
+	})
+
+	It("Update max auto compounds per block", func() {
+		// ARRANGE
+		payload := `{
+			"max_auto_compounds_per_block": 10
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().DelegationKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.UnbondingDelegationTime).To(Equal(types.DefaultUnbondingDelegationTime))
+		Expect(updatedParams.MaxAutoCompoundsPerBlock).To(Equal(uint64(10)))
+		Expect(updatedParams.MinAutoCompoundDelegation).To(Equal(types.DefaultMinAutoCompoundDelegation))
+	})
+
+	It("Update max auto compounds per block with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"max_auto_compounds_per_block": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().DelegationKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.UnbondingDelegationTime).To(Equal(types.DefaultUnbondingDelegationTime))
+		Expect(updatedParams.MaxAutoCompoundsPerBlock).To(Equal(types.DefaultMaxAutoCompoundsPerBlock))
+		Expect(updatedParams.MinAutoCompoundDelegation).To(Equal(types.DefaultMinAutoCompoundDelegation))
+	})
+
+	It("Update min auto compound delegation", func() {
+		// ARRANGE
+		payload := `{
+			"min_auto_compound_delegation": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().DelegationKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.UnbondingDelegationTime).To(Equal(types.DefaultUnbondingDelegationTime))
+		Expect(updatedParams.MaxAutoCompoundsPerBlock).To(Equal(types.DefaultMaxAutoCompoundsPerBlock))
+		Expect(updatedParams.MinAutoCompoundDelegation).To(Equal(uint64(0)))
+	})
 })
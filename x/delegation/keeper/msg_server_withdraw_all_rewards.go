@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/util"
+
+	sdkErrors "cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WithdrawAllRewards calculates the current rewards of a delegator for all stakers
+// it delegates to and transfers the total balance with a single transfer to the
+// delegator's wallet. Only the delegator himself can call this transaction.
+func (k msgServer) WithdrawAllRewards(
+	goCtx context.Context,
+	msg *types.MsgWithdrawAllRewards,
+) (*types.MsgWithdrawAllRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stakers := k.GetStakersByDelegator(ctx, msg.Creator)
+
+	// Check if the sender has delegated at all
+	if len(stakers) == 0 {
+		return nil, sdkErrors.Wrapf(types.ErrNotADelegator, "%s does not delegate to any staker", msg.Creator)
+	}
+
+	// Limit the amount of F1-withdrawals which are performed in a single transaction
+	if len(stakers) > types.MaxWithdrawAllRewardsStakers {
+		return nil, sdkErrors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrTooManyStakersToWithdraw.Error(), len(stakers), types.MaxWithdrawAllRewardsStakers)
+	}
+
+	// Withdraw all rewards of the sender.
+	totalReward := uint64(0)
+	totalCoinReward := sdk.NewCoins()
+	for _, staker := range stakers {
+		reward, coinReward := k.f1WithdrawRewards(ctx, staker, msg.Creator)
+
+		totalReward += reward
+		totalCoinReward = totalCoinReward.Add(coinReward...)
+	}
+
+	// Transfer $KYVE and rewards in non-native denoms from this module to the withdraw address.
+	coins := totalCoinReward.Add(sdk.NewInt64Coin(globalTypes.Denom, int64(totalReward)))
+	if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, k.GetWithdrawAddress(ctx, msg.Creator), coins); err != nil {
+		return nil, err
+	}
+
+	// Emit an aggregated withdraw event.
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventWithdrawAllRewards{
+		Address: msg.Creator,
+		Stakers: stakers,
+		Amount:  totalReward,
+		Coins:   totalCoinReward,
+	})
+
+	return &types.MsgWithdrawAllRewardsResponse{}, nil
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_withdraw_all_rewards.go
+
+* Withdraw all rewards without any delegation
+* Withdraw all rewards from multiple stakers
+* Withdraw all rewards without outstanding rewards
+* Withdraw all rewards from more stakers than allowed
+
+*/
+
+var _ = Describe("msg_server_withdraw_all_rewards.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.BOB,
+			Amount:  100 * i.KYVE,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Withdraw all rewards without any delegation", func() {
+		// ARRANGE
+		balanceBefore := s.GetBalanceFromAddress(i.DUMMY[0])
+
+		// ACT
+		s.RunTxDelegatorError(&types.MsgWithdrawAllRewards{
+			Creator: i.DUMMY[0],
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(balanceBefore))
+	})
+
+	It("Withdraw all rewards from multiple stakers", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.BOB,
+			Amount:  100 * i.KYVE,
+		})
+
+		PayoutRewards(s, i.ALICE, 20*i.KYVE)
+		PayoutRewards(s, i.BOB, 10*i.KYVE)
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.BOB, i.DUMMY[0])).To(Equal(5 * i.KYVE))
+
+		balanceBefore := s.GetBalanceFromAddress(i.DUMMY[0])
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawAllRewards{
+			Creator: i.DUMMY[0],
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(balanceBefore + 15*i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.BOB, i.DUMMY[0])).To(BeZero())
+
+		// rewards of other delegators are not affected
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.ALICE)).To(Equal(10 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.BOB, i.BOB)).To(Equal(5 * i.KYVE))
+
+		// delegations are not affected
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(100 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, i.DUMMY[0])).To(Equal(100 * i.KYVE))
+	})
+
+	It("Withdraw all rewards without outstanding rewards", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		balanceBefore := s.GetBalanceFromAddress(i.DUMMY[0])
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawAllRewards{
+			Creator: i.DUMMY[0],
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(balanceBefore))
+	})
+
+	It("Withdraw all rewards from more stakers than allowed", func() {
+		// ARRANGE
+		stakers := append([]string{i.ALICE}, i.DUMMY...)
+		Expect(len(stakers)).To(BeNumerically(">", types.MaxWithdrawAllRewardsStakers))
+
+		for _, staker := range stakers {
+			if staker != i.ALICE {
+				s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+					Creator: staker,
+					Amount:  100 * i.KYVE,
+				})
+			}
+
+			s.RunTxDelegatorSuccess(&types.MsgDelegate{
+				Creator: i.CHARLIE,
+				Staker:  staker,
+				Amount:  1 * i.KYVE,
+			})
+		}
+
+		PayoutRewards(s, i.ALICE, 10*i.KYVE)
+		balanceBefore := s.GetBalanceFromAddress(i.CHARLIE)
+
+		// ACT
+		s.RunTxDelegatorError(&types.MsgWithdrawAllRewards{
+			Creator: i.CHARLIE,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.CHARLIE)).To(Equal(balanceBefore))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.CHARLIE)).NotTo(BeZero())
+	})
+})
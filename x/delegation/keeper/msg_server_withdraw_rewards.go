@@ -11,7 +11,7 @@ import (
 )
 
 // WithdrawRewards calculates the current rewards of a delegator and transfers the balance to
-// the delegator's wallet. Only the delegator himself can call this transaction.
+// the withdraw address of the delegator. Only the delegator himself can call this transaction.
 func (k msgServer) WithdrawRewards(
 	goCtx context.Context,
 	msg *types.MsgWithdrawRewards,
@@ -24,10 +24,16 @@ func (k msgServer) WithdrawRewards(
 	}
 
 	// Withdraw all rewards of the sender.
-	reward := k.f1WithdrawRewards(ctx, msg.Staker, msg.Creator)
+	reward, coinReward := k.f1WithdrawRewards(ctx, msg.Staker, msg.Creator)
+	withdrawAddress := k.GetWithdrawAddress(ctx, msg.Creator)
 
-	// Transfer reward $KYVE from this module to sender.
-	if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, msg.Creator, reward); err != nil {
+	// Transfer reward $KYVE from this module to the withdraw address.
+	if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, withdrawAddress, reward); err != nil {
+		return nil, err
+	}
+
+	// Transfer rewards in non-native denoms from this module to the withdraw address.
+	if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, withdrawAddress, coinReward); err != nil {
 		return nil, err
 	}
 
@@ -36,6 +42,7 @@ func (k msgServer) WithdrawRewards(
 		Address: msg.Creator,
 		Staker:  msg.Staker,
 		Amount:  reward,
+		Coins:   coinReward,
 	})
 
 	return &types.MsgWithdrawRewardsResponse{}, nil
@@ -10,15 +10,25 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegate{}, "kyve/delegation/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgWithdrawRewards{}, "kyve/delegation/MsgWithdrawRewards", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAllRewards{}, "kyve/delegation/MsgWithdrawAllRewards", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "kyve/delegation/MsgUndelegate", nil)
+	cdc.RegisterConcrete(&MsgCancelUndelegation{}, "kyve/delegation/MsgCancelUndelegation", nil)
 	cdc.RegisterConcrete(&MsgRedelegate{}, "kyve/delegation/MsgRedelegate", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "kyve/delegation/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "kyve/delegation/MsgSetWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgSetInsuranceOptIn{}, "kyve/delegation/MsgSetInsuranceOptIn", nil)
 }
 
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDelegate{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawRewards{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawAllRewards{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUndelegate{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelUndelegation{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRedelegate{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetAutoCompound{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetWithdrawAddress{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetInsuranceOptIn{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
 }
 
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -145,6 +146,9 @@ type DelegationEntry struct {
 	KIndex uint64 `protobuf:"varint,2,opt,name=k_index,json=kIndex,proto3" json:"k_index,omitempty"`
 	// value is the quotient of collected rewards and total stake according to F1-distribution
 	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+	// coin_values are the quotients of collected non-native rewards
+	// and total stake according to F1-distribution
+	CoinValues github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=coin_values,json=coinValues,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"coin_values"`
 }
 
 func (m *DelegationEntry) Reset()         { *m = DelegationEntry{} }
@@ -194,6 +198,13 @@ func (m *DelegationEntry) GetKIndex() uint64 {
 	return 0
 }
 
+func (m *DelegationEntry) GetCoinValues() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CoinValues
+	}
+	return nil
+}
+
 // DelegationPoolData stores general delegation information for every staker
 type DelegationData struct {
 	// Every staker has one DelegationData
@@ -208,6 +219,9 @@ type DelegationData struct {
 	DelegatorCount uint64 `protobuf:"varint,5,opt,name=delegator_count,json=delegatorCount,proto3" json:"delegator_count,omitempty"`
 	// latest_index_was_undelegation helps indicates when an entry can be deleted
 	LatestIndexWasUndelegation bool `protobuf:"varint,6,opt,name=latest_index_was_undelegation,json=latestIndexWasUndelegation,proto3" json:"latest_index_was_undelegation,omitempty"`
+	// current_coin_rewards are the collected non-native rewards
+	// of the current period
+	CurrentCoinRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=current_coin_rewards,json=currentCoinRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_coin_rewards"`
 }
 
 func (m *DelegationData) Reset()         { *m = DelegationData{} }
@@ -285,6 +299,13 @@ func (m *DelegationData) GetLatestIndexWasUndelegation() bool {
 	return false
 }
 
+func (m *DelegationData) GetCurrentCoinRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CurrentCoinRewards
+	}
+	return nil
+}
+
 // DelegationSlash represents an f1-slash
 // these entries needs to be iterated to obtain the current amount of the actual stake
 // Every staker can have n slash-entries
@@ -536,6 +557,345 @@ func (m *RedelegationCooldown) GetCreationDate() uint64 {
 	return 0
 }
 
+// SlashRecord is a persistent record of a slash which happened to a
+// staker. In contrast to the DelegationSlash entries, which are only
+// needed by the F1-algorithm, it contains the full context of the slash.
+type SlashRecord struct {
+	// id is a unique identifier of the slash record
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// staker who got slashed
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id of the pool in which the staker got slashed
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// slash_type ...
+	SlashType SlashType `protobuf:"varint,4,opt,name=slash_type,json=slashType,proto3,enum=kyve.delegation.v1beta1.SlashType" json:"slash_type,omitempty"`
+	// fraction that got slashed
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// amount that got slashed in ukyve
+	Amount uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// height is the block height at which the slash happened
+	Height uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// bundle_id of the bundle in the pool the slash is related to
+	BundleId uint64 `protobuf:"varint,8,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// insurance_payout is the amount in ukyve which got reimbursed
+	// to the delegators from the insurance fund
+	InsurancePayout uint64 `protobuf:"varint,9,opt,name=insurance_payout,json=insurancePayout,proto3" json:"insurance_payout,omitempty"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{7}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetSlashType() SlashType {
+	if m != nil {
+		return m.SlashType
+	}
+	return SLASH_TYPE_UNSPECIFIED
+}
+
+func (m *SlashRecord) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetInsurancePayout() uint64 {
+	if m != nil {
+		return m.InsurancePayout
+	}
+	return 0
+}
+
+// InsuranceFund holds the state of the insurance fund which reimburses
+// delegators of stakers which received a timeout slash. The funds are
+// held by the delegation module account.
+type InsuranceFund struct {
+	// balance is the current amount in ukyve of the insurance fund
+	Balance uint64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// total_deposited is the total amount in ukyve which was deposited
+	TotalDeposited uint64 `protobuf:"varint,2,opt,name=total_deposited,json=totalDeposited,proto3" json:"total_deposited,omitempty"`
+	// total_claimed is the total amount in ukyve which was reimbursed
+	TotalClaimed uint64 `protobuf:"varint,3,opt,name=total_claimed,json=totalClaimed,proto3" json:"total_claimed,omitempty"`
+}
+
+func (m *InsuranceFund) Reset()         { *m = InsuranceFund{} }
+func (m *InsuranceFund) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFund) ProtoMessage()    {}
+func (*InsuranceFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{8}
+}
+func (m *InsuranceFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFund.Merge(m, src)
+}
+func (m *InsuranceFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFund proto.InternalMessageInfo
+
+func (m *InsuranceFund) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *InsuranceFund) GetTotalDeposited() uint64 {
+	if m != nil {
+		return m.TotalDeposited
+	}
+	return 0
+}
+
+func (m *InsuranceFund) GetTotalClaimed() uint64 {
+	if m != nil {
+		return m.TotalClaimed
+	}
+	return 0
+}
+
+// AutoCompound marks a delegation whose rewards get automatically
+// re-delegated to the same staker every time rewards are paid out.
+type AutoCompound struct {
+	// staker is the address of the protocol node
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// delegator is the address of the delegator
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *AutoCompound) Reset()         { *m = AutoCompound{} }
+func (m *AutoCompound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompound) ProtoMessage()    {}
+func (*AutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{9}
+}
+func (m *AutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompound.Merge(m, src)
+}
+func (m *AutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompound proto.InternalMessageInfo
+
+func (m *AutoCompound) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *AutoCompound) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+// WithdrawAddress is the address which receives all rewards and
+// undelegated tokens of a delegator or staker.
+type WithdrawAddress struct {
+	// address is the address of the delegator or staker
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// withdraw_address is the address which receives the payouts
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *WithdrawAddress) Reset()         { *m = WithdrawAddress{} }
+func (m *WithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*WithdrawAddress) ProtoMessage()    {}
+func (*WithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{10}
+}
+func (m *WithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawAddress.Merge(m, src)
+}
+func (m *WithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawAddress proto.InternalMessageInfo
+
+func (m *WithdrawAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// InsuranceOptIn marks a staker whose delegators get reimbursed
+// from the insurance fund after a timeout slash.
+type InsuranceOptIn struct {
+	// staker who opted in to the insurance
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+}
+
+func (m *InsuranceOptIn) Reset()         { *m = InsuranceOptIn{} }
+func (m *InsuranceOptIn) String() string { return proto.CompactTextString(m) }
+func (*InsuranceOptIn) ProtoMessage()    {}
+func (*InsuranceOptIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{11}
+}
+func (m *InsuranceOptIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceOptIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceOptIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceOptIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceOptIn.Merge(m, src)
+}
+func (m *InsuranceOptIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceOptIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceOptIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceOptIn proto.InternalMessageInfo
+
+func (m *InsuranceOptIn) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("kyve.delegation.v1beta1.SlashType", SlashType_name, SlashType_value)
 	proto.RegisterType((*Delegator)(nil), "kyve.delegation.v1beta1.Delegator")
@@ -545,6 +905,11 @@ func init() {
 	proto.RegisterType((*UndelegationQueueEntry)(nil), "kyve.delegation.v1beta1.UndelegationQueueEntry")
 	proto.RegisterType((*QueueState)(nil), "kyve.delegation.v1beta1.QueueState")
 	proto.RegisterType((*RedelegationCooldown)(nil), "kyve.delegation.v1beta1.RedelegationCooldown")
+	proto.RegisterType((*SlashRecord)(nil), "kyve.delegation.v1beta1.SlashRecord")
+	proto.RegisterType((*InsuranceFund)(nil), "kyve.delegation.v1beta1.InsuranceFund")
+	proto.RegisterType((*AutoCompound)(nil), "kyve.delegation.v1beta1.AutoCompound")
+	proto.RegisterType((*WithdrawAddress)(nil), "kyve.delegation.v1beta1.WithdrawAddress")
+	proto.RegisterType((*InsuranceOptIn)(nil), "kyve.delegation.v1beta1.InsuranceOptIn")
 }
 
 func init() {
@@ -552,49 +917,69 @@ func init() {
 }
 
 var fileDescriptor_e07f10cb3da486ac = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x8e, 0x03, 0x04, 0x3c, 0x82, 0x24, 0xbf, 0xfd, 0xd1, 0x10, 0xa5, 0xc5, 0x20, 0xf7, 0x5f,
-	0x5a, 0xa9, 0xb1, 0x50, 0x9f, 0x20, 0x60, 0x57, 0xa4, 0x50, 0xa0, 0xf9, 0x43, 0x45, 0x2f, 0xd6,
-	0x62, 0x6f, 0x13, 0xcb, 0x8e, 0x97, 0xda, 0x6b, 0x42, 0x8e, 0xbd, 0x71, 0x42, 0x7d, 0x85, 0xaa,
-	0x2f, 0xc3, 0x91, 0x63, 0xd5, 0x03, 0xaa, 0xe0, 0x45, 0xaa, 0xac, 0x97, 0x64, 0x53, 0x89, 0x03,
-	0xa7, 0x64, 0xbe, 0x19, 0xcf, 0xf7, 0xcd, 0x37, 0xa3, 0x85, 0xaa, 0x3f, 0x3c, 0x25, 0x86, 0x4b,
-	0x02, 0xd2, 0xc5, 0xcc, 0xa3, 0xa1, 0x71, 0xba, 0x71, 0x4c, 0x18, 0xde, 0x90, 0xa0, 0xda, 0x49,
-	0x44, 0x19, 0x45, 0x2b, 0xa3, 0xca, 0x9a, 0x04, 0x8b, 0xca, 0xca, 0x72, 0x97, 0x76, 0x29, 0xaf,
-	0x31, 0x46, 0xff, 0xd2, 0x72, 0xfd, 0x9b, 0x02, 0xaa, 0x99, 0x16, 0xd3, 0x08, 0x95, 0x20, 0x17,
-	0x33, 0xec, 0x93, 0xa8, 0xac, 0xac, 0x2b, 0x55, 0xb5, 0x29, 0x22, 0xf4, 0x04, 0x54, 0xf7, 0xae,
-	0xa8, 0x9c, 0xe5, 0xa9, 0x09, 0x80, 0x56, 0x60, 0xde, 0xb7, 0xbd, 0xd0, 0x25, 0x67, 0xe5, 0x99,
-	0x75, 0xa5, 0x3a, 0xdb, 0xcc, 0xf9, 0x8d, 0x51, 0x84, 0x9e, 0x43, 0xde, 0x0b, 0x3d, 0xe6, 0xe1,
-	0xc0, 0xc6, 0x7d, 0x9a, 0x84, 0xac, 0x3c, 0xcb, 0xf3, 0x4b, 0x02, 0xad, 0x73, 0x50, 0x3f, 0x57,
-	0xa0, 0x60, 0x8e, 0x05, 0x5b, 0x21, 0x8b, 0x86, 0xf7, 0x2a, 0x91, 0xb8, 0xb2, 0x53, 0x5c, 0x26,
-	0xcc, 0x9d, 0xe2, 0x20, 0x21, 0x5c, 0x82, 0xba, 0x59, 0xbb, 0xbc, 0x5e, 0xcb, 0xfc, 0xbe, 0x5e,
-	0x7b, 0xd1, 0xf5, 0x58, 0x2f, 0x39, 0xae, 0x39, 0xb4, 0x6f, 0x38, 0x34, 0xee, 0xd3, 0x58, 0xfc,
-	0xbc, 0x89, 0x5d, 0xdf, 0x60, 0xc3, 0x13, 0x12, 0xd7, 0x4c, 0xe2, 0x34, 0xd3, 0x8f, 0xf5, 0x8b,
-	0x2c, 0xe4, 0x27, 0x52, 0x4c, 0xcc, 0xf0, 0xbd, 0x4a, 0x5e, 0x42, 0xc1, 0x49, 0xa2, 0x88, 0x84,
-	0xcc, 0x8e, 0xc8, 0x00, 0x47, 0x6e, 0x2c, 0x14, 0xe5, 0x05, 0xdc, 0x4c, 0x51, 0xf4, 0x0a, 0x8a,
-	0x8c, 0x32, 0x1c, 0xd8, 0x93, 0xa5, 0x08, 0x9f, 0x0a, 0x1c, 0x9f, 0xf0, 0xa1, 0x67, 0x90, 0x0f,
-	0x30, 0x23, 0x31, 0x4b, 0x47, 0xb4, 0x7d, 0x61, 0xd8, 0x62, 0x8a, 0xf2, 0x49, 0x77, 0x46, 0xcc,
-	0x63, 0xf3, 0x6d, 0x87, 0xfb, 0x3a, 0x97, 0x32, 0x8f, 0xe1, 0xad, 0x11, 0x8a, 0xea, 0xb0, 0x3a,
-	0xd5, 0x6e, 0x80, 0x63, 0x3b, 0x09, 0x25, 0x19, 0xb9, 0x75, 0xa5, 0xba, 0xd0, 0xac, 0x48, 0xdd,
-	0x3f, 0xe1, 0xb8, 0x23, 0x55, 0xe8, 0x17, 0x53, 0xbb, 0x69, 0x05, 0x38, 0xee, 0x3d, 0x7c, 0x37,
-	0xef, 0x61, 0xe1, 0x4b, 0x84, 0x9d, 0xf1, 0xe4, 0x0f, 0x5f, 0xcf, 0xf8, 0x7b, 0xfd, 0x87, 0x02,
-	0x25, 0x59, 0xe1, 0xc7, 0x84, 0x24, 0x24, 0xbd, 0x99, 0x65, 0x98, 0x4b, 0xd9, 0x15, 0xce, 0x9e,
-	0x06, 0x92, 0xda, 0xec, 0xfd, 0x37, 0x3d, 0xf3, 0xef, 0x4d, 0x97, 0x20, 0x37, 0x75, 0xb2, 0x22,
-	0x42, 0x4f, 0x61, 0xc9, 0x89, 0x08, 0x67, 0xb6, 0x99, 0xd7, 0x27, 0xc2, 0xf9, 0xc5, 0x3b, 0xb0,
-	0xed, 0xf5, 0x89, 0xbe, 0x0d, 0xc0, 0x65, 0xb5, 0x18, 0x66, 0x04, 0x3d, 0x06, 0x35, 0xa0, 0x03,
-	0x5b, 0x96, 0xb6, 0x10, 0xd0, 0x41, 0x6a, 0xcd, 0x2a, 0x40, 0xcf, 0xeb, 0xf6, 0xa6, 0x6c, 0x53,
-	0x47, 0x08, 0x4f, 0xeb, 0x1d, 0x58, 0x6e, 0x92, 0xc9, 0xb0, 0x5b, 0x94, 0x06, 0x2e, 0x1d, 0x84,
-	0xa8, 0x0c, 0xf3, 0xd8, 0x75, 0x23, 0x12, 0xc7, 0x62, 0x07, 0x77, 0xe1, 0x94, 0x40, 0x17, 0x33,
-	0x22, 0x7a, 0x8e, 0x05, 0x9a, 0x98, 0x91, 0xd7, 0x5f, 0x41, 0xe5, 0xab, 0x6c, 0x0f, 0x4f, 0x08,
-	0xaa, 0x40, 0xa9, 0xb5, 0x5b, 0x6f, 0x6d, 0xdb, 0xed, 0xa3, 0x03, 0xcb, 0xee, 0xec, 0xb5, 0x0e,
-	0xac, 0xad, 0xc6, 0xbb, 0x86, 0x65, 0x16, 0x33, 0xa8, 0x04, 0x48, 0xca, 0xb5, 0x1b, 0x1f, 0xac,
-	0xfd, 0x4e, 0xbb, 0xa8, 0xa0, 0xff, 0xa1, 0x20, 0xe1, 0x87, 0xfb, 0x6d, 0xab, 0x98, 0x45, 0x8f,
-	0xe0, 0x3f, 0xb9, 0xd1, 0xc1, 0xee, 0x7e, 0xdd, 0x2c, 0xce, 0x54, 0x66, 0xcf, 0x7f, 0x6a, 0x99,
-	0xcd, 0xc6, 0xe5, 0x8d, 0xa6, 0x5c, 0xdd, 0x68, 0xca, 0x9f, 0x1b, 0x4d, 0xf9, 0x7e, 0xab, 0x65,
-	0xae, 0x6e, 0xb5, 0xcc, 0xaf, 0x5b, 0x2d, 0xf3, 0xd9, 0x90, 0x6e, 0x60, 0xe7, 0xe8, 0xd0, 0xda,
-	0x23, 0x6c, 0x40, 0x23, 0xdf, 0x70, 0x7a, 0xd8, 0x0b, 0x8d, 0x33, 0xf9, 0xd5, 0xe3, 0x07, 0x71,
-	0x9c, 0xe3, 0x4f, 0xd7, 0xdb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x40, 0x35, 0xc4, 0x38, 0x15,
-	0x05, 0x00, 0x00,
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0x1b, 0x55,
+	0x14, 0xf6, 0x38, 0x89, 0x13, 0x9f, 0x24, 0xb6, 0xb9, 0x04, 0xc7, 0x98, 0xd6, 0x89, 0x86, 0x3f,
+	0x17, 0x84, 0x4d, 0xdb, 0x27, 0x70, 0x6c, 0x57, 0x35, 0x2d, 0x4d, 0xb0, 0x9d, 0x54, 0x65, 0x33,
+	0xba, 0x9e, 0xb9, 0xd8, 0x57, 0x1e, 0xcf, 0x35, 0x33, 0x77, 0x32, 0xf5, 0x82, 0x05, 0x3b, 0x36,
+	0x20, 0x5e, 0x01, 0xb1, 0xe3, 0x49, 0xba, 0xec, 0x06, 0x09, 0xb1, 0x28, 0x28, 0x79, 0x0d, 0x16,
+	0xe8, 0xfe, 0x8c, 0x3d, 0x83, 0xb0, 0xd4, 0xa8, 0xab, 0xcc, 0xf9, 0xee, 0xcf, 0x77, 0xce, 0xf7,
+	0x9d, 0x7b, 0x62, 0xa8, 0x4f, 0x17, 0x97, 0xa4, 0xe9, 0x10, 0x97, 0x8c, 0x31, 0xa7, 0xcc, 0x6b,
+	0x5e, 0xde, 0x1d, 0x11, 0x8e, 0xef, 0x26, 0xa0, 0xc6, 0xdc, 0x67, 0x9c, 0xa1, 0x43, 0xb1, 0xb3,
+	0x91, 0x80, 0xf5, 0xce, 0x6a, 0xcd, 0x66, 0xc1, 0x8c, 0x05, 0xcd, 0x11, 0x0e, 0xc8, 0xf2, 0xb8,
+	0xcd, 0xa8, 0x3e, 0x58, 0x3d, 0x18, 0xb3, 0x31, 0x93, 0x9f, 0x4d, 0xf1, 0xa5, 0x50, 0xf3, 0x7b,
+	0x03, 0xf2, 0x1d, 0x75, 0x19, 0xf3, 0x51, 0x19, 0x72, 0x01, 0xc7, 0x53, 0xe2, 0x57, 0x8c, 0x63,
+	0xa3, 0x9e, 0xef, 0xeb, 0x08, 0xdd, 0x82, 0xbc, 0x13, 0x6f, 0xaa, 0x64, 0xe5, 0xd2, 0x0a, 0x40,
+	0x87, 0xb0, 0x3d, 0xb5, 0xa8, 0xe7, 0x90, 0xe7, 0x95, 0x8d, 0x63, 0xa3, 0xbe, 0xd9, 0xcf, 0x4d,
+	0x7b, 0x22, 0x42, 0x1f, 0x42, 0x81, 0x7a, 0x94, 0x53, 0xec, 0x5a, 0x78, 0xc6, 0x42, 0x8f, 0x57,
+	0x36, 0xe5, 0xfa, 0xbe, 0x46, 0x5b, 0x12, 0x34, 0xff, 0x31, 0xa0, 0xd8, 0x59, 0x16, 0xd4, 0xf5,
+	0xb8, 0xbf, 0x58, 0x9b, 0x49, 0x82, 0x2b, 0x9b, 0xe2, 0xea, 0xc0, 0xd6, 0x25, 0x76, 0x43, 0x22,
+	0x53, 0xc8, 0x9f, 0x34, 0x5e, 0xbc, 0x3a, 0xca, 0xfc, 0xf9, 0xea, 0xe8, 0xa3, 0x31, 0xe5, 0x93,
+	0x70, 0xd4, 0xb0, 0xd9, 0xac, 0xa9, 0x05, 0x52, 0x7f, 0x3e, 0x0b, 0x9c, 0x69, 0x93, 0x2f, 0xe6,
+	0x24, 0x68, 0x74, 0x88, 0xdd, 0x57, 0x87, 0x91, 0x0f, 0xbb, 0x42, 0x32, 0x4b, 0x46, 0x41, 0x65,
+	0xf3, 0x78, 0xa3, 0xbe, 0x7b, 0xef, 0x56, 0x43, 0x1d, 0x69, 0x08, 0x69, 0x63, 0xbd, 0xc5, 0xa9,
+	0x36, 0xa3, 0xde, 0xc9, 0x7d, 0xc1, 0xf4, 0xdb, 0x5f, 0x47, 0x9f, 0xbe, 0x1e, 0x93, 0x38, 0x13,
+	0xf4, 0x41, 0xb0, 0x5c, 0x48, 0x12, 0xf3, 0xc7, 0x0d, 0x28, 0xac, 0xca, 0xef, 0x60, 0x8e, 0xd7,
+	0x56, 0xff, 0x31, 0x14, 0xed, 0xd0, 0xf7, 0x89, 0xc7, 0x2d, 0x9f, 0x44, 0xd8, 0x77, 0x02, 0xad,
+	0x42, 0x41, 0xc3, 0x7d, 0x85, 0xa2, 0x3b, 0x50, 0xe2, 0x8c, 0x63, 0xd7, 0x5a, 0x35, 0x8a, 0xf6,
+	0xa6, 0x28, 0xf1, 0x15, 0x1f, 0xfa, 0x00, 0x0a, 0x2e, 0xe6, 0x24, 0xe0, 0x4a, 0x56, 0x6b, 0xaa,
+	0x4d, 0xda, 0x53, 0xa8, 0x54, 0xf7, 0x91, 0x60, 0x5e, 0x1a, 0x6e, 0xd9, 0xd2, 0xcb, 0x2d, 0xc5,
+	0xbc, 0x84, 0xdb, 0x02, 0x45, 0x2d, 0xb8, 0x9d, 0xba, 0x2e, 0xc2, 0x81, 0x15, 0x7a, 0x89, 0x34,
+	0x72, 0xc7, 0x46, 0x7d, 0xa7, 0x5f, 0x4d, 0xdc, 0xfe, 0x14, 0x07, 0xe7, 0x89, 0x1d, 0xe8, 0x3b,
+	0x38, 0x88, 0xab, 0x94, 0x66, 0xc4, 0xa5, 0x6e, 0x4b, 0x37, 0xde, 0xfd, 0x5f, 0x37, 0xa4, 0x15,
+	0x9f, 0x6b, 0x2b, 0xea, 0xaf, 0x61, 0x85, 0xf2, 0x01, 0x69, 0x22, 0x11, 0x69, 0xed, 0xcc, 0x9f,
+	0x52, 0xed, 0x38, 0x70, 0x71, 0x30, 0xb9, 0x79, 0x3b, 0x7e, 0x01, 0x3b, 0xdf, 0xf8, 0xd8, 0x5e,
+	0x0a, 0x7f, 0xf3, 0x8e, 0x5c, 0x9e, 0x37, 0x7f, 0x31, 0xa0, 0x9c, 0x14, 0xe8, 0xab, 0x90, 0x84,
+	0x44, 0x3d, 0x93, 0x03, 0xd8, 0x52, 0xec, 0x86, 0x64, 0x57, 0x41, 0x22, 0xdb, 0xec, 0xfa, 0x67,
+	0xbc, 0xf1, 0xdf, 0x67, 0x5c, 0x86, 0x5c, 0xea, 0x95, 0xea, 0x08, 0xbd, 0x0f, 0xfb, 0xb6, 0x4f,
+	0x24, 0xb3, 0xc5, 0xe9, 0x8c, 0x68, 0xe3, 0xf7, 0x62, 0x70, 0x48, 0x67, 0xc4, 0x7c, 0x08, 0x20,
+	0xd3, 0x1a, 0x70, 0xcc, 0x09, 0x7a, 0x0f, 0xf2, 0x2e, 0x8b, 0xac, 0x64, 0x6a, 0x3b, 0x2e, 0x8b,
+	0x94, 0x34, 0xb7, 0x01, 0x26, 0x74, 0x3c, 0x49, 0xc9, 0x96, 0x17, 0x88, 0x5c, 0x36, 0xcf, 0xe1,
+	0xa0, 0x4f, 0x56, 0xc5, 0xb6, 0x19, 0x73, 0x1d, 0x16, 0x79, 0xa8, 0x02, 0xdb, 0xd8, 0x71, 0x7c,
+	0x12, 0x04, 0xda, 0x83, 0x38, 0x4c, 0x25, 0xe8, 0x60, 0x4e, 0x2a, 0xd9, 0x74, 0x82, 0x1d, 0xcc,
+	0x89, 0xf9, 0x7b, 0x16, 0x76, 0xa5, 0x97, 0x7d, 0x62, 0x33, 0xdf, 0x41, 0x05, 0xc8, 0x52, 0x47,
+	0xe7, 0x96, 0xa5, 0xce, 0x5a, 0xcd, 0x0e, 0x61, 0x7b, 0xce, 0x98, 0x6b, 0x51, 0x27, 0x1e, 0x6e,
+	0x22, 0xec, 0x39, 0xa8, 0x05, 0x10, 0x88, 0xfb, 0x2c, 0x61, 0x99, 0x94, 0xac, 0x70, 0xcf, 0x6c,
+	0xac, 0x99, 0xce, 0x0d, 0x49, 0x3d, 0x5c, 0xcc, 0x49, 0x3f, 0x1f, 0xc4, 0x9f, 0xa9, 0x26, 0xd9,
+	0x7a, 0xb3, 0x26, 0x49, 0xb8, 0x97, 0x4b, 0xb9, 0x57, 0x86, 0xdc, 0x84, 0xd0, 0xf1, 0x84, 0x57,
+	0xb6, 0x15, 0xae, 0x22, 0x61, 0xd1, 0x28, 0xf4, 0x1c, 0x97, 0x88, 0xca, 0x76, 0x94, 0x45, 0x0a,
+	0xe8, 0x39, 0x62, 0x7c, 0x50, 0x2f, 0x08, 0x7d, 0xec, 0xd9, 0xc4, 0x9a, 0xe3, 0x05, 0x0b, 0x79,
+	0x25, 0xaf, 0xc6, 0xc7, 0x12, 0x3f, 0x93, 0xb0, 0x19, 0xc1, 0x7e, 0x2f, 0x86, 0x1e, 0x84, 0x9e,
+	0x23, 0x7c, 0x1a, 0x61, 0x57, 0x84, 0x5a, 0xdd, 0x38, 0x14, 0x33, 0x24, 0x1e, 0x4a, 0x73, 0x16,
+	0x50, 0x4e, 0x9c, 0x78, 0x7a, 0xe9, 0x99, 0xa4, 0x51, 0x61, 0xa8, 0xda, 0x68, 0xbb, 0x98, 0xce,
+	0x48, 0xac, 0xfc, 0x9e, 0x04, 0xdb, 0x0a, 0x33, 0x3b, 0xb0, 0xd7, 0x0a, 0x39, 0x6b, 0xb3, 0xd9,
+	0x9c, 0x85, 0x5e, 0xd2, 0xc0, 0x1b, 0xfc, 0xef, 0x32, 0x2f, 0xa0, 0xf8, 0x94, 0xf2, 0x89, 0xe3,
+	0xe3, 0xa8, 0xa5, 0xdb, 0x69, 0x7d, 0xa3, 0xdd, 0x81, 0x52, 0xa4, 0x37, 0x5b, 0xf1, 0x16, 0x75,
+	0x63, 0x31, 0x4a, 0x5f, 0x62, 0xd6, 0xa1, 0xb0, 0x94, 0xe5, 0x74, 0xce, 0x7b, 0xde, 0xba, 0xfc,
+	0x3e, 0xf9, 0x16, 0xf2, 0xcb, 0xe6, 0x40, 0x55, 0x28, 0x0f, 0x1e, 0xb7, 0x06, 0x0f, 0xad, 0xe1,
+	0xb3, 0xb3, 0xae, 0x75, 0xfe, 0x64, 0x70, 0xd6, 0x6d, 0xf7, 0x1e, 0xf4, 0xba, 0x9d, 0x52, 0x06,
+	0x95, 0x01, 0x25, 0xd6, 0x86, 0xbd, 0x2f, 0xbb, 0xa7, 0xe7, 0xc3, 0x92, 0x81, 0xde, 0x86, 0x62,
+	0x02, 0xbf, 0x38, 0x1d, 0x76, 0x4b, 0x59, 0xf4, 0x0e, 0xbc, 0x95, 0xbc, 0xe8, 0xec, 0xf1, 0x69,
+	0xab, 0x53, 0xda, 0xa8, 0x6e, 0xfe, 0xf0, 0x6b, 0x2d, 0x73, 0xd2, 0x7b, 0x71, 0x55, 0x33, 0x5e,
+	0x5e, 0xd5, 0x8c, 0xbf, 0xaf, 0x6a, 0xc6, 0xcf, 0xd7, 0xb5, 0xcc, 0xcb, 0xeb, 0x5a, 0xe6, 0x8f,
+	0xeb, 0x5a, 0xe6, 0xeb, 0x66, 0xa2, 0xef, 0x1e, 0x3d, 0xbb, 0xe8, 0x3e, 0x21, 0x3c, 0x62, 0xfe,
+	0xb4, 0x69, 0x4f, 0x30, 0xf5, 0x9a, 0xcf, 0x93, 0xbf, 0x50, 0x64, 0x13, 0x8e, 0x72, 0xf2, 0x67,
+	0xc4, 0xfd, 0x7f, 0x07, 0x00, 0x30, 0x96, 0x23, 0x5b, 0xc1, 0x08, 0x00, 0x00,
 }
 
 func (m *Delegator) Marshal() (dAtA []byte, err error) {
@@ -664,6 +1049,20 @@ func (m *DelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CoinValues) > 0 {
+		for iNdEx := len(m.CoinValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -709,6 +1108,20 @@ func (m *DelegationData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CurrentCoinRewards) > 0 {
+		for iNdEx := len(m.CurrentCoinRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentCoinRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.LatestIndexWasUndelegation {
 		i--
 		if m.LatestIndexWasUndelegation {
@@ -914,42 +1327,259 @@ func (m *RedelegationCooldown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDelegation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Delegator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovDelegation(uint64(l))
+	if m.InsurancePayout != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.InsurancePayout))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovDelegation(uint64(l))
+	if m.BundleId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.KIndex != 0 {
-		n += 1 + sovDelegation(uint64(m.KIndex))
+	if m.Height != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.InitialAmount != 0 {
-		n += 1 + sovDelegation(uint64(m.InitialAmount))
+	if m.Amount != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *DelegationEntry) Size() (n int) {
-	if m == nil {
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SlashType != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.SlashType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalClaimed != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.TotalClaimed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalDeposited != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.TotalDeposited))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Balance != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceOptIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceOptIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceOptIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Delegator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.KIndex != 0 {
+		n += 1 + sovDelegation(uint64(m.KIndex))
+	}
+	if m.InitialAmount != 0 {
+		n += 1 + sovDelegation(uint64(m.InitialAmount))
+	}
+	return n
+}
+
+func (m *DelegationEntry) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -963,6 +1593,12 @@ func (m *DelegationEntry) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovDelegation(uint64(l))
+	if len(m.CoinValues) > 0 {
+		for _, e := range m.CoinValues {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -991,6 +1627,12 @@ func (m *DelegationData) Size() (n int) {
 	if m.LatestIndexWasUndelegation {
 		n += 2
 	}
+	if len(m.CurrentCoinRewards) > 0 {
+		for _, e := range m.CurrentCoinRewards {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1050,32 +1692,801 @@ func (m *QueueState) Size() (n int) {
 	if m.HighIndex != 0 {
 		n += 1 + sovDelegation(uint64(m.HighIndex))
 	}
-	return n
-}
+	return n
+}
+
+func (m *RedelegationCooldown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.CreationDate != 0 {
+		n += 1 + sovDelegation(uint64(m.CreationDate))
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDelegation(uint64(m.Id))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovDelegation(uint64(m.PoolId))
+	}
+	if m.SlashType != 0 {
+		n += 1 + sovDelegation(uint64(m.SlashType))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovDelegation(uint64(m.Amount))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDelegation(uint64(m.Height))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovDelegation(uint64(m.BundleId))
+	}
+	if m.InsurancePayout != 0 {
+		n += 1 + sovDelegation(uint64(m.InsurancePayout))
+	}
+	return n
+}
+
+func (m *InsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		n += 1 + sovDelegation(uint64(m.Balance))
+	}
+	if m.TotalDeposited != 0 {
+		n += 1 + sovDelegation(uint64(m.TotalDeposited))
+	}
+	if m.TotalClaimed != 0 {
+		n += 1 + sovDelegation(uint64(m.TotalClaimed))
+	}
+	return n
+}
+
+func (m *AutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *InsuranceOptIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func sovDelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelegation(x uint64) (n int) {
+	return sovDelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Delegator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+			}
+			m.KIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+			}
+			m.InitialAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+			}
+			m.KIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinValues = append(m.CoinValues, types.DecCoin{})
+			if err := m.CoinValues[len(m.CoinValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewards", wireType)
+			}
+			m.CurrentRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRewards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			}
+			m.TotalDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestIndexK", wireType)
+			}
+			m.LatestIndexK = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestIndexK |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorCount", wireType)
+			}
+			m.DelegatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestIndexWasUndelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatestIndexWasUndelegation = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentCoinRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentCoinRewards = append(m.CurrentCoinRewards, types.Coin{})
+			if err := m.CurrentCoinRewards[len(m.CurrentCoinRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+			}
+			m.KIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *RedelegationCooldown) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovDelegation(uint64(l))
-	}
-	if m.CreationDate != 0 {
-		n += 1 + sovDelegation(uint64(m.CreationDate))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDelegation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDelegation(x uint64) (n int) {
-	return sovDelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Delegator) Unmarshal(dAtA []byte) error {
+func (m *UndelegationQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1098,13 +2509,32 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegator: wiretype end group for non-group")
+			return fmt.Errorf("proto: UndelegationQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UndelegationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
@@ -1136,7 +2566,7 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 			}
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
@@ -1168,11 +2598,11 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 			}
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.KIndex = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1182,16 +2612,16 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KIndex |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
 			}
-			m.InitialAmount = 0
+			m.CreationTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1201,7 +2631,7 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialAmount |= uint64(b&0x7F) << shift
+				m.CreationTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1227,7 +2657,7 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *QueueState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1250,17 +2680,17 @@ func (m *DelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowIndex", wireType)
 			}
-			var stringLen uint64
+			m.LowIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1270,29 +2700,16 @@ func (m *DelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LowIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighIndex", wireType)
 			}
-			m.KIndex = 0
+			m.HighIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1302,14 +2719,64 @@ func (m *DelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KIndex |= uint64(b&0x7F) << shift
+				m.HighIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationCooldown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationCooldown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1337,10 +2804,27 @@ func (m *DelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -1362,7 +2846,7 @@ func (m *DelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationData) Unmarshal(dAtA []byte) error {
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1385,13 +2869,32 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationData: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
@@ -1423,11 +2926,11 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 			}
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.CurrentRewards = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1437,16 +2940,16 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentRewards |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashType", wireType)
 			}
-			m.TotalDelegation = 0
+			m.SlashType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1456,16 +2959,50 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalDelegation |= uint64(b&0x7F) << shift
+				m.SlashType |= SlashType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestIndexK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.LatestIndexK = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1475,16 +3012,16 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestIndexK |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.DelegatorCount = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1494,16 +3031,16 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegatorCount |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestIndexWasUndelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
 			}
-			var v int
+			m.BundleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1513,12 +3050,30 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BundleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurancePayout", wireType)
+			}
+			m.InsurancePayout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsurancePayout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LatestIndexWasUndelegation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -1540,7 +3095,7 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationSlash) Unmarshal(dAtA []byte) error {
+func (m *InsuranceFund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1563,17 +3118,17 @@ func (m *DelegationSlash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationSlash: wiretype end group for non-group")
+			return fmt.Errorf("proto: InsuranceFund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			m.Balance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1583,29 +3138,16 @@ func (m *DelegationSlash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Balance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposited", wireType)
 			}
-			m.KIndex = 0
+			m.TotalDeposited = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1615,16 +3157,16 @@ func (m *DelegationSlash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KIndex |= uint64(b&0x7F) << shift
+				m.TotalDeposited |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
 			}
-			var stringLen uint64
+			m.TotalClaimed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1634,26 +3176,11 @@ func (m *DelegationSlash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalClaimed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -1675,7 +3202,7 @@ func (m *DelegationSlash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationQueueEntry) Unmarshal(dAtA []byte) error {
+func (m *AutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1698,32 +3225,13 @@ func (m *UndelegationQueueEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationQueueEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
@@ -1755,7 +3263,7 @@ func (m *UndelegationQueueEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
@@ -1787,44 +3295,6 @@ func (m *UndelegationQueueEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
-			}
-			m.CreationTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -1846,7 +3316,7 @@ func (m *UndelegationQueueEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueState) Unmarshal(dAtA []byte) error {
+func (m *WithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1869,17 +3339,17 @@ func (m *QueueState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueState: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.LowIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1889,16 +3359,29 @@ func (m *QueueState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			m.HighIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1908,11 +3391,24 @@ func (m *QueueState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HighIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -1934,7 +3430,7 @@ func (m *QueueState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
+func (m *InsuranceOptIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1957,15 +3453,15 @@ func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationCooldown: wiretype end group for non-group")
+			return fmt.Errorf("proto: InsuranceOptIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationCooldown: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InsuranceOptIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1993,27 +3489,8 @@ func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
-			}
-			m.CreationDate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationDate |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -11,4 +11,9 @@ var (
 	ErrMultipleRedelegationInSameBlock = sdkErrors.Register(ModuleName, 1003, "only one redelegation per delegator per block")
 	ErrStakerDoesNotExist              = sdkErrors.Register(ModuleName, 1004, "staker does not exist")
 	ErrRedelegationToInactiveStaker    = sdkErrors.Register(ModuleName, 1005, "redelegation to inactive staker not allowed")
+	ErrTooManyStakersToWithdraw        = sdkErrors.Register(ModuleName, 1006, "delegator delegates to %v stakers, maximum is %v")
+	ErrUndelegationNotFound            = sdkErrors.Register(ModuleName, 1007, "undelegation with index %v does not exist for %v")
+	ErrNotEnoughUndelegation           = sdkErrors.Register(ModuleName, 1008, "cancel-amount is larger than pending undelegation")
+	ErrAutoCompoundDelegationTooLow    = sdkErrors.Register(ModuleName, 1009, "delegation of %v is below the minimum of %v for auto-compounding")
+	ErrNotAStaker                      = sdkErrors.Register(ModuleName, 1010, "%v is not a staker")
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/KYVENetwork/chain/util"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -17,6 +18,10 @@ func DefaultGenesis() *GenesisState {
 		UndelegationQueueEntryList: []UndelegationQueueEntry{},
 		QueueStateUndelegation:     QueueState{},
 		RedelegationCooldownList:   []RedelegationCooldown{},
+		SlashRecordList:            []SlashRecord{},
+		AutoCompoundList:           []AutoCompound{},
+		WithdrawAddressList:        []WithdrawAddress{},
+		InsuranceOptInList:         []InsuranceOptIn{},
 	}
 }
 
@@ -34,6 +39,22 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.validateSlashRecords(); err != nil {
+		return err
+	}
+
+	if err := gs.validateAutoCompounds(); err != nil {
+		return err
+	}
+
+	if err := gs.validateWithdrawAddresses(); err != nil {
+		return err
+	}
+
+	if err := gs.validateInsuranceOptIns(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -133,3 +154,77 @@ func (gs *GenesisState) validateRedelegation() error {
 	}
 	return nil
 }
+
+func (gs *GenesisState) validateSlashRecords() error {
+	// Check slash records
+	slashRecordMap := make(map[string]struct{})
+
+	for _, elem := range gs.SlashRecordList {
+		index := string(SlashRecordKey(elem.Id))
+		if _, ok := slashRecordMap[index]; ok {
+			return fmt.Errorf("duplicated index for slash record %v", elem)
+		}
+		if elem.Id >= gs.SlashRecordCount {
+			return fmt.Errorf("slash record id higher than slash record count: %v", elem)
+		}
+
+		slashRecordMap[index] = struct{}{}
+	}
+	return nil
+}
+
+func (gs *GenesisState) validateAutoCompounds() error {
+	// Check auto-compounding delegations
+	delegatorMap := make(map[string]struct{})
+	for _, elem := range gs.DelegatorList {
+		delegatorMap[string(DelegatorKey(elem.Staker, elem.Delegator))] = struct{}{}
+	}
+
+	autoCompoundMap := make(map[string]struct{})
+
+	for _, elem := range gs.AutoCompoundList {
+		index := string(AutoCompoundKey(elem.Staker, elem.Delegator))
+		if _, ok := autoCompoundMap[index]; ok {
+			return fmt.Errorf("duplicated index for auto compound %v", elem)
+		}
+		if _, ok := delegatorMap[string(DelegatorKey(elem.Staker, elem.Delegator))]; !ok {
+			return fmt.Errorf("auto compound for non-existent delegator: %v", elem)
+		}
+
+		autoCompoundMap[index] = struct{}{}
+	}
+	return nil
+}
+
+func (gs *GenesisState) validateWithdrawAddresses() error {
+	// Check withdraw addresses
+	withdrawAddressMap := make(map[string]struct{})
+
+	for _, elem := range gs.WithdrawAddressList {
+		index := string(WithdrawAddressKey(elem.Address))
+		if _, ok := withdrawAddressMap[index]; ok {
+			return fmt.Errorf("duplicated index for withdraw address %v", elem)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.WithdrawAddress); err != nil {
+			return fmt.Errorf("invalid withdraw address %v: %w", elem, err)
+		}
+
+		withdrawAddressMap[index] = struct{}{}
+	}
+	return nil
+}
+
+func (gs *GenesisState) validateInsuranceOptIns() error {
+	// Check insurance opt-ins
+	insuranceOptInMap := make(map[string]struct{})
+
+	for _, elem := range gs.InsuranceOptInList {
+		index := string(InsuranceOptInKey(elem.Staker))
+		if _, ok := insuranceOptInMap[index]; ok {
+			return fmt.Errorf("duplicated index for insurance opt-in %v", elem)
+		}
+
+		insuranceOptInMap[index] = struct{}{}
+	}
+	return nil
+}
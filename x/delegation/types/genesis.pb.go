@@ -41,6 +41,18 @@ type GenesisState struct {
 	QueueStateUndelegation QueueState `protobuf:"bytes,7,opt,name=queue_state_undelegation,json=queueStateUndelegation,proto3" json:"queue_state_undelegation"`
 	// redelegation_cooldown_list ...
 	RedelegationCooldownList []RedelegationCooldown `protobuf:"bytes,8,rep,name=redelegation_cooldown_list,json=redelegationCooldownList,proto3" json:"redelegation_cooldown_list"`
+	// slash_record_list ...
+	SlashRecordList []SlashRecord `protobuf:"bytes,9,rep,name=slash_record_list,json=slashRecordList,proto3" json:"slash_record_list"`
+	// slash_record_count ...
+	SlashRecordCount uint64 `protobuf:"varint,10,opt,name=slash_record_count,json=slashRecordCount,proto3" json:"slash_record_count,omitempty"`
+	// insurance_fund ...
+	InsuranceFund InsuranceFund `protobuf:"bytes,11,opt,name=insurance_fund,json=insuranceFund,proto3" json:"insurance_fund"`
+	// auto_compound_list ...
+	AutoCompoundList []AutoCompound `protobuf:"bytes,12,rep,name=auto_compound_list,json=autoCompoundList,proto3" json:"auto_compound_list"`
+	// withdraw_address_list ...
+	WithdrawAddressList []WithdrawAddress `protobuf:"bytes,13,rep,name=withdraw_address_list,json=withdrawAddressList,proto3" json:"withdraw_address_list"`
+	// insurance_opt_in_list ...
+	InsuranceOptInList []InsuranceOptIn `protobuf:"bytes,14,rep,name=insurance_opt_in_list,json=insuranceOptInList,proto3" json:"insurance_opt_in_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +144,48 @@ func (m *GenesisState) GetRedelegationCooldownList() []RedelegationCooldown {
 	return nil
 }
 
+func (m *GenesisState) GetSlashRecordList() []SlashRecord {
+	if m != nil {
+		return m.SlashRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashRecordCount() uint64 {
+	if m != nil {
+		return m.SlashRecordCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetInsuranceFund() InsuranceFund {
+	if m != nil {
+		return m.InsuranceFund
+	}
+	return InsuranceFund{}
+}
+
+func (m *GenesisState) GetAutoCompoundList() []AutoCompound {
+	if m != nil {
+		return m.AutoCompoundList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawAddressList() []WithdrawAddress {
+	if m != nil {
+		return m.WithdrawAddressList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInsuranceOptInList() []InsuranceOptIn {
+	if m != nil {
+		return m.InsuranceOptInList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.delegation.v1beta1.GenesisState")
 }
@@ -141,35 +195,45 @@ func init() {
 }
 
 var fileDescriptor_0bd28fed64b7905b = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0xef, 0xd2, 0x30,
-	0x1c, 0xc6, 0x37, 0x7f, 0x38, 0x4d, 0x51, 0x0f, 0x13, 0x75, 0x59, 0xe2, 0x20, 0xa8, 0x71, 0x17,
-	0xd7, 0x80, 0x67, 0x2f, 0x08, 0x31, 0x46, 0xe3, 0x1f, 0x88, 0x26, 0x7a, 0x59, 0xba, 0xad, 0x19,
-	0x0b, 0x63, 0x85, 0xb5, 0xe3, 0xcf, 0xbb, 0xf0, 0xea, 0x3b, 0xe2, 0xc8, 0xd1, 0x93, 0x31, 0xf0,
-	0x46, 0xcc, 0xda, 0x0a, 0x25, 0xb2, 0xfc, 0xb8, 0x2d, 0xdf, 0x3d, 0xcf, 0xf3, 0xe9, 0xd3, 0x7c,
-	0x0b, 0x9e, 0x4d, 0xd6, 0x0b, 0x0c, 0x23, 0x9c, 0xe2, 0x18, 0xb1, 0x84, 0x64, 0x70, 0xd1, 0x09,
-	0x30, 0x43, 0x1d, 0x18, 0xe3, 0x0c, 0xd3, 0x84, 0x7a, 0xb3, 0x9c, 0x30, 0x62, 0x3e, 0x2a, 0x65,
-	0xde, 0x51, 0xe6, 0x49, 0x99, 0xdd, 0x88, 0x49, 0x4c, 0xb8, 0x06, 0x96, 0x5f, 0x42, 0x6e, 0xbb,
-	0x55, 0xa9, 0x4a, 0x82, 0x50, 0x3e, 0xad, 0x52, 0xce, 0x50, 0x8e, 0xa6, 0x12, 0xdf, 0xfe, 0x69,
-	0x80, 0x3b, 0x6f, 0xc4, 0x81, 0x46, 0x0c, 0x31, 0x6c, 0xbe, 0x02, 0x86, 0x10, 0x58, 0x7a, 0x4b,
-	0x77, 0xeb, 0xdd, 0xa6, 0x57, 0x71, 0x40, 0xef, 0x13, 0x97, 0xf5, 0x6a, 0x9b, 0xdf, 0x4d, 0x6d,
-	0x28, 0x4d, 0xe6, 0x47, 0x70, 0x4f, 0x4a, 0x49, 0xee, 0xa7, 0x09, 0x65, 0xd6, 0x8d, 0xd6, 0x95,
-	0x5b, 0xef, 0xb6, 0x2b, 0x63, 0xfa, 0xff, 0xe4, 0x32, 0xe9, 0xee, 0xc1, 0xff, 0x3e, 0xa1, 0xcc,
-	0x0c, 0xc0, 0x83, 0xa3, 0xc9, 0xc7, 0x19, 0xcb, 0xd7, 0x22, 0xf7, 0x8a, 0xe7, 0xba, 0xd7, 0xe5,
-	0x26, 0x24, 0x1b, 0x94, 0x26, 0x99, 0x7e, 0x3f, 0x3a, 0x1d, 0x73, 0x86, 0x0f, 0x1a, 0x0a, 0x23,
-	0x42, 0x0c, 0x09, 0x44, 0x8d, 0x23, 0x9e, 0x5f, 0x80, 0xe8, 0x23, 0x86, 0x24, 0xc1, 0x8c, 0x4e,
-	0xa6, 0x67, 0x4a, 0xd0, 0x14, 0xd1, 0xb1, 0x20, 0xdc, 0xbc, 0xb8, 0xc4, 0xa8, 0x34, 0xfd, 0x5f,
-	0x82, 0x8f, 0x39, 0x63, 0x05, 0x1e, 0x17, 0x99, 0x42, 0x99, 0x17, 0xb8, 0xc0, 0xea, 0x85, 0x19,
-	0x9c, 0x05, 0x2b, 0x59, 0x5f, 0x14, 0xf7, 0xe7, 0xd2, 0xac, 0xde, 0x9b, 0x5d, 0x9c, 0xfd, 0xcb,
-	0xc9, 0x21, 0xb0, 0x04, 0x8c, 0x96, 0x1b, 0xe4, 0xab, 0x4a, 0xeb, 0x16, 0x5f, 0xa2, 0x27, 0x95,
-	0x50, 0x1e, 0xc5, 0x37, 0x4f, 0x82, 0x1e, 0xce, 0x0f, 0x13, 0xf5, 0x40, 0xe6, 0x1c, 0xd8, 0x39,
-	0x56, 0xea, 0x85, 0x84, 0xa4, 0x11, 0x59, 0x66, 0xa2, 0xdb, 0x6d, 0xde, 0xed, 0x45, 0x25, 0x66,
-	0xa8, 0x58, 0x5f, 0x4b, 0xa7, 0x04, 0x5a, 0xf9, 0x99, 0x7f, 0x65, 0xaf, 0xde, 0xdb, 0xcd, 0xce,
-	0xd1, 0xb7, 0x3b, 0x47, 0xff, 0xb3, 0x73, 0xf4, 0x1f, 0x7b, 0x47, 0xdb, 0xee, 0x1d, 0xed, 0xd7,
-	0xde, 0xd1, 0xbe, 0xc3, 0x38, 0x61, 0xe3, 0x22, 0xf0, 0x42, 0x32, 0x85, 0xef, 0xbe, 0x7d, 0x1d,
-	0x7c, 0xc0, 0x6c, 0x49, 0xf2, 0x09, 0x0c, 0xc7, 0x28, 0xc9, 0xe0, 0x4a, 0x7d, 0x75, 0x6c, 0x3d,
-	0xc3, 0x34, 0x30, 0xf8, 0x6b, 0x7b, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xa4, 0xfe, 0x14,
-	0x15, 0x04, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x18, 0x85, 0xe3, 0xaf, 0xfd, 0x0a, 0x4c, 0x7f, 0x28, 0xa6, 0x05, 0x2b, 0x12, 0x6e, 0x55, 0x5a,
+	0xc8, 0x02, 0x6c, 0xb5, 0xac, 0x59, 0xb4, 0x69, 0x41, 0x11, 0x88, 0x42, 0x22, 0x8a, 0xca, 0xc6,
+	0x4c, 0xec, 0x21, 0x19, 0x35, 0x99, 0x71, 0xe6, 0xa7, 0x69, 0xee, 0x82, 0x0d, 0xf7, 0xd4, 0x65,
+	0x97, 0xac, 0x10, 0x4a, 0x6e, 0x04, 0x79, 0x66, 0x9c, 0x4c, 0x44, 0xac, 0x74, 0x17, 0x1d, 0x9f,
+	0x73, 0x9e, 0x79, 0xc7, 0x6f, 0x0c, 0xf6, 0x2e, 0x06, 0x97, 0x28, 0x4c, 0x50, 0x07, 0xb5, 0xa0,
+	0xc0, 0x94, 0x84, 0x97, 0xfb, 0x4d, 0x24, 0xe0, 0x7e, 0xd8, 0x42, 0x04, 0x71, 0xcc, 0x83, 0x94,
+	0x51, 0x41, 0xdd, 0xc7, 0x99, 0x2d, 0x98, 0xd8, 0x02, 0x63, 0x2b, 0x6f, 0xb4, 0x68, 0x8b, 0x2a,
+	0x4f, 0x98, 0xfd, 0xd2, 0xf6, 0x72, 0xa5, 0xa8, 0xd5, 0x6a, 0xd0, 0xce, 0xdd, 0x22, 0x67, 0x0a,
+	0x19, 0xec, 0x1a, 0xfc, 0xce, 0x4f, 0x00, 0x56, 0xde, 0xea, 0x03, 0x35, 0x04, 0x14, 0xc8, 0x7d,
+	0x0d, 0x96, 0xb4, 0xc1, 0x73, 0xb6, 0x9d, 0xca, 0xf2, 0xc1, 0x56, 0x50, 0x70, 0xc0, 0xe0, 0xa3,
+	0xb2, 0x1d, 0x2d, 0x5e, 0xff, 0xde, 0x2a, 0xd5, 0x4d, 0xc8, 0x3d, 0x05, 0x6b, 0xc6, 0x4a, 0x59,
+	0xd4, 0xc1, 0x5c, 0x78, 0xff, 0x6d, 0x2f, 0x54, 0x96, 0x0f, 0x76, 0x0a, 0x6b, 0x8e, 0x73, 0xbb,
+	0x69, 0x5a, 0x1d, 0xe7, 0xdf, 0x63, 0x2e, 0xdc, 0x26, 0xd8, 0x9c, 0x84, 0x22, 0x44, 0x04, 0x1b,
+	0xe8, 0xde, 0x05, 0xd5, 0x5b, 0x99, 0xd7, 0x8b, 0x29, 0x39, 0xc9, 0x42, 0xa6, 0xfd, 0x61, 0x32,
+	0x2d, 0x2b, 0x46, 0x04, 0x36, 0x2c, 0x46, 0x02, 0x05, 0xd4, 0x88, 0x45, 0x85, 0x78, 0x7e, 0x0b,
+	0xc4, 0x31, 0x14, 0xd0, 0x10, 0xdc, 0x64, 0x4a, 0x9d, 0x31, 0x04, 0xef, 0x40, 0xde, 0xd6, 0x84,
+	0xff, 0x6f, 0x3d, 0x44, 0x23, 0x0b, 0xfd, 0x3b, 0x84, 0x92, 0x15, 0xe3, 0x0a, 0x3c, 0x91, 0xc4,
+	0xa2, 0xf4, 0x24, 0x92, 0xc8, 0xbe, 0xb0, 0x25, 0xc5, 0x0a, 0x0b, 0x59, 0x9f, 0xad, 0xf4, 0xa7,
+	0x2c, 0x6c, 0xdf, 0x5b, 0x59, 0xce, 0x7c, 0xaa, 0xc8, 0x31, 0xf0, 0x34, 0x8c, 0x0b, 0x28, 0x50,
+	0x64, 0x3b, 0xbd, 0x3b, 0x6a, 0x89, 0x9e, 0x16, 0x42, 0x55, 0x95, 0xda, 0x3c, 0x03, 0x7a, 0xd4,
+	0x1b, 0x2b, 0xf6, 0x81, 0xdc, 0x1e, 0x28, 0x33, 0x64, 0x8d, 0x17, 0x53, 0xda, 0x49, 0x68, 0x9f,
+	0xe8, 0xd9, 0xee, 0xaa, 0xd9, 0x5e, 0x16, 0x62, 0xea, 0x56, 0xb4, 0x6a, 0x92, 0x06, 0xe8, 0xb1,
+	0x19, 0xcf, 0xd4, 0x5c, 0x67, 0xe0, 0x81, 0x7e, 0x55, 0x0c, 0xc5, 0x94, 0x25, 0x9a, 0x74, 0x4f,
+	0x91, 0x76, 0x0b, 0x49, 0xea, 0x85, 0xd4, 0x55, 0xc0, 0x00, 0xee, 0xf3, 0x89, 0xa4, 0x7a, 0x5f,
+	0x00, 0x77, 0xaa, 0x37, 0xa6, 0x92, 0x08, 0x0f, 0x6c, 0x3b, 0x95, 0xc5, 0xfa, 0xba, 0x65, 0xae,
+	0x66, 0xba, 0xdb, 0x00, 0x6b, 0x98, 0x70, 0xc9, 0x20, 0x89, 0x51, 0xf4, 0x5d, 0x92, 0xc4, 0x5b,
+	0x56, 0x77, 0xfa, 0xac, 0xf0, 0x08, 0xb5, 0xdc, 0xfe, 0x46, 0x92, 0xfc, 0x10, 0xab, 0xd8, 0x16,
+	0xdd, 0x73, 0xe0, 0x42, 0x29, 0x68, 0x14, 0xd3, 0x6e, 0x4a, 0x25, 0x31, 0xb3, 0xad, 0xa8, 0xd9,
+	0xf6, 0x0a, 0x8b, 0x0f, 0xa5, 0xa0, 0x55, 0x93, 0x30, 0xbd, 0xeb, 0xd0, 0xd2, 0xf2, 0x5d, 0xef,
+	0x63, 0xd1, 0x4e, 0x18, 0xec, 0x47, 0x30, 0x49, 0x18, 0xe2, 0x5c, 0xb7, 0xaf, 0xce, 0xd9, 0xf5,
+	0x2f, 0x26, 0x75, 0xa8, 0x43, 0xf9, 0xae, 0xf7, 0xa7, 0x65, 0xc5, 0xf8, 0x06, 0x36, 0x27, 0x77,
+	0x42, 0x53, 0x11, 0x61, 0xb3, 0x07, 0x6b, 0x73, 0xfe, 0xb1, 0xe3, 0xab, 0x39, 0x4d, 0x45, 0x2d,
+	0xdf, 0x00, 0x17, 0x4f, 0xa9, 0x19, 0xe1, 0xa8, 0x76, 0x3d, 0xf4, 0x9d, 0x9b, 0xa1, 0xef, 0xfc,
+	0x19, 0xfa, 0xce, 0x8f, 0x91, 0x5f, 0xba, 0x19, 0xf9, 0xa5, 0x5f, 0x23, 0xbf, 0xf4, 0x35, 0x6c,
+	0x61, 0xd1, 0x96, 0xcd, 0x20, 0xa6, 0xdd, 0xf0, 0xdd, 0xf9, 0xd9, 0xc9, 0x07, 0x24, 0xfa, 0x94,
+	0x5d, 0x84, 0x71, 0x1b, 0x62, 0x12, 0x5e, 0xd9, 0x5f, 0x5c, 0x31, 0x48, 0x11, 0x6f, 0x2e, 0xa9,
+	0x2f, 0xed, 0xab, 0xbf, 0x03, 0x00, 0x30, 0x1b, 0x11, 0x47, 0x11, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -192,6 +256,77 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InsuranceOptInList) > 0 {
+		for iNdEx := len(m.InsuranceOptInList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceOptInList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.WithdrawAddressList) > 0 {
+		for iNdEx := len(m.WithdrawAddressList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawAddressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AutoCompoundList) > 0 {
+		for iNdEx := len(m.AutoCompoundList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.InsuranceFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.SlashRecordCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashRecordCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SlashRecordList) > 0 {
+		for iNdEx := len(m.SlashRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.RedelegationCooldownList) > 0 {
 		for iNdEx := len(m.RedelegationCooldownList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -356,6 +491,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashRecordList) > 0 {
+		for _, e := range m.SlashRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SlashRecordCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashRecordCount))
+	}
+	l = m.InsuranceFund.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AutoCompoundList) > 0 {
+		for _, e := range m.AutoCompoundList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawAddressList) > 0 {
+		for _, e := range m.WithdrawAddressList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InsuranceOptInList) > 0 {
+		for _, e := range m.InsuranceOptInList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -664,6 +828,194 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecordList = append(m.SlashRecordList, SlashRecord{})
+			if err := m.SlashRecordList[len(m.SlashRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordCount", wireType)
+			}
+			m.SlashRecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashRecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundList = append(m.AutoCompoundList, AutoCompound{})
+			if err := m.AutoCompoundList[len(m.AutoCompoundList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddressList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddressList = append(m.WithdrawAddressList, WithdrawAddress{})
+			if err := m.WithdrawAddressList[len(m.WithdrawAddressList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceOptInList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceOptInList = append(m.InsuranceOptInList, InsuranceOptIn{})
+			if err := m.InsuranceOptInList[len(m.InsuranceOptInList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -49,6 +49,33 @@ var (
 
 	// RedelegationCooldownPrefix ...
 	RedelegationCooldownPrefix = []byte{7}
+
+	// SlashRecordKeyPrefix is the prefix to retrieve all SlashRecords
+	SlashRecordKeyPrefix = []byte{8, 0}
+
+	// SlashRecordKeyPrefixIndex2 is the prefix to retrieve all SlashRecords of a staker
+	SlashRecordKeyPrefixIndex2 = []byte{8, 1}
+
+	// SlashRecordKeyPrefixIndex3 is the prefix to retrieve all SlashRecords of a pool
+	SlashRecordKeyPrefixIndex3 = []byte{8, 2}
+
+	// SlashRecordCountKey ...
+	SlashRecordCountKey = []byte{9}
+
+	// InsuranceFundKey ...
+	InsuranceFundKey = []byte{10}
+
+	// AutoCompoundKeyPrefix is the prefix to retrieve all delegations with auto-compounding
+	AutoCompoundKeyPrefix = []byte{11}
+
+	// WithdrawAddressKeyPrefix is the prefix to retrieve all withdraw addresses
+	WithdrawAddressKeyPrefix = []byte{12}
+
+	// AutoCompoundCursorKey stores the key of the next delegation which gets auto-compounded
+	AutoCompoundCursorKey = []byte{13}
+
+	// InsuranceOptInKeyPrefix is the prefix to retrieve all stakers which opted in to the insurance
+	InsuranceOptInKeyPrefix = []byte{14}
 )
 
 // DelegatorKey returns the store Key to retrieve a Delegator from the index fields
@@ -87,6 +114,30 @@ func DelegationSlashEntriesKey(stakerAddress string, kIndex uint64) []byte {
 	return util.GetByteKey(stakerAddress, kIndex)
 }
 
+func SlashRecordKey(id uint64) []byte {
+	return util.GetByteKey(id)
+}
+
+func SlashRecordKeyIndex2(stakerAddress string, id uint64) []byte {
+	return util.GetByteKey(stakerAddress, id)
+}
+
+func SlashRecordKeyIndex3(poolId uint64, id uint64) []byte {
+	return util.GetByteKey(poolId, id)
+}
+
+func AutoCompoundKey(stakerAddress string, delegatorAddress string) []byte {
+	return util.GetByteKey(stakerAddress, delegatorAddress)
+}
+
+func WithdrawAddressKey(address string) []byte {
+	return util.GetByteKey(address)
+}
+
+func InsuranceOptInKey(stakerAddress string) []byte {
+	return util.GetByteKey(stakerAddress)
+}
+
 func StakerIndexKey(amount uint64, stakerAddress string) []byte {
 	return util.GetByteKey(amount, stakerAddress)
 }
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgCancelUndelegation{}
+	_ sdk.Msg            = &MsgCancelUndelegation{}
+)
+
+func (msg *MsgCancelUndelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelUndelegation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelUndelegation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelUndelegation) Type() string {
+	return "kyve/delegation/MsgCancelUndelegation"
+}
+
+func (msg *MsgCancelUndelegation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Amount == 0 {
+		return errors.Wrap(errorsTypes.ErrInvalidRequest, "amount must be greater than zero")
+	}
+
+	return nil
+}
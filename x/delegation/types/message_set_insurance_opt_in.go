@@ -0,0 +1,44 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgSetInsuranceOptIn{}
+	_ sdk.Msg            = &MsgSetInsuranceOptIn{}
+)
+
+func (msg *MsgSetInsuranceOptIn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetInsuranceOptIn) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetInsuranceOptIn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetInsuranceOptIn) Type() string {
+	return "kyve/delegation/MsgSetInsuranceOptIn"
+}
+
+func (msg *MsgSetInsuranceOptIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
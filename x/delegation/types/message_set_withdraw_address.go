@@ -0,0 +1,49 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgSetWithdrawAddress{}
+	_ sdk.Msg            = &MsgSetWithdrawAddress{}
+)
+
+func (msg *MsgSetWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetWithdrawAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetWithdrawAddress) Type() string {
+	return "kyve/delegation/MsgSetWithdrawAddress"
+}
+
+func (msg *MsgSetWithdrawAddress) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid withdraw address (%s)", err)
+	}
+
+	return nil
+}
@@ -23,6 +23,18 @@ var DefaultUploadSlash = sdk.MustNewDecFromStr("0.2")
 // DefaultTimeoutSlash ...
 var DefaultTimeoutSlash = sdk.MustNewDecFromStr("0.02")
 
+// DefaultInsuranceFundShare ...
+var DefaultInsuranceFundShare = sdk.ZeroDec()
+
+// DefaultInsuranceMaxPayout ...
+var DefaultInsuranceMaxPayout = uint64(0)
+
+// DefaultMaxAutoCompoundsPerBlock ...
+var DefaultMaxAutoCompoundsPerBlock = uint64(100)
+
+// DefaultMinAutoCompoundDelegation ...
+var DefaultMinAutoCompoundDelegation = uint64(100_000_000_000)
+
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingDelegationTime uint64,
@@ -31,14 +43,22 @@ func NewParams(
 	voteSlash sdk.Dec,
 	uploadSlash sdk.Dec,
 	timeoutSlash sdk.Dec,
+	insuranceFundShare sdk.Dec,
+	insuranceMaxPayout uint64,
+	maxAutoCompoundsPerBlock uint64,
+	minAutoCompoundDelegation uint64,
 ) Params {
 	return Params{
-		UnbondingDelegationTime: unbondingDelegationTime,
-		RedelegationCooldown:    redelegationCooldown,
-		RedelegationMaxAmount:   redelegationMaxAmount,
-		VoteSlash:               voteSlash,
-		UploadSlash:             uploadSlash,
-		TimeoutSlash:            timeoutSlash,
+		UnbondingDelegationTime:   unbondingDelegationTime,
+		RedelegationCooldown:      redelegationCooldown,
+		RedelegationMaxAmount:     redelegationMaxAmount,
+		VoteSlash:                 voteSlash,
+		UploadSlash:               uploadSlash,
+		TimeoutSlash:              timeoutSlash,
+		InsuranceFundShare:        insuranceFundShare,
+		InsuranceMaxPayout:        insuranceMaxPayout,
+		MaxAutoCompoundsPerBlock:  maxAutoCompoundsPerBlock,
+		MinAutoCompoundDelegation: minAutoCompoundDelegation,
 	}
 }
 
@@ -51,6 +71,10 @@ func DefaultParams() Params {
 		DefaultVoteSlash,
 		DefaultUploadSlash,
 		DefaultTimeoutSlash,
+		DefaultInsuranceFundShare,
+		DefaultInsuranceMaxPayout,
+		DefaultMaxAutoCompoundsPerBlock,
+		DefaultMinAutoCompoundDelegation,
 	)
 }
 
@@ -80,5 +104,21 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidatePercentage(p.InsuranceFundShare); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.InsuranceMaxPayout); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePositiveNumber(p.MaxAutoCompoundsPerBlock); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.MinAutoCompoundDelegation); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -38,6 +38,18 @@ type Params struct {
 	UploadSlash github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=upload_slash,json=uploadSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upload_slash"`
 	// timeout_slash ...
 	TimeoutSlash github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=timeout_slash,json=timeoutSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"timeout_slash"`
+	// insurance_fund_share is the fraction of the network fee of
+	// every bundle which is used to fund the insurance fund.
+	InsuranceFundShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=insurance_fund_share,json=insuranceFundShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_fund_share"`
+	// insurance_max_payout is the maximum amount in ukyve which is
+	// reimbursed from the insurance fund for a single timeout slash.
+	InsuranceMaxPayout uint64 `protobuf:"varint,8,opt,name=insurance_max_payout,json=insuranceMaxPayout,proto3" json:"insurance_max_payout,omitempty"`
+	// max_auto_compounds_per_block is the maximum number of delegations
+	// with auto-compounding whose rewards are re-delegated in a single block.
+	MaxAutoCompoundsPerBlock uint64 `protobuf:"varint,9,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty"`
+	// min_auto_compound_delegation is the minimum delegation in ukyve
+	// which is required for enabling auto-compounding.
+	MinAutoCompoundDelegation uint64 `protobuf:"varint,10,opt,name=min_auto_compound_delegation,json=minAutoCompoundDelegation,proto3" json:"min_auto_compound_delegation,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -94,6 +106,27 @@ func (m *Params) GetRedelegationMaxAmount() uint64 {
 	return 0
 }
 
+func (m *Params) GetInsuranceMaxPayout() uint64 {
+	if m != nil {
+		return m.InsuranceMaxPayout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMinAutoCompoundDelegation() uint64 {
+	if m != nil {
+		return m.MinAutoCompoundDelegation
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.delegation.v1beta1.Params")
 }
@@ -103,29 +136,36 @@ func init() {
 }
 
 var fileDescriptor_17019e1d49c878a9 = []byte{
-	// 350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd2, 0x3f, 0x4f, 0xfa, 0x40,
-	0x1c, 0x06, 0xf0, 0xf6, 0x07, 0x3f, 0x12, 0x4e, 0x5c, 0x1a, 0x0c, 0xd5, 0xa1, 0x10, 0x63, 0x0c,
-	0x8b, 0xbd, 0x10, 0x12, 0x07, 0x37, 0x11, 0x07, 0x63, 0x30, 0x0a, 0xc6, 0x44, 0x97, 0xe6, 0xda,
-	0x5e, 0x4a, 0x43, 0x7b, 0xdf, 0xa6, 0x77, 0xe5, 0xcf, 0xbb, 0xf0, 0x65, 0xf8, 0x52, 0x18, 0x19,
-	0x8d, 0x03, 0x31, 0xf0, 0x46, 0x4c, 0x8f, 0x06, 0xca, 0xca, 0xd4, 0x26, 0xcf, 0xf3, 0x7c, 0x86,
-	0xbb, 0x43, 0x17, 0xa3, 0xd9, 0x98, 0x62, 0x97, 0x06, 0xd4, 0x23, 0xc2, 0x07, 0x86, 0xc7, 0x2d,
-	0x9b, 0x0a, 0xd2, 0xc2, 0x11, 0x89, 0x49, 0xc8, 0xcd, 0x28, 0x06, 0x01, 0x5a, 0x2d, 0x6d, 0x99,
-	0xbb, 0x96, 0x99, 0xb5, 0xce, 0xaa, 0x1e, 0x78, 0x20, 0x3b, 0x38, 0xfd, 0xdb, 0xd4, 0xcf, 0xbf,
-	0x0a, 0xa8, 0xf4, 0x2c, 0xf7, 0xda, 0x0d, 0x3a, 0x4d, 0x98, 0x0d, 0xcc, 0xf5, 0x99, 0x67, 0xed,
-	0x00, 0x4b, 0xf8, 0x21, 0xd5, 0xd5, 0x86, 0xda, 0x2c, 0xf6, 0x6b, 0xdb, 0x42, 0x77, 0x9b, 0xbf,
-	0xfa, 0x21, 0xd5, 0xda, 0xe8, 0x24, 0xa6, 0xb9, 0x8d, 0x03, 0x10, 0xb8, 0x30, 0x61, 0xfa, 0x3f,
-	0xb9, 0xab, 0xe6, 0xc3, 0xbb, 0x2c, 0xd3, 0xae, 0x51, 0x6d, 0x6f, 0x14, 0x92, 0xa9, 0x45, 0x42,
-	0x48, 0x98, 0xd0, 0x0b, 0x72, 0xb6, 0x67, 0xf6, 0xc8, 0xf4, 0x56, 0x86, 0x5a, 0x0f, 0xa1, 0x31,
-	0x08, 0x6a, 0xf1, 0x80, 0xf0, 0xa1, 0x5e, 0x6c, 0xa8, 0xcd, 0x72, 0xc7, 0x9c, 0x2f, 0xeb, 0xca,
-	0xcf, 0xb2, 0x7e, 0xe9, 0xf9, 0x62, 0x98, 0xd8, 0xa6, 0x03, 0x21, 0x76, 0x80, 0x87, 0xc0, 0xb3,
-	0xcf, 0x15, 0x77, 0x47, 0x58, 0xcc, 0x22, 0xca, 0xcd, 0x2e, 0x75, 0xfa, 0xe5, 0x54, 0x18, 0xa4,
-	0x80, 0xf6, 0x82, 0x2a, 0x49, 0x14, 0x00, 0x71, 0x33, 0xf0, 0xff, 0x41, 0xe0, 0xd1, 0xc6, 0xd8,
-	0x90, 0x03, 0x74, 0x9c, 0x9e, 0x1a, 0x24, 0x22, 0x33, 0x4b, 0x07, 0x99, 0x95, 0x0c, 0x91, 0x68,
-	0xe7, 0x61, 0xbe, 0x32, 0xd4, 0xc5, 0xca, 0x50, 0x7f, 0x57, 0x86, 0xfa, 0xb9, 0x36, 0x94, 0xc5,
-	0xda, 0x50, 0xbe, 0xd7, 0x86, 0xf2, 0x81, 0x73, 0xde, 0xe3, 0xfb, 0xdb, 0xfd, 0x13, 0x15, 0x13,
-	0x88, 0x47, 0xd8, 0x19, 0x12, 0x9f, 0xe1, 0x69, 0xfe, 0xcd, 0x48, 0xdc, 0x2e, 0xc9, 0xcb, 0x6f,
-	0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x45, 0xa8, 0x27, 0x47, 0x53, 0x02, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x1b, 0xd8, 0x0a, 0x35, 0xe3, 0x62, 0x15, 0x35, 0x43, 0x53, 0x36, 0x21, 0x84, 0x76,
+	0x21, 0x66, 0x9a, 0xc4, 0x81, 0x03, 0x68, 0xdd, 0x40, 0x42, 0xa8, 0xa8, 0xac, 0x08, 0x09, 0x2e,
+	0xc6, 0x49, 0x4c, 0x6a, 0x35, 0xf6, 0x17, 0xc5, 0x76, 0x97, 0xfe, 0x0b, 0xfe, 0x10, 0xf7, 0x1d,
+	0x77, 0x44, 0x1c, 0x26, 0xd4, 0xfe, 0x11, 0x14, 0x37, 0xb4, 0xe9, 0xb5, 0xa7, 0x44, 0x7a, 0xdf,
+	0xe7, 0xb1, 0xf4, 0x7d, 0x36, 0x7a, 0x3a, 0x99, 0x4d, 0x39, 0x49, 0x78, 0xc6, 0x53, 0x66, 0x04,
+	0x28, 0x32, 0x3d, 0x89, 0xb8, 0x61, 0x27, 0x24, 0x67, 0x05, 0x93, 0x3a, 0xcc, 0x0b, 0x30, 0x80,
+	0x7b, 0x55, 0x2b, 0x5c, 0xb7, 0xc2, 0xba, 0xf5, 0xb8, 0x9b, 0x42, 0x0a, 0xae, 0x43, 0xaa, 0xbf,
+	0x65, 0xfd, 0xc9, 0xaf, 0x5d, 0xd4, 0x1e, 0x3a, 0x1e, 0xbf, 0x42, 0xfb, 0x56, 0x45, 0xa0, 0x12,
+	0xa1, 0x52, 0xba, 0x16, 0x50, 0x23, 0x24, 0xf7, 0xbd, 0x23, 0xef, 0x78, 0xe7, 0xb2, 0xb7, 0x2a,
+	0x5c, 0xac, 0xf2, 0xcf, 0x42, 0x72, 0x7c, 0x8a, 0x1e, 0x15, 0xbc, 0xc1, 0xc4, 0x00, 0x59, 0x02,
+	0x57, 0xca, 0xbf, 0xe3, 0xb8, 0x6e, 0x33, 0x3c, 0xaf, 0x33, 0xfc, 0x12, 0xf5, 0x36, 0x20, 0xc9,
+	0x4a, 0xca, 0x24, 0x58, 0x65, 0xfc, 0xbb, 0x0e, 0xdb, 0x70, 0x0e, 0x58, 0x79, 0xe6, 0x42, 0x3c,
+	0x40, 0x68, 0x0a, 0x86, 0x53, 0x9d, 0x31, 0x3d, 0xf6, 0x77, 0x8e, 0xbc, 0xe3, 0x4e, 0x3f, 0xbc,
+	0xbe, 0x3d, 0x6c, 0xfd, 0xb9, 0x3d, 0x7c, 0x96, 0x0a, 0x33, 0xb6, 0x51, 0x18, 0x83, 0x24, 0x31,
+	0x68, 0x09, 0xba, 0xfe, 0x3c, 0xd7, 0xc9, 0x84, 0x98, 0x59, 0xce, 0x75, 0x78, 0xc1, 0xe3, 0xcb,
+	0x4e, 0x65, 0x18, 0x55, 0x02, 0xfc, 0x09, 0xed, 0xd9, 0x3c, 0x03, 0x96, 0xd4, 0xc2, 0xdd, 0xad,
+	0x84, 0x0f, 0x96, 0x8e, 0xa5, 0x72, 0x84, 0x1e, 0x56, 0x53, 0x03, 0x6b, 0x6a, 0x67, 0x7b, 0x2b,
+	0xe7, 0x5e, 0x2d, 0x59, 0x4a, 0xbf, 0xa3, 0xae, 0x50, 0xda, 0x16, 0x4c, 0xc5, 0x9c, 0xfe, 0xb0,
+	0x2a, 0xa1, 0x7a, 0xcc, 0x0a, 0xee, 0xdf, 0xdb, 0xca, 0x8d, 0x57, 0xae, 0x77, 0x56, 0x25, 0xa3,
+	0xca, 0x84, 0x5f, 0x34, 0x4f, 0xa8, 0xb6, 0x91, 0xb3, 0x19, 0x58, 0xe3, 0xdf, 0x77, 0xdb, 0x58,
+	0x13, 0x03, 0x56, 0x0e, 0x5d, 0x82, 0x5f, 0xa3, 0x03, 0xb7, 0x35, 0x6b, 0x80, 0xc6, 0x20, 0x73,
+	0xb0, 0x2a, 0xd1, 0x34, 0xe7, 0x05, 0x8d, 0x32, 0x88, 0x27, 0x7e, 0xc7, 0x91, 0xbe, 0x64, 0xe5,
+	0x99, 0x35, 0x70, 0xfe, 0xbf, 0x31, 0xe4, 0x45, 0xbf, 0xca, 0xf1, 0x1b, 0x74, 0x20, 0x85, 0xda,
+	0xe4, 0x1b, 0x77, 0xcf, 0x47, 0x8e, 0xdf, 0x97, 0x42, 0x35, 0xf9, 0xf5, 0xe5, 0xeb, 0xbf, 0xbf,
+	0x9e, 0x07, 0xde, 0xcd, 0x3c, 0xf0, 0xfe, 0xce, 0x03, 0xef, 0xe7, 0x22, 0x68, 0xdd, 0x2c, 0x82,
+	0xd6, 0xef, 0x45, 0xd0, 0xfa, 0x46, 0x1a, 0x83, 0xf8, 0xf0, 0xf5, 0xcb, 0xdb, 0x8f, 0xdc, 0x5c,
+	0x41, 0x31, 0x21, 0xf1, 0x98, 0x09, 0x45, 0xca, 0xe6, 0x43, 0x72, 0x53, 0x89, 0xda, 0xee, 0x45,
+	0x9c, 0xfe, 0x1b, 0x00, 0x71, 0x3c, 0x4b, 0x00, 0x68, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -148,6 +188,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinAutoCompoundDelegation != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinAutoCompoundDelegation))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InsuranceMaxPayout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InsuranceMaxPayout))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.InsuranceFundShare.Size()
+		i -= size
+		if _, err := m.InsuranceFundShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.TimeoutSlash.Size()
 		i -= size
@@ -228,6 +293,17 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.TimeoutSlash.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.InsuranceFundShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.InsuranceMaxPayout != 0 {
+		n += 1 + sovParams(uint64(m.InsuranceMaxPayout))
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxAutoCompoundsPerBlock))
+	}
+	if m.MinAutoCompoundDelegation != 0 {
+		n += 1 + sovParams(uint64(m.MinAutoCompoundDelegation))
+	}
 	return n
 }
 
@@ -425,6 +501,97 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceMaxPayout", wireType)
+			}
+			m.InsuranceMaxPayout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsuranceMaxPayout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAutoCompoundDelegation", wireType)
+			}
+			m.MinAutoCompoundDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAutoCompoundDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
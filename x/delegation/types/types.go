@@ -1 +1,5 @@
 package types
+
+// MaxWithdrawAllRewardsStakers is the maximum number of stakers a delegator
+// can withdraw the rewards from with a single MsgWithdrawAllRewards.
+const MaxWithdrawAllRewardsStakers = 50
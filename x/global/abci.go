@@ -11,11 +11,13 @@ import (
 	bankKeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	// Global
 	"github.com/KYVENetwork/chain/x/global/keeper"
+	"github.com/KYVENetwork/chain/x/global/types"
 	// Upgrade
 	upgradeKeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 )
 
 // EndBlocker handles the fee burning if it is configured
+// and adjusts the base fee if it is enabled.
 func EndBlocker(ctx sdk.Context, ak authKeeper.AccountKeeper, bk bankKeeper.Keeper, gk keeper.Keeper, uk upgradeKeeper.Keeper) {
 	// Since no fees are paid in the genesis block, skip.
 	// NOTE: This is Tendermint specific.
@@ -23,6 +25,20 @@ func EndBlocker(ctx sdk.Context, ak authKeeper.AccountKeeper, bk bankKeeper.Keep
 		return
 	}
 
+	burnFees(ctx, ak, bk, gk, uk)
+
+	if gk.GetBaseFeeEnabled(ctx) && ctx.BlockGasMeter() != nil {
+		gk.UpdateBaseFee(ctx, ctx.BlockGasMeter().GasConsumed())
+	}
+}
+
+// burnFees burns the burn ratio of the collected fees and records the burnt
+// amount. If the base fee is enabled, only the base fee portion of the
+// collected fees is burnt.
+func burnFees(ctx sdk.Context, ak authKeeper.AccountKeeper, bk bankKeeper.Keeper, gk keeper.Keeper, uk upgradeKeeper.Keeper) {
+	blockBaseFees := gk.GetBlockBaseFees(ctx)
+	gk.RemoveBlockBaseFees(ctx)
+
 	burnRatio := gk.GetBurnRatio(ctx)
 	if burnRatio.IsZero() {
 		return
@@ -30,6 +46,10 @@ func EndBlocker(ctx sdk.Context, ak authKeeper.AccountKeeper, bk bankKeeper.Keep
 
 	// Obtain all collected fees.
 	feeCoinsInt := bk.GetAllBalances(ctx, ak.GetModuleAddress(authTypes.FeeCollectorName))
+	if gk.GetBaseFeeEnabled(ctx) {
+		feeCoinsInt = sdk.NewCoins(sdk.NewCoin(types.Denom, sdk.MinInt(blockBaseFees, feeCoinsInt.AmountOf(types.Denom))))
+	}
+
 	feeCoins := sdk.NewDecCoinsFromCoins(feeCoinsInt...)
 	if feeCoins.IsZero() {
 		return
@@ -42,8 +62,14 @@ func EndBlocker(ctx sdk.Context, ak authKeeper.AccountKeeper, bk bankKeeper.Keep
 		burnCoins = burnCoins.Add(sdk.NewCoin(coin.Denom, amount.TruncateInt()))
 	}
 
+	if burnCoins.IsZero() {
+		return
+	}
+
 	err := bk.BurnCoins(ctx, authTypes.FeeCollectorName, burnCoins)
 	if err != nil {
 		util.PanicHalt(uk, ctx, err.Error())
 	}
+
+	gk.RecordBurnedFees(ctx, burnCoins)
 }
@@ -2,7 +2,9 @@ package global_test
 
 import (
 	"cosmossdk.io/math"
+	v1p4 "github.com/KYVENetwork/chain/app/upgrades/v1_4"
 	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundlesTypes "github.com/KYVENetwork/chain/x/bundles/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -20,6 +22,12 @@ TEST CASES - DeductFeeDecorator
 * BurnRatio = 2/3 - test truncate
 * BurnRatio = 0.5
 * BurnRatio = 1.0
+* BaseFee = 2.0; BurnRatio = 1.0 - burn only the base fee portion
+* BaseFee = 2.0; BurnRatio = 0.5 - don't burn the refunded base fee portion
+* Record burnt fees within one burn epoch
+* Record burnt fees of multiple burn epochs
+* Don't record fees if nothing is burnt
+* Initialise the burn epoch duration and the base fee params on upgrade
 
 * TODO(@max): combine with refund
 
@@ -28,7 +36,7 @@ TEST CASES - DeductFeeDecorator
 var _ = Describe("AbciEndBlocker", Ordered, func() {
 	s := i.NewCleanChain()
 	encodingConfig := BuildEncodingConfig()
-	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
 
 	accountBalanceBefore := s.GetBalanceFromAddress(i.DUMMY[0])
 	totalSupplyBefore := s.App().BankKeeper.GetSupply(s.Ctx(), types.Denom).Amount.Uint64()
@@ -43,7 +51,7 @@ var _ = Describe("AbciEndBlocker", Ordered, func() {
 
 		accountBalanceBefore = s.GetBalanceFromAddress(i.DUMMY[0])
 		totalSupplyBefore = s.App().BankKeeper.GetSupply(s.Ctx(), types.Denom).Amount.Uint64()
-		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
 	})
 
 	AfterEach(func() {
@@ -151,4 +159,186 @@ var _ = Describe("AbciEndBlocker", Ordered, func() {
 		totalSupplyDifference := totalSupplyBefore - totalSupplyAfter
 		Expect(totalSupplyDifference).To(Equal(uint64(200_000)))
 	})
+
+	It("BaseFee = 2.0; BurnRatio = 1.0 - burn only the base fee portion", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.BurnRatio = sdk.OneDec()
+		params.BaseFeeEnabled = true
+		params.MinBaseFee = sdk.NewDec(2)
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		denom := s.App().StakingKeeper.BondDenom(s.Ctx())
+		tx := BuildTestTx(math.NewInt(3), denom, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+
+		accountBalanceAfter := s.GetBalanceFromAddress(i.DUMMY[0])
+		accountBalanceDifference := accountBalanceBefore - accountBalanceAfter
+		Expect(accountBalanceDifference).To(Equal(uint64(600_000)))
+
+		totalSupplyAfter := s.App().BankKeeper.GetSupply(s.Ctx(), types.Denom).Amount.Uint64()
+		totalSupplyDifference := totalSupplyBefore - totalSupplyAfter
+		Expect(totalSupplyDifference).To(Equal(uint64(400_000)))
+
+		Expect(s.App().GlobalKeeper.GetBlockBaseFees(s.Ctx()).IsZero()).To(BeTrue())
+	})
+
+	It("BaseFee = 2.0; BurnRatio = 0.5 - don't burn the refunded base fee portion", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.BurnRatio = sdk.OneDec().QuoInt64(2)
+		params.BaseFeeEnabled = true
+		params.MinBaseFee = sdk.NewDec(2)
+		params.GasRefunds = []types.GasRefund{
+			{
+				Type:     "/kyve.bundles.v1beta1.MsgSubmitBundleProposal",
+				Fraction: sdk.OneDec().QuoInt64(4),
+			},
+		}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		rfd := global.NewRefundFeeDecorator(s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().PoolKeeper)
+
+		msg := bundlesTypes.MsgSubmitBundleProposal{Creator: i.DUMMY[0]}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		denom := s.App().StakingKeeper.BondDenom(s.Ctx())
+		fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(2).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(&msg)
+		tx := txBuilder.GetTx()
+
+		// ACT
+		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+		_, errPost := rfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+
+		accountBalanceAfter := s.GetBalanceFromAddress(i.DUMMY[0])
+		accountBalanceDifference := accountBalanceBefore - accountBalanceAfter
+		Expect(accountBalanceDifference).To(Equal(uint64(300_000)))
+
+		totalSupplyAfter := s.App().BankKeeper.GetSupply(s.Ctx(), types.Denom).Amount.Uint64()
+		totalSupplyDifference := totalSupplyBefore - totalSupplyAfter
+		Expect(totalSupplyDifference).To(Equal(uint64(150_000)))
+	})
+
+	It("Record burnt fees within one burn epoch", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.BurnRatio = sdk.OneDec().QuoInt64(2)
+		params.BurnEpochDuration = 1_000_000_000_000
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		denom := s.App().StakingKeeper.BondDenom(s.Ctx())
+
+		// ACT
+		_, errFirst := dfd.AnteHandle(s.Ctx(), BuildTestTx(math.NewInt(1), denom, i.DUMMY[0], encodingConfig), false, NextFn)
+		s.CommitAfterSeconds(1)
+		_, errSecond := dfd.AnteHandle(s.Ctx(), BuildTestTx(math.NewInt(1), denom, i.DUMMY[0], encodingConfig), false, NextFn)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(errFirst).Should(Not(HaveOccurred()))
+		Expect(errSecond).Should(Not(HaveOccurred()))
+
+		totalSupplyAfter := s.App().BankKeeper.GetSupply(s.Ctx(), types.Denom).Amount.Uint64()
+		Expect(totalSupplyBefore - totalSupplyAfter).To(Equal(uint64(200_000)))
+
+		burnStats, err := s.App().GlobalKeeper.BurnStats(sdk.WrapSDKContext(s.Ctx()), &types.QueryBurnStatsRequest{})
+		Expect(err).Should(Not(HaveOccurred()))
+
+		Expect(burnStats.TotalBurned).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 200_000))))
+		Expect(burnStats.BurnEpochs).To(HaveLen(1))
+		Expect(burnStats.BurnEpochs[0].Index).To(Equal(uint64(0)))
+		Expect(burnStats.BurnEpochs[0].Burned).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 200_000))))
+	})
+
+	It("Record burnt fees of multiple burn epochs", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.BurnRatio = sdk.OneDec().QuoInt64(2)
+		params.BurnEpochDuration = 1
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		denom := s.App().StakingKeeper.BondDenom(s.Ctx())
+		firstEpoch := s.App().GlobalKeeper.GetBurnEpochIndex(s.Ctx())
+
+		// ACT
+		_, errFirst := dfd.AnteHandle(s.Ctx(), BuildTestTx(math.NewInt(1), denom, i.DUMMY[0], encodingConfig), false, NextFn)
+		s.CommitAfterSeconds(1)
+		_, errSecond := dfd.AnteHandle(s.Ctx(), BuildTestTx(math.NewInt(2), denom, i.DUMMY[0], encodingConfig), false, NextFn)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(errFirst).Should(Not(HaveOccurred()))
+		Expect(errSecond).Should(Not(HaveOccurred()))
+
+		Expect(s.App().GlobalKeeper.GetTotalBurned(s.Ctx())).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 300_000))))
+
+		burnEpochs := s.App().GlobalKeeper.GetAllBurnEpochs(s.Ctx())
+		Expect(burnEpochs).To(HaveLen(2))
+		Expect(burnEpochs[0].Index).To(Equal(firstEpoch))
+		Expect(burnEpochs[0].Burned).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 100_000))))
+		Expect(burnEpochs[1].Index).To(Equal(firstEpoch + 1))
+		Expect(burnEpochs[1].Burned).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 200_000))))
+	})
+
+	It("Don't record fees if nothing is burnt", func() {
+		// ARRANGE
+		// default burn ratio is zero
+		denom := s.App().StakingKeeper.BondDenom(s.Ctx())
+		tx := BuildTestTx(math.NewInt(1), denom, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+
+		Expect(s.App().GlobalKeeper.GetTotalBurned(s.Ctx()).IsZero()).To(BeTrue())
+		Expect(s.App().GlobalKeeper.GetAllBurnEpochs(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Initialise the burn epoch duration and the base fee params on upgrade", func() {
+		// ARRANGE
+		// params as they were stored before the upgrade
+		s.App().GlobalKeeper.SetParams(s.Ctx(), types.Params{
+			MinGasPrice:            types.DefaultMinGasPrice,
+			BurnRatio:              types.DefaultBurnRatio,
+			MinInitialDepositRatio: types.DefaultMinInitialDepositRatio,
+		})
+
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		Expect(params.Validate()).To(HaveOccurred())
+
+		// ACT
+		v1p4.SetGlobalParams(s.Ctx(), s.App().GlobalKeeper)
+
+		// ASSERT
+		params = s.App().GlobalKeeper.GetParams(s.Ctx())
+		Expect(params.Validate()).To(Succeed())
+
+		Expect(params.BurnEpochDuration).To(Equal(types.DefaultBurnEpochDuration))
+		Expect(params.BaseFeeEnabled).To(BeFalse())
+		Expect(params.TargetBlockGas).To(Equal(types.DefaultTargetBlockGas))
+		Expect(params.BaseFeeChangeRate).To(Equal(types.DefaultBaseFeeChangeRate))
+		Expect(params.MinBaseFee).To(Equal(types.DefaultMinBaseFee))
+		Expect(params.MaxBaseFee).To(Equal(types.DefaultMaxBaseFee))
+
+		// the base fee can be enabled without setting its params
+		params.BaseFeeEnabled = true
+		Expect(params.Validate()).To(Succeed())
+	})
 })
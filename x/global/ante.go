@@ -14,12 +14,17 @@ import (
 	feeGrantKeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	// Global
 	"github.com/KYVENetwork/chain/x/global/keeper"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	// Gov
 	govKeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	legacyGovTypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	// Pool
+	poolKeeper "github.com/KYVENetwork/chain/x/pool/keeper"
 	// Staking
 	stakingKeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	// Stakers
+	stakersKeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
 )
 
 // DeductFeeDecorator
@@ -27,21 +32,27 @@ import (
 // The DeductFeeDecorator is responsible for the
 // consensus minimum gas price.
 // Validators can still choose their own (higher) gas prices.
+// If a transaction only contains bundle messages of an authorized
+// valaccount, the fee is paid by the gas sponsorship of the pool.
 type DeductFeeDecorator struct {
 	accountKeeper  authKeeper.AccountKeeper
 	bankKeeper     bankKeeper.Keeper
 	feeGrantKeeper feeGrantKeeper.Keeper
 	globalKeeper   keeper.Keeper
 	stakingKeeper  stakingKeeper.Keeper
+	poolKeeper     poolKeeper.Keeper
+	stakersKeeper  stakersKeeper.Keeper
 }
 
-func NewDeductFeeDecorator(ak authKeeper.AccountKeeper, bk bankKeeper.Keeper, fk feeGrantKeeper.Keeper, gk keeper.Keeper, sk stakingKeeper.Keeper) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak authKeeper.AccountKeeper, bk bankKeeper.Keeper, fk feeGrantKeeper.Keeper, gk keeper.Keeper, sk stakingKeeper.Keeper, pk poolKeeper.Keeper, stk stakersKeeper.Keeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
 		accountKeeper:  ak,
 		bankKeeper:     bk,
 		feeGrantKeeper: fk,
 		globalKeeper:   gk,
 		stakingKeeper:  sk,
+		poolKeeper:     pk,
+		stakersKeeper:  stk,
 	}
 }
 
@@ -52,6 +63,40 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		tfc = BuildTxFeeChecker(ctx, dfd.globalKeeper, dfd.stakingKeeper)
 	}
 
+	// Track the base fee portion of the fee, which is burnt at the end of the block.
+	if tfc != nil && !simulate && !ctx.IsCheckTx() && dfd.globalKeeper.GetBaseFeeEnabled(ctx) {
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			dfd.globalKeeper.AddBlockBaseFees(ctx, getBaseFeePortion(ctx, dfd.globalKeeper, feeTx.GetFee(), feeTx.GetGas()))
+		}
+	}
+
+	// Let the gas sponsorship of the pool pay the fee if possible.
+	if poolId, signer, ok := getSponsoringPool(ctx, tx, dfd.stakersKeeper); ok {
+		feeTx := tx.(sdk.FeeTx)
+
+		if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+			return ctx, errors.Wrap(errorsTypes.ErrInvalidGasLimit, "must provide positive gas")
+		}
+
+		fee, priority := feeTx.GetFee(), int64(0)
+		if !simulate && tfc != nil {
+			fee, priority, err = tfc(ctx, tx)
+			if err != nil {
+				return ctx, err
+			}
+		}
+
+		// The budget pays at most the minimum gas price (or the base fee) times the gas limit.
+		// If the fee is higher or the budget can not pay it, the fee payer pays as usual.
+		amount := fee.AmountOf(globalTypes.Denom)
+		if amount.LTE(getMaxSponsoredFee(ctx, dfd.globalKeeper, feeTx.GetGas())) {
+			if err := dfd.poolKeeper.ChargeGasSponsorship(ctx, poolId, signer, amount.Uint64()); err == nil {
+				newCtx := ctx.WithPriority(priority).WithValue(gasSponsorshipKey{}, poolId)
+				return next(newCtx, tx, simulate)
+			}
+		}
+	}
+
 	internalDfd := ante.NewDeductFeeDecorator(dfd.accountKeeper, dfd.bankKeeper, dfd.feeGrantKeeper, tfc)
 
 	return internalDfd.AnteHandle(ctx, tx, simulate, next)
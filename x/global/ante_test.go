@@ -3,6 +3,8 @@ package global_test
 import (
 	"cosmossdk.io/math"
 	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundlesTypes "github.com/KYVENetwork/chain/x/bundles/types"
+	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
 	stakersTypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -37,13 +39,19 @@ TEST CASES - DeductFeeDecorator
 * consensusGasPrice = 1.0; validatorGasPrice = 2.0 - deliverTX - not enough fees for validator but enough for consensus.
 * consensusGasPrice = 1.0; validatorGasPrice = 2.0 - checkTx - not enough fees
 * consensusGasPrice = 1.0; validatorGasPrice = 2.0 - checkTx - not enough fees for validator but enough for consensus.
+* acceptedFeeDenomGasPrice = 2.0 - deliverTX - not enough fees
+* acceptedFeeDenomGasPrice = 2.0 - deliverTX - enough fees
+* acceptedFeeDenomGasPrice = 2.0 - deliverTX - zero fees with consensusGasPrice = 0.0
+* Fee denom is not accepted
+* baseFee = 2.0; consensusGasPrice = 1.0 - deliverTX - not enough fees
+* baseFee = 2.0; consensusGasPrice = 1.0 - deliverTX - enough fees
 
 */
 
 var _ = Describe("DeductFeeDecorator", Ordered, func() {
 	s := i.NewCleanChain()
 	encodingConfig := BuildEncodingConfig()
-	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
 	denom := s.App().StakingKeeper.BondDenom(s.Ctx())
 
 	accountBalanceBefore := s.GetBalanceFromAddress(i.DUMMY[0])
@@ -53,7 +61,7 @@ var _ = Describe("DeductFeeDecorator", Ordered, func() {
 		s = i.NewCleanChain()
 		encodingConfig = BuildEncodingConfig()
 		denom = s.App().StakingKeeper.BondDenom(s.Ctx())
-		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
 	})
 
 	AfterEach(func() {
@@ -62,7 +70,7 @@ var _ = Describe("DeductFeeDecorator", Ordered, func() {
 
 	It("Invalid transaction.", func() {
 		// ARRANGE
-		dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+		dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
 
 		// ACT
 		_, err := dfd.AnteHandle(s.Ctx(), &InvalidTx{}, false, NextFn)
@@ -73,7 +81,7 @@ var _ = Describe("DeductFeeDecorator", Ordered, func() {
 
 	It("consensusGasPrice = 0.0; validatorGasPrice = 0.0 - deliverTX", func() {
 		// ARRANGE
-		dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+		dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
 
 		denom := s.App().StakingKeeper.BondDenom(s.Ctx())
 		tx := BuildTestTx(math.ZeroInt(), denom, i.DUMMY[0], encodingConfig)
@@ -92,7 +100,7 @@ var _ = Describe("DeductFeeDecorator", Ordered, func() {
 
 	It("consensusGasPrice = 0.0; validatorGasPrice = 0.0 - checkTX", func() {
 		// ARRANGE
-		dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+		dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
 
 		denom := s.App().StakingKeeper.BondDenom(s.Ctx())
 		tx := BuildTestTx(math.ZeroInt(), denom, i.DUMMY[0], encodingConfig)
@@ -272,6 +280,377 @@ var _ = Describe("DeductFeeDecorator", Ordered, func() {
 		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter))
 		Expect(collectorBalanceBefore).To(Equal(collectorBalanceAfter))
 	})
+
+	It("acceptedFeeDenomGasPrice = 2.0 - deliverTX - not enough fees", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.MinGasPrice = sdk.OneDec()
+		params.AcceptedFeeDenoms = []types.FeeDenom{{Denom: i.IBC_DENOM, MinGasPrice: sdk.NewDec(2)}}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		_ = s.MintDenom(i.DUMMY[0], i.IBC_DENOM, 1000*i.KYVE)
+		tx := BuildTestTx(math.NewInt(1), i.IBC_DENOM, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		Expect(err).Should(HaveOccurred())
+		Expect(s.GetBalanceOfDenomFromAddress(i.DUMMY[0], i.IBC_DENOM)).To(Equal(1000 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(accountBalanceBefore))
+	})
+
+	It("acceptedFeeDenomGasPrice = 2.0 - deliverTX - enough fees", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.MinGasPrice = sdk.OneDec()
+		params.AcceptedFeeDenoms = []types.FeeDenom{{Denom: i.IBC_DENOM, MinGasPrice: sdk.NewDec(2)}}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		_ = s.MintDenom(i.DUMMY[0], i.IBC_DENOM, 1000*i.KYVE)
+		tx := BuildTestTx(math.NewInt(2), i.IBC_DENOM, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		collectorModule := s.App().AccountKeeper.GetModuleAddress(authTypes.FeeCollectorName)
+		collectorBalanceAfter := s.App().BankKeeper.GetBalance(s.Ctx(), collectorModule, i.IBC_DENOM).Amount.Uint64()
+
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.GetBalanceOfDenomFromAddress(i.DUMMY[0], i.IBC_DENOM)).To(Equal(1000*i.KYVE - 400_000))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(accountBalanceBefore))
+		Expect(collectorBalanceAfter).To(Equal(uint64(400_000)))
+	})
+
+	It("acceptedFeeDenomGasPrice = 2.0 - deliverTX - zero fees with consensusGasPrice = 0.0", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.AcceptedFeeDenoms = []types.FeeDenom{{Denom: i.IBC_DENOM, MinGasPrice: sdk.NewDec(2)}}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		tx := BuildTestTx(math.ZeroInt(), denom, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		accountBalanceAfter := s.GetBalanceFromAddress(i.DUMMY[0])
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter))
+		Expect(collectorBalanceBefore).To(Equal(collectorBalanceAfter))
+	})
+
+	It("Fee denom is not accepted", func() {
+		// ARRANGE
+		_ = s.MintDenom(i.DUMMY[0], i.IBC_DENOM, 1000*i.KYVE)
+		tx := BuildTestTx(math.NewInt(1), i.IBC_DENOM, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		Expect(err).Should(HaveOccurred())
+		Expect(s.GetBalanceOfDenomFromAddress(i.DUMMY[0], i.IBC_DENOM)).To(Equal(1000 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(accountBalanceBefore))
+	})
+
+	It("baseFee = 2.0; consensusGasPrice = 1.0 - deliverTX - not enough fees", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.MinGasPrice = sdk.OneDec()
+		params.BaseFeeEnabled = true
+		params.MinBaseFee = sdk.NewDec(2)
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+		tx := BuildTestTx(math.NewInt(1), denom, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		accountBalanceAfter := s.GetBalanceFromAddress(i.DUMMY[0])
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		Expect(err).Should(HaveOccurred())
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter))
+		Expect(collectorBalanceBefore).To(Equal(collectorBalanceAfter))
+	})
+
+	It("baseFee = 2.0; consensusGasPrice = 1.0 - deliverTX - enough fees", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.MinGasPrice = sdk.OneDec()
+		params.BaseFeeEnabled = true
+		params.MinBaseFee = sdk.NewDec(2)
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+		tx := BuildTestTx(math.NewInt(3), denom, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		accountBalanceAfter := s.GetBalanceFromAddress(i.DUMMY[0])
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 600_000))
+		Expect(collectorBalanceBefore).To(Equal(collectorBalanceAfter - 600_000))
+		Expect(s.App().GlobalKeeper.GetBlockBaseFees(s.Ctx()).Uint64()).To(Equal(uint64(400_000)))
+	})
+})
+
+/*
+
+TEST CASES - DeductFeeDecorator - GasSponsorship
+
+* Sponsor the fee of a bundle message
+* Sponsor the fee of multiple bundle messages
+* Don't sponsor if the budget is too low
+* Don't sponsor an unauthorized valaccount
+* Don't sponsor a transaction with non-bundle messages
+* Refund a sponsored transaction to the pool
+* Don't sponsor a fee above the minimum gas price
+* Don't sponsor above the limit per block
+
+*/
+
+var _ = Describe("DeductFeeDecorator - GasSponsorship", Ordered, func() {
+	s := i.NewCleanChain()
+	encodingConfig := BuildEncodingConfig()
+	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
+	rfd := global.NewRefundFeeDecorator(s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().PoolKeeper)
+	denom := s.App().StakingKeeper.BondDenom(s.Ctx())
+
+	valaddressBalanceBefore := s.GetBalanceFromAddress(i.VALADDRESS_0)
+	collectorBalanceBefore := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+	buildBundleTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1).MulRaw(int64(gasLimit)))))
+		_ = txBuilder.SetMsgs(msgs...)
+		return txBuilder.GetTx()
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		encodingConfig = BuildEncodingConfig()
+		denom = s.App().StakingKeeper.BondDenom(s.Ctx())
+		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
+		rfd = global.NewRefundFeeDecorator(s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().PoolKeeper)
+
+		params := types.DefaultParams()
+		params.MinGasPrice = sdk.OneDec()
+		params.GasRefunds = []types.GasRefund{
+			{
+				Type:     "/kyve.bundles.v1beta1.MsgVoteBundleProposal",
+				Fraction: sdk.OneDec(),
+			},
+		}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		s.App().PoolKeeper.AppendPool(s.Ctx(), poolTypes.Pool{
+			Name: "PoolTest",
+			Protocol: &poolTypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &poolTypes.UpgradePlan{},
+		})
+
+		s.RunTxStakersSuccess(&stakersTypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakersTypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		s.RunTxPoolSuccess(&poolTypes.MsgFundGasSponsorship{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  1 * i.KYVE,
+		})
+
+		valaddressBalanceBefore = s.GetBalanceFromAddress(i.VALADDRESS_0)
+		collectorBalanceBefore = s.GetBalanceFromModule(authTypes.FeeCollectorName)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Sponsor the fee of a bundle message", func() {
+		// ARRANGE
+		tx := buildBundleTx(&bundlesTypes.MsgVoteBundleProposal{Creator: i.VALADDRESS_0, Staker: i.STAKER_0, PoolId: 0})
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0)).To(Equal(valaddressBalanceBefore))
+		Expect(s.GetBalanceFromModule(authTypes.FeeCollectorName)).To(Equal(collectorBalanceBefore + 200_000))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(1*i.KYVE - 200_000))
+	})
+
+	It("Sponsor the fee of multiple bundle messages", func() {
+		// ARRANGE
+		tx := buildBundleTx(
+			&bundlesTypes.MsgSubmitBundleProposal{Creator: i.VALADDRESS_0, Staker: i.STAKER_0, PoolId: 0},
+			&bundlesTypes.MsgClaimUploaderRole{Creator: i.VALADDRESS_0, Staker: i.STAKER_0, PoolId: 0},
+		)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0)).To(Equal(valaddressBalanceBefore))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(1*i.KYVE - 200_000))
+	})
+
+	It("Don't sponsor if the budget is too low", func() {
+		// ARRANGE
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.MaxGasSponsorshipPerBlock = 1 * i.KYVE
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		Expect(s.App().PoolKeeper.ChargeGasSponsorship(s.Ctx(), 0, i.VALADDRESS_0, 1*i.KYVE-100_000)).To(Succeed())
+		collectorBalanceBefore = s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		tx := buildBundleTx(&bundlesTypes.MsgVoteBundleProposal{Creator: i.VALADDRESS_0, Staker: i.STAKER_0, PoolId: 0})
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0)).To(Equal(valaddressBalanceBefore - 200_000))
+		Expect(s.GetBalanceFromModule(authTypes.FeeCollectorName)).To(Equal(collectorBalanceBefore + 200_000))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(uint64(100_000)))
+	})
+
+	It("Don't sponsor an unauthorized valaccount", func() {
+		// ARRANGE
+		balanceBefore := s.GetBalanceFromAddress(i.VALADDRESS_1)
+		tx := buildBundleTx(&bundlesTypes.MsgVoteBundleProposal{Creator: i.VALADDRESS_1, Staker: i.STAKER_0, PoolId: 0})
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_1)).To(Equal(balanceBefore - 200_000))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(1 * i.KYVE))
+	})
+
+	It("Don't sponsor a transaction with non-bundle messages", func() {
+		// ARRANGE
+		tx := buildBundleTx(
+			&bundlesTypes.MsgVoteBundleProposal{Creator: i.VALADDRESS_0, Staker: i.STAKER_0, PoolId: 0},
+			&stakersTypes.MsgUpdateCommission{Creator: i.VALADDRESS_0},
+		)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0)).To(Equal(valaddressBalanceBefore - 200_000))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(1 * i.KYVE))
+	})
+
+	It("Refund a sponsored transaction to the pool", func() {
+		// ARRANGE
+		tx := buildBundleTx(&bundlesTypes.MsgVoteBundleProposal{Creator: i.VALADDRESS_0, Staker: i.STAKER_0, PoolId: 0})
+
+		// ACT
+		ctx, errAnte := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+		_, errPost := rfd.AnteHandle(ctx, tx, false, NextFn)
+
+		// ASSERT
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+
+		// the refund goes back to the pool instead of the valaccount
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0)).To(Equal(valaddressBalanceBefore))
+		Expect(s.GetBalanceFromModule(authTypes.FeeCollectorName)).To(Equal(collectorBalanceBefore))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(1 * i.KYVE))
+	})
+
+	It("Don't sponsor a fee above the minimum gas price", func() {
+		// ARRANGE
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		txBuilder.SetGasLimit(200_000)
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(denom, 400_000)))
+		_ = txBuilder.SetMsgs(&bundlesTypes.MsgVoteBundleProposal{Creator: i.VALADDRESS_0, Staker: i.STAKER_0, PoolId: 0})
+		tx := txBuilder.GetTx()
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0)).To(Equal(valaddressBalanceBefore - 400_000))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(1 * i.KYVE))
+	})
+
+	It("Don't sponsor above the limit per block", func() {
+		// ARRANGE
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.MaxGasSponsorshipPerBlock = 300_000
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		tx := buildBundleTx(&bundlesTypes.MsgVoteBundleProposal{Creator: i.VALADDRESS_0, Staker: i.STAKER_0, PoolId: 0})
+
+		// ACT
+		_, errFirst := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+		_, errSecond := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		Expect(errFirst).Should(Not(HaveOccurred()))
+		Expect(errSecond).Should(Not(HaveOccurred()))
+
+		// only the first transaction is sponsored
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0)).To(Equal(valaddressBalanceBefore - 200_000))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(1*i.KYVE - 200_000))
+
+		// ACT
+		s.Commit()
+		_, errThird := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, NextFn)
+
+		// ASSERT
+		// the limit is reset in the next block
+		Expect(errThird).Should(Not(HaveOccurred()))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0)).To(Equal(valaddressBalanceBefore - 200_000))
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(1*i.KYVE - 400_000))
+	})
 })
 
 /*
@@ -291,7 +670,7 @@ var _ = Describe("GasAdjustmentDecorator", Ordered, func() {
 	encodingConfig := BuildEncodingConfig()
 
 	// NOTE: This will change as implementation changes.
-	BaseCost := 32079
+	BaseCost := 34323
 
 	BeforeEach(func() {
 		s = i.NewCleanChain()
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/global/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryBurnStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-stats",
+		Short: "shows the total amount of burnt fees and the burnt fees of every burn epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnStats(context.Background(), &types.QueryBurnStatsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
@@ -9,10 +9,25 @@ import (
 // InitGenesis initializes the x/global module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+
+	if !genState.BaseFee.IsNil() {
+		k.SetBaseFee(ctx, genState.BaseFee)
+	}
+
+	for _, coin := range genState.TotalBurned {
+		k.SetTotalBurned(ctx, coin)
+	}
+
+	for _, burnEpoch := range genState.BurnEpochList {
+		k.SetBurnEpoch(ctx, burnEpoch)
+	}
 }
 
 // ExportGenesis returns the x/global module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	params := k.GetParams(ctx)
-	return types.NewGenesisState(params)
+	baseFee := k.GetBaseFee(ctx)
+	totalBurned := k.GetTotalBurned(ctx)
+	burnEpochList := k.GetAllBurnEpochs(ctx)
+	return types.NewGenesisState(params, baseFee, totalBurned, burnEpochList)
 }
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBaseFee returns the current base fee bounded by the MinBaseFee and
+// MaxBaseFee params. If no base fee was stored yet, MinBaseFee is returned.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return params.MinBaseFee
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	if baseFee.LT(params.MinBaseFee) {
+		return params.MinBaseFee
+	}
+	if baseFee.GT(params.MaxBaseFee) {
+		return params.MaxBaseFee
+	}
+
+	return baseFee
+}
+
+// SetBaseFee stores the current base fee.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// GetBlockBaseFees returns the amount of base fees collected in the current block.
+func (k Keeper) GetBlockBaseFees(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.BlockBaseFeesKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
+
+// AddBlockBaseFees adds the given amount to the base fees collected in the current block.
+func (k Keeper) AddBlockBaseFees(ctx sdk.Context, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.GetBlockBaseFees(ctx).Add(amount).Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.BlockBaseFeesKey, bz)
+}
+
+// SubtractBlockBaseFees subtracts the given amount from the base fees collected
+// in the current block.
+func (k Keeper) SubtractBlockBaseFees(ctx sdk.Context, amount math.Int) {
+	blockBaseFees := k.GetBlockBaseFees(ctx)
+	if amount.GT(blockBaseFees) {
+		amount = blockBaseFees
+	}
+
+	k.AddBlockBaseFees(ctx, amount.Neg())
+}
+
+// RemoveBlockBaseFees resets the base fees collected in the current block.
+func (k Keeper) RemoveBlockBaseFees(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BlockBaseFeesKey)
+}
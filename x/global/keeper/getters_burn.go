@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/global/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetTotalBurned stores the total amount of burnt fees of the coin's denom.
+func (k Keeper) SetTotalBurned(ctx sdk.Context, coin sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalBurnedKeyPrefix)
+
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.TotalBurnedKey(coin.Denom), bz)
+}
+
+// GetTotalBurned returns the total amount of burnt fees of all denoms.
+func (k Keeper) GetTotalBurned(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalBurnedKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	totalBurned := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		totalBurned = totalBurned.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return totalBurned
+}
+
+// SetBurnEpoch stores the burnt fees of a burn epoch.
+func (k Keeper) SetBurnEpoch(ctx sdk.Context, burnEpoch types.BurnEpoch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnEpochKeyPrefix)
+	b := k.cdc.MustMarshal(&burnEpoch)
+	store.Set(types.BurnEpochKey(burnEpoch.Index), b)
+}
+
+// GetBurnEpoch returns the burnt fees of a burn epoch.
+func (k Keeper) GetBurnEpoch(ctx sdk.Context, index uint64) (val types.BurnEpoch, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnEpochKeyPrefix)
+
+	b := store.Get(types.BurnEpochKey(index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllBurnEpochs returns the burnt fees of all burn epochs.
+func (k Keeper) GetAllBurnEpochs(ctx sdk.Context) (list []types.BurnEpoch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnEpochKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BurnEpoch
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
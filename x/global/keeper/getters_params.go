@@ -43,6 +43,41 @@ func (k Keeper) GetMinInitialDepositRatio(ctx sdk.Context) (res sdk.Dec) {
 	return k.GetParams(ctx).MinInitialDepositRatio
 }
 
+// GetAcceptedFeeDenoms returns the AcceptedFeeDenoms param.
+func (k Keeper) GetAcceptedFeeDenoms(ctx sdk.Context) (res []types.FeeDenom) {
+	return k.GetParams(ctx).AcceptedFeeDenoms
+}
+
+// GetBaseFeeEnabled returns the BaseFeeEnabled param.
+func (k Keeper) GetBaseFeeEnabled(ctx sdk.Context) (res bool) {
+	return k.GetParams(ctx).BaseFeeEnabled
+}
+
+// GetTargetBlockGas returns the TargetBlockGas param.
+func (k Keeper) GetTargetBlockGas(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).TargetBlockGas
+}
+
+// GetBaseFeeChangeRate returns the BaseFeeChangeRate param.
+func (k Keeper) GetBaseFeeChangeRate(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).BaseFeeChangeRate
+}
+
+// GetMinBaseFee returns the MinBaseFee param.
+func (k Keeper) GetMinBaseFee(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).MinBaseFee
+}
+
+// GetMaxBaseFee returns the MaxBaseFee param.
+func (k Keeper) GetMaxBaseFee(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).MaxBaseFee
+}
+
+// GetBurnEpochDuration returns the BurnEpochDuration param.
+func (k Keeper) GetBurnEpochDuration(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).BurnEpochDuration
+}
+
 // SetParams sets the x/global module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/global/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BurnStats(c context.Context, req *types.QueryBurnStatsRequest) (*types.QueryBurnStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	burnEpochs := make([]types.BurnEpoch, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnEpochKeyPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var burnEpoch types.BurnEpoch
+		if err := k.cdc.Unmarshal(value, &burnEpoch); err != nil {
+			return err
+		}
+
+		burnEpochs = append(burnEpochs, burnEpoch)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnStatsResponse{
+		TotalBurned: k.GetTotalBurned(ctx),
+		BurnEpochs:  burnEpochs,
+		Pagination:  pageRes,
+	}, nil
+}
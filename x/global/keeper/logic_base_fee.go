@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateBaseFee adjusts the base fee towards the TargetBlockGas param based on
+// the gas used in the current block. The relative change is proportional to the
+// deviation from the target and never exceeds the BaseFeeChangeRate param.
+// The new base fee is bounded by the MinBaseFee and MaxBaseFee params.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, blockGasUsed uint64) {
+	params := k.GetParams(ctx)
+	baseFee := k.GetBaseFee(ctx)
+
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(params.TargetBlockGas))
+	deviation := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGasUsed)).Sub(target).Quo(target)
+	if deviation.GT(sdk.OneDec()) {
+		deviation = sdk.OneDec()
+	}
+
+	baseFee = baseFee.Add(baseFee.Mul(deviation).Mul(params.BaseFeeChangeRate))
+
+	if baseFee.LT(params.MinBaseFee) {
+		baseFee = params.MinBaseFee
+	}
+	if baseFee.GT(params.MaxBaseFee) {
+		baseFee = params.MaxBaseFee
+	}
+
+	k.SetBaseFee(ctx, baseFee)
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Global
+	"github.com/KYVENetwork/chain/x/global/types"
+)
+
+/*
+
+TEST CASES - logic_base_fee.go
+
+* Use min base fee if no base fee is stored
+* Increase base fee if block gas is above target
+* Decrease base fee if block gas is below target
+* Keep base fee if block gas is at target
+* Limit the change to the base fee change rate
+* Bound base fee by max base fee
+* Bound base fee by min base fee
+
+*/
+
+var _ = Describe("logic_base_fee.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		params := types.DefaultParams()
+		params.BaseFeeEnabled = true
+		params.TargetBlockGas = 1_000_000
+		params.BaseFeeChangeRate = sdk.MustNewDecFromStr("0.125")
+		params.MinBaseFee = sdk.NewDec(1)
+		params.MaxBaseFee = sdk.NewDec(100)
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		s.App().GlobalKeeper.SetBaseFee(s.Ctx(), sdk.NewDec(10))
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Use min base fee if no base fee is stored", func() {
+		// ARRANGE
+		s = i.NewCleanChain()
+
+		params := types.DefaultParams()
+		params.MinBaseFee = sdk.NewDec(2)
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		// ASSERT
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx())).To(Equal(sdk.NewDec(2)))
+	})
+
+	It("Increase base fee if block gas is above target", func() {
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 1_500_000)
+
+		// ASSERT
+		// 10 + 10 * 0.5 * 0.125
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx())).To(Equal(sdk.MustNewDecFromStr("10.625")))
+	})
+
+	It("Decrease base fee if block gas is below target", func() {
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 0)
+
+		// ASSERT
+		// 10 - 10 * 1 * 0.125
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx())).To(Equal(sdk.MustNewDecFromStr("8.75")))
+	})
+
+	It("Keep base fee if block gas is at target", func() {
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 1_000_000)
+
+		// ASSERT
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx())).To(Equal(sdk.NewDec(10)))
+	})
+
+	It("Limit the change to the base fee change rate", func() {
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 10_000_000)
+
+		// ASSERT
+		// 10 + 10 * 1 * 0.125
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx())).To(Equal(sdk.MustNewDecFromStr("11.25")))
+	})
+
+	It("Bound base fee by max base fee", func() {
+		// ARRANGE
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.MaxBaseFee = sdk.MustNewDecFromStr("10.5")
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 2_000_000)
+
+		// ASSERT
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx())).To(Equal(sdk.MustNewDecFromStr("10.5")))
+	})
+
+	It("Bound base fee by min base fee", func() {
+		// ARRANGE
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.MinBaseFee = sdk.NewDec(9)
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx())).To(Equal(sdk.NewDec(9)))
+	})
+})
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBurnEpochIndex returns the index of the current burn epoch, which is
+// the block time divided by the BurnEpochDuration param.
+func (k Keeper) GetBurnEpochIndex(ctx sdk.Context) uint64 {
+	duration := k.GetBurnEpochDuration(ctx)
+	if duration == 0 {
+		return 0
+	}
+
+	return uint64(ctx.BlockTime().Unix()) / duration
+}
+
+// RecordBurnedFees adds the given amount to the total amount of burnt fees
+// and to the burnt fees of the current burn epoch.
+func (k Keeper) RecordBurnedFees(ctx sdk.Context, amount sdk.Coins) {
+	totalBurned := k.GetTotalBurned(ctx)
+	for _, coin := range amount {
+		k.SetTotalBurned(ctx, sdk.NewCoin(coin.Denom, totalBurned.AmountOf(coin.Denom).Add(coin.Amount)))
+	}
+
+	index := k.GetBurnEpochIndex(ctx)
+	burnEpoch, found := k.GetBurnEpoch(ctx, index)
+	if !found {
+		burnEpoch = types.BurnEpoch{Index: index, Burned: sdk.NewCoins()}
+	}
+	burnEpoch.Burned = burnEpoch.Burned.Add(amount...)
+	k.SetBurnEpoch(ctx, burnEpoch)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventFeesBurned{
+		Epoch:  index,
+		Amount: amount,
+	})
+}
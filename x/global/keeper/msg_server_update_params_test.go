@@ -41,6 +41,12 @@ TEST CASES - msg_server_update_params.go
 * Update min initial deposit ratio
 * Update min initial deposit ratio with invalid value
 
+* Update base fee params
+* Update base fee params with invalid value
+
+* Update burn epoch duration
+* Update burn epoch duration with invalid value
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -626,4 +632,148 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.GasRefunds).To(BeNil())
 		Expect(updatedParams.MinInitialDepositRatio).To(Equal(types.DefaultMinInitialDepositRatio))
 	})
+
+	It("Update base fee params", func() {
+		// ARRANGE
+		payload := `{
+			"base_fee_enabled": true,
+			"target_block_gas": 5000000,
+			"base_fee_change_rate": "0.25",
+			"min_base_fee": "0.01",
+			"max_base_fee": "10"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().GlobalKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.MinGasPrice).To(Equal(types.DefaultMinGasPrice))
+		Expect(updatedParams.BurnRatio).To(Equal(types.DefaultBurnRatio))
+		Expect(updatedParams.BaseFeeEnabled).To(BeTrue())
+		Expect(updatedParams.TargetBlockGas).To(Equal(uint64(5_000_000)))
+		Expect(updatedParams.BaseFeeChangeRate).To(Equal(sdk.MustNewDecFromStr("0.25")))
+		Expect(updatedParams.MinBaseFee).To(Equal(sdk.MustNewDecFromStr("0.01")))
+		Expect(updatedParams.MaxBaseFee).To(Equal(sdk.NewDec(10)))
+	})
+
+	It("Update base fee params with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"base_fee_enabled": true,
+			"min_base_fee": "10",
+			"max_base_fee": "1"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().GlobalKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.BaseFeeEnabled).To(BeFalse())
+		Expect(updatedParams.MinBaseFee).To(Equal(types.DefaultMinBaseFee))
+		Expect(updatedParams.MaxBaseFee).To(Equal(types.DefaultMaxBaseFee))
+	})
+
+	It("Update burn epoch duration", func() {
+		// ARRANGE
+		payload := `{
+			"burn_epoch_duration": 3600
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().GlobalKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.MinGasPrice).To(Equal(types.DefaultMinGasPrice))
+		Expect(updatedParams.BurnRatio).To(Equal(types.DefaultBurnRatio))
+		Expect(updatedParams.BurnEpochDuration).To(Equal(uint64(3600)))
+	})
+
+	It("Update burn epoch duration with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"burn_epoch_duration": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().GlobalKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.BurnEpochDuration).To(Equal(types.DefaultBurnEpochDuration))
+	})
 })
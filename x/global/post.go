@@ -13,6 +13,9 @@ import (
 	feeGrantKeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	// Global
 	"github.com/KYVENetwork/chain/x/global/keeper"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	// Pool
+	poolKeeper "github.com/KYVENetwork/chain/x/pool/keeper"
 )
 
 // RefundFeeDecorator
@@ -21,13 +24,15 @@ type RefundFeeDecorator struct {
 	bankKeeper     bankKeeper.Keeper
 	feeGrantKeeper feeGrantKeeper.Keeper
 	globalKeeper   keeper.Keeper
+	poolKeeper     poolKeeper.Keeper
 }
 
-func NewRefundFeeDecorator(bk bankKeeper.Keeper, fk feeGrantKeeper.Keeper, gk keeper.Keeper) RefundFeeDecorator {
+func NewRefundFeeDecorator(bk bankKeeper.Keeper, fk feeGrantKeeper.Keeper, gk keeper.Keeper, pk poolKeeper.Keeper) RefundFeeDecorator {
 	return RefundFeeDecorator{
 		bankKeeper:     bk,
 		feeGrantKeeper: fk,
 		globalKeeper:   gk,
+		poolKeeper:     pk,
 	}
 }
 
@@ -38,23 +43,17 @@ func (rfd RefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, sdkErrors.Wrap(errorsTypes.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
-	// Return early if the transaction fee is zero (nothing to refund)
-	// or there are more than one message (can't refund).
+	// Return early if the transaction fee is zero (nothing to refund).
 	fee := feeTx.GetFee()
 	msgs := feeTx.GetMsgs()
-	if fee.IsZero() || len(msgs) != 1 {
+	if fee.IsZero() || len(msgs) == 0 {
 		return next(ctx, tx, simulate)
 	}
 
-	// Find the refund percentage based on the transaction message type.
-	refundPercentage := sdk.ZeroDec()
-	gasRefunds := rfd.globalKeeper.GetGasRefunds(ctx)
-	for _, refund := range gasRefunds {
-		if sdk.MsgTypeURL(msgs[0]) == refund.Type {
-			refundPercentage = refund.Fraction
-			break
-		}
-	}
+	// Find the refund percentage of the entire fee based on the transaction message types,
+	// weighted by the gas consumed by every message.
+	msgGas, _ := getMsgGas(ctx, msgs)
+	refundPercentage := calculateRefundPercentage(msgs, msgGas, rfd.globalKeeper.GetGasRefunds(ctx))
 
 	// Return early if the refund percentage is zero.
 	if refundPercentage.IsZero() {
@@ -68,6 +67,26 @@ func (rfd RefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		refund = refund.Add(sdk.NewCoin(coin.Denom, amount.TruncateInt()))
 	}
 
+	// Return early if the refund got truncated to zero.
+	if refund.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// The refunded part of the base fee portion is no longer burnt.
+	if !simulate && !ctx.IsCheckTx() && rfd.globalKeeper.GetBaseFeeEnabled(ctx) {
+		baseFeePortion := getBaseFeePortion(ctx, rfd.globalKeeper, fee, feeTx.GetGas())
+		rfd.globalKeeper.SubtractBlockBaseFees(ctx, sdk.NewDecFromInt(baseFeePortion).Mul(refundPercentage).TruncateInt())
+	}
+
+	// If the fee was paid by the gas sponsorship of a pool, the refund goes back to the pool.
+	if poolId, ok := ctx.Value(gasSponsorshipKey{}).(uint64); ok {
+		if err := rfd.poolKeeper.RefundGasSponsorship(ctx, poolId, refund.AmountOf(globalTypes.Denom).Uint64()); err != nil {
+			return ctx, err
+		}
+
+		return next(ctx, tx, simulate)
+	}
+
 	// Send the refund back to this transaction's fee payer.
 	account, err := GetFeeAccount(ctx, feeTx, rfd.feeGrantKeeper)
 	if err != nil {
@@ -22,17 +22,21 @@ TEST CASES - RefundFeeDecorator
 * Non-refundable message
 * Refund 0%
 * Refund 10%
+* Refund 10% of a fee paid in an accepted fee denom
 * Refund 2/3 %
 * Refund 100%
-* Don't refund multiple
+* Refund multiple with an equal share per message
+* Refund multiple refundable messages
+* Don't refund multiple non-refundable messages
+* Refund multiple messages weighted by their gas consumption
 
 */
 
 var _ = Describe("RefundFeeDecorator", Ordered, func() {
 	s := i.NewCleanChain()
 	encodingConfig := BuildEncodingConfig()
-	rfd := global.NewRefundFeeDecorator(s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper)
-	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+	rfd := global.NewRefundFeeDecorator(s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().PoolKeeper)
+	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
 	denom := s.App().StakingKeeper.BondDenom(s.Ctx())
 
 	accountBalanceBefore := s.GetBalanceFromAddress(i.DUMMY[0])
@@ -41,8 +45,8 @@ var _ = Describe("RefundFeeDecorator", Ordered, func() {
 		s = i.NewCleanChain()
 
 		accountBalanceBefore = s.GetBalanceFromAddress(i.DUMMY[0])
-		rfd = global.NewRefundFeeDecorator(s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper)
-		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+		rfd = global.NewRefundFeeDecorator(s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().PoolKeeper)
+		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().PoolKeeper, s.App().StakersKeeper)
 
 		denom = s.App().StakingKeeper.BondDenom(s.Ctx())
 
@@ -147,6 +151,39 @@ var _ = Describe("RefundFeeDecorator", Ordered, func() {
 		Expect(collectorBalanceAfter).To(Equal(uint64(180_000)))
 	})
 
+	It("Refund 10% of a fee paid in an accepted fee denom", func() {
+		// ARRANGE
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.AcceptedFeeDenoms = []types.FeeDenom{{Denom: i.IBC_DENOM, MinGasPrice: sdk.ZeroDec()}}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		_ = s.MintDenom(i.ALICE, i.IBC_DENOM, 1000*i.KYVE)
+		ibcBalanceBefore := s.GetBalanceOfDenomFromAddress(i.ALICE, i.IBC_DENOM)
+
+		msg := bundlesTypes.MsgSubmitBundleProposal{Creator: i.ALICE}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		fees := sdk.NewCoins(sdk.NewCoin(i.IBC_DENOM, math.NewInt(1).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(&msg)
+		tx := txBuilder.GetTx()
+
+		// ACT
+		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+		_, errPost := rfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+
+		// ASSERT
+		ibcBalanceAfter := s.GetBalanceOfDenomFromAddress(i.ALICE, i.IBC_DENOM)
+		collectorModule := s.App().AccountKeeper.GetModuleAddress(authTypes.FeeCollectorName)
+		collectorBalanceAfter := s.App().BankKeeper.GetBalance(s.Ctx(), collectorModule, i.IBC_DENOM).Amount.Uint64()
+
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+		Expect(ibcBalanceBefore).To(Equal(ibcBalanceAfter + 180_000))
+		Expect(collectorBalanceAfter).To(Equal(uint64(180_000)))
+	})
+
 	It("Refund 2/3 %", func() {
 		// ARRANGE
 		msg := stakersTypes.MsgCreateStaker{Creator: i.ALICE}
@@ -197,7 +234,7 @@ var _ = Describe("RefundFeeDecorator", Ordered, func() {
 		Expect(collectorBalanceAfter).To(Equal(uint64(0)))
 	})
 
-	It("Don't refund multiple", func() {
+	It("Refund multiple with an equal share per message", func() {
 		// ARRANGE
 		msg1 := bundlesTypes.MsgVoteBundleProposal{Creator: i.ALICE}
 		msg2 := bundlesTypes.MsgSkipUploaderRole{Creator: i.ALICE}
@@ -218,9 +255,103 @@ var _ = Describe("RefundFeeDecorator", Ordered, func() {
 		accountBalanceAfter := s.GetBalanceFromAddress(i.ALICE)
 		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
 
+		// only the share of MsgVoteBundleProposal (1/3) gets refunded (100%)
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 133_334))
+		Expect(collectorBalanceAfter).To(Equal(uint64(133_334)))
+	})
+
+	It("Refund multiple refundable messages", func() {
+		// ARRANGE
+		msg1 := bundlesTypes.MsgVoteBundleProposal{Creator: i.ALICE}
+		msg2 := bundlesTypes.MsgSubmitBundleProposal{Creator: i.ALICE}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(&msg1, &msg2)
+		tx := txBuilder.GetTx()
+
+		// ACT
+		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+		_, errPost := rfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+
+		// ASSERT
+		accountBalanceAfter := s.GetBalanceFromAddress(i.ALICE)
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		// (100% + 10%) / 2 = 55% of the fee gets refunded
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 90_000))
+		Expect(collectorBalanceAfter).To(Equal(uint64(90_000)))
+	})
+
+	It("Don't refund multiple non-refundable messages", func() {
+		// ARRANGE
+		msg1 := bundlesTypes.MsgSkipUploaderRole{Creator: i.ALICE}
+		msg2 := stakersTypes.MsgJoinPool{Creator: i.ALICE}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(&msg1, &msg2)
+		tx := txBuilder.GetTx()
+
+		// ACT
+		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+		_, errPost := rfd.AnteHandle(s.Ctx(), tx, false, NextFn)
+
+		// ASSERT
+		accountBalanceAfter := s.GetBalanceFromAddress(i.ALICE)
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
 		Expect(errAnte).Should(Not(HaveOccurred()))
 		Expect(errPost).Should(Not(HaveOccurred()))
 		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 200_000))
 		Expect(collectorBalanceAfter).To(Equal(uint64(200_000)))
 	})
+
+	It("Refund multiple messages weighted by their gas consumption", func() {
+		// ARRANGE
+		msg1 := stakersTypes.MsgCreateStaker{Creator: i.ALICE, Amount: 100 * i.KYVE, Commission: sdk.NewDecWithPrec(1, 1)}
+		msg2 := bundlesTypes.MsgSkipUploaderRole{Creator: i.ALICE}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(&msg1, &msg2)
+		tx := txBuilder.GetTx()
+
+		// ACT
+		ctx, errAnte := global.NewMsgGasDecorator().AnteHandle(s.Ctx(), tx, false, NextFn)
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		_, errAnte = dfd.AnteHandle(ctx, tx, false, NextFn)
+
+		var msgGas []int64
+		for _, msg := range tx.GetMsgs() {
+			gasBefore := ctx.GasMeter().GasConsumed()
+			_, _ = s.App().MsgServiceRouter().Handler(msg)(ctx, msg)
+			msgGas = append(msgGas, int64(ctx.GasMeter().GasConsumed()-gasBefore))
+		}
+
+		_, errPost := rfd.AnteHandle(ctx, tx, false, NextFn)
+
+		// ASSERT
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		// only the gas share of MsgCreateStaker gets refunded (2/3)
+		refundPercentage := sdk.NewDec(2).QuoInt64(3).MulInt64(msgGas[0]).QuoInt64(msgGas[0] + msgGas[1])
+		refund := sdk.NewDec(200_000).Mul(refundPercentage).TruncateInt().Uint64()
+
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+		Expect(msgGas[0]).To(BeNumerically(">", msgGas[1]))
+		Expect(refund).To(BeNumerically(">", uint64(66_666)))
+		Expect(collectorBalanceAfter).To(Equal(200_000 - refund))
+	})
 })
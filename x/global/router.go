@@ -0,0 +1,100 @@
+package global
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+)
+
+// msgGasKey is the context key under which the MsgGasDecorator
+// stores the msgGasMeter of the transaction.
+type msgGasKey struct{}
+
+// msgGasMeter records the gas consumed by every top-level message of a
+// transaction. Messages which are executed by other messages (e.g. authz)
+// are accounted to the message which executed them.
+type msgGasMeter struct {
+	depth int
+	gas   []uint64
+}
+
+// getMsgGas returns the gas consumed by every message of the transaction.
+// It returns false if the gas consumption of the messages was not tracked,
+// e.g. during CheckTx where messages are not executed.
+func getMsgGas(ctx sdk.Context, msgs []sdk.Msg) ([]uint64, bool) {
+	meter, ok := ctx.Value(msgGasKey{}).(*msgGasMeter)
+	if !ok || len(meter.gas) != len(msgs) {
+		return nil, false
+	}
+
+	return meter.gas, true
+}
+
+// MsgGasDecorator
+
+// The MsgGasDecorator adds a msgGasMeter to the context of the transaction,
+// so that the MsgGasRouter can record the gas consumed by every message.
+type MsgGasDecorator struct{}
+
+func NewMsgGasDecorator() MsgGasDecorator {
+	return MsgGasDecorator{}
+}
+
+func (mgd MsgGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	return next(ctx.WithValue(msgGasKey{}, &msgGasMeter{}), tx, simulate)
+}
+
+// MsgGasRouter
+
+// The MsgGasRouter wraps the msg service router and records the gas consumed
+// by every executed message in the msgGasMeter of the transaction.
+type MsgGasRouter struct {
+	gogogrpc.Server
+}
+
+func NewMsgGasRouter(router gogogrpc.Server) MsgGasRouter {
+	return MsgGasRouter{Server: router}
+}
+
+func (mgr MsgGasRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+
+	for i, method := range sd.Methods {
+		methodHandler := method.Handler
+
+		desc.Methods[i] = method
+		desc.Methods[i].Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			if interceptor == nil {
+				return methodHandler(srv, ctx, dec, interceptor)
+			}
+
+			return methodHandler(srv, ctx, dec, func(goCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				return interceptor(goCtx, req, info, func(goCtx context.Context, req interface{}) (interface{}, error) {
+					sdkCtx := sdk.UnwrapSDKContext(goCtx)
+
+					meter, ok := sdkCtx.Value(msgGasKey{}).(*msgGasMeter)
+					if !ok {
+						return handler(goCtx, req)
+					}
+
+					gasBefore := sdkCtx.GasMeter().GasConsumed()
+
+					meter.depth++
+					res, err := handler(goCtx, req)
+					meter.depth--
+
+					if meter.depth == 0 {
+						meter.gas = append(meter.gas, sdkCtx.GasMeter().GasConsumed()-gasBefore)
+					}
+
+					return res, err
+				})
+			})
+		}
+	}
+
+	mgr.Server.RegisterService(&desc, handler)
+}
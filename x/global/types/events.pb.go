@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -88,30 +90,94 @@ func (m *EventUpdateParams) GetPayload() string {
 	return ""
 }
 
+// EventFeesBurned is an event emitted when transaction fees are burnt.
+// emitted_by: EndBlock
+type EventFeesBurned struct {
+	// epoch is the index of the burn epoch the fees are accounted for.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// amount is the amount of fees which were burnt.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventFeesBurned) Reset()         { *m = EventFeesBurned{} }
+func (m *EventFeesBurned) String() string { return proto.CompactTextString(m) }
+func (*EventFeesBurned) ProtoMessage()    {}
+func (*EventFeesBurned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e23fcddbe36854a4, []int{1}
+}
+func (m *EventFeesBurned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeesBurned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeesBurned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeesBurned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeesBurned.Merge(m, src)
+}
+func (m *EventFeesBurned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeesBurned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeesBurned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeesBurned proto.InternalMessageInfo
+
+func (m *EventFeesBurned) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EventFeesBurned) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateParams)(nil), "kyve.global.v1beta1.EventUpdateParams")
+	proto.RegisterType((*EventFeesBurned)(nil), "kyve.global.v1beta1.EventFeesBurned")
 }
 
 func init() { proto.RegisterFile("kyve/global/v1beta1/events.proto", fileDescriptor_e23fcddbe36854a4) }
 
 var fileDescriptor_e23fcddbe36854a4 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xae, 0x2c, 0x4b,
-	0xd5, 0x4f, 0xcf, 0xc9, 0x4f, 0x4a, 0xcc, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06, 0xa9,
-	0xd0, 0x83, 0xa8, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83,
-	0x58, 0x10, 0xa5, 0x52, 0x58, 0x0d, 0x83, 0xea, 0x04, 0xab, 0x50, 0xda, 0xc8, 0xc8, 0x25, 0xe8,
-	0x0a, 0x32, 0x3d, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0x35, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0xc8,
-	0x81, 0x8b, 0x2b, 0x3f, 0x27, 0x25, 0xbe, 0x00, 0xcc, 0x93, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36,
-	0x92, 0xd6, 0xc3, 0x62, 0xaf, 0x1e, 0x44, 0x83, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x9c,
-	0xf9, 0x39, 0x29, 0x08, 0x13, 0xf2, 0x52, 0xcb, 0x61, 0x26, 0x30, 0x11, 0x6d, 0x42, 0x5e, 0x6a,
-	0x39, 0xd4, 0x04, 0x09, 0x2e, 0xf6, 0x82, 0xc4, 0xca, 0x9c, 0xfc, 0xc4, 0x14, 0x09, 0x66, 0x05,
-	0x46, 0x0d, 0xce, 0x20, 0x18, 0xd7, 0xc9, 0xf5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18,
-	0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5,
-	0x18, 0xa2, 0xb4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xbd, 0x23,
-	0xc3, 0x5c, 0xfd, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xf4,
-	0x2b, 0x60, 0x21, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x01, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xe5, 0x68, 0x7b, 0x75, 0x72, 0x01, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x6e, 0xda, 0x40,
+	0x10, 0x86, 0xbd, 0x40, 0xa9, 0x58, 0x0e, 0x55, 0x5d, 0x0e, 0x2e, 0x95, 0x8c, 0xc5, 0xc9, 0x52,
+	0xd5, 0xdd, 0x42, 0x5f, 0xa0, 0x72, 0x45, 0x2f, 0x91, 0xa2, 0xc8, 0x52, 0x22, 0x25, 0x97, 0x68,
+	0x6d, 0xaf, 0x8c, 0x85, 0xbd, 0x63, 0x79, 0x17, 0x08, 0xef, 0x90, 0x43, 0x9e, 0x23, 0xb7, 0xbc,
+	0x05, 0x47, 0x8e, 0x39, 0x25, 0x11, 0xbc, 0x48, 0xe4, 0xb5, 0x21, 0x39, 0x70, 0xc8, 0x69, 0x67,
+	0x56, 0xff, 0xff, 0xcd, 0xaf, 0x19, 0xec, 0xcc, 0x56, 0x0b, 0x4e, 0xe3, 0x14, 0x02, 0x96, 0xd2,
+	0xc5, 0x28, 0xe0, 0x8a, 0x8d, 0x28, 0x5f, 0x70, 0xa1, 0x24, 0xc9, 0x0b, 0x50, 0x60, 0x7e, 0x2b,
+	0x15, 0xa4, 0x52, 0x90, 0x5a, 0xd1, 0xb7, 0x43, 0x90, 0x19, 0x48, 0x1a, 0x30, 0xc9, 0x0f, 0xb6,
+	0x10, 0x12, 0x51, 0x99, 0xfa, 0xbd, 0x18, 0x62, 0xd0, 0x25, 0x2d, 0xab, 0xfa, 0xf7, 0xe8, 0xb0,
+	0x9a, 0xac, 0x15, 0xc3, 0x07, 0x84, 0xbf, 0x4e, 0xca, 0xe9, 0xe7, 0x79, 0xc4, 0x14, 0x3f, 0x63,
+	0x05, 0xcb, 0xa4, 0xf9, 0x17, 0x63, 0x48, 0xa3, 0xeb, 0x5c, 0x77, 0x16, 0x72, 0x90, 0xdb, 0x1d,
+	0xff, 0x20, 0x47, 0x72, 0x91, 0xca, 0xe0, 0xb5, 0xd6, 0x4f, 0x03, 0xc3, 0xef, 0x40, 0x1a, 0xbd,
+	0x11, 0x04, 0x5f, 0xee, 0x09, 0x8d, 0x0f, 0x13, 0x04, 0x5f, 0xd6, 0x04, 0x0b, 0x7f, 0xce, 0xd9,
+	0x2a, 0x05, 0x16, 0x59, 0x4d, 0x07, 0xb9, 0x1d, 0x7f, 0xdf, 0x0e, 0x6f, 0x11, 0xfe, 0xa2, 0x33,
+	0xff, 0xe7, 0x5c, 0x7a, 0xf3, 0x42, 0xf0, 0xc8, 0xec, 0xe1, 0x4f, 0x3c, 0x87, 0x70, 0xaa, 0xc3,
+	0xb6, 0xfc, 0xaa, 0x31, 0x43, 0xdc, 0x66, 0x19, 0xcc, 0x85, 0xb2, 0x1a, 0x4e, 0xd3, 0xed, 0x8e,
+	0xbf, 0x93, 0x6a, 0x8d, 0xa4, 0x5c, 0xe3, 0x21, 0xc1, 0x3f, 0x48, 0x84, 0xf7, 0xbb, 0x9c, 0x7f,
+	0xff, 0x3c, 0x70, 0xe3, 0x44, 0x4d, 0xe7, 0x01, 0x09, 0x21, 0xa3, 0xf5, 0xce, 0xab, 0xe7, 0x97,
+	0x8c, 0x66, 0x54, 0xad, 0x72, 0x2e, 0xb5, 0x41, 0xfa, 0x35, 0xda, 0x9b, 0xac, 0xb7, 0x36, 0xda,
+	0x6c, 0x6d, 0xf4, 0xb2, 0xb5, 0xd1, 0xdd, 0xce, 0x36, 0x36, 0x3b, 0xdb, 0x78, 0xdc, 0xd9, 0xc6,
+	0xd5, 0xcf, 0x77, 0xac, 0x93, 0xcb, 0x8b, 0xc9, 0x29, 0x57, 0x4b, 0x28, 0x66, 0x34, 0x9c, 0xb2,
+	0x44, 0xd0, 0x9b, 0xfd, 0x61, 0x34, 0x34, 0x68, 0xeb, 0x83, 0xfc, 0x79, 0x1d, 0x00, 0x94, 0xdc,
+	0x27, 0xb4, 0x21, 0x02, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -164,6 +230,48 @@ func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFeesBurned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeesBurned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeesBurned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -192,6 +300,24 @@ func (m *EventUpdateParams) Size() (n int) {
 	return n
 }
 
+func (m *EventFeesBurned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovEvents(uint64(m.Epoch))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -346,6 +472,109 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFeesBurned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeesBurned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeesBurned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
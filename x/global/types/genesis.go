@@ -1,20 +1,50 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(params Params, baseFee sdk.Dec, totalBurned sdk.Coins, burnEpochList []BurnEpoch) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:        params,
+		BaseFee:       baseFee,
+		TotalBurned:   totalBurned,
+		BurnEpochList: burnEpochList,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:  DefaultParams(),
+		BaseFee: DefaultMinBaseFee,
 	}
 }
 
 // ValidateGenesis validates the provided genesis state to ensure the expected invariants holds.
 func ValidateGenesis(data GenesisState) error {
+	if !data.BaseFee.IsNil() && data.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", data.BaseFee)
+	}
+
+	if err := data.TotalBurned.Validate(); err != nil {
+		return err
+	}
+
+	burnEpochIndexMap := make(map[uint64]struct{})
+	for _, burnEpoch := range data.BurnEpochList {
+		if _, ok := burnEpochIndexMap[burnEpoch.Index]; ok {
+			return fmt.Errorf("duplicated burn epoch %d", burnEpoch.Index)
+		}
+		burnEpochIndexMap[burnEpoch.Index] = struct{}{}
+
+		if err := burnEpoch.Burned.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return data.Params.Validate()
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +29,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// base_fee is the current base fee of the dynamic base fee mode.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee"`
+	// total_burned is the total amount of fees burnt.
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned"`
+	// burn_epoch_list contains the burnt fees of every burn epoch.
+	BurnEpochList []BurnEpoch `protobuf:"bytes,4,rep,name=burn_epoch_list,json=burnEpochList,proto3" json:"burn_epoch_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +77,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnEpochList() []BurnEpoch {
+	if m != nil {
+		return m.BurnEpochList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.global.v1beta1.GenesisState")
 }
@@ -76,21 +98,31 @@ func init() {
 func init() { proto.RegisterFile("kyve/global/v1beta1/genesis.proto", fileDescriptor_c35b7ff881baba68) }
 
 var fileDescriptor_c35b7ff881baba68 = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xae, 0x2c, 0x4b,
-	0xd5, 0x4f, 0xcf, 0xc9, 0x4f, 0x4a, 0xcc, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06,
-	0x29, 0xd1, 0x83, 0x28, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x0a, 0x58, 0x4d, 0x83, 0xe8, 0x04, 0xab, 0x50, 0xf2, 0xe4, 0xe2,
-	0x71, 0x87, 0x98, 0x1e, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc9, 0xc5, 0x56, 0x90, 0x58, 0x94,
-	0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xad, 0x87, 0xc5, 0x36, 0xbd, 0x00,
-	0xb0, 0x12, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x1a, 0x9c, 0x5c, 0x4f, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x57, 0xdf, 0x3b, 0x32, 0xcc, 0xd5, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x5b, 0x3f,
-	0x39, 0x23, 0x31, 0x33, 0x4f, 0xbf, 0x02, 0xe6, 0xc0, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0xc3, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x72, 0xf3, 0x92, 0x32, 0x0a, 0x01, 0x00,
-	0x00,
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4f, 0xe2, 0x40,
+	0x14, 0xc7, 0x5b, 0x20, 0xec, 0x6e, 0x61, 0xb3, 0x49, 0x77, 0x0f, 0x5d, 0x36, 0x19, 0xba, 0x7b,
+	0xd8, 0x34, 0x31, 0xce, 0x08, 0x9e, 0xbc, 0x56, 0xd1, 0x18, 0x89, 0x31, 0x98, 0x98, 0xe8, 0xa5,
+	0x99, 0x96, 0x67, 0x69, 0x28, 0x9d, 0xa6, 0x33, 0xa0, 0x7c, 0x0b, 0x3f, 0x87, 0x9f, 0x84, 0x23,
+	0xf1, 0x64, 0x3c, 0xa0, 0x81, 0x2f, 0x62, 0x66, 0x5a, 0x88, 0x07, 0x0e, 0x9e, 0xfa, 0xf2, 0xfa,
+	0xff, 0xbf, 0xdf, 0xff, 0xcd, 0x33, 0xfe, 0x0e, 0xa7, 0x13, 0x20, 0x61, 0xcc, 0x7c, 0x1a, 0x93,
+	0x49, 0xcb, 0x07, 0x41, 0x5b, 0x24, 0x84, 0x04, 0x78, 0xc4, 0x71, 0x9a, 0x31, 0xc1, 0xcc, 0x9f,
+	0x52, 0x82, 0x73, 0x09, 0x2e, 0x24, 0x0d, 0x14, 0x30, 0x3e, 0x62, 0x9c, 0xf8, 0x94, 0xc3, 0xc6,
+	0x17, 0xb0, 0x28, 0xc9, 0x4d, 0x8d, 0x5f, 0x21, 0x0b, 0x99, 0x2a, 0x89, 0xac, 0x8a, 0xae, 0xbd,
+	0x95, 0x96, 0x4f, 0x56, 0x8a, 0x7f, 0x4f, 0x25, 0xa3, 0x7e, 0x92, 0xe3, 0x2f, 0x05, 0x15, 0x60,
+	0x1e, 0x18, 0xd5, 0x94, 0x66, 0x74, 0xc4, 0x2d, 0xdd, 0xd6, 0x9d, 0x5a, 0xfb, 0x0f, 0xde, 0x12,
+	0x07, 0x5f, 0x28, 0x89, 0x5b, 0x99, 0x2d, 0x9a, 0x5a, 0xaf, 0x30, 0x98, 0xa7, 0xc6, 0x57, 0x19,
+	0xcf, 0xbb, 0x05, 0xb0, 0x4a, 0xb6, 0xee, 0x7c, 0x73, 0xb1, 0xfc, 0xff, 0xb2, 0x68, 0xfe, 0x0f,
+	0x23, 0x31, 0x18, 0xfb, 0x38, 0x60, 0x23, 0x52, 0x2c, 0x92, 0x7f, 0x76, 0x79, 0x7f, 0x48, 0xc4,
+	0x34, 0x05, 0x8e, 0x8f, 0x20, 0xe8, 0x7d, 0x91, 0xfe, 0x63, 0x00, 0x33, 0x31, 0xea, 0x82, 0x09,
+	0x1a, 0x7b, 0xfe, 0x38, 0x4b, 0xa0, 0x6f, 0x95, 0xed, 0xb2, 0x53, 0x6b, 0xff, 0xc6, 0xb9, 0x0b,
+	0x4b, 0xd9, 0x26, 0xcb, 0x21, 0x8b, 0x12, 0x77, 0x4f, 0x92, 0x1e, 0x5f, 0x9b, 0xce, 0x27, 0x48,
+	0xd2, 0xc0, 0x7b, 0x35, 0x05, 0x70, 0xd5, 0x7c, 0xb3, 0x6b, 0xfc, 0x90, 0x24, 0x0f, 0x52, 0x16,
+	0x0c, 0xbc, 0x38, 0xe2, 0xc2, 0xaa, 0x28, 0x24, 0xda, 0xba, 0xbe, 0x74, 0x75, 0xa4, 0xb4, 0x78,
+	0x81, 0xef, 0xfe, 0xba, 0xd1, 0x8d, 0xb8, 0x70, 0x3b, 0xb3, 0x25, 0xd2, 0xe7, 0x4b, 0xa4, 0xbf,
+	0x2d, 0x91, 0xfe, 0xb0, 0x42, 0xda, 0x7c, 0x85, 0xb4, 0xe7, 0x15, 0xd2, 0x6e, 0x76, 0x3e, 0xc4,
+	0x3b, 0xbb, 0xbe, 0xea, 0x9c, 0x83, 0xb8, 0x63, 0xd9, 0x90, 0x04, 0x03, 0x1a, 0x25, 0xe4, 0x7e,
+	0x7d, 0x2a, 0x95, 0xd3, 0xaf, 0xaa, 0x13, 0xed, 0xbf, 0x0f, 0x00, 0xf7, 0x81, 0xbc, 0x26, 0x34,
+	0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +145,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnEpochList) > 0 {
+		for iNdEx := len(m.BurnEpochList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnEpochList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +215,20 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnEpochList) > 0 {
+		for _, e := range m.BurnEpochList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -216,6 +300,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnEpochList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnEpochList = append(m.BurnEpochList, BurnEpoch{})
+			if err := m.BurnEpochList[len(m.BurnEpochList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
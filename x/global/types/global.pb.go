@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -43,6 +44,24 @@ type Params struct {
 	// governance proposal. This is used to avoid spamming of proposals and
 	// polluting the proposals page.
 	MinInitialDepositRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_initial_deposit_ratio"`
+	// accepted_fee_denoms defines additional denominations besides the bond denom
+	// in which transaction fees can be paid, each with its own minimum gas price.
+	AcceptedFeeDenoms []FeeDenom `protobuf:"bytes,6,rep,name=accepted_fee_denoms,json=acceptedFeeDenoms,proto3" json:"accepted_fee_denoms"`
+	// base_fee_enabled enables the dynamic base fee. If enabled, the base fee
+	// replaces min_gas_price and only the base fee portion of the fees is burnt.
+	BaseFeeEnabled bool `protobuf:"varint,7,opt,name=base_fee_enabled,json=baseFeeEnabled,proto3" json:"base_fee_enabled,omitempty"`
+	// target_block_gas defines the block gas usage the base fee adjusts towards.
+	TargetBlockGas uint64 `protobuf:"varint,8,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_rate defines the maximum relative change of the base fee
+	// from one block to the next.
+	BaseFeeChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=base_fee_change_rate,json=baseFeeChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_change_rate"`
+	// min_base_fee defines the lower bound of the base fee.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee"`
+	// max_base_fee defines the upper bound of the base fee.
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee"`
+	// burn_epoch_duration defines the duration of a burn epoch in seconds.
+	// The burnt fees are accounted for every burn epoch.
+	BurnEpochDuration uint64 `protobuf:"varint,12,opt,name=burn_epoch_duration,json=burnEpochDuration,proto3" json:"burn_epoch_duration,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -92,6 +111,138 @@ func (m *Params) GetGasRefunds() []GasRefund {
 	return nil
 }
 
+func (m *Params) GetAcceptedFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.AcceptedFeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetBaseFeeEnabled() bool {
+	if m != nil {
+		return m.BaseFeeEnabled
+	}
+	return false
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBurnEpochDuration() uint64 {
+	if m != nil {
+		return m.BurnEpochDuration
+	}
+	return 0
+}
+
+// BurnEpoch stores the fees which were burnt within a burn epoch.
+type BurnEpoch struct {
+	// index of the epoch, which is the block time divided by the burn epoch duration
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// burned is the amount of fees burnt within the epoch
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *BurnEpoch) Reset()         { *m = BurnEpoch{} }
+func (m *BurnEpoch) String() string { return proto.CompactTextString(m) }
+func (*BurnEpoch) ProtoMessage()    {}
+func (*BurnEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1b5d4c0bbdf8bfb, []int{1}
+}
+func (m *BurnEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnEpoch.Merge(m, src)
+}
+func (m *BurnEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnEpoch proto.InternalMessageInfo
+
+func (m *BurnEpoch) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BurnEpoch) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// FeeDenom stores the minimum gas price of a denomination
+// which is accepted for paying transaction fees.
+type FeeDenom struct {
+	// denom of the fee coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_gas_price defines the minimum gas price in this denomination
+	MinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_price"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1b5d4c0bbdf8bfb, []int{2}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // GasAdjustment stores for every message type a fixed amount
 // of gas which is added to the message
 type GasAdjustment struct {
@@ -105,7 +256,7 @@ func (m *GasAdjustment) Reset()         { *m = GasAdjustment{} }
 func (m *GasAdjustment) String() string { return proto.CompactTextString(m) }
 func (*GasAdjustment) ProtoMessage()    {}
 func (*GasAdjustment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1b5d4c0bbdf8bfb, []int{1}
+	return fileDescriptor_d1b5d4c0bbdf8bfb, []int{3}
 }
 func (m *GasAdjustment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +313,7 @@ func (m *GasRefund) Reset()         { *m = GasRefund{} }
 func (m *GasRefund) String() string { return proto.CompactTextString(m) }
 func (*GasRefund) ProtoMessage()    {}
 func (*GasRefund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1b5d4c0bbdf8bfb, []int{2}
+	return fileDescriptor_d1b5d4c0bbdf8bfb, []int{4}
 }
 func (m *GasRefund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,6 +351,8 @@ func (m *GasRefund) GetType() string {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.global.v1beta1.Params")
+	proto.RegisterType((*BurnEpoch)(nil), "kyve.global.v1beta1.BurnEpoch")
+	proto.RegisterType((*FeeDenom)(nil), "kyve.global.v1beta1.FeeDenom")
 	proto.RegisterType((*GasAdjustment)(nil), "kyve.global.v1beta1.GasAdjustment")
 	proto.RegisterType((*GasRefund)(nil), "kyve.global.v1beta1.GasRefund")
 }
@@ -207,33 +360,49 @@ func init() {
 func init() { proto.RegisterFile("kyve/global/v1beta1/global.proto", fileDescriptor_d1b5d4c0bbdf8bfb) }
 
 var fileDescriptor_d1b5d4c0bbdf8bfb = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0xce, 0xd2, 0x40,
-	0x10, 0xc7, 0x5b, 0xa9, 0x44, 0x96, 0x7c, 0x9a, 0xac, 0xe6, 0x4b, 0xf5, 0x50, 0x48, 0x0f, 0x86,
-	0xc4, 0xd8, 0x06, 0x3d, 0x7a, 0x92, 0x40, 0x88, 0x1a, 0x0d, 0xf6, 0x60, 0xa2, 0x97, 0x66, 0xdb,
-	0x2e, 0x65, 0x2d, 0xbb, 0xdb, 0xec, 0x6e, 0x51, 0xde, 0xc2, 0x47, 0xf1, 0x31, 0x38, 0x72, 0x34,
-	0x1e, 0x88, 0x81, 0x17, 0x31, 0xbb, 0x2d, 0x04, 0x13, 0xbc, 0x70, 0xea, 0xcc, 0xf4, 0x3f, 0xbf,
-	0xf6, 0x3f, 0x33, 0xa0, 0x5f, 0xac, 0x57, 0x38, 0xcc, 0x97, 0x3c, 0x41, 0xcb, 0x70, 0x35, 0x4c,
-	0xb0, 0x42, 0xc3, 0x26, 0x0d, 0x4a, 0xc1, 0x15, 0x87, 0x0f, 0xb5, 0x22, 0x68, 0x4a, 0x8d, 0xe2,
-	0xc9, 0xa3, 0x9c, 0xe7, 0xdc, 0xbc, 0x0f, 0x75, 0x54, 0x4b, 0xfd, 0x9f, 0x2d, 0xd0, 0x9e, 0x21,
-	0x81, 0xa8, 0x84, 0x11, 0xb8, 0xa1, 0x84, 0xc5, 0x39, 0x92, 0x71, 0x29, 0x48, 0x8a, 0x5d, 0xbb,
-	0x6f, 0x0f, 0x3a, 0xa3, 0x60, 0xb3, 0xeb, 0x59, 0xbf, 0x77, 0xbd, 0xa7, 0x39, 0x51, 0x8b, 0x2a,
-	0x09, 0x52, 0x4e, 0xc3, 0x94, 0x4b, 0xca, 0x65, 0xf3, 0x78, 0x2e, 0xb3, 0x22, 0x54, 0xeb, 0x12,
-	0xcb, 0x60, 0x8c, 0xd3, 0xa8, 0x4b, 0x09, 0x9b, 0x22, 0x39, 0xd3, 0x08, 0xf8, 0x1e, 0x80, 0xa4,
-	0x12, 0x2c, 0x16, 0x48, 0x11, 0xee, 0xde, 0xb9, 0x0a, 0xd8, 0xd1, 0x84, 0x48, 0x03, 0xe0, 0x47,
-	0xf0, 0x40, 0xff, 0x1e, 0xca, 0xbe, 0x56, 0x52, 0x51, 0xcc, 0x94, 0x74, 0x5b, 0xfd, 0xd6, 0xa0,
-	0xfb, 0xc2, 0x0f, 0x2e, 0x58, 0x0e, 0xa6, 0x48, 0xbe, 0x3e, 0x49, 0x47, 0x8e, 0xfe, 0x6e, 0x74,
-	0x3f, 0x3f, 0x2f, 0x4a, 0x38, 0x01, 0x5d, 0x8d, 0x14, 0x78, 0x5e, 0xb1, 0x4c, 0xba, 0x8e, 0xc1,
-	0x79, 0xff, 0xc3, 0x45, 0x46, 0xd6, 0xa0, 0x40, 0x7e, 0x2c, 0x48, 0x48, 0xc0, 0x63, 0x3d, 0x3c,
-	0xc2, 0x88, 0x22, 0x68, 0x19, 0x67, 0xb8, 0xe4, 0x92, 0xa8, 0xc6, 0xf7, 0xdd, 0xab, 0x7c, 0xdf,
-	0x52, 0xc2, 0xde, 0xd4, 0xbc, 0x71, 0x8d, 0x33, 0x43, 0xf0, 0x5f, 0x81, 0x9b, 0x7f, 0x8c, 0x41,
-	0x08, 0x1c, 0xdd, 0x55, 0xef, 0x2b, 0x32, 0x31, 0xbc, 0x05, 0x6d, 0x44, 0x79, 0xc5, 0x94, 0x19,
-	0xba, 0x13, 0x35, 0x99, 0x5f, 0x80, 0xce, 0xc9, 0xc6, 0xc5, 0xc6, 0xb7, 0xe0, 0xde, 0x5c, 0xa0,
-	0x54, 0x11, 0xce, 0xae, 0xdc, 0xd7, 0xa9, 0x7f, 0x34, 0xd9, 0xec, 0x3d, 0x7b, 0xbb, 0xf7, 0xec,
-	0x3f, 0x7b, 0xcf, 0xfe, 0x71, 0xf0, 0xac, 0xed, 0xc1, 0xb3, 0x7e, 0x1d, 0x3c, 0xeb, 0xcb, 0xb3,
-	0x33, 0xd6, 0xbb, 0xcf, 0x9f, 0x26, 0x1f, 0xb0, 0xfa, 0xc6, 0x45, 0x11, 0xa6, 0x0b, 0x44, 0x58,
-	0xf8, 0xfd, 0x78, 0xdd, 0x06, 0x9a, 0xb4, 0xcd, 0xa9, 0xbe, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x49, 0xa4, 0x7a, 0xa9, 0xf9, 0x02, 0x00, 0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xad, 0xeb, 0x5a, 0x77, 0x1b, 0xcc, 0x9b, 0xa6, 0x6c, 0x12, 0x59, 0xd5, 0x03,
+	0xaa, 0x84, 0x48, 0x18, 0x1c, 0x39, 0xd1, 0xb5, 0x9b, 0x00, 0x81, 0x46, 0x90, 0x90, 0xe0, 0x12,
+	0x39, 0xce, 0x5b, 0x6a, 0xda, 0xd8, 0x55, 0xec, 0x8e, 0xee, 0x0b, 0x70, 0x44, 0x7c, 0x0e, 0x3e,
+	0xc9, 0x8e, 0x3b, 0x22, 0x0e, 0x03, 0x6d, 0x5f, 0x04, 0xd9, 0x4e, 0xca, 0x10, 0x43, 0x42, 0xe5,
+	0x14, 0xfb, 0xef, 0xf7, 0x7e, 0xfe, 0xe7, 0xd9, 0x7e, 0xa8, 0x35, 0x3c, 0x3d, 0x81, 0x20, 0x1d,
+	0x89, 0x98, 0x8c, 0x82, 0x93, 0xbd, 0x18, 0x14, 0xd9, 0x2b, 0xa6, 0xfe, 0x38, 0x17, 0x4a, 0xe0,
+	0x0d, 0x1d, 0xe1, 0x17, 0x52, 0x11, 0xb1, 0xe3, 0x51, 0x21, 0x33, 0x21, 0x83, 0x98, 0x48, 0x98,
+	0xa5, 0x51, 0xc1, 0xb8, 0x4d, 0xda, 0xd9, 0x4c, 0x45, 0x2a, 0xcc, 0x30, 0xd0, 0x23, 0xab, 0xb6,
+	0x3f, 0x2d, 0xa3, 0xda, 0x11, 0xc9, 0x49, 0x26, 0x71, 0x88, 0x56, 0x33, 0xc6, 0xa3, 0x94, 0xc8,
+	0x68, 0x9c, 0x33, 0x0a, 0xae, 0xd3, 0x72, 0x3a, 0x8d, 0xae, 0x7f, 0x76, 0xb1, 0x5b, 0xf9, 0x76,
+	0xb1, 0x7b, 0x37, 0x65, 0x6a, 0x30, 0x89, 0x7d, 0x2a, 0xb2, 0xa0, 0xd8, 0xca, 0x7e, 0xee, 0xcb,
+	0x64, 0x18, 0xa8, 0xd3, 0x31, 0x48, 0xbf, 0x07, 0x34, 0x6c, 0x66, 0x8c, 0x1f, 0x12, 0x79, 0xa4,
+	0x11, 0xf8, 0x05, 0x42, 0xf1, 0x24, 0xe7, 0x51, 0x4e, 0x14, 0x13, 0xee, 0xc2, 0x5c, 0xc0, 0x86,
+	0x26, 0x84, 0x1a, 0x80, 0x5f, 0xa1, 0x5b, 0xda, 0x1e, 0x49, 0xde, 0x4f, 0xa4, 0xca, 0x80, 0x2b,
+	0xe9, 0x2e, 0xb6, 0x16, 0x3b, 0xcd, 0x87, 0x6d, 0xff, 0x86, 0x92, 0xf8, 0x87, 0x44, 0x3e, 0x99,
+	0x85, 0x76, 0xab, 0x7a, 0xdf, 0x70, 0x2d, 0xbd, 0x2e, 0x4a, 0xdc, 0x47, 0x4d, 0x8d, 0xcc, 0xe1,
+	0x78, 0xc2, 0x13, 0xe9, 0x56, 0x0d, 0xce, 0xfb, 0x1b, 0x2e, 0x34, 0x61, 0x05, 0x0a, 0xa5, 0xa5,
+	0x20, 0x31, 0x43, 0xdb, 0xba, 0x78, 0x8c, 0x33, 0xc5, 0xc8, 0x28, 0x4a, 0x60, 0x2c, 0x24, 0x53,
+	0xc5, 0x7f, 0x2f, 0xcd, 0xf5, 0xdf, 0x5b, 0x19, 0xe3, 0x4f, 0x2d, 0xaf, 0x67, 0x71, 0xb6, 0x08,
+	0xaf, 0xd1, 0x06, 0xa1, 0x14, 0xc6, 0x0a, 0x92, 0xe8, 0x18, 0x20, 0x4a, 0x80, 0x8b, 0x4c, 0xba,
+	0x35, 0xe3, 0xfc, 0xce, 0x8d, 0xce, 0x0f, 0x00, 0x7a, 0x3a, 0xaa, 0x30, 0xbe, 0x5e, 0xe6, 0x97,
+	0xba, 0xc4, 0x1d, 0x74, 0x5b, 0x5f, 0x1c, 0x03, 0x04, 0x4e, 0xe2, 0x11, 0x24, 0xee, 0x72, 0xcb,
+	0xe9, 0xd4, 0xc3, 0x35, 0xad, 0x1f, 0x00, 0xf4, 0xad, 0xaa, 0x23, 0x15, 0xc9, 0x53, 0x50, 0x51,
+	0x3c, 0x12, 0x74, 0xa8, 0xef, 0x8b, 0x5b, 0x6f, 0x39, 0x9d, 0x6a, 0xb8, 0x66, 0xf5, 0xae, 0x96,
+	0x0f, 0x89, 0xc4, 0x11, 0xda, 0x9c, 0x31, 0xe9, 0x80, 0xf0, 0x14, 0x74, 0x3d, 0xc0, 0x6d, 0xcc,
+	0x55, 0x8e, 0xf5, 0xc2, 0xc7, 0xbe, 0x21, 0x85, 0x44, 0x01, 0x3e, 0x42, 0x2b, 0xba, 0xe8, 0xe5,
+	0x26, 0x2e, 0x9a, 0x0b, 0x8c, 0x32, 0xc6, 0xbb, 0x96, 0x6d, 0x88, 0x64, 0xfa, 0x8b, 0xd8, 0x9c,
+	0x93, 0x48, 0xa6, 0x25, 0xd1, 0x47, 0x1b, 0xe6, 0x05, 0xc0, 0x58, 0xd0, 0x41, 0x94, 0x4c, 0xcc,
+	0x8d, 0xe0, 0xee, 0x8a, 0xa9, 0xd8, 0xba, 0x5e, 0xea, 0xeb, 0x95, 0x5e, 0xb1, 0xd0, 0xfe, 0xe8,
+	0xa0, 0x46, 0xb7, 0x54, 0xf1, 0x26, 0x5a, 0x62, 0x3c, 0x81, 0xa9, 0x79, 0x8b, 0xd5, 0xd0, 0x4e,
+	0x30, 0x45, 0x35, 0x9d, 0x08, 0x89, 0xbb, 0x60, 0x0e, 0x7d, 0xdb, 0xb7, 0x36, 0x7c, 0xed, 0x7b,
+	0x76, 0xe8, 0xfb, 0x82, 0xf1, 0xee, 0x03, 0x6d, 0xfd, 0xcb, 0xf7, 0xdd, 0xce, 0x3f, 0x58, 0xd7,
+	0x09, 0x32, 0x2c, 0xd0, 0x6d, 0x85, 0xea, 0xe5, 0xf5, 0xd0, 0x36, 0xcc, 0x2d, 0xb3, 0x2d, 0x21,
+	0xb4, 0x93, 0x3f, 0x1b, 0xc6, 0xc2, 0x7f, 0x37, 0x8c, 0xf6, 0x63, 0xb4, 0xfa, 0xdb, 0xab, 0xc5,
+	0x18, 0x55, 0x75, 0x68, 0xb1, 0xb3, 0x19, 0xe3, 0x2d, 0x54, 0x23, 0x99, 0x98, 0x70, 0x65, 0x76,
+	0xac, 0x86, 0xc5, 0xac, 0x3d, 0x44, 0x8d, 0xd9, 0x1b, 0xbd, 0x31, 0xf1, 0x19, 0xaa, 0x1f, 0xe7,
+	0x84, 0x9a, 0x13, 0x98, 0xcf, 0xec, 0x2c, 0xbf, 0xdb, 0x3f, 0xbb, 0xf4, 0x9c, 0xf3, 0x4b, 0xcf,
+	0xf9, 0x71, 0xe9, 0x39, 0x9f, 0xaf, 0xbc, 0xca, 0xf9, 0x95, 0x57, 0xf9, 0x7a, 0xe5, 0x55, 0xde,
+	0xdd, 0xbb, 0xc6, 0x7a, 0xfe, 0xf6, 0x4d, 0xff, 0x25, 0xa8, 0x0f, 0x22, 0x1f, 0x06, 0x74, 0x40,
+	0x18, 0x0f, 0xa6, 0x65, 0x6b, 0x37, 0xd0, 0xb8, 0x66, 0xfa, 0xf0, 0xa3, 0x9f, 0x03, 0x00, 0xfe,
+	0x74, 0xe5, 0x48, 0xf6, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -256,6 +425,70 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BurnEpochDuration != 0 {
+		i = encodeVarintGlobal(dAtA, i, uint64(m.BurnEpochDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGlobal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGlobal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BaseFeeChangeRate.Size()
+		i -= size
+		if _, err := m.BaseFeeChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGlobal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGlobal(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BaseFeeEnabled {
+		i--
+		if m.BaseFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for iNdEx := len(m.AcceptedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGlobal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.MinInitialDepositRatio.Size()
 		i -= size
@@ -317,6 +550,88 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BurnEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGlobal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintGlobal(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGlobal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGlobal(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GasAdjustment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -427,6 +742,60 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinInitialDepositRatio.Size()
 	n += 1 + l + sovGlobal(uint64(l))
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for _, e := range m.AcceptedFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGlobal(uint64(l))
+		}
+	}
+	if m.BaseFeeEnabled {
+		n += 2
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGlobal(uint64(m.TargetBlockGas))
+	}
+	l = m.BaseFeeChangeRate.Size()
+	n += 1 + l + sovGlobal(uint64(l))
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovGlobal(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovGlobal(uint64(l))
+	if m.BurnEpochDuration != 0 {
+		n += 1 + sovGlobal(uint64(m.BurnEpochDuration))
+	}
+	return n
+}
+
+func (m *BurnEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGlobal(uint64(m.Index))
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovGlobal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGlobal(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovGlobal(uint64(l))
 	return n
 }
 
@@ -666,6 +1035,419 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedFeeDenoms = append(m.AcceptedFeeDenoms, FeeDenom{})
+			if err := m.AcceptedFeeDenoms[len(m.AcceptedFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BaseFeeEnabled = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnEpochDuration", wireType)
+			}
+			m.BurnEpochDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnEpochDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGlobal(dAtA[iNdEx:])
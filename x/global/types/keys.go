@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "global"
@@ -14,4 +16,28 @@ const (
 	MemStoreKey = "mem_global"
 )
 
-var ParamsKey = []byte{0x00}
+var (
+	ParamsKey = []byte{0x00}
+
+	// BaseFeeKey is the key of the current base fee.
+	BaseFeeKey = []byte{0x01}
+
+	// BlockBaseFeesKey is the key of the base fees collected in the current block.
+	BlockBaseFeesKey = []byte{0x02}
+
+	// TotalBurnedKeyPrefix is the prefix of the total amount of burnt fees per denom.
+	TotalBurnedKeyPrefix = []byte{0x03}
+
+	// BurnEpochKeyPrefix is the prefix of the burnt fees of every burn epoch.
+	BurnEpochKeyPrefix = []byte{0x04}
+)
+
+// TotalBurnedKey returns the store key of the total amount of burnt fees of a denom.
+func TotalBurnedKey(denom string) []byte {
+	return []byte(denom)
+}
+
+// BurnEpochKey returns the store key of a burn epoch.
+func BurnEpochKey(index uint64) []byte {
+	return sdk.Uint64ToBigEndian(index)
+}
@@ -17,20 +17,45 @@ var DefaultBurnRatio = sdk.NewDec(0)
 // DefaultMinInitialDepositRatio is 0% (i.e. disabled)
 var DefaultMinInitialDepositRatio = sdk.NewDec(0)
 
+// DefaultBaseFeeEnabled is false (i.e. disabled)
+var DefaultBaseFeeEnabled = false
+
+// DefaultTargetBlockGas is 10_000_000
+var DefaultTargetBlockGas = uint64(10_000_000)
+
+// DefaultBaseFeeChangeRate is 12.5%
+var DefaultBaseFeeChangeRate = sdk.MustNewDecFromStr("0.125")
+
+// DefaultMinBaseFee is 0.001
+var DefaultMinBaseFee = sdk.MustNewDecFromStr("0.001")
+
+// DefaultMaxBaseFee is 1
+var DefaultMaxBaseFee = sdk.NewDec(1)
+
+// DefaultBurnEpochDuration is 1 day
+var DefaultBurnEpochDuration = uint64(60 * 60 * 24)
+
 // NewParams creates a new Params instance
-func NewParams(minGasPrice sdk.Dec, burnRatio sdk.Dec, gasAdjustments []GasAdjustment, gasRefunds []GasRefund, minInitialDepositRatio sdk.Dec) Params {
+func NewParams(minGasPrice sdk.Dec, burnRatio sdk.Dec, gasAdjustments []GasAdjustment, gasRefunds []GasRefund, minInitialDepositRatio sdk.Dec, acceptedFeeDenoms []FeeDenom, baseFeeEnabled bool, targetBlockGas uint64, baseFeeChangeRate sdk.Dec, minBaseFee sdk.Dec, maxBaseFee sdk.Dec, burnEpochDuration uint64) Params {
 	return Params{
 		MinGasPrice:            minGasPrice,
 		BurnRatio:              burnRatio,
 		GasAdjustments:         gasAdjustments,
 		GasRefunds:             gasRefunds,
 		MinInitialDepositRatio: minInitialDepositRatio,
+		AcceptedFeeDenoms:      acceptedFeeDenoms,
+		BaseFeeEnabled:         baseFeeEnabled,
+		TargetBlockGas:         targetBlockGas,
+		BaseFeeChangeRate:      baseFeeChangeRate,
+		MinBaseFee:             minBaseFee,
+		MaxBaseFee:             maxBaseFee,
+		BurnEpochDuration:      burnEpochDuration,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultMinGasPrice, DefaultBurnRatio, []GasAdjustment{}, []GasRefund{}, DefaultMinInitialDepositRatio)
+	return NewParams(DefaultMinGasPrice, DefaultBurnRatio, []GasAdjustment{}, []GasRefund{}, DefaultMinInitialDepositRatio, []FeeDenom{}, DefaultBaseFeeEnabled, DefaultTargetBlockGas, DefaultBaseFeeChangeRate, DefaultMinBaseFee, DefaultMaxBaseFee, DefaultBurnEpochDuration)
 }
 
 // Validate validates the set of params
@@ -59,6 +84,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAcceptedFeeDenoms(p.AcceptedFeeDenoms); err != nil {
+		return err
+	}
+
+	if err := validateBaseFee(p); err != nil {
+		return err
+	}
+
+	if err := validateBurnEpochDuration(p.BurnEpochDuration); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -170,3 +207,84 @@ func validateMinInitialDepositRatio(i interface{}) error {
 
 	return nil
 }
+
+// validateAcceptedFeeDenoms ...
+func validateAcceptedFeeDenoms(i interface{}) error {
+	v, ok := i.([]FeeDenom)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool)
+	for _, feeDenom := range v {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return err
+		}
+
+		if feeDenom.Denom == Denom {
+			return fmt.Errorf("denom is already covered by min_gas_price: %s", feeDenom.Denom)
+		}
+
+		if denoms[feeDenom.Denom] {
+			return fmt.Errorf("duplicate denom: %s", feeDenom.Denom)
+		}
+		denoms[feeDenom.Denom] = true
+
+		if err := validateMinGasPrice(feeDenom.MinGasPrice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateBaseFee ...
+func validateBaseFee(p Params) error {
+	// The base fee params are only used if the base fee is enabled.
+	if !p.BaseFeeEnabled {
+		return nil
+	}
+
+	if p.TargetBlockGas == 0 {
+		return fmt.Errorf("target block gas must be positive")
+	}
+
+	if p.BaseFeeChangeRate.IsNil() || p.MinBaseFee.IsNil() || p.MaxBaseFee.IsNil() {
+		return fmt.Errorf("invalid parameter: nil")
+	}
+
+	if p.BaseFeeChangeRate.IsNegative() {
+		return fmt.Errorf("value cannot be negative: %s", p.BaseFeeChangeRate)
+	}
+
+	if p.BaseFeeChangeRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("value cannot be greater than 1: %s", p.BaseFeeChangeRate)
+	}
+
+	// A zero base fee could never increase again.
+	if !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", p.MinBaseFee)
+	}
+
+	if p.MaxBaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf("max base fee cannot be lower than min base fee: %s < %s", p.MaxBaseFee, p.MinBaseFee)
+	}
+
+	return nil
+}
+
+// validateBurnEpochDuration ...
+func validateBurnEpochDuration(i interface{}) error {
+	v, ok := i.(uint64)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("value must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,34 +115,159 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryBurnStatsRequest is request type for the Query/BurnStats RPC method.
+type QueryBurnStatsRequest struct {
+	// pagination defines an optional pagination for the burn epochs.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBurnStatsRequest) Reset()         { *m = QueryBurnStatsRequest{} }
+func (m *QueryBurnStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnStatsRequest) ProtoMessage()    {}
+func (*QueryBurnStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_117f917a03a4039c, []int{2}
+}
+func (m *QueryBurnStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnStatsRequest.Merge(m, src)
+}
+func (m *QueryBurnStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnStatsRequest proto.InternalMessageInfo
+
+func (m *QueryBurnStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBurnStatsResponse is response type for the Query/BurnStats RPC method.
+type QueryBurnStatsResponse struct {
+	// total_burned is the total amount of fees burnt.
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned"`
+	// burn_epochs contains the burnt fees of every burn epoch.
+	BurnEpochs []BurnEpoch `protobuf:"bytes,2,rep,name=burn_epochs,json=burnEpochs,proto3" json:"burn_epochs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBurnStatsResponse) Reset()         { *m = QueryBurnStatsResponse{} }
+func (m *QueryBurnStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnStatsResponse) ProtoMessage()    {}
+func (*QueryBurnStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_117f917a03a4039c, []int{3}
+}
+func (m *QueryBurnStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnStatsResponse.Merge(m, src)
+}
+func (m *QueryBurnStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnStatsResponse proto.InternalMessageInfo
+
+func (m *QueryBurnStatsResponse) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
+}
+
+func (m *QueryBurnStatsResponse) GetBurnEpochs() []BurnEpoch {
+	if m != nil {
+		return m.BurnEpochs
+	}
+	return nil
+}
+
+func (m *QueryBurnStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kyve.global.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kyve.global.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryBurnStatsRequest)(nil), "kyve.global.v1beta1.QueryBurnStatsRequest")
+	proto.RegisterType((*QueryBurnStatsResponse)(nil), "kyve.global.v1beta1.QueryBurnStatsResponse")
 }
 
 func init() { proto.RegisterFile("kyve/global/v1beta1/query.proto", fileDescriptor_117f917a03a4039c) }
 
 var fileDescriptor_117f917a03a4039c = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xae, 0x2c, 0x4b,
-	0xd5, 0x4f, 0xcf, 0xc9, 0x4f, 0x4a, 0xcc, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06, 0x29, 0xd0,
-	0x83, 0x28, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x05, 0x6c, 0x36, 0x41,
-	0xcd, 0x05, 0xab, 0x50, 0x12, 0xe1, 0x12, 0x0a, 0x04, 0xd9, 0x1c, 0x90, 0x58, 0x94, 0x98, 0x5b,
-	0x1c, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0xa2, 0x14, 0xc0, 0x25, 0x8c, 0x22, 0x5a, 0x5c, 0x90,
-	0x9f, 0x57, 0x9c, 0x2a, 0x64, 0xc9, 0xc5, 0x56, 0x00, 0x16, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x36, 0x92, 0xd6, 0xc3, 0xe2, 0x50, 0x3d, 0x88, 0x26, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82,
-	0xa0, 0x1a, 0x8c, 0xba, 0x18, 0xb9, 0x58, 0xc1, 0x46, 0x0a, 0x35, 0x30, 0x72, 0xb1, 0x41, 0x94,
-	0x08, 0xa9, 0x63, 0xd5, 0x8f, 0xe9, 0x1e, 0x29, 0x0d, 0xc2, 0x0a, 0x21, 0x4e, 0x54, 0x52, 0x6e,
-	0xba, 0xfc, 0x64, 0x32, 0x93, 0xac, 0x90, 0xb4, 0x3e, 0x36, 0xaf, 0x43, 0x1c, 0xe3, 0xe4, 0x7a,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xda, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xde, 0x91, 0x61, 0xae, 0x7e, 0xa9, 0x25, 0xe5, 0xf9, 0x45,
-	0xd9, 0xfa, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x15, 0x30, 0xf3, 0x4a, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x41, 0x68, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x21, 0xc5, 0xfb, 0xd0,
-	0x01, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xa6, 0x1a, 0x70, 0xe2, 0x69, 0x5a, 0x25, 0xa6, 0xba, 0x89, 0x11, 0x4c, 0x68, 0x71,
+	0xc6, 0xc6, 0x93, 0xd7, 0x95, 0xe8, 0x41, 0x90, 0x1a, 0x41, 0xd0, 0x4b, 0x98, 0xdd, 0x0e, 0x9b,
+	0x25, 0xc9, 0xbc, 0xed, 0xce, 0x6c, 0x35, 0x37, 0xf1, 0x2e, 0x08, 0x5e, 0xc4, 0x3f, 0xc1, 0xbf,
+	0xa4, 0xc7, 0x82, 0x17, 0x4f, 0x2a, 0x89, 0x7f, 0x88, 0xcc, 0x8f, 0x6d, 0x93, 0xba, 0x52, 0x4f,
+	0x3b, 0xbc, 0xf9, 0xbe, 0xef, 0x7d, 0xf3, 0xbd, 0xb7, 0xa8, 0x35, 0x99, 0x1f, 0x71, 0x1a, 0x4f,
+	0x21, 0x64, 0x53, 0x7a, 0xb4, 0x17, 0x72, 0xc5, 0xf6, 0xe8, 0x61, 0xce, 0xb3, 0x39, 0x49, 0x33,
+	0x50, 0x80, 0x37, 0x35, 0x80, 0x58, 0x00, 0x71, 0x80, 0xe6, 0x4e, 0x04, 0x72, 0x06, 0x92, 0x86,
+	0x4c, 0x72, 0x8b, 0x3e, 0xe5, 0xa6, 0x2c, 0x4e, 0x04, 0x53, 0x09, 0x08, 0x2b, 0xd0, 0xf4, 0x57,
+	0xb1, 0x05, 0x2a, 0x82, 0xa4, 0xb8, 0xdf, 0x8a, 0x21, 0x06, 0x73, 0xa4, 0xfa, 0xe4, 0xaa, 0x37,
+	0x63, 0x80, 0x78, 0xca, 0x29, 0x4b, 0x13, 0xca, 0x84, 0x00, 0x65, 0x24, 0xa5, 0xbb, 0x6d, 0x97,
+	0xb9, 0x76, 0x1e, 0x0d, 0xa2, 0xb3, 0x85, 0xf0, 0x73, 0xed, 0x6b, 0x9f, 0x65, 0x6c, 0x26, 0x87,
+	0xfc, 0x30, 0xe7, 0x52, 0x75, 0xf6, 0xd1, 0xe6, 0x5a, 0x55, 0xa6, 0x20, 0x24, 0xc7, 0x0f, 0x51,
+	0x2d, 0x35, 0x95, 0x86, 0xd7, 0xf6, 0x7a, 0xf5, 0xfe, 0x36, 0x29, 0x79, 0x34, 0xb1, 0xa4, 0xe0,
+	0xd2, 0xf1, 0x8f, 0x56, 0x65, 0xe8, 0x08, 0x9d, 0x11, 0xba, 0x66, 0x14, 0x83, 0x3c, 0x13, 0x2f,
+	0x14, 0x53, 0x45, 0x2b, 0xfc, 0x18, 0xa1, 0xb3, 0x28, 0x9c, 0xee, 0x5d, 0x62, 0xb3, 0x20, 0x3a,
+	0x0b, 0x62, 0x53, 0x3e, 0x53, 0x8f, 0xb9, 0xe3, 0x0e, 0x57, 0x98, 0x9d, 0xcf, 0x55, 0x74, 0xfd,
+	0x7c, 0x07, 0x67, 0x5b, 0xa0, 0xab, 0x0a, 0x14, 0x9b, 0x8e, 0xc2, 0x3c, 0x13, 0xfc, 0xa0, 0xe1,
+	0xb5, 0x37, 0x7a, 0xf5, 0xfe, 0x8d, 0xb5, 0x26, 0x85, 0xfc, 0x23, 0x48, 0x44, 0x70, 0x5f, 0x5b,
+	0xff, 0xfa, 0xb3, 0xd5, 0x8b, 0x13, 0x35, 0xce, 0x43, 0x12, 0xc1, 0x8c, 0xba, 0xe9, 0xd8, 0xcf,
+	0x3d, 0x79, 0x30, 0xa1, 0x6a, 0x9e, 0x72, 0x69, 0x08, 0x72, 0x58, 0x37, 0x0d, 0x02, 0xa3, 0x8f,
+	0x07, 0xa8, 0xae, 0x3b, 0x8d, 0x78, 0x0a, 0xd1, 0x58, 0x36, 0xaa, 0xa6, 0x9d, 0x5f, 0x9a, 0x95,
+	0x66, 0x0c, 0x34, 0xcc, 0xc5, 0x85, 0xc2, 0xa2, 0x20, 0xf1, 0x93, 0xb5, 0x64, 0x36, 0x4c, 0x32,
+	0xdd, 0x0b, 0x93, 0xb1, 0x6f, 0x5e, 0x8d, 0xa6, 0xff, 0xa5, 0x8a, 0x2e, 0x9b, 0x68, 0xf0, 0x3b,
+	0x0f, 0xd5, 0xec, 0x78, 0x70, 0xb7, 0xd4, 0xcf, 0xdf, 0xbb, 0xd0, 0xec, 0x5d, 0x0c, 0xb4, 0x3d,
+	0x3b, 0x77, 0xde, 0x7f, 0xfb, 0xfd, 0xa9, 0x7a, 0x0b, 0x6f, 0xd3, 0xb2, 0xb5, 0xb3, 0x8b, 0x80,
+	0x3f, 0x78, 0xe8, 0xca, 0xe9, 0x88, 0xf0, 0xce, 0xbf, 0xc5, 0xcf, 0x6f, 0x4a, 0x73, 0xf7, 0xbf,
+	0xb0, 0xce, 0x4b, 0xd7, 0x78, 0xb9, 0x8d, 0x5b, 0xa5, 0x5e, 0xcc, 0x78, 0xa4, 0x26, 0x04, 0x83,
+	0xe3, 0x85, 0xef, 0x9d, 0x2c, 0x7c, 0xef, 0xd7, 0xc2, 0xf7, 0x3e, 0x2e, 0xfd, 0xca, 0xc9, 0xd2,
+	0xaf, 0x7c, 0x5f, 0xfa, 0x95, 0xd7, 0xbb, 0x2b, 0xd3, 0x7f, 0xfa, 0xea, 0xe5, 0xe0, 0x19, 0x57,
+	0x6f, 0x20, 0x9b, 0xd0, 0x68, 0xcc, 0x12, 0x41, 0xdf, 0x16, 0x9a, 0x66, 0x0d, 0xc2, 0x9a, 0xf9,
+	0x9d, 0x1e, 0xfc, 0x19, 0x00, 0x29, 0x85, 0xc0, 0x1f, 0x28, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +284,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BurnStats queries the total amount of burnt fees and the burnt fees of every burn epoch.
+	BurnStats(ctx context.Context, in *QueryBurnStatsRequest, opts ...grpc.CallOption) (*QueryBurnStatsResponse, error)
 }
 
 type queryClient struct {
@@ -175,10 +305,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BurnStats(ctx context.Context, in *QueryBurnStatsRequest, opts ...grpc.CallOption) (*QueryBurnStatsResponse, error) {
+	out := new(QueryBurnStatsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.global.v1beta1.Query/BurnStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BurnStats queries the total amount of burnt fees and the burnt fees of every burn epoch.
+	BurnStats(context.Context, *QueryBurnStatsRequest) (*QueryBurnStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -188,6 +329,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) BurnStats(ctx context.Context, req *QueryBurnStatsRequest) (*QueryBurnStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -211,6 +355,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.global.v1beta1.Query/BurnStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnStats(ctx, req.(*QueryBurnStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.global.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -219,6 +381,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BurnStats",
+			Handler:    _Query_BurnStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/global/v1beta1/query.proto",
@@ -280,6 +446,104 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurnStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BurnEpochs) > 0 {
+		for iNdEx := len(m.BurnEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -311,6 +575,44 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBurnStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BurnEpochs) > 0 {
+		for _, e := range m.BurnEpochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -450,6 +752,246 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnEpochs = append(m.BurnEpochs, BurnEpoch{})
+			if err := m.BurnEpochs[len(m.BurnEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
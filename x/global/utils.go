@@ -11,12 +11,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	// FeeGrant
 	feeGrantKeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	// Bundles
+	bundlesTypes "github.com/KYVENetwork/chain/x/bundles/types"
 	// Global
 	"github.com/KYVENetwork/chain/x/global/keeper"
+	"github.com/KYVENetwork/chain/x/global/types"
 	// Staking
 	stakingKeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	// Stakers
+	stakersKeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
 )
 
+// gasSponsorshipKey is the context key under which the DeductFeeDecorator
+// stores the id of the pool which paid the fee of the transaction.
+type gasSponsorshipKey struct{}
+
 func GetFeeAccount(ctx sdk.Context, tx sdk.FeeTx, feeGrantKeeper feeGrantKeeper.Keeper) (sdk.AccAddress, error) {
 	fee := tx.GetFee()
 	feePayer := tx.FeePayer()
@@ -35,12 +44,145 @@ func GetFeeAccount(ctx sdk.Context, tx sdk.FeeTx, feeGrantKeeper feeGrantKeeper.
 	return account, nil
 }
 
+// getSponsoringPool returns the id of the pool whose gas sponsorship can pay the
+// fee of the transaction. This is only the case if all messages are bundle messages
+// of the same pool, which are signed by an authorized valaccount of that pool, the
+// signer pays the fee himself and the fee is only denominated in $KYVE.
+func getSponsoringPool(ctx sdk.Context, tx sdk.Tx, sk stakersKeeper.Keeper) (poolId uint64, signer string, ok bool) {
+	feeTx, isFeeTx := tx.(sdk.FeeTx)
+	if !isFeeTx || feeTx.FeeGranter() != nil {
+		return 0, "", false
+	}
+
+	fee := feeTx.GetFee()
+	if fee.IsZero() || len(fee) != 1 || fee[0].Denom != types.Denom {
+		return 0, "", false
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return 0, "", false
+	}
+
+	for i, msg := range msgs {
+		var creator, staker string
+		var msgPoolId uint64
+
+		switch bundleMsg := msg.(type) {
+		case *bundlesTypes.MsgSubmitBundleProposal:
+			creator, staker, msgPoolId = bundleMsg.Creator, bundleMsg.Staker, bundleMsg.PoolId
+		case *bundlesTypes.MsgVoteBundleProposal:
+			creator, staker, msgPoolId = bundleMsg.Creator, bundleMsg.Staker, bundleMsg.PoolId
+		case *bundlesTypes.MsgSkipUploaderRole:
+			creator, staker, msgPoolId = bundleMsg.Creator, bundleMsg.Staker, bundleMsg.PoolId
+		case *bundlesTypes.MsgClaimUploaderRole:
+			creator, staker, msgPoolId = bundleMsg.Creator, bundleMsg.Staker, bundleMsg.PoolId
+		default:
+			return 0, "", false
+		}
+
+		if i == 0 {
+			poolId, signer = msgPoolId, creator
+		} else if msgPoolId != poolId || creator != signer {
+			return 0, "", false
+		}
+
+		valaccount, found := sk.GetValaccount(ctx, msgPoolId, staker)
+		if !found || valaccount.Valaddress != creator {
+			return 0, "", false
+		}
+	}
+
+	if feeTx.FeePayer().String() != signer {
+		return 0, "", false
+	}
+
+	return poolId, signer, true
+}
+
+// getMaxSponsoredFee returns the maximum fee the gas sponsorship of a pool pays
+// for the given gas limit. This is the minimum gas price, or the current base
+// fee if enabled, multiplied by the gas limit.
+func getMaxSponsoredFee(ctx sdk.Context, gk keeper.Keeper, gas uint64) sdk.Int {
+	minGasPrice := gk.GetMinGasPrice(ctx)
+	if gk.GetBaseFeeEnabled(ctx) {
+		minGasPrice = gk.GetBaseFee(ctx)
+	}
+
+	return minGasPrice.MulInt64(int64(gas)).Ceil().TruncateInt()
+}
+
+// calculateRefundPercentage returns the fraction of the transaction fee which
+// gets refunded. Every message accounts for a share of the fee proportional to
+// the gas it consumed, to which the refund fraction configured for the message
+// type is applied. If the gas consumption of the messages is unknown, every
+// message accounts for an equal share of the fee.
+func calculateRefundPercentage(msgs []sdk.Msg, msgGas []uint64, gasRefunds []types.GasRefund) sdk.Dec {
+	refundPercentage := sdk.ZeroDec()
+	if len(msgs) == 0 {
+		return refundPercentage
+	}
+
+	totalGas := sdk.ZeroInt()
+	if len(msgGas) == len(msgs) {
+		for _, gas := range msgGas {
+			totalGas = totalGas.Add(sdk.NewIntFromUint64(gas))
+		}
+	}
+
+	for i, msg := range msgs {
+		for _, refund := range gasRefunds {
+			if sdk.MsgTypeURL(msg) == refund.Type {
+				if totalGas.IsPositive() {
+					refundPercentage = refundPercentage.Add(refund.Fraction.MulInt(sdk.NewIntFromUint64(msgGas[i])))
+				} else {
+					refundPercentage = refundPercentage.Add(refund.Fraction)
+				}
+				break
+			}
+		}
+	}
+
+	if totalGas.IsPositive() {
+		return refundPercentage.QuoInt(totalGas)
+	}
+
+	return refundPercentage.QuoInt64(int64(len(msgs)))
+}
+
+// getBaseFeePortion returns the part of the $KYVE fee which is equal to the
+// current base fee multiplied by the gas limit.
+func getBaseFeePortion(ctx sdk.Context, gk keeper.Keeper, fee sdk.Coins, gas uint64) sdk.Int {
+	baseFee := gk.GetBaseFee(ctx).MulInt64(int64(gas)).Ceil().TruncateInt()
+	return sdk.MinInt(fee.AmountOf(types.Denom), baseFee)
+}
+
 // BuildTxFeeChecker ensures that the configured minimum gas price is met.
+// If enabled, the current base fee is used instead of the minimum gas price
+// of the bond denom.
+// Fees can be paid in the bond denom or in any of the accepted fee denoms,
+// each of them having its own minimum gas price. The fee is sufficient if
+// it covers the required fee in at least one of those denoms.
 // In contrast to
 // https://github.com/cosmos/cosmos-sdk/blob/release/v0.46.x/x/auth/ante/validator_tx_fee.go#L12
 // this code runs within the consensus layer.
 func BuildTxFeeChecker(ctx sdk.Context, fk keeper.Keeper, sk stakingKeeper.Keeper) ante.TxFeeChecker {
-	consensusMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sk.BondDenom(ctx), fk.GetMinGasPrice(ctx)))
+	// If the base fee is enabled, it replaces the static minimum gas price.
+	minGasPrice := fk.GetMinGasPrice(ctx)
+	if fk.GetBaseFeeEnabled(ctx) {
+		minGasPrice = fk.GetBaseFee(ctx)
+	}
+
+	consensusMinGasPrices := sdk.DecCoins{sdk.NewDecCoinFromDec(sk.BondDenom(ctx), minGasPrice)}
+	for _, feeDenom := range fk.GetAcceptedFeeDenoms(ctx) {
+		consensusMinGasPrices = append(consensusMinGasPrices, sdk.NewDecCoinFromDec(feeDenom.Denom, feeDenom.MinGasPrice))
+	}
+	consensusMinGasPrices = consensusMinGasPrices.Sort()
+
+	acceptedDenoms := make(map[string]bool)
+	for _, gp := range consensusMinGasPrices {
+		acceptedDenoms[gp.Denom] = true
+	}
 
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
@@ -51,30 +193,37 @@ func BuildTxFeeChecker(ctx sdk.Context, fk keeper.Keeper, sk stakingKeeper.Keepe
 		feeCoins := feeTx.GetFee()
 		gas := feeTx.GetGas()
 
+		// Only accepted denoms can be used to pay fees.
+		for _, coin := range feeCoins {
+			if !acceptedDenoms[coin.Denom] {
+				return nil, 0, sdkErrors.Wrapf(errorsTypes.ErrInvalidCoins, "fee denom %s is not accepted", coin.Denom)
+			}
+		}
+
 		validatorMinGasPrices := ctx.MinGasPrices()
 
 		requiredFees := make(sdk.Coins, len(consensusMinGasPrices))
+		sufficient := false
 
 		// Determine the required fees by multiplying each required minimum gas
 		// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
 		glDec := sdk.NewDec(int64(gas))
 		for i, gp := range consensusMinGasPrices {
-			fee := gp.Amount.Mul(glDec)
-			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-		}
+			fee := gp.Amount.Mul(glDec).Ceil().RoundInt()
 
-		if ctx.IsCheckTx() {
-			validatorFees := make(sdk.Coins, len(validatorMinGasPrices))
-			for i, gp := range validatorMinGasPrices {
-				fee := gp.Amount.Mul(glDec)
-				validatorFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			if ctx.IsCheckTx() {
+				validatorFee := validatorMinGasPrices.AmountOf(gp.Denom).Mul(glDec).Ceil().RoundInt()
+				fee = sdk.MaxInt(fee, validatorFee)
 			}
 
-			requiredFees = requiredFees.Max(validatorFees)
+			requiredFees[i] = sdk.NewCoin(gp.Denom, fee)
+			if feeCoins.AmountOf(gp.Denom).GTE(fee) {
+				sufficient = true
+			}
 		}
 
-		if !requiredFees.IsZero() && !feeCoins.IsAnyGTE(requiredFees) {
-			return nil, 0, sdkErrors.Wrapf(errorsTypes.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+		if !sufficient {
+			return nil, 0, sdkErrors.Wrapf(errorsTypes.ErrInsufficientFee, "insufficient fees; got: %s required one of: %s", feeCoins, requiredFees)
 		}
 
 		priority := getTxPriority(feeCoins, int64(gas))
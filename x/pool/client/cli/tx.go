@@ -20,6 +20,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdFundPool())
 	cmd.AddCommand(CmdDefundPool())
+	cmd.AddCommand(CmdFundGasSponsorship())
 
 	return cmd
 }
@@ -24,6 +24,11 @@ func CmdDefundPool() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -33,6 +38,7 @@ func CmdDefundPool() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				argId,
 				argAmount,
+				denom,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -41,6 +47,8 @@ func CmdDefundPool() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDenom, "", "denom of the amount (empty for ukyve)")
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdFundGasSponsorship() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-gas-sponsorship [id] [amount]",
+		Short: "Broadcast message fund-gas-sponsorship",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argAmount, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundGasSponsorship(
+				clientCtx.GetFromAddress().String(),
+				argId,
+				argAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
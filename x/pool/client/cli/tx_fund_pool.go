@@ -9,6 +9,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagAmountPerBundle = "amount-per-bundle"
+	FlagStartAt         = "start-at"
+	FlagEndAt           = "end-at"
+	FlagDenom           = "denom"
+	FlagClearSchedule   = "clear-schedule"
+)
+
 func CmdFundPool() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fund-pool [id] [amount]",
@@ -24,6 +32,27 @@ func CmdFundPool() *cobra.Command {
 				return err
 			}
 
+			amountPerBundle, err := cmd.Flags().GetUint64(FlagAmountPerBundle)
+			if err != nil {
+				return err
+			}
+			startAt, err := cmd.Flags().GetUint64(FlagStartAt)
+			if err != nil {
+				return err
+			}
+			endAt, err := cmd.Flags().GetUint64(FlagEndAt)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			clearSchedule, err := cmd.Flags().GetBool(FlagClearSchedule)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -33,6 +62,11 @@ func CmdFundPool() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				argId,
 				argAmount,
+				amountPerBundle,
+				startAt,
+				endAt,
+				denom,
+				clearSchedule,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -41,6 +75,12 @@ func CmdFundPool() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagAmountPerBundle, 0, "maximum amount charged per bundle (0 keeps the current limit, required for the first funding of non-native denoms)")
+	cmd.Flags().Uint64(FlagStartAt, 0, "unix time the funding starts (0 keeps the current start)")
+	cmd.Flags().Uint64(FlagEndAt, 0, "unix time the funding ends (0 keeps the current end)")
+	cmd.Flags().String(FlagDenom, "", "whitelisted denom of the amount (empty for ukyve)")
+	cmd.Flags().Bool(FlagClearSchedule, false, "remove the amount per bundle and the funding period before setting the new values")
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
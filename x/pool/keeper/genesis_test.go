@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - genesis.go
+
+* Validate the default genesis
+* Validate a genesis with pools
+* Try to validate a genesis with duplicated pool ids
+* Try to validate a genesis with a pool id higher than the pool count
+* Try to validate a genesis with more funders than allowed
+
+*/
+
+var _ = Describe("genesis.go", Ordered, func() {
+	newFunders := func(count int) (funders []*pooltypes.Funder) {
+		for n := 0; n < count; n++ {
+			funders = append(funders, &pooltypes.Funder{
+				Address: i.ALICE,
+				Amount:  100 * i.KYVE,
+			})
+		}
+		return
+	}
+
+	It("Validate the default genesis", func() {
+		// ACT
+		err := pooltypes.DefaultGenesis().Validate()
+
+		// ASSERT
+		Expect(err).To(BeNil())
+	})
+
+	It("Validate a genesis with pools", func() {
+		// ARRANGE
+		genesis := pooltypes.DefaultGenesis()
+		genesis.PoolList = []pooltypes.Pool{
+			{Id: 0, Funders: newFunders(int(genesis.Params.MaxFunders))},
+			{Id: 1},
+		}
+		genesis.PoolCount = 2
+
+		// ACT
+		err := genesis.Validate()
+
+		// ASSERT
+		Expect(err).To(BeNil())
+	})
+
+	It("Try to validate a genesis with duplicated pool ids", func() {
+		// ARRANGE
+		genesis := pooltypes.DefaultGenesis()
+		genesis.PoolList = []pooltypes.Pool{{Id: 0}, {Id: 0}}
+		genesis.PoolCount = 1
+
+		// ACT
+		err := genesis.Validate()
+
+		// ASSERT
+		Expect(err).To(MatchError(ContainSubstring("duplicated pool id")))
+	})
+
+	It("Try to validate a genesis with a pool id higher than the pool count", func() {
+		// ARRANGE
+		genesis := pooltypes.DefaultGenesis()
+		genesis.PoolList = []pooltypes.Pool{{Id: 1}}
+		genesis.PoolCount = 1
+
+		// ACT
+		err := genesis.Validate()
+
+		// ASSERT
+		Expect(err).To(MatchError(ContainSubstring("pool id higher than pool count")))
+	})
+
+	It("Try to validate a genesis with more funders than allowed", func() {
+		// ARRANGE
+		genesis := pooltypes.DefaultGenesis()
+		genesis.PoolList = []pooltypes.Pool{
+			{Id: 0, Funders: newFunders(int(genesis.Params.MaxFunders) + 1)},
+		}
+		genesis.PoolCount = 1
+
+		// ACT
+		err := genesis.Validate()
+
+		// ASSERT
+		Expect(err).To(MatchError(ContainSubstring("more funders than allowed")))
+	})
+})
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetGasSponsorshipUsage returns the amount the gas sponsorship of the given
+// pool has paid for the given signer in the current block.
+func (k Keeper) GetGasSponsorshipUsage(ctx sdk.Context, poolId uint64, signer string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GasSponsorshipUsageKey)
+
+	bz := store.Get(types.GasSponsorshipUsageKeyPrefix(poolId, signer))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setGasSponsorshipUsage stores the amount the gas sponsorship of the given
+// pool has paid for the given signer in the current block.
+func (k Keeper) setGasSponsorshipUsage(ctx sdk.Context, poolId uint64, signer string, amount uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GasSponsorshipUsageKey)
+	store.Set(types.GasSponsorshipUsageKeyPrefix(poolId, signer), sdk.Uint64ToBigEndian(amount))
+}
+
+// ResetGasSponsorshipUsages removes the gas sponsorship usages of all
+// signers. It is called at the end of every block.
+func (k Keeper) ResetGasSponsorshipUsages(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GasSponsorshipUsageKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
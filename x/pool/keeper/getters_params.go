@@ -25,6 +25,31 @@ func (k Keeper) GetPoolInflationPayoutRate(ctx sdk.Context) (res sdk.Dec) {
 	return k.GetParams(ctx).PoolInflationPayoutRate
 }
 
+// GetMaxFunders returns the MaxFunders param
+func (k Keeper) GetMaxFunders(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MaxFunders
+}
+
+// GetWhitelistedDenoms returns the WhitelistedDenoms param
+func (k Keeper) GetWhitelistedDenoms(ctx sdk.Context) (res []string) {
+	return k.GetParams(ctx).WhitelistedDenoms
+}
+
+// GetMaxGasSponsorshipPerBlock returns the MaxGasSponsorshipPerBlock param
+func (k Keeper) GetMaxGasSponsorshipPerBlock(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MaxGasSponsorshipPerBlock
+}
+
+// IsDenomWhitelisted returns true if pools can be funded with the given denom
+func (k Keeper) IsDenomWhitelisted(ctx sdk.Context, denom string) bool {
+	for _, whitelistedDenom := range k.GetWhitelistedDenoms(ctx) {
+		if whitelistedDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
 // SetParams stores the x/pool params in state.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	bz := k.cdc.MustMarshal(&params)
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all pool invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-funds", TotalFundsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the pool module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return TotalFundsInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the pool module account holds
+// at least the sum of all pool funds in every denom and all gas
+// sponsorship budgets.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := uint64(0)
+		expectedCoins := sdk.NewCoins()
+		for _, pool := range k.GetAllPools(ctx) {
+			expected += pool.TotalFunds + pool.GasSponsorship
+			expectedCoins = expectedCoins.Add(pool.TotalFundsPerDenom...)
+		}
+
+		moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balance := k.bankKeeper.GetBalance(ctx, moduleAddress, globalTypes.Denom).Amount.Uint64()
+		balanceCoins := k.bankKeeper.GetAllBalances(ctx, moduleAddress)
+
+		broken := balance < expected || !balanceCoins.IsAllGTE(expectedCoins)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account",
+			fmt.Sprintf(
+				"\tpool module balance: %d\n\tsum of total funds and gas sponsorships: %d\n\tpool module coins: %s\n\tsum of total funds per denom: %s\n",
+				balance, expected, balanceCoins, expectedCoins,
+			),
+		), broken
+	}
+}
+
+// TotalFundsInvariant checks that the total funds of every pool are
+// equal to the sum of the amounts of all its funders in every denom.
+func TotalFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, pool := range k.GetAllPools(ctx) {
+			sum := uint64(0)
+			sumCoins := sdk.NewCoins()
+			for _, funder := range pool.Funders {
+				sum += funder.Amount
+				sumCoins = sumCoins.Add(funder.Coins...)
+			}
+
+			if sum != pool.TotalFunds {
+				broken = true
+				msg += fmt.Sprintf("\tpool %d: total funds %d, sum of funders %d\n", pool.Id, pool.TotalFunds, sum)
+			}
+
+			if !sumCoins.IsAllGTE(pool.TotalFundsPerDenom) || !pool.TotalFundsPerDenom.IsAllGTE(sumCoins) {
+				broken = true
+				msg += fmt.Sprintf("\tpool %d: total funds per denom %s, sum of funders %s\n", pool.Id, pool.TotalFundsPerDenom, sumCoins)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total-funds", msg), broken
+	}
+}
@@ -5,8 +5,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// ChargeFundersOfPool equally splits the amount between all funders and removes
-// the appropriate amount from each funder.
+// ChargeFundersOfPool charges the given amount from the funders of a pool
+// according to the funding policy of the pool.
+// Only funders whose funding period is active get charged and no funder
+// gets charged more than his amount per bundle.
+// Funders who have only funded the pool with non-native denoms are
+// not charged here, see ChargeFunderCoinsOfPool.
 // All funders who can't afford the amount, are kicked out.
 // Their remaining amount is transferred to the Treasury.
 // This method does not transfer any funds. The bundles-module
@@ -17,43 +21,61 @@ func (k Keeper) ChargeFundersOfPool(ctx sdk.Context, poolId uint64, amount uint6
 		return 0, poolErr
 	}
 
-	// if pool has no funders we immediately return
-	if len(pool.Funders) == 0 {
+	funders := make([]poolTypes.Funder, 0)
+	for _, funder := range pool.GetActiveFunders(uint64(ctx.BlockTime().Unix())) {
+		if funder.Amount > 0 {
+			funders = append(funders, funder)
+		}
+	}
+
+	// if pool has no active funders we immediately return
+	if len(funders) == 0 {
 		return payout, err
 	}
 
-	// This is the amount every funder will be charged
-	amountPerFunder := amount / uint64(len(pool.Funders))
+	switch pool.FundingPolicy {
+	case poolTypes.FUNDING_POLICY_PRO_RATA:
+		payout = chargeFundersProRata(&pool, funders, amount)
+	default:
+		payout = chargeFundersEqualSplit(&pool, funders, amount)
+	}
 
-	// Due to discrete division there will be a reminder which can not be split
-	// equally among all funders. This amount is charged to the lowest funder
-	amountRemainder := amount - amountPerFunder*uint64(len(pool.Funders))
+	if len(pool.Funders) == 0 {
+		_ = ctx.EventManager().EmitTypedEvent(&poolTypes.EventPoolOutOfFunds{
+			PoolId: pool.Id,
+		})
+	}
 
-	funders := pool.Funders
+	k.SetPool(ctx, pool)
+	return payout, nil
+}
 
-	for _, funder := range funders {
-		if funder.Amount < amountPerFunder {
-			pool.RemoveFunder(funder.Address)
-			payout += funder.Amount
-		} else {
-			pool.SubtractAmountFromFunder(funder.Address, amountPerFunder)
-			payout += amountPerFunder
-		}
+// ChargeFunderCoinsOfPool charges every active funder of a pool his
+// amount per bundle in every non-native denom he has funded the pool with.
+// The funding policy of the pool does not apply here, because the
+// operating cost of a pool is only defined in ukyve.
+// Funders who have no funds left in any denom are removed.
+// This method does not transfer any funds. The bundles-module
+// is responsible for transferring the rewards out of the module.
+func (k Keeper) ChargeFunderCoinsOfPool(ctx sdk.Context, poolId uint64) (payout sdk.Coins, err error) {
+	pool, poolErr := k.GetPoolWithError(ctx, poolId)
+	if poolErr != nil {
+		return sdk.NewCoins(), poolErr
 	}
 
-	lowestFunder := pool.GetLowestFunder()
+	payout = sdk.NewCoins()
 
-	if lowestFunder.Address != "" {
-		if lowestFunder.Amount < amountRemainder {
-			pool.RemoveFunder(lowestFunder.Address)
-			payout += lowestFunder.Amount
-		} else {
-			pool.SubtractAmountFromFunder(lowestFunder.Address, amountRemainder)
-			payout += amountRemainder
+	for _, funder := range pool.GetActiveFunders(uint64(ctx.BlockTime().Unix())) {
+		coins := funder.CoinsPerBundle.Min(funder.Coins)
+		if coins.IsZero() {
+			continue
 		}
+
+		pool.SubtractCoinsFromFunder(funder.Address, coins)
+		payout = payout.Add(coins...)
 	}
 
-	if len(pool.Funders) == 0 {
+	if len(pool.Funders) == 0 && !payout.IsZero() {
 		_ = ctx.EventManager().EmitTypedEvent(&poolTypes.EventPoolOutOfFunds{
 			PoolId: pool.Id,
 		})
@@ -62,3 +84,103 @@ func (k Keeper) ChargeFundersOfPool(ctx sdk.Context, poolId uint64, amount uint6
 	k.SetPool(ctx, pool)
 	return payout, nil
 }
+
+// chargeFundersEqualSplit equally splits the amount between the given funders and removes
+// the appropriate amount from each funder.
+func chargeFundersEqualSplit(pool *poolTypes.Pool, funders []poolTypes.Funder, amount uint64) (payout uint64) {
+	charged := make(map[string]uint64)
+
+	// This is the amount every funder will be charged
+	amountPerFunder := amount / uint64(len(funders))
+
+	// Due to discrete division there will be a reminder which can not be split
+	// equally among all funders. This amount is charged to the lowest funder
+	amountRemainder := amount - amountPerFunder*uint64(len(funders))
+
+	for _, funder := range funders {
+		payout += chargeFunder(pool, funder, amountPerFunder, charged)
+	}
+
+	lowestFunder := poolTypes.Funder{}
+	for _, funder := range funders {
+		funder.Amount = pool.GetFunderAmount(funder.Address)
+		if funder.Amount > 0 && (lowestFunder.Address == "" || funder.Amount < lowestFunder.Amount) {
+			lowestFunder = funder
+		}
+	}
+
+	if lowestFunder.Address != "" {
+		payout += chargeFunder(pool, lowestFunder, amountRemainder, charged)
+	}
+
+	return payout
+}
+
+// chargeFundersProRata charges every given funder relative to its share of the
+// total funds of all given funders.
+func chargeFundersProRata(pool *poolTypes.Pool, funders []poolTypes.Funder, amount uint64) (payout uint64) {
+	charged := make(map[string]uint64)
+
+	totalFunds := uint64(0)
+	for _, funder := range funders {
+		totalFunds += funder.Amount
+	}
+
+	// if the funders can not afford the amount every funder gets charged
+	// everything he has left
+	if amount >= totalFunds {
+		for _, funder := range funders {
+			payout += chargeFunder(pool, funder, funder.Amount, charged)
+		}
+
+		return payout
+	}
+
+	// The share of a funder is always lower than his amount, therefore
+	// no funder gets removed here
+	for _, funder := range funders {
+		share := sdk.NewIntFromUint64(amount).
+			Mul(sdk.NewIntFromUint64(funder.Amount)).
+			Quo(sdk.NewIntFromUint64(totalFunds)).
+			Uint64()
+
+		payout += chargeFunder(pool, funder, share, charged)
+	}
+
+	// Due to discrete division there will be a reminder which is at most the
+	// number of funders. This amount is charged to the highest funder
+	amountRemainder := amount - payout
+
+	highestFunder := poolTypes.Funder{}
+	for _, funder := range funders {
+		funder.Amount = pool.GetFunderAmount(funder.Address)
+		if funder.Amount > highestFunder.Amount {
+			highestFunder = funder
+		}
+	}
+
+	if highestFunder.Address != "" {
+		payout += chargeFunder(pool, highestFunder, amountRemainder, charged)
+	}
+
+	return payout
+}
+
+// chargeFunder charges the given amount from a single funder. The amount is
+// capped by the amount per bundle of the funder, taking into account what was
+// already charged for the current bundle. If the funder can not afford
+// the amount he is kicked out.
+func chargeFunder(pool *poolTypes.Pool, funder poolTypes.Funder, amount uint64, charged map[string]uint64) uint64 {
+	if funder.AmountPerBundle > 0 && charged[funder.Address]+amount > funder.AmountPerBundle {
+		amount = funder.AmountPerBundle - charged[funder.Address]
+	}
+
+	if funderAmount := pool.GetFunderAmount(funder.Address); funderAmount < amount {
+		amount = funderAmount
+	}
+
+	pool.SubtractAmountFromFunder(funder.Address, amount)
+	charged[funder.Address] += amount
+
+	return amount
+}
@@ -4,6 +4,7 @@ import (
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	"github.com/KYVENetwork/chain/util"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -22,6 +23,15 @@ TEST CASES - logic_funders.go
 * Kick out multiple lowest funders
 * Charge more than pool has funds
 * Charge pool which has no funds at all
+* Charge Funders pro-rata
+* Charge Funders pro-rata test remainder
+* Charge more than pool has funds pro-rata
+* Charge Funders with amount per bundle
+* Charge only funders with an active funding period
+* Get runway of pool
+* Charge Funders in whitelisted denoms
+* Do not charge funders who only funded whitelisted denoms in ukyve
+* Kick out funders who used up all their funds in whitelisted denoms
 
 */
 
@@ -38,6 +48,19 @@ func chargeFunders(s *i.KeeperTestSuite, amount uint64) (payout uint64, err erro
 	return payout, err
 }
 
+func chargeFunderCoins(s *i.KeeperTestSuite) (payout sdk.Coins, err error) {
+	payout, err = s.App().PoolKeeper.ChargeFunderCoinsOfPool(s.Ctx(), 0)
+	if err != nil {
+		return sdk.NewCoins(), err
+	}
+
+	if err := util.TransferCoinsFromModuleToAddress(s.App().BankKeeper, s.Ctx(), pooltypes.ModuleName, i.BURNER, payout); err != nil {
+		return sdk.NewCoins(), err
+	}
+
+	return payout, err
+}
+
 func fundersCheck(pool *pooltypes.Pool) {
 	poolFunds := uint64(0)
 	funders := make(map[string]bool)
@@ -66,6 +89,14 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 		}
 
 		s.App().PoolKeeper.AppendPool(s.Ctx(), *pool)
+
+		// whitelist a non-native denom for funding
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.WhitelistedDenoms = []string{i.IBC_DENOM}
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		_ = s.MintDenom(i.ALICE, i.IBC_DENOM, 1000*i.KYVE)
+		_ = s.MintDenom(i.BOB, i.IBC_DENOM, 1000*i.KYVE)
 	})
 
 	AfterEach(func() {
@@ -311,4 +342,289 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 		Expect(pool.Funders).To(HaveLen(0))
 		Expect(pool.TotalFunds).To(BeZero())
 	})
+
+	It("Charge Funders pro-rata", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.FundingPolicy = pooltypes.FUNDING_POLICY_PRO_RATA
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  900 * i.KYVE,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		payout, err := chargeFunders(s, 100*i.KYVE)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payout).To(Equal(100 * i.KYVE))
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalFunds).To(Equal(900 * i.KYVE))
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(810 * i.KYVE))
+		Expect(pool.GetFunderAmount(i.BOB)).To(Equal(90 * i.KYVE))
+	})
+
+	It("Charge Funders pro-rata test remainder", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.FundingPolicy = pooltypes.FUNDING_POLICY_PRO_RATA
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  200,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  100,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.CHARLIE,
+			Id:      0,
+			Amount:  1,
+		})
+
+		// ACT
+		// Alice: 100 * 200 / 301 = 66
+		// Bob: 100 * 100 / 301 = 33
+		// Charlie: 100 * 1 / 301 = 0
+		// the remainder of 1 will be charged to the highest funder
+		payout, err := chargeFunders(s, 100)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payout).To(Equal(uint64(100)))
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Funders).To(HaveLen(3))
+		Expect(pool.TotalFunds).To(Equal(uint64(201)))
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(uint64(133)))
+		Expect(pool.GetFunderAmount(i.BOB)).To(Equal(uint64(67)))
+		Expect(pool.GetFunderAmount(i.CHARLIE)).To(Equal(uint64(1)))
+	})
+
+	It("Charge more than pool has funds pro-rata", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.FundingPolicy = pooltypes.FUNDING_POLICY_PRO_RATA
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  900 * i.KYVE,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		payout, err := chargeFunders(s, 5000*i.KYVE)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payout).To(Equal(1000 * i.KYVE))
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Funders).To(HaveLen(0))
+		Expect(pool.TotalFunds).To(BeZero())
+	})
+
+	It("Charge Funders with amount per bundle", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		payout, err := chargeFunders(s, 10*i.KYVE)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payout).To(Equal(6 * i.KYVE))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(99 * i.KYVE))
+		Expect(pool.GetFunderAmount(i.BOB)).To(Equal(95 * i.KYVE))
+	})
+
+	It("Charge only funders with an active funding period", func() {
+		// ARRANGE
+		now := uint64(s.Ctx().BlockTime().Unix())
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+			StartAt: now + 60,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+			EndAt:   now + 60,
+		})
+
+		// ACT
+		payout, err := chargeFunders(s, 10*i.KYVE)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payout).To(Equal(10 * i.KYVE))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(100 * i.KYVE))
+		Expect(pool.GetFunderAmount(i.BOB)).To(Equal(90 * i.KYVE))
+
+		// ACT
+		s.CommitAfterSeconds(60)
+
+		payout, err = chargeFunders(s, 10*i.KYVE)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payout).To(Equal(10 * i.KYVE))
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(90 * i.KYVE))
+		Expect(pool.GetFunderAmount(i.BOB)).To(Equal(90 * i.KYVE))
+	})
+
+	It("Get runway of pool", func() {
+		// ARRANGE
+		now := uint64(s.Ctx().BlockTime().Unix())
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 2_000,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.BOB,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 3_000,
+			EndAt:           now + 60,
+		})
+
+		// ASSERT
+		// operating cost is 10_000 and funders pay at most 5_000 per bundle
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GetRunway(now)).To(Equal(200 * i.KYVE / 5_000))
+
+		// after the funding period of bob only alice pays 2_000 per bundle
+		Expect(pool.GetRunway(now + 60)).To(Equal(100 * i.KYVE / 2_000))
+	})
+
+	It("Charge Funders in whitelisted denoms", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 2 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.BOB,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 3 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		payout, err := chargeFunderCoins(s)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payout).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(5*i.KYVE)))))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalFunds).To(Equal(100 * i.KYVE))
+		Expect(pool.TotalFundsPerDenom).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(195*i.KYVE)))))
+
+		alice, _ := pool.GetFunder(i.ALICE)
+		Expect(alice.Coins.AmountOf(i.IBC_DENOM).Uint64()).To(Equal(98 * i.KYVE))
+
+		bob, _ := pool.GetFunder(i.BOB)
+		Expect(bob.Coins.AmountOf(i.IBC_DENOM).Uint64()).To(Equal(97 * i.KYVE))
+	})
+
+	It("Do not charge funders who only funded whitelisted denoms in ukyve", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.BOB,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		payout, err := chargeFunders(s, 10*i.KYVE)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payout).To(Equal(10 * i.KYVE))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Funders).To(HaveLen(2))
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(90 * i.KYVE))
+		Expect(pool.GetFunderAmount(i.BOB)).To(BeZero())
+	})
+
+	It("Kick out funders who used up all their funds in whitelisted denoms", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          1 * i.KYVE,
+			AmountPerBundle: 2 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		payout, err := chargeFunderCoins(s)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payout).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(1*i.KYVE)))))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Funders).To(BeEmpty())
+		Expect(pool.TotalFundsPerDenom).To(BeEmpty())
+	})
 })
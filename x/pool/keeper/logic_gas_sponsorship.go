@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// Auth
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ChargeGasSponsorship pays the transaction fee of the given signer with the gas
+// sponsorship budget of the pool. The fee is transferred to the fee collector.
+// If the budget can not pay the entire fee or the signer would exceed the
+// MaxGasSponsorshipPerBlock param, an error is returned and nothing is charged.
+func (k Keeper) ChargeGasSponsorship(ctx sdk.Context, poolId uint64, signer string, amount uint64) error {
+	pool, poolErr := k.GetPoolWithError(ctx, poolId)
+	if poolErr != nil {
+		return poolErr
+	}
+
+	if pool.GasSponsorship < amount {
+		return errors.Wrapf(errorsTypes.ErrInsufficientFunds, types.ErrGasSponsorshipTooLow.Error(), pool.GasSponsorship, amount)
+	}
+
+	usage := k.GetGasSponsorshipUsage(ctx, poolId, signer)
+	if maxUsage := k.GetMaxGasSponsorshipPerBlock(ctx); usage+amount > maxUsage {
+		return errors.Wrapf(errorsTypes.ErrInsufficientFunds, types.ErrGasSponsorshipLimitReached.Error(), maxUsage)
+	}
+
+	if err := util.TransferFromModuleToModule(k.bankKeeper, ctx, types.ModuleName, authTypes.FeeCollectorName, amount); err != nil {
+		return err
+	}
+
+	pool.GasSponsorship -= amount
+	k.SetPool(ctx, pool)
+
+	k.setGasSponsorshipUsage(ctx, poolId, signer, usage+amount)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventGasSponsored{
+		PoolId:  poolId,
+		Address: signer,
+		Amount:  amount,
+	})
+
+	return nil
+}
+
+// RefundGasSponsorship transfers the given amount from the fee collector back
+// to the gas sponsorship budget of the pool. It is used for gas refunds of
+// transactions which were paid by the pool.
+func (k Keeper) RefundGasSponsorship(ctx sdk.Context, poolId uint64, amount uint64) error {
+	pool, poolErr := k.GetPoolWithError(ctx, poolId)
+	if poolErr != nil {
+		return poolErr
+	}
+
+	if err := util.TransferFromModuleToModule(k.bankKeeper, ctx, authTypes.FeeCollectorName, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	pool.GasSponsorship += amount
+	k.SetPool(ctx, pool)
+
+	return nil
+}
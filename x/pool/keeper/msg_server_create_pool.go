@@ -44,6 +44,13 @@ func (k msgServer) CreatePool(goCtx context.Context, req *types.MsgCreatePool) (
 		UpgradePlan:              &types.UpgradePlan{},
 		CurrentStorageProviderId: req.StorageProviderId,
 		CurrentCompressionId:     req.CompressionId,
+		FundingPolicy:            req.FundingPolicy,
+		MaxStakers:               req.MaxStakers,
+		UploaderSelection:        req.UploaderSelection,
+		ValidQuorum:              req.ValidQuorum,
+		InvalidQuorum:            req.InvalidQuorum,
+		VotingMode:               req.VotingMode,
+		RevealWindow:             req.RevealWindow,
 	})
 
 	k.EnsurePoolAccount(ctx, id)
@@ -63,6 +70,13 @@ func (k msgServer) CreatePool(goCtx context.Context, req *types.MsgCreatePool) (
 		Binaries:          req.Binaries,
 		StorageProviderId: req.StorageProviderId,
 		CompressionId:     req.CompressionId,
+		FundingPolicy:     req.FundingPolicy,
+		MaxStakers:        req.MaxStakers,
+		UploaderSelection: req.UploaderSelection,
+		ValidQuorum:       req.ValidQuorum,
+		InvalidQuorum:     req.InvalidQuorum,
+		VotingMode:        req.VotingMode,
+		RevealWindow:      req.RevealWindow,
 	})
 
 	return &types.MsgCreatePoolResponse{}, nil
@@ -159,6 +159,8 @@ var _ = Describe("msg_server_create_pool.go", Ordered, func() {
 			},
 			CurrentStorageProviderId: 2,
 			CurrentCompressionId:     1,
+			ValidQuorum:              sdk.ZeroDec(),
+			InvalidQuorum:            sdk.ZeroDec(),
 		}))
 	})
 
@@ -262,6 +264,8 @@ var _ = Describe("msg_server_create_pool.go", Ordered, func() {
 			},
 			CurrentStorageProviderId: 2,
 			CurrentCompressionId:     1,
+			ValidQuorum:              sdk.ZeroDec(),
+			InvalidQuorum:            sdk.ZeroDec(),
 		}))
 	})
 
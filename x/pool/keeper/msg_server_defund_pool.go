@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/errors"
 	"github.com/KYVENetwork/chain/util"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	"github.com/KYVENetwork/chain/x/pool/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,6 +15,7 @@ import (
 // If the user is a funder, it will subtract the provided amount
 // and send the tokens back. If the amount equals the current funding amount
 // the funder is removed completely.
+// If a non-native denom is given, the amount is defunded in that denom.
 func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (*types.MsgDefundPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	pool, found := k.GetPool(ctx, msg.Id)
@@ -23,6 +25,10 @@ func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (
 		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
 	}
 
+	if msg.Denom != "" && msg.Denom != globalTypes.Denom {
+		return k.defundPoolWithCoins(ctx, pool, msg)
+	}
+
 	// Sender needs to be a funder in the pool
 	funderAmount := pool.GetFunderAmount(msg.Creator)
 	if funderAmount == 0 {
@@ -53,3 +59,38 @@ func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (
 
 	return &types.MsgDefundPoolResponse{}, nil
 }
+
+// defundPoolWithCoins handles the logic to defund a non-native denom from a pool.
+func (k msgServer) defundPoolWithCoins(ctx sdk.Context, pool types.Pool, msg *types.MsgDefundPool) (*types.MsgDefundPoolResponse, error) {
+	// Sender needs to be a funder of the denom in the pool
+	funder, found := pool.GetFunder(msg.Creator)
+	if !found || funder.Coins.AmountOf(msg.Denom).IsZero() {
+		return nil, errorsTypes.ErrNotFound
+	}
+
+	// Check if the sender is trying to defund more than they have funded.
+	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, sdk.NewIntFromUint64(msg.Amount)))
+	if !coins.IsAllLTE(funder.Coins) {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrDefundTooHigh.Error(), msg.Creator)
+	}
+
+	// Update state variables (or completely remove if fully defunding).
+	pool.SubtractCoinsFromFunder(msg.Creator, coins)
+
+	// Transfer tokens from this module to sender.
+	if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, msg.Creator, coins); err != nil {
+		return nil, err
+	}
+
+	// Emit a defund event.
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
+		PoolId:  msg.Id,
+		Address: msg.Creator,
+		Amount:  msg.Amount,
+		Denom:   msg.Denom,
+	})
+
+	k.SetPool(ctx, pool)
+
+	return &types.MsgDefundPoolResponse{}, nil
+}
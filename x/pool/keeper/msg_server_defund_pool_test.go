@@ -6,6 +6,7 @@ import (
 
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 /*
@@ -16,6 +17,9 @@ TEST CASES - msg_server_defund_pool.go
 * Try to defund more than actually funded
 * Defund full funding amount from a funder who has previously funded 100 KYVE
 * Defund as highest funder 75 KYVE in order to be the lowest funder afterwards
+* Defund a whitelisted denom from a funder who has also funded KYVE
+* Defund the full amount of a whitelisted denom from a funder who has only funded this denom
+* Try to defund more of a whitelisted denom than actually funded
 
 */
 
@@ -45,6 +49,14 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 			Id:      0,
 			Amount:  100 * i.KYVE,
 		})
+
+		// whitelist a non-native denom for funding
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.WhitelistedDenoms = []string{i.IBC_DENOM}
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		_ = s.MintDenom(i.ALICE, i.IBC_DENOM, 100*i.KYVE)
+		_ = s.MintDenom(i.BOB, i.IBC_DENOM, 100*i.KYVE)
 	})
 
 	AfterEach(func() {
@@ -146,4 +158,104 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 		Expect(pool.GetLowestFunder().Address).To(Equal(i.ALICE))
 		Expect(pool.GetLowestFunder().Amount).To(Equal(25 * i.KYVE))
 	})
+
+	It("Defund a whitelisted denom from a funder who has also funded KYVE", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+			Denom:   i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(s.GetBalanceOfDenomFromAddress(i.ALICE, i.IBC_DENOM)).To(Equal(100 * i.KYVE))
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.TotalFunds).To(Equal(100 * i.KYVE))
+		Expect(pool.TotalFundsPerDenom).To(BeEmpty())
+
+		funder, _ := pool.GetFunder(i.ALICE)
+		Expect(funder.Coins).To(BeEmpty())
+		Expect(funder.CoinsPerBundle).To(BeEmpty())
+	})
+
+	It("Defund the full amount of a whitelisted denom from a funder who has only funded this denom", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.BOB,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  40 * i.KYVE,
+			Denom:   i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Funders).To(HaveLen(2))
+		Expect(pool.TotalFundsPerDenom).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(60*i.KYVE)))))
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  60 * i.KYVE,
+			Denom:   i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(s.GetBalanceOfDenomFromAddress(i.BOB, i.IBC_DENOM)).To(Equal(100 * i.KYVE))
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.TotalFundsPerDenom).To(BeEmpty())
+
+		_, found := pool.GetFunder(i.BOB)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to defund more of a whitelisted denom than actually funded", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          50 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgDefundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  51 * i.KYVE,
+			Denom:   i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(s.GetBalanceOfDenomFromAddress(i.ALICE, i.IBC_DENOM)).To(Equal(50 * i.KYVE))
+		Expect(pool.TotalFundsPerDenom).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(50*i.KYVE)))))
+	})
 })
@@ -194,8 +194,10 @@ var _ = Describe("msg_server_disable_pool.go", Ordered, func() {
 		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 
 		s.App().PoolKeeper.SetParams(s.Ctx(), types.Params{
-			ProtocolInflationShare:  sdk.MustNewDecFromStr("0.1"),
-			PoolInflationPayoutRate: sdk.MustNewDecFromStr("0.05"),
+			ProtocolInflationShare:    sdk.MustNewDecFromStr("0.1"),
+			PoolInflationPayoutRate:   sdk.MustNewDecFromStr("0.05"),
+			MaxFunders:                types.DefaultMaxFunders,
+			MaxGasSponsorshipPerBlock: types.DefaultMaxGasSponsorshipPerBlock,
 		})
 
 		for i := 0; i < 100; i++ {
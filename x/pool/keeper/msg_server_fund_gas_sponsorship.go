@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FundGasSponsorship adds the given amount to the gas sponsorship budget
+// of a pool. The budget pays the transaction fees of the bundle messages
+// of the pool stakers. Funds in the budget can not be withdrawn again.
+func (k msgServer) FundGasSponsorship(goCtx context.Context, msg *types.MsgFundGasSponsorship) (*types.MsgFundGasSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, poolFound := k.GetPool(ctx, msg.Id)
+
+	if !poolFound {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
+	}
+
+	if err := util.TransferFromAddressToModule(k.bankKeeper, ctx, msg.Creator, types.ModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	pool.GasSponsorship += msg.Amount
+	k.SetPool(ctx, pool)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventFundGasSponsorship{
+		PoolId:  msg.Id,
+		Address: msg.Creator,
+		Amount:  msg.Amount,
+	})
+
+	return &types.MsgFundGasSponsorshipResponse{}, nil
+}
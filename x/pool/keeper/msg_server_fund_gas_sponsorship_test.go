@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - msg_server_fund_gas_sponsorship.go
+
+* Fund the gas sponsorship of a pool
+* Fund the gas sponsorship of a pool twice
+* Try to fund the gas sponsorship of a non-existing pool
+* Try to fund the gas sponsorship with more than available balance
+* Charge the gas sponsorship of a pool
+* Try to charge more than the gas sponsorship of a pool
+* Try to charge more than the gas sponsorship limit per block
+
+*/
+
+var _ = Describe("msg_server_fund_gas_sponsorship.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	initialBalance := s.GetBalanceFromAddress(i.ALICE)
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		initialBalance = s.GetBalanceFromAddress(i.ALICE)
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name: "PoolTest",
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Fund the gas sponsorship of a pool", func() {
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundGasSponsorship{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ASSERT
+		balanceAfter := s.GetBalanceFromAddress(i.ALICE)
+		Expect(initialBalance - balanceAfter).To(Equal(100 * i.KYVE))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(100 * i.KYVE))
+
+		// gas sponsorship is independent of the pool funds
+		Expect(pool.Funders).To(BeEmpty())
+		Expect(pool.TotalFunds).To(BeZero())
+	})
+
+	It("Fund the gas sponsorship of a pool twice", func() {
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundGasSponsorship{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundGasSponsorship{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  50 * i.KYVE,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(150 * i.KYVE))
+	})
+
+	It("Try to fund the gas sponsorship of a non-existing pool", func() {
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundGasSponsorship{
+			Creator: i.ALICE,
+			Id:      1,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.ALICE)).To(Equal(initialBalance))
+	})
+
+	It("Try to fund the gas sponsorship with more than available balance", func() {
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundGasSponsorship{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  initialBalance + 1,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(BeZero())
+	})
+
+	It("Charge the gas sponsorship of a pool", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundGasSponsorship{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		err := s.App().PoolKeeper.ChargeGasSponsorship(s.Ctx(), 0, i.VALADDRESS_0, 3_000_000)
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(100*i.KYVE - 3_000_000))
+		Expect(s.App().PoolKeeper.GetGasSponsorshipUsage(s.Ctx(), 0, i.VALADDRESS_0)).To(Equal(uint64(3_000_000)))
+	})
+
+	It("Try to charge more than the gas sponsorship of a pool", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundGasSponsorship{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		err := s.App().PoolKeeper.ChargeGasSponsorship(s.Ctx(), 0, i.VALADDRESS_0, 101*i.KYVE)
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(100 * i.KYVE))
+	})
+
+	It("Try to charge more than the gas sponsorship limit per block", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundGasSponsorship{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		Expect(s.App().PoolKeeper.ChargeGasSponsorship(s.Ctx(), 0, i.VALADDRESS_0, 6_000_000)).To(Succeed())
+
+		// ACT
+		err := s.App().PoolKeeper.ChargeGasSponsorship(s.Ctx(), 0, i.VALADDRESS_0, 6_000_000)
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		// the limit applies per valaccount
+		Expect(s.App().PoolKeeper.ChargeGasSponsorship(s.Ctx(), 0, i.VALADDRESS_1, 6_000_000)).To(Succeed())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GasSponsorship).To(Equal(100*i.KYVE - 12_000_000))
+
+		// ACT
+		s.Commit()
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetGasSponsorshipUsage(s.Ctx(), 0, i.VALADDRESS_0)).To(BeZero())
+		Expect(s.App().PoolKeeper.ChargeGasSponsorship(s.Ctx(), 0, i.VALADDRESS_0, 6_000_000)).To(Succeed())
+	})
+})
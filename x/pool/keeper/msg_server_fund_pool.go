@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/errors"
 	"github.com/KYVENetwork/chain/util"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	"github.com/KYVENetwork/chain/x/pool/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,6 +16,11 @@ import (
 // If the funders list is full, it checks if the funder wants to fund
 // more than the current lowest funder. If so, the current lowest funder
 // will get their tokens back and removed form the funders list.
+// The amount per bundle and the funding period of the funder are
+// only overwritten with the values which are set in the message.
+// They can be removed explicitly with the clear schedule flag.
+// If a whitelisted non-native denom is given, the pool is funded with
+// that denom instead, see fundPoolWithCoins.
 func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*types.MsgFundPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	pool, poolFound := k.GetPool(ctx, msg.Id)
@@ -23,29 +29,26 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
 	}
 
+	if msg.Denom != "" && msg.Denom != globalTypes.Denom {
+		return k.fundPoolWithCoins(ctx, pool, msg)
+	}
+
 	// Check if funder already exists
 	// If sender is not a funder, check if a free funding slot is still available
-	if pool.GetFunderAmount(msg.Creator) == 0 {
+	if _, found := pool.GetFunder(msg.Creator); !found {
 		// If funder does not exist, check if limit is already exceeded.
-		if len(pool.Funders) >= types.MaxFunders {
+		if uint64(len(pool.Funders)) >= k.GetMaxFunders(ctx) {
 			// If so, check if funder wants to fund more than current lowest funder.
-			lowestFunder := pool.GetLowestFunder()
+			// Funders who only funded whitelisted denoms can not be replaced.
+			lowestFunder, found := pool.GetLowestNativeFunder()
+			if !found {
+				return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrMaxFundersReached.Error(), k.GetMaxFunders(ctx))
+			}
 			if msg.Amount > lowestFunder.Amount {
-				// Unstake lowest Funder
-				err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, lowestFunder.Address, lowestFunder.Amount)
-				if err != nil {
+				// Unstake lowest Funder and remove him from the pool
+				if err := k.refundFunder(ctx, &pool, lowestFunder); err != nil {
 					return nil, err
 				}
-
-				// Emit a defund event.
-				_ = ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
-					PoolId:  msg.Id,
-					Address: lowestFunder.Address,
-					Amount:  lowestFunder.Amount,
-				})
-
-				// Remove from pool
-				pool.RemoveFunder(lowestFunder.Address)
 			} else {
 				return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrFundsTooLow.Error(), lowestFunder.Amount)
 			}
@@ -54,18 +57,118 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 
 	// User is allowed to fund
 	pool.AddAmountToFunder(msg.Creator, msg.Amount)
+	if msg.ClearSchedule {
+		pool.ClearFunderSchedule(msg.Creator, globalTypes.Denom)
+	}
+	pool.SetFunderSchedule(msg.Creator, msg.AmountPerBundle, msg.StartAt, msg.EndAt)
+
+	// The updated funding period must still be valid.
+	if funder, _ := pool.GetFunder(msg.Creator); funder.EndAt != 0 && funder.EndAt <= funder.StartAt {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, "end time must be after start time")
+	}
 
 	if err := util.TransferFromAddressToModule(k.bankKeeper, ctx, msg.Creator, types.ModuleName, msg.Amount); err != nil {
 		return nil, err
 	}
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventFundPool{
-		PoolId:  msg.Id,
-		Address: msg.Creator,
-		Amount:  msg.Amount,
+		PoolId:          msg.Id,
+		Address:         msg.Creator,
+		Amount:          msg.Amount,
+		AmountPerBundle: msg.AmountPerBundle,
+		StartAt:         msg.StartAt,
+		EndAt:           msg.EndAt,
+	})
+
+	k.SetPool(ctx, pool)
+
+	return &types.MsgFundPoolResponse{}, nil
+}
+
+// fundPoolWithCoins handles the logic to fund a pool with a whitelisted
+// non-native denom. The amount per bundle of the funder is set for the
+// given denom only. Because funds of different denoms can not be compared,
+// no funder gets kicked out if the funders list is full.
+func (k msgServer) fundPoolWithCoins(ctx sdk.Context, pool types.Pool, msg *types.MsgFundPool) (*types.MsgFundPoolResponse, error) {
+	if !k.IsDenomWhitelisted(ctx, msg.Denom) {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrDenomNotWhitelisted.Error(), msg.Denom)
+	}
+
+	if _, found := pool.GetFunder(msg.Creator); !found {
+		if uint64(len(pool.Funders)) >= k.GetMaxFunders(ctx) {
+			return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrMaxFundersReached.Error(), k.GetMaxFunders(ctx))
+		}
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, sdk.NewIntFromUint64(msg.Amount)))
+
+	// User is allowed to fund
+	pool.AddCoinsToFunder(msg.Creator, coins)
+	if msg.ClearSchedule {
+		pool.ClearFunderSchedule(msg.Creator, msg.Denom)
+	}
+	pool.SetFunderCoinSchedule(msg.Creator, sdk.NewCoin(msg.Denom, sdk.NewIntFromUint64(msg.AmountPerBundle)), msg.StartAt, msg.EndAt)
+
+	// The funder must have an amount per bundle for the denom and
+	// the updated funding period must still be valid.
+	funder, _ := pool.GetFunder(msg.Creator)
+	if funder.CoinsPerBundle.AmountOf(msg.Denom).IsZero() {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, "amount per bundle is required for denom %s", msg.Denom)
+	}
+	if funder.EndAt != 0 && funder.EndAt <= funder.StartAt {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, "end time must be after start time")
+	}
+
+	if err := util.TransferCoinsFromAddressToModule(k.bankKeeper, ctx, msg.Creator, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventFundPool{
+		PoolId:          msg.Id,
+		Address:         msg.Creator,
+		Amount:          msg.Amount,
+		AmountPerBundle: msg.AmountPerBundle,
+		StartAt:         msg.StartAt,
+		EndAt:           msg.EndAt,
+		Denom:           msg.Denom,
 	})
 
 	k.SetPool(ctx, pool)
 
 	return &types.MsgFundPoolResponse{}, nil
 }
+
+// refundFunder transfers all funds of the given funder back and
+// removes him from the pool.
+func (k msgServer) refundFunder(ctx sdk.Context, pool *types.Pool, funder types.Funder) error {
+	if funder.Amount > 0 {
+		if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, funder.Address, funder.Amount); err != nil {
+			return err
+		}
+
+		// Emit a defund event.
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
+			PoolId:  pool.Id,
+			Address: funder.Address,
+			Amount:  funder.Amount,
+		})
+	}
+
+	if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, funder.Address, funder.Coins); err != nil {
+		return err
+	}
+
+	// Emit a defund event for every non-native denom.
+	for _, coin := range funder.Coins {
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
+			PoolId:  pool.Id,
+			Address: funder.Address,
+			Amount:  coin.Amount.Uint64(),
+			Denom:   coin.Denom,
+		})
+	}
+
+	pool.RemoveFunder(funder.Address)
+
+	return nil
+}
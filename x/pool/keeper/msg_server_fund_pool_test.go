@@ -6,6 +6,7 @@ import (
 
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 /*
@@ -19,6 +20,24 @@ TEST CASES - msg_server_fund_pool.go
 * Fund with a new funder more $KYVE than the existing one
 * Try to fund less $KYVE than the lowest funder with full funding slots
 * Try to fund more $KYVE than the lowest funder with full funding slots
+* Fund with amount per bundle and funding period
+* Try to fund with an end time before the start time
+* Top up without amount per bundle and funding period keeps the schedule
+* Try to top up with a start time after the existing end time
+* Clear the amount per bundle and funding period on a top-up
+* Clear the funding period and set a new amount per bundle on a top-up
+* Fund more $KYVE than the lowest funder with a lower max funders param
+* Fund a pool with a whitelisted denom
+* Fund additional $KYVE to an existing funder of a whitelisted denom
+* Try to fund a pool with a denom which is not whitelisted
+* Try to fund a whitelisted denom with a new funder with full funding slots
+* Try to fund a whitelisted denom without amount per bundle
+* Top up a whitelisted denom without amount per bundle and funding period keeps the schedule
+* Try to top up a whitelisted denom with a start time after the existing end time
+* Clear the schedule of a whitelisted denom and set a new amount per bundle
+* Try to clear the amount per bundle of a whitelisted denom
+* Try to replace a funder who also funded a whitelisted denom with a $KYVE funder
+* Try to replace a funder who only funded a whitelisted denom with a $KYVE funder
 
 */
 
@@ -31,6 +50,14 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		// init new clean chain
 		s = i.NewCleanChain()
 
+		// whitelist a non-native denom for funding
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.WhitelistedDenoms = []string{i.IBC_DENOM}
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		_ = s.MintDenom(i.ALICE, i.IBC_DENOM, 1000*i.KYVE)
+		_ = s.MintDenom(i.BOB, i.IBC_DENOM, 1000*i.KYVE)
+
 		// create clean pool for every test case
 		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
 			Name: "PoolTest",
@@ -275,4 +302,529 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		balanceAfter := s.GetBalanceFromAddress(i.ALICE)
 		Expect(initialBalance - balanceAfter).To(BeZero())
 	})
+
+	It("Fund with amount per bundle and funding period", func() {
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.TotalFunds).To(Equal(100 * i.KYVE))
+
+		Expect(*pool.Funders[0]).To(Equal(pooltypes.Funder{
+			Address:         i.ALICE,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+		}))
+	})
+
+	It("Try to fund with an end time before the start time", func() {
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         200,
+			EndAt:           100,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(BeEmpty())
+		Expect(pool.TotalFunds).To(BeZero())
+	})
+
+	It("Top up without amount per bundle and funding period keeps the schedule", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  50 * i.KYVE,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.TotalFunds).To(Equal(150 * i.KYVE))
+
+		Expect(*pool.Funders[0]).To(Equal(pooltypes.Funder{
+			Address:         i.ALICE,
+			Amount:          150 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+		}))
+	})
+
+	It("Try to top up with a start time after the existing end time", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  50 * i.KYVE,
+			StartAt: 300,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.TotalFunds).To(Equal(100 * i.KYVE))
+		Expect(pool.Funders[0].StartAt).To(Equal(uint64(100)))
+		Expect(pool.Funders[0].EndAt).To(Equal(uint64(200)))
+	})
+
+	It("Clear the amount per bundle and funding period on a top-up", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:       i.ALICE,
+			Id:            0,
+			Amount:        50 * i.KYVE,
+			ClearSchedule: true,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.TotalFunds).To(Equal(150 * i.KYVE))
+		Expect(*pool.Funders[0]).To(Equal(pooltypes.Funder{
+			Address: i.ALICE,
+			Amount:  150 * i.KYVE,
+		}))
+	})
+
+	It("Clear the funding period and set a new amount per bundle on a top-up", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          50 * i.KYVE,
+			AmountPerBundle: 2 * i.KYVE,
+			StartAt:         300,
+			ClearSchedule:   true,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(*pool.Funders[0]).To(Equal(pooltypes.Funder{
+			Address:         i.ALICE,
+			Amount:          150 * i.KYVE,
+			AmountPerBundle: 2 * i.KYVE,
+			StartAt:         300,
+		}))
+	})
+
+	It("Fund more $KYVE than the lowest funder with a lower max funders param", func() {
+		// ARRANGE
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.MaxFunders = 2
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  200 * i.KYVE,
+		})
+
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator: i.CHARLIE,
+			Id:      0,
+			Amount:  50 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.CHARLIE,
+			Id:      0,
+			Amount:  150 * i.KYVE,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(2))
+		Expect(pool.TotalFunds).To(Equal(350 * i.KYVE))
+
+		Expect(pool.GetFunderAmount(i.ALICE)).To(BeZero())
+		Expect(pool.GetLowestFunder().Address).To(Equal(i.CHARLIE))
+
+		balanceAfter := s.GetBalanceFromAddress(i.ALICE)
+		Expect(initialBalance - balanceAfter).To(BeZero())
+	})
+
+	It("Fund a pool with a whitelisted denom", func() {
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(s.GetBalanceFromAddress(i.ALICE)).To(Equal(initialBalance))
+		Expect(s.GetBalanceOfDenomFromAddress(i.ALICE, i.IBC_DENOM)).To(Equal(900 * i.KYVE))
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.TotalFunds).To(BeZero())
+		Expect(pool.TotalFundsPerDenom).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(100*i.KYVE)))))
+
+		funder, found := pool.GetFunder(i.ALICE)
+		Expect(found).To(BeTrue())
+		Expect(funder.Amount).To(BeZero())
+		Expect(funder.Coins).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(100*i.KYVE)))))
+		Expect(funder.CoinsPerBundle).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(1*i.KYVE)))))
+	})
+
+	It("Fund additional $KYVE to an existing funder of a whitelisted denom", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  50 * i.KYVE,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(initialBalance - s.GetBalanceFromAddress(i.ALICE)).To(Equal(50 * i.KYVE))
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.TotalFunds).To(Equal(50 * i.KYVE))
+		Expect(pool.TotalFundsPerDenom).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(100*i.KYVE)))))
+
+		funder, _ := pool.GetFunder(i.ALICE)
+		Expect(funder.Amount).To(Equal(50 * i.KYVE))
+		Expect(funder.Coins).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(100*i.KYVE)))))
+	})
+
+	It("Try to fund a pool with a denom which is not whitelisted", func() {
+		// ARRANGE
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.WhitelistedDenoms = []string{}
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(BeEmpty())
+		Expect(pool.TotalFundsPerDenom).To(BeEmpty())
+		Expect(s.GetBalanceOfDenomFromAddress(i.ALICE, i.IBC_DENOM)).To(Equal(1000 * i.KYVE))
+	})
+
+	It("Try to fund a whitelisted denom with a new funder with full funding slots", func() {
+		// ARRANGE
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.MaxFunders = 1
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator:         i.BOB,
+			Id:              0,
+			Amount:          1000 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(100 * i.KYVE))
+		Expect(pool.TotalFundsPerDenom).To(BeEmpty())
+	})
+
+	It("Try to fund a whitelisted denom without amount per bundle", func() {
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+			Denom:   i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(BeEmpty())
+		Expect(pool.TotalFundsPerDenom).To(BeEmpty())
+		Expect(s.GetBalanceOfDenomFromAddress(i.ALICE, i.IBC_DENOM)).To(Equal(1000 * i.KYVE))
+	})
+
+	It("Top up a whitelisted denom without amount per bundle and funding period keeps the schedule", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  50 * i.KYVE,
+			Denom:   i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.TotalFundsPerDenom).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(150*i.KYVE)))))
+
+		funder, _ := pool.GetFunder(i.ALICE)
+		Expect(funder.Coins).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(150*i.KYVE)))))
+		Expect(funder.CoinsPerBundle).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(1*i.KYVE)))))
+		Expect(funder.StartAt).To(Equal(uint64(100)))
+		Expect(funder.EndAt).To(Equal(uint64(200)))
+	})
+
+	It("Try to top up a whitelisted denom with a start time after the existing end time", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  50 * i.KYVE,
+			StartAt: 300,
+			Denom:   i.IBC_DENOM,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.TotalFundsPerDenom).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(100*i.KYVE)))))
+		Expect(pool.Funders[0].StartAt).To(Equal(uint64(100)))
+		Expect(pool.Funders[0].EndAt).To(Equal(uint64(200)))
+		Expect(s.GetBalanceOfDenomFromAddress(i.ALICE, i.IBC_DENOM)).To(Equal(900 * i.KYVE))
+	})
+
+	It("Clear the schedule of a whitelisted denom and set a new amount per bundle", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			StartAt:         100,
+			EndAt:           200,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          50 * i.KYVE,
+			AmountPerBundle: 2 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+			ClearSchedule:   true,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		funder, _ := pool.GetFunder(i.ALICE)
+		Expect(funder.Coins).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(150*i.KYVE)))))
+		Expect(funder.CoinsPerBundle).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(2*i.KYVE)))))
+		Expect(funder.StartAt).To(BeZero())
+		Expect(funder.EndAt).To(BeZero())
+	})
+
+	It("Try to clear the amount per bundle of a whitelisted denom", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator:       i.ALICE,
+			Id:            0,
+			Amount:        50 * i.KYVE,
+			Denom:         i.IBC_DENOM,
+			ClearSchedule: true,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		funder, _ := pool.GetFunder(i.ALICE)
+		Expect(funder.Coins).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(100*i.KYVE)))))
+		Expect(funder.CoinsPerBundle).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(1*i.KYVE)))))
+	})
+
+	It("Try to replace a funder who also funded a whitelisted denom with a $KYVE funder", func() {
+		// ARRANGE
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.MaxFunders = 1
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  100 * i.KYVE,
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  200 * i.KYVE,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.GetFunderAmount(i.ALICE)).To(Equal(100 * i.KYVE))
+		Expect(pool.TotalFundsPerDenom).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, int64(100*i.KYVE)))))
+
+		Expect(s.GetBalanceFromAddress(i.BOB)).To(Equal(initialBalance))
+	})
+
+	It("Try to replace a funder who only funded a whitelisted denom with a $KYVE funder", func() {
+		// ARRANGE
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.MaxFunders = 1
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator:         i.ALICE,
+			Id:              0,
+			Amount:          100 * i.KYVE,
+			AmountPerBundle: 1 * i.KYVE,
+			Denom:           i.IBC_DENOM,
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  1,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.Funders[0].Address).To(Equal(i.ALICE))
+		Expect(pool.Funders[0].Coins.AmountOf(i.IBC_DENOM).Uint64()).To(Equal(100 * i.KYVE))
+		Expect(pool.TotalFunds).To(BeZero())
+
+		Expect(s.GetBalanceFromAddress(i.BOB)).To(Equal(initialBalance))
+	})
 })
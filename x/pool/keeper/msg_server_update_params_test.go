@@ -30,6 +30,15 @@ TEST CASES - msg_server_update_params.go
 * Update pool inflation payout rate
 * Update pool inflation payout rate with invalid value
 
+* Update max funders
+* Update max funders with invalid value
+
+* Update whitelisted denoms
+* Update whitelisted denoms with the native denom
+
+* Update max gas sponsorship per block
+* Update max gas sponsorship per block with invalid value
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -60,6 +69,9 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 
 		Expect(params.ProtocolInflationShare).To(Equal(types.DefaultProtocolInflationShare))
 		Expect(params.PoolInflationPayoutRate).To(Equal(types.DefaultPoolInflationPayoutRate))
+		Expect(params.MaxFunders).To(Equal(types.DefaultMaxFunders))
+		Expect(params.WhitelistedDenoms).To(BeEmpty())
+		Expect(params.MaxGasSponsorshipPerBlock).To(Equal(types.DefaultMaxGasSponsorshipPerBlock))
 	})
 
 	It("Invalid authority (transaction)", func() {
@@ -98,7 +110,9 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		// ARRANGE
 		payload := `{
 			"protocol_inflation_share": "0.2",
-			"pool_inflation_payout_rate": "0.05"
+			"pool_inflation_payout_rate": "0.05",
+			"max_funders": 20,
+			"max_gas_sponsorship_per_block": 1000000
 		}`
 
 		msg := &types.MsgUpdateParams{
@@ -129,6 +143,8 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 
 		Expect(updatedParams.ProtocolInflationShare).To(Equal(sdk.MustNewDecFromStr("0.2")))
 		Expect(updatedParams.PoolInflationPayoutRate).To(Equal(sdk.MustNewDecFromStr("0.05")))
+		Expect(updatedParams.MaxFunders).To(Equal(uint64(20)))
+		Expect(updatedParams.MaxGasSponsorshipPerBlock).To(Equal(uint64(1_000_000)))
 	})
 
 	It("Update no params", func() {
@@ -326,4 +342,200 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.ProtocolInflationShare).To(Equal(types.DefaultProtocolInflationShare))
 		Expect(updatedParams.PoolInflationPayoutRate).To(Equal(types.DefaultPoolInflationPayoutRate))
 	})
+
+	It("Update max funders", func() {
+		// ARRANGE
+		payload := `{
+			"max_funders": 100
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.ProtocolInflationShare).To(Equal(types.DefaultProtocolInflationShare))
+		Expect(updatedParams.PoolInflationPayoutRate).To(Equal(types.DefaultPoolInflationPayoutRate))
+		Expect(updatedParams.MaxFunders).To(Equal(uint64(100)))
+	})
+
+	It("Update max funders with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"max_funders": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.MaxFunders).To(Equal(types.DefaultMaxFunders))
+	})
+
+	It("Update whitelisted denoms", func() {
+		// ARRANGE
+		payload := `{
+			"whitelisted_denoms": ["` + i.IBC_DENOM + `"]
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.MaxFunders).To(Equal(types.DefaultMaxFunders))
+		Expect(updatedParams.WhitelistedDenoms).To(Equal([]string{i.IBC_DENOM}))
+	})
+
+	It("Update whitelisted denoms with the native denom", func() {
+		// ARRANGE
+		payload := `{
+			"whitelisted_denoms": ["` + i.KYVE_DENOM + `"]
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.WhitelistedDenoms).To(BeEmpty())
+	})
+
+	It("Update max gas sponsorship per block", func() {
+		// ARRANGE
+		payload := `{
+			"max_gas_sponsorship_per_block": 1000000
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.MaxFunders).To(Equal(types.DefaultMaxFunders))
+		Expect(updatedParams.MaxGasSponsorshipPerBlock).To(Equal(uint64(1_000_000)))
+	})
+
+	It("Update max gas sponsorship per block with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"max_gas_sponsorship_per_block": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.MaxGasSponsorshipPerBlock).To(Equal(types.DefaultMaxGasSponsorshipPerBlock))
+	})
 })
@@ -59,9 +59,53 @@ func (k msgServer) UpdatePool(goCtx context.Context, req *types.MsgUpdatePool) (
 	if update.CompressionId != nil {
 		pool.CurrentCompressionId = *update.CompressionId
 	}
+	if update.FundingPolicy != nil {
+		pool.FundingPolicy = *update.FundingPolicy
+	}
+	if update.MaxStakers != nil {
+		pool.MaxStakers = *update.MaxStakers
+	}
+	if update.UploaderSelection != nil {
+		pool.UploaderSelection = *update.UploaderSelection
+	}
+	if update.ValidQuorum != nil {
+		pool.ValidQuorum = *update.ValidQuorum
+	}
+	if update.InvalidQuorum != nil {
+		pool.InvalidQuorum = *update.InvalidQuorum
+	}
+	if update.VotingMode != nil {
+		pool.VotingMode = *update.VotingMode
+	}
+	if update.RevealWindow != nil {
+		pool.RevealWindow = *update.RevealWindow
+	}
+	if update.VoteSlash != nil || update.ClearVoteSlash {
+		pool.VoteSlash = update.VoteSlash
+	}
+	if update.UploadSlash != nil || update.ClearUploadSlash {
+		pool.UploadSlash = update.UploadSlash
+	}
+	if update.TimeoutSlash != nil || update.ClearTimeoutSlash {
+		pool.TimeoutSlash = update.TimeoutSlash
+	}
+
+	if err := types.ValidateQuorums(pool.ValidQuorum, pool.InvalidQuorum); err != nil {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid quorum: %s", err)
+	}
+
+	if err := types.ValidateVotingMode(pool.VotingMode, pool.RevealWindow); err != nil {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, "%s", err)
+	}
 
 	k.SetPool(ctx, pool)
 
+	// if the limit was lowered the stakers with the lowest
+	// delegation have to leave the pool
+	if update.MaxStakers != nil {
+		k.stakersKeeper.RemoveExcessStakersOfPool(ctx, pool.Id)
+	}
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolUpdated{
 		Id:                pool.Id,
 		RawUpdateString:   req.Payload,
@@ -75,6 +119,16 @@ func (k msgServer) UpdatePool(goCtx context.Context, req *types.MsgUpdatePool) (
 		MaxBundleSize:     pool.MaxBundleSize,
 		StorageProviderId: pool.CurrentStorageProviderId,
 		CompressionId:     pool.CurrentCompressionId,
+		FundingPolicy:     pool.FundingPolicy,
+		MaxStakers:        pool.MaxStakers,
+		UploaderSelection: pool.UploaderSelection,
+		ValidQuorum:       pool.ValidQuorum,
+		InvalidQuorum:     pool.InvalidQuorum,
+		VotingMode:        pool.VotingMode,
+		RevealWindow:      pool.RevealWindow,
+		VoteSlash:         pool.VoteSlash,
+		UploadSlash:       pool.UploadSlash,
+		TimeoutSlash:      pool.TimeoutSlash,
 	})
 
 	return &types.MsgUpdatePoolResponse{}, nil
@@ -9,6 +9,8 @@ import (
 
 	// Pool
 	"github.com/KYVENetwork/chain/x/pool/types"
+	// Stakers
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
 )
 
 /*
@@ -21,6 +23,22 @@ TEST CASES - msg_server_update_pool.go
 * Update first pool partially
 * Update another pool
 * Update pool with invalid json payload
+* Update pool funding policy
+* Update pool with invalid FundingPolicy
+* Update pool max stakers
+* Lower pool max stakers and remove lowest stakers
+* Update pool quorums
+* Update pool with quorum out of range
+* Update pool with a valid quorum of one
+* Update pool with quorums which do not add up to one
+* Update pool voting mode to commit-reveal
+* Update pool with invalid VotingMode
+* Update pool voting mode to commit-reveal without reveal window
+* Update pool slash overrides
+* Update pool with a zero slash override
+* Clear pool slash overrides
+* Try to set and clear a pool slash override at once
+* Update pool with slash override out of range
 
 */
 
@@ -142,6 +160,8 @@ var _ = Describe("msg_server_update_pool.go", Ordered, func() {
 			},
 			CurrentStorageProviderId: 2,
 			CurrentCompressionId:     1,
+			ValidQuorum:              sdk.ZeroDec(),
+			InvalidQuorum:            sdk.ZeroDec(),
 		}))
 	})
 
@@ -202,6 +222,8 @@ var _ = Describe("msg_server_update_pool.go", Ordered, func() {
 			},
 			CurrentStorageProviderId: 0,
 			CurrentCompressionId:     0,
+			ValidQuorum:              sdk.ZeroDec(),
+			InvalidQuorum:            sdk.ZeroDec(),
 		}))
 	})
 
@@ -277,6 +299,8 @@ var _ = Describe("msg_server_update_pool.go", Ordered, func() {
 			},
 			CurrentStorageProviderId: 2,
 			CurrentCompressionId:     1,
+			ValidQuorum:              sdk.ZeroDec(),
+			InvalidQuorum:            sdk.ZeroDec(),
 		}))
 	})
 
@@ -363,4 +387,474 @@ var _ = Describe("msg_server_update_pool.go", Ordered, func() {
 		Expect(found).To(BeTrue())
 		Expect(pool.Name).To(BeEmpty())
 	})
+
+	It("Update pool funding policy", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"FundingPolicy\": 1}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		Expect(proposal.Status).To(Equal(govV1Types.StatusPassed))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.FundingPolicy).To(Equal(types.FUNDING_POLICY_PRO_RATA))
+	})
+
+	It("Update pool with invalid FundingPolicy", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"FundingPolicy\": 2}",
+		}
+
+		p, _ := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_ = s.RunTxError(&p)
+		s.Commit()
+
+		// ASSERT
+		pool, found := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(found).To(BeTrue())
+		Expect(pool.FundingPolicy).To(Equal(types.FUNDING_POLICY_EQUAL_SPLIT))
+	})
+
+	It("Update pool max stakers", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"MaxStakers\": 100}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		Expect(proposal.Status).To(Equal(govV1Types.StatusPassed))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.MaxStakers).To(Equal(uint64(100)))
+		Expect(s.App().StakersKeeper.GetMaxStakersOfPool(s.Ctx(), 0)).To(Equal(uint64(100)))
+	})
+
+	It("Lower pool max stakers and remove lowest stakers", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+			Amount:     0,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1,
+			Amount:     0,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_2,
+			Amount:  200 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_2,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_2,
+			Amount:     0,
+		})
+
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"MaxStakers\": 1}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		msgVoteStaker0 := govV1Types.NewMsgVote(sdk.MustAccAddressFromBech32(i.STAKER_0), 1, govV1Types.VoteOption_VOTE_OPTION_YES, "")
+		msgVoteStaker1 := govV1Types.NewMsgVote(sdk.MustAccAddressFromBech32(i.STAKER_1), 1, govV1Types.VoteOption_VOTE_OPTION_YES, "")
+		msgVoteStaker2 := govV1Types.NewMsgVote(sdk.MustAccAddressFromBech32(i.STAKER_2), 1, govV1Types.VoteOption_VOTE_OPTION_YES, "")
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+		_, voteErr0 := s.RunTx(msgVoteStaker0)
+		_, voteErr1 := s.RunTx(msgVoteStaker1)
+		_, voteErr2 := s.RunTx(msgVoteStaker2)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+		Expect(voteErr0).To(Not(HaveOccurred()))
+		Expect(voteErr1).To(Not(HaveOccurred()))
+		Expect(voteErr2).To(Not(HaveOccurred()))
+
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+		Expect(proposal.Status).To(Equal(govV1Types.StatusPassed))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.MaxStakers).To(Equal(uint64(1)))
+
+		Expect(s.App().StakersKeeper.GetStakerCountOfPool(s.Ctx(), 0)).To(Equal(uint64(1)))
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ConsistOf(i.STAKER_2))
+	})
+
+	It("Update pool quorums", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"ValidQuorum\": \"0.67\", \"InvalidQuorum\": \"0.34\"}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		Expect(proposal.Status).To(Equal(govV1Types.StatusPassed))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.ValidQuorum).To(Equal(sdk.MustNewDecFromStr("0.67")))
+		Expect(pool.InvalidQuorum).To(Equal(sdk.MustNewDecFromStr("0.34")))
+	})
+
+	It("Update pool with quorum out of range", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"ValidQuorum\": \"1.5\"}",
+		}
+
+		p, _ := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_ = s.RunTxError(&p)
+		s.Commit()
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GetEffectiveValidQuorum()).To(Equal(types.DefaultQuorum))
+	})
+
+	It("Update pool with a valid quorum of one", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"ValidQuorum\": \"1\"}",
+		}
+
+		p, _ := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_ = s.RunTxError(&p)
+		s.Commit()
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GetEffectiveValidQuorum()).To(Equal(types.DefaultQuorum))
+	})
+
+	It("Update pool with quorums which do not add up to one", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"ValidQuorum\": \"0.3\"}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		Expect(proposal.Status).To(Equal(govV1Types.StatusFailed))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GetEffectiveValidQuorum()).To(Equal(types.DefaultQuorum))
+	})
+
+	It("Update pool voting mode to commit-reveal", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"VotingMode\": 1, \"RevealWindow\": 30}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		Expect(proposal.Status).To(Equal(govV1Types.StatusPassed))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.VotingMode).To(Equal(types.VOTING_MODE_COMMIT_REVEAL))
+		Expect(pool.RevealWindow).To(Equal(uint64(30)))
+		Expect(pool.GetEffectiveRevealWindow()).To(Equal(uint64(30)))
+	})
+
+	It("Update pool with invalid VotingMode", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"VotingMode\": 2, \"RevealWindow\": 30}",
+		}
+
+		p, _ := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_ = s.RunTxError(&p)
+		s.Commit()
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.VotingMode).To(Equal(types.VOTING_MODE_PLAIN))
+		Expect(pool.GetEffectiveRevealWindow()).To(BeZero())
+	})
+
+	It("Update pool voting mode to commit-reveal without reveal window", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"VotingMode\": 1}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		Expect(proposal.Status).To(Equal(govV1Types.StatusFailed))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.VotingMode).To(Equal(types.VOTING_MODE_PLAIN))
+	})
+
+	It("Update pool slash overrides", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"VoteSlash\": \"0.01\", \"UploadSlash\": \"0.02\", \"TimeoutSlash\": \"0.005\"}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		Expect(proposal.Status).To(Equal(govV1Types.StatusPassed))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(*pool.VoteSlash).To(Equal(sdk.MustNewDecFromStr("0.01")))
+		Expect(*pool.UploadSlash).To(Equal(sdk.MustNewDecFromStr("0.02")))
+		Expect(*pool.TimeoutSlash).To(Equal(sdk.MustNewDecFromStr("0.005")))
+	})
+
+	It("Update pool with a zero slash override", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"UploadSlash\": \"0\"}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		Expect(proposal.Status).To(Equal(govV1Types.StatusPassed))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.UploadSlash).NotTo(BeNil())
+		Expect(pool.UploadSlash.IsZero()).To(BeTrue())
+		Expect(pool.VoteSlash).To(BeNil())
+		Expect(pool.TimeoutSlash).To(BeNil())
+	})
+
+	It("Clear pool slash overrides", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		voteSlash, uploadSlash := sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.02")
+		pool.VoteSlash, pool.UploadSlash = &voteSlash, &uploadSlash
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"ClearVoteSlash\": true}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		proposal, _ := s.App().GovKeeper.GetProposal(s.Ctx(), 1)
+
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		Expect(proposal.Status).To(Equal(govV1Types.StatusPassed))
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.VoteSlash).To(BeNil())
+		Expect(*pool.UploadSlash).To(Equal(sdk.MustNewDecFromStr("0.02")))
+	})
+
+	It("Try to set and clear a pool slash override at once", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"VoteSlash\": \"0.01\", \"ClearVoteSlash\": true}",
+		}
+
+		p, _ := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_ = s.RunTxError(&p)
+		s.Commit()
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.VoteSlash).To(BeNil())
+	})
+
+	It("Update pool with slash override out of range", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"UploadSlash\": \"1.5\"}",
+		}
+
+		p, _ := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_ = s.RunTxError(&p)
+		s.Commit()
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.UploadSlash).To(BeNil())
+	})
 })
@@ -10,11 +10,13 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFundPool{}, "kyve/pool/MsgFundPool", nil)
 	cdc.RegisterConcrete(&MsgDefundPool{}, "kyve/pool/MsgDefundPool", nil)
+	cdc.RegisterConcrete(&MsgFundGasSponsorship{}, "kyve/pool/MsgFundGasSponsorship", nil)
 }
 
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgFundPool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDefundPool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgFundGasSponsorship{})
 
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCreatePool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdatePool{})
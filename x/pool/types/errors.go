@@ -12,4 +12,10 @@ var (
 	ErrDefundTooHigh = errors.Register(ModuleName, 1102, "maximum defunding amount of %vkyve surpassed")
 	ErrInvalidJson   = errors.Register(ModuleName, 1103, "invalid json object: %v")
 	ErrInvalidArgs   = errors.Register(ModuleName, 1104, "invalid args")
+
+	ErrDenomNotWhitelisted = errors.Register(ModuleName, 1105, "denom %v is not whitelisted for funding")
+	ErrMaxFundersReached   = errors.Register(ModuleName, 1106, "maximum number of %v funders reached")
+
+	ErrGasSponsorshipTooLow       = errors.Register(ModuleName, 1107, "gas sponsorship of %vkyve can not pay fee of %vkyve")
+	ErrGasSponsorshipLimitReached = errors.Register(ModuleName, 1108, "gas sponsorship limit of %vkyve per block reached")
 )
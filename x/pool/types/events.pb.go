@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -127,6 +128,20 @@ type EventCreatePool struct {
 	// compression_id is the unique id of the compression type the bundles
 	// get compressed with
 	CompressionId uint32 `protobuf:"varint,14,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
+	// funding_policy defines how the funders of the pool get charged
+	FundingPolicy FundingPolicy `protobuf:"varint,15,opt,name=funding_policy,json=fundingPolicy,proto3,enum=kyve.pool.v1beta1.FundingPolicy" json:"funding_policy,omitempty"`
+	// max_stakers is the maximum number of stakers of the pool
+	MaxStakers uint64 `protobuf:"varint,16,opt,name=max_stakers,json=maxStakers,proto3" json:"max_stakers,omitempty"`
+	// uploader_selection defines how the next uploader gets selected
+	UploaderSelection UploaderSelection `protobuf:"varint,17,opt,name=uploader_selection,json=uploaderSelection,proto3,enum=kyve.pool.v1beta1.UploaderSelection" json:"uploader_selection,omitempty"`
+	// valid_quorum is the fraction of voting power required for a valid bundle
+	ValidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=valid_quorum,json=validQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_quorum"`
+	// invalid_quorum is the fraction of voting power required for an invalid bundle
+	InvalidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=invalid_quorum,json=invalidQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"invalid_quorum"`
+	// voting_mode defines how the stakers vote on bundle proposals
+	VotingMode VotingMode `protobuf:"varint,20,opt,name=voting_mode,json=votingMode,proto3,enum=kyve.pool.v1beta1.VotingMode" json:"voting_mode,omitempty"`
+	// reveal_window is the time in seconds in which committed votes can be revealed
+	RevealWindow uint64 `protobuf:"varint,21,opt,name=reveal_window,json=revealWindow,proto3" json:"reveal_window,omitempty"`
 }
 
 func (m *EventCreatePool) Reset()         { *m = EventCreatePool{} }
@@ -260,6 +275,41 @@ func (m *EventCreatePool) GetCompressionId() uint32 {
 	return 0
 }
 
+func (m *EventCreatePool) GetFundingPolicy() FundingPolicy {
+	if m != nil {
+		return m.FundingPolicy
+	}
+	return FUNDING_POLICY_EQUAL_SPLIT
+}
+
+func (m *EventCreatePool) GetMaxStakers() uint64 {
+	if m != nil {
+		return m.MaxStakers
+	}
+	return 0
+}
+
+func (m *EventCreatePool) GetUploaderSelection() UploaderSelection {
+	if m != nil {
+		return m.UploaderSelection
+	}
+	return UPLOADER_SELECTION_ROUND_ROBIN
+}
+
+func (m *EventCreatePool) GetVotingMode() VotingMode {
+	if m != nil {
+		return m.VotingMode
+	}
+	return VOTING_MODE_PLAIN
+}
+
+func (m *EventCreatePool) GetRevealWindow() uint64 {
+	if m != nil {
+		return m.RevealWindow
+	}
+	return 0
+}
+
 // EventPoolEnabled ...
 // emitted_by: EndBlock(gov)
 type EventPoolEnabled struct {
@@ -539,6 +589,26 @@ type EventPoolUpdated struct {
 	// compression_id is the unique id of the compression type the bundles
 	// get compressed with
 	CompressionId uint32 `protobuf:"varint,12,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
+	// funding_policy defines how the funders of the pool get charged
+	FundingPolicy FundingPolicy `protobuf:"varint,13,opt,name=funding_policy,json=fundingPolicy,proto3,enum=kyve.pool.v1beta1.FundingPolicy" json:"funding_policy,omitempty"`
+	// max_stakers is the maximum number of stakers of the pool
+	MaxStakers uint64 `protobuf:"varint,14,opt,name=max_stakers,json=maxStakers,proto3" json:"max_stakers,omitempty"`
+	// uploader_selection defines how the next uploader gets selected
+	UploaderSelection UploaderSelection `protobuf:"varint,15,opt,name=uploader_selection,json=uploaderSelection,proto3,enum=kyve.pool.v1beta1.UploaderSelection" json:"uploader_selection,omitempty"`
+	// valid_quorum is the fraction of voting power required for a valid bundle
+	ValidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=valid_quorum,json=validQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_quorum"`
+	// invalid_quorum is the fraction of voting power required for an invalid bundle
+	InvalidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=invalid_quorum,json=invalidQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"invalid_quorum"`
+	// voting_mode defines how the stakers vote on bundle proposals
+	VotingMode VotingMode `protobuf:"varint,18,opt,name=voting_mode,json=votingMode,proto3,enum=kyve.pool.v1beta1.VotingMode" json:"voting_mode,omitempty"`
+	// reveal_window is the time in seconds in which committed votes can be revealed
+	RevealWindow uint64 `protobuf:"varint,19,opt,name=reveal_window,json=revealWindow,proto3" json:"reveal_window,omitempty"`
+	// vote_slash is the pool specific vote slash, not set if the global param is used
+	VoteSlash *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=vote_slash,json=voteSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_slash,omitempty"`
+	// upload_slash is the pool specific upload slash, not set if the global param is used
+	UploadSlash *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=upload_slash,json=uploadSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upload_slash,omitempty"`
+	// timeout_slash is the pool specific timeout slash, not set if the global param is used
+	TimeoutSlash *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=timeout_slash,json=timeoutSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"timeout_slash,omitempty"`
 }
 
 func (m *EventPoolUpdated) Reset()         { *m = EventPoolUpdated{} }
@@ -658,6 +728,41 @@ func (m *EventPoolUpdated) GetCompressionId() uint32 {
 	return 0
 }
 
+func (m *EventPoolUpdated) GetFundingPolicy() FundingPolicy {
+	if m != nil {
+		return m.FundingPolicy
+	}
+	return FUNDING_POLICY_EQUAL_SPLIT
+}
+
+func (m *EventPoolUpdated) GetMaxStakers() uint64 {
+	if m != nil {
+		return m.MaxStakers
+	}
+	return 0
+}
+
+func (m *EventPoolUpdated) GetUploaderSelection() UploaderSelection {
+	if m != nil {
+		return m.UploaderSelection
+	}
+	return UPLOADER_SELECTION_ROUND_ROBIN
+}
+
+func (m *EventPoolUpdated) GetVotingMode() VotingMode {
+	if m != nil {
+		return m.VotingMode
+	}
+	return VOTING_MODE_PLAIN
+}
+
+func (m *EventPoolUpdated) GetRevealWindow() uint64 {
+	if m != nil {
+		return m.RevealWindow
+	}
+	return 0
+}
+
 // EventFundPool is an event emitted when a pool is funded.
 // emitted_by: MsgFundPool
 type EventFundPool struct {
@@ -667,6 +772,16 @@ type EventFundPool struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// amount is the amount in ukyve the funder has funded
 	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// amount_per_bundle is the maximum amount in ukyve the funder
+	// gets charged per bundle
+	AmountPerBundle uint64 `protobuf:"varint,4,opt,name=amount_per_bundle,json=amountPerBundle,proto3" json:"amount_per_bundle,omitempty"`
+	// start_at is the unix time the funder starts getting charged
+	StartAt uint64 `protobuf:"varint,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// end_at is the unix time the funder stops getting charged
+	EndAt uint64 `protobuf:"varint,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// denom is the denom of the funded amount. If empty the
+	// amount is in ukyve
+	Denom string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *EventFundPool) Reset()         { *m = EventFundPool{} }
@@ -723,6 +838,34 @@ func (m *EventFundPool) GetAmount() uint64 {
 	return 0
 }
 
+func (m *EventFundPool) GetAmountPerBundle() uint64 {
+	if m != nil {
+		return m.AmountPerBundle
+	}
+	return 0
+}
+
+func (m *EventFundPool) GetStartAt() uint64 {
+	if m != nil {
+		return m.StartAt
+	}
+	return 0
+}
+
+func (m *EventFundPool) GetEndAt() uint64 {
+	if m != nil {
+		return m.EndAt
+	}
+	return 0
+}
+
+func (m *EventFundPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // EventDefundPool is an event emitted when a pool is defunded.
 // emitted_by: MsgDefundPool
 type EventDefundPool struct {
@@ -732,6 +875,9 @@ type EventDefundPool struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// amount is the amount in ukyve the funder has defunded
 	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// denom is the denom of the defunded amount. If empty the
+	// amount is in ukyve
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *EventDefundPool) Reset()         { *m = EventDefundPool{} }
@@ -788,6 +934,145 @@ func (m *EventDefundPool) GetAmount() uint64 {
 	return 0
 }
 
+func (m *EventDefundPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventFundGasSponsorship is an event emitted when the gas
+// sponsorship budget of a pool is funded.
+// emitted_by: MsgFundGasSponsorship
+type EventFundGasSponsorship struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// address is the account address of the funder.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount in ukyve the funder has funded
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventFundGasSponsorship) Reset()         { *m = EventFundGasSponsorship{} }
+func (m *EventFundGasSponsorship) String() string { return proto.CompactTextString(m) }
+func (*EventFundGasSponsorship) ProtoMessage()    {}
+func (*EventFundGasSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{9}
+}
+func (m *EventFundGasSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFundGasSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFundGasSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFundGasSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFundGasSponsorship.Merge(m, src)
+}
+func (m *EventFundGasSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFundGasSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFundGasSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFundGasSponsorship proto.InternalMessageInfo
+
+func (m *EventFundGasSponsorship) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventFundGasSponsorship) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventFundGasSponsorship) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// EventGasSponsored is an event emitted when the gas sponsorship
+// budget of a pool paid the fee of a transaction.
+// emitted_by: AnteHandler
+type EventGasSponsored struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// address is the account address of the signer of the transaction.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the fee in ukyve which got paid by the pool
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventGasSponsored) Reset()         { *m = EventGasSponsored{} }
+func (m *EventGasSponsored) String() string { return proto.CompactTextString(m) }
+func (*EventGasSponsored) ProtoMessage()    {}
+func (*EventGasSponsored) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{10}
+}
+func (m *EventGasSponsored) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGasSponsored) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGasSponsored.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGasSponsored) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGasSponsored.Merge(m, src)
+}
+func (m *EventGasSponsored) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGasSponsored) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGasSponsored.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGasSponsored proto.InternalMessageInfo
+
+func (m *EventGasSponsored) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventGasSponsored) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventGasSponsored) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 // EventDefundPool is an event emitted when a pool is defunded.
 // emitted_by: MsgSubmitBundleProposal
 type EventPoolFundsSlashed struct {
@@ -803,7 +1088,7 @@ func (m *EventPoolFundsSlashed) Reset()         { *m = EventPoolFundsSlashed{} }
 func (m *EventPoolFundsSlashed) String() string { return proto.CompactTextString(m) }
 func (*EventPoolFundsSlashed) ProtoMessage()    {}
 func (*EventPoolFundsSlashed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1828a100d789238, []int{9}
+	return fileDescriptor_c1828a100d789238, []int{11}
 }
 func (m *EventPoolFundsSlashed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +1149,7 @@ func (m *EventPoolOutOfFunds) Reset()         { *m = EventPoolOutOfFunds{} }
 func (m *EventPoolOutOfFunds) String() string { return proto.CompactTextString(m) }
 func (*EventPoolOutOfFunds) ProtoMessage()    {}
 func (*EventPoolOutOfFunds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1828a100d789238, []int{10}
+	return fileDescriptor_c1828a100d789238, []int{12}
 }
 func (m *EventPoolOutOfFunds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,6 +1195,8 @@ func init() {
 	proto.RegisterType((*EventPoolUpdated)(nil), "kyve.pool.v1beta1.EventPoolUpdated")
 	proto.RegisterType((*EventFundPool)(nil), "kyve.pool.v1beta1.EventFundPool")
 	proto.RegisterType((*EventDefundPool)(nil), "kyve.pool.v1beta1.EventDefundPool")
+	proto.RegisterType((*EventFundGasSponsorship)(nil), "kyve.pool.v1beta1.EventFundGasSponsorship")
+	proto.RegisterType((*EventGasSponsored)(nil), "kyve.pool.v1beta1.EventGasSponsored")
 	proto.RegisterType((*EventPoolFundsSlashed)(nil), "kyve.pool.v1beta1.EventPoolFundsSlashed")
 	proto.RegisterType((*EventPoolOutOfFunds)(nil), "kyve.pool.v1beta1.EventPoolOutOfFunds")
 }
@@ -917,57 +1204,80 @@ func init() {
 func init() { proto.RegisterFile("kyve/pool/v1beta1/events.proto", fileDescriptor_c1828a100d789238) }
 
 var fileDescriptor_c1828a100d789238 = []byte{
-	// 792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0x6d, 0x26, 0x4d, 0x42, 0x7c, 0xf9, 0x31, 0x05, 0x85, 0x10, 0x74, 0xa1,
-	0xb0, 0x70, 0x74, 0x61, 0x8f, 0x44, 0xd3, 0x22, 0x45, 0x57, 0xe2, 0x5e, 0x25, 0xba, 0x48, 0x54,
-	0x48, 0xd6, 0xc4, 0x73, 0xe2, 0x8c, 0x6a, 0xcf, 0x58, 0x33, 0xe3, 0xa4, 0xe9, 0x53, 0xf0, 0x04,
-	0xbc, 0x01, 0x6f, 0xc1, 0xa2, 0xcb, 0x2e, 0x58, 0xb0, 0x42, 0xa8, 0x7d, 0x11, 0x34, 0xe3, 0x1f,
-	0x92, 0xd6, 0x45, 0x5d, 0x80, 0xc4, 0xce, 0xe7, 0x9c, 0xef, 0xfc, 0xcc, 0xf9, 0xce, 0x97, 0xa0,
-	0xfe, 0xc5, 0x66, 0x05, 0xa3, 0x98, 0xf3, 0x70, 0xb4, 0x7a, 0x31, 0x07, 0x85, 0x5f, 0x8c, 0x60,
-	0x05, 0x4c, 0x49, 0x37, 0x16, 0x5c, 0x71, 0xbb, 0xa7, 0xe3, 0xae, 0x8e, 0xbb, 0x59, 0xfc, 0xe8,
-	0xed, 0x80, 0x07, 0xdc, 0x44, 0x47, 0xfa, 0x2b, 0x05, 0x1e, 0x95, 0x14, 0x8a, 0xb1, 0xc0, 0x51,
-	0x56, 0x68, 0xf8, 0x8b, 0x85, 0x7a, 0x67, 0xba, 0xf2, 0x9b, 0x98, 0x60, 0x05, 0xaf, 0x4d, 0xcc,
-	0xfe, 0x1a, 0x21, 0x1e, 0x12, 0x2f, 0x45, 0x3a, 0xd6, 0xc0, 0x3a, 0x6e, 0x7d, 0xf9, 0xbe, 0xfb,
-	0xa0, 0xa7, 0x9b, 0xc2, 0x4f, 0xea, 0xd7, 0x7f, 0x7c, 0x54, 0x99, 0x36, 0x79, 0x48, 0xfe, 0xce,
-	0x67, 0xb0, 0xce, 0xf3, 0xab, 0x4f, 0xcc, 0x67, 0xb0, 0xce, 0xf2, 0x1d, 0xb4, 0x1f, 0xe3, 0x4d,
-	0xc8, 0x31, 0x71, 0x6a, 0x03, 0xeb, 0xb8, 0x39, 0xcd, 0xcd, 0xe1, 0xaf, 0x35, 0xd4, 0x35, 0xf3,
-	0x8e, 0x05, 0xe8, 0x79, 0x39, 0x0f, 0xed, 0x0e, 0xaa, 0x52, 0x62, 0xa6, 0xac, 0x4f, 0xab, 0x94,
-	0xd8, 0x36, 0xaa, 0x33, 0x1c, 0x81, 0xe9, 0xdb, 0x9c, 0x9a, 0x6f, 0x5d, 0x51, 0x24, 0x4c, 0xd1,
-	0x08, 0xf2, 0x8a, 0x99, 0xa9, 0xd1, 0x21, 0x0f, 0xb8, 0x53, 0x4f, 0xd1, 0xfa, 0xdb, 0x7e, 0x17,
-	0x35, 0x7c, 0xce, 0x16, 0x34, 0x70, 0xf6, 0x8c, 0x37, 0xb3, 0xec, 0x0f, 0x50, 0x53, 0x2a, 0x2c,
-	0x94, 0x77, 0x01, 0x1b, 0xa7, 0x61, 0x42, 0x07, 0xc6, 0xf1, 0x12, 0x36, 0xf6, 0x67, 0xa8, 0x9b,
-	0xc4, 0x7a, 0x48, 0x8f, 0x32, 0x05, 0x62, 0x85, 0x43, 0x67, 0xdf, 0xcc, 0xd4, 0x49, 0xdd, 0x93,
-	0xcc, 0x6b, 0x3f, 0x47, 0x1d, 0x1e, 0x83, 0xc0, 0x8a, 0xb2, 0xc0, 0xf3, 0xb9, 0x54, 0xce, 0x81,
-	0xc1, 0xb5, 0x0b, 0xef, 0x98, 0x4b, 0xa5, 0x61, 0x11, 0x65, 0x1e, 0x81, 0x10, 0x02, 0xac, 0x28,
-	0x67, 0x4e, 0x33, 0x85, 0x45, 0x94, 0x9d, 0x16, 0x4e, 0xfb, 0x53, 0xd4, 0x8d, 0xf0, 0xa5, 0x37,
-	0x4f, 0x18, 0x09, 0xc1, 0x93, 0xf4, 0x0a, 0x1c, 0x94, 0xe1, 0xf0, 0xe5, 0x89, 0xf1, 0xce, 0xe8,
-	0x95, 0xd9, 0xc0, 0x0a, 0x84, 0xd4, 0x75, 0x5a, 0xe9, 0x06, 0x32, 0xd3, 0x3e, 0x42, 0x07, 0x73,
-	0xca, 0xb0, 0xa0, 0x20, 0x9d, 0xc3, 0xf4, 0x51, 0xb9, 0x6d, 0xbb, 0xe8, 0x99, 0x54, 0x5c, 0xe0,
-	0x00, 0xbc, 0x58, 0xf0, 0x15, 0x25, 0x20, 0x3c, 0x4a, 0x9c, 0xf6, 0xc0, 0x3a, 0x6e, 0x4f, 0x7b,
-	0x59, 0xe8, 0x75, 0x16, 0x99, 0x10, 0x3d, 0xb4, 0xcf, 0xa3, 0x58, 0x80, 0xd4, 0xa5, 0x35, 0xb4,
-	0x63, 0xa0, 0xed, 0x2d, 0xef, 0x84, 0x0c, 0x87, 0xe8, 0x2d, 0xc3, 0xa2, 0xe6, 0xef, 0x8c, 0xe1,
-	0x79, 0x08, 0xe4, 0x3e, 0x8d, 0xc3, 0x4f, 0xb2, 0xcb, 0xd4, 0x98, 0x53, 0x2a, 0xcb, 0x41, 0xbf,
-	0x59, 0xe8, 0x43, 0x83, 0x9a, 0xa6, 0x74, 0xbe, 0x89, 0x03, 0x81, 0x09, 0xcc, 0xfc, 0x25, 0x90,
-	0x44, 0x27, 0x6c, 0x11, 0x6f, 0xed, 0x12, 0xbf, 0xb5, 0x90, 0xea, 0xee, 0x42, 0x3e, 0x46, 0x87,
-	0x32, 0x2f, 0xe0, 0x61, 0x65, 0x2e, 0xa6, 0x3e, 0x6d, 0x15, 0xbe, 0x6f, 0x94, 0xde, 0x19, 0x49,
-	0x44, 0x4a, 0x4b, 0xdd, 0x84, 0x0b, 0x7b, 0x67, 0x9f, 0x7b, 0xf7, 0xf6, 0xf9, 0x1c, 0x75, 0xf0,
-	0x62, 0x01, 0xbe, 0x02, 0xe2, 0x69, 0x29, 0x48, 0xa7, 0x31, 0xa8, 0x69, 0xb2, 0x72, 0xaf, 0x7e,
-	0xad, 0x1c, 0x7a, 0xa5, 0xaf, 0x1a, 0x63, 0xe6, 0x43, 0xf8, 0xcf, 0xaf, 0x7a, 0xd8, 0xa0, 0x5a,
-	0xd6, 0xe0, 0xe7, 0xda, 0x16, 0x03, 0xa9, 0xf6, 0x1f, 0x2c, 0xd7, 0xfe, 0x02, 0xf5, 0x04, 0x5e,
-	0x7b, 0x89, 0x09, 0x7b, 0x52, 0x09, 0xca, 0x82, 0x6c, 0x57, 0x5d, 0x81, 0xd7, 0x69, 0xda, 0xcc,
-	0xb8, 0x0b, 0xd1, 0xd5, 0xca, 0x45, 0x57, 0x2f, 0x17, 0xdd, 0x5e, 0xa9, 0xe8, 0x1a, 0x3b, 0xa2,
-	0xfb, 0x9f, 0xeb, 0xea, 0x11, 0x85, 0xb4, 0x9e, 0xae, 0x90, 0xc3, 0x32, 0x85, 0x9c, 0xa3, 0xb6,
-	0xe1, 0xe7, 0xdb, 0x84, 0x19, 0xca, 0xec, 0xf7, 0xd0, 0xbe, 0xe6, 0xd3, 0x2b, 0x18, 0x6a, 0x68,
-	0x73, 0x62, 0x6e, 0x01, 0x13, 0xa2, 0x33, 0xf3, 0x3b, 0xce, 0x4c, 0xbd, 0x51, 0x1c, 0xf1, 0x84,
-	0xe5, 0x17, 0x9c, 0x59, 0xc3, 0x1f, 0xb3, 0xdf, 0xd0, 0x53, 0x58, 0xfc, 0x07, 0xd5, 0xe7, 0xe8,
-	0x9d, 0xe2, 0xb2, 0xf4, 0xf4, 0x72, 0x16, 0x62, 0xb9, 0x04, 0xf2, 0x6f, 0xf6, 0x70, 0xd1, 0xb3,
-	0xa2, 0xc7, 0xab, 0x44, 0xbd, 0x5a, 0x98, 0x46, 0x8f, 0x76, 0x38, 0x19, 0x5f, 0xdf, 0xf6, 0xad,
-	0x9b, 0xdb, 0xbe, 0xf5, 0xe7, 0x6d, 0xdf, 0xfa, 0xe9, 0xae, 0x5f, 0xb9, 0xb9, 0xeb, 0x57, 0x7e,
-	0xbf, 0xeb, 0x57, 0xce, 0x3f, 0x0f, 0xa8, 0x5a, 0x26, 0x73, 0xd7, 0xe7, 0xd1, 0xe8, 0xe5, 0x0f,
-	0xdf, 0x9f, 0x7d, 0x07, 0x6a, 0xcd, 0xc5, 0xc5, 0xc8, 0x5f, 0x62, 0xca, 0x46, 0x97, 0xe9, 0x5f,
-	0xa7, 0xda, 0xc4, 0x20, 0xe7, 0x0d, 0xf3, 0x97, 0xf9, 0xd5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xc1, 0xd7, 0x44, 0xf8, 0x9d, 0x07, 0x00, 0x00,
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xc1, 0x6e, 0x1b, 0x37,
+	0x13, 0xf6, 0x3a, 0xb2, 0x6c, 0x51, 0x96, 0x14, 0xd1, 0x76, 0xb2, 0xf1, 0x9f, 0x5f, 0x71, 0x95,
+	0x26, 0x75, 0x0b, 0x54, 0x42, 0xd2, 0x7b, 0x80, 0xd8, 0x4e, 0x03, 0x23, 0x48, 0xe3, 0xac, 0xe0,
+	0x14, 0xed, 0xa1, 0x0b, 0x6a, 0x39, 0x92, 0x09, 0xef, 0x92, 0x5b, 0x92, 0x2b, 0xd9, 0x79, 0x8a,
+	0xa2, 0xef, 0xd1, 0xf7, 0xc8, 0xa9, 0xc8, 0xa1, 0x87, 0xa2, 0x87, 0xa0, 0x88, 0x5f, 0xa4, 0x20,
+	0xb9, 0xda, 0xc8, 0xb1, 0x52, 0xb8, 0xa9, 0x0d, 0xf4, 0x64, 0xce, 0xcc, 0xc7, 0x6f, 0x38, 0xe3,
+	0x99, 0x4f, 0x12, 0x6a, 0x1d, 0x1e, 0x8f, 0xa0, 0x9b, 0x0a, 0x11, 0x77, 0x47, 0xf7, 0xfa, 0xa0,
+	0xc9, 0xbd, 0x2e, 0x8c, 0x80, 0x6b, 0xd5, 0x49, 0xa5, 0xd0, 0x02, 0x37, 0x4d, 0xbc, 0x63, 0xe2,
+	0x9d, 0x3c, 0xbe, 0xbe, 0x3a, 0x14, 0x43, 0x61, 0xa3, 0x5d, 0x73, 0x72, 0xc0, 0xf5, 0x19, 0x44,
+	0x29, 0x91, 0x24, 0xc9, 0x89, 0xd6, 0x6f, 0xce, 0x88, 0x1b, 0x56, 0x1b, 0x6d, 0xff, 0xe2, 0xa1,
+	0xe6, 0x23, 0x93, 0x77, 0x3f, 0xa5, 0x44, 0xc3, 0x9e, 0xbd, 0x89, 0x1f, 0x20, 0x24, 0x62, 0x1a,
+	0x3a, 0x1e, 0xdf, 0xdb, 0xf0, 0x36, 0xab, 0xf7, 0x6f, 0x74, 0xce, 0xbc, 0xa8, 0xe3, 0xe0, 0x5b,
+	0xa5, 0x57, 0x6f, 0x6e, 0xcd, 0x05, 0x15, 0x11, 0xd3, 0x77, 0xf7, 0x39, 0x8c, 0x27, 0xf7, 0xe7,
+	0xcf, 0x79, 0x9f, 0xc3, 0x38, 0xbf, 0xef, 0xa3, 0xc5, 0x94, 0x1c, 0xc7, 0x82, 0x50, 0xff, 0xca,
+	0x86, 0xb7, 0x59, 0x09, 0x26, 0x66, 0xfb, 0xe7, 0x45, 0xd4, 0xb0, 0xef, 0xdd, 0x96, 0x60, 0xde,
+	0x2b, 0x44, 0x8c, 0xeb, 0x68, 0x9e, 0x51, 0xfb, 0xca, 0x52, 0x30, 0xcf, 0x28, 0xc6, 0xa8, 0xc4,
+	0x49, 0x02, 0x36, 0x6f, 0x25, 0xb0, 0x67, 0xc3, 0x28, 0x33, 0xae, 0x59, 0x02, 0x13, 0xc6, 0xdc,
+	0x34, 0xe8, 0x58, 0x0c, 0x85, 0x5f, 0x72, 0x68, 0x73, 0xc6, 0xd7, 0x50, 0x39, 0x12, 0x7c, 0xc0,
+	0x86, 0xfe, 0x82, 0xf5, 0xe6, 0x16, 0xfe, 0x1f, 0xaa, 0x28, 0x4d, 0xa4, 0x0e, 0x0f, 0xe1, 0xd8,
+	0x2f, 0xdb, 0xd0, 0x92, 0x75, 0x3c, 0x81, 0x63, 0xfc, 0x19, 0x6a, 0x64, 0xa9, 0x79, 0x64, 0xc8,
+	0xb8, 0x06, 0x39, 0x22, 0xb1, 0xbf, 0x68, 0xdf, 0x54, 0x77, 0xee, 0xdd, 0xdc, 0x8b, 0xef, 0xa0,
+	0xba, 0x48, 0x41, 0x12, 0xcd, 0xf8, 0x30, 0x8c, 0x84, 0xd2, 0xfe, 0x92, 0xc5, 0xd5, 0x0a, 0xef,
+	0xb6, 0x50, 0xda, 0xc0, 0x12, 0xc6, 0x43, 0x0a, 0x31, 0x0c, 0x89, 0x66, 0x82, 0xfb, 0x15, 0x07,
+	0x4b, 0x18, 0xdf, 0x29, 0x9c, 0xf8, 0x2e, 0x6a, 0x24, 0xe4, 0x28, 0xec, 0x67, 0x9c, 0xc6, 0x10,
+	0x2a, 0xf6, 0x12, 0x7c, 0x94, 0xe3, 0xc8, 0xd1, 0x96, 0xf5, 0xf6, 0xd8, 0x4b, 0xdb, 0x81, 0x11,
+	0x48, 0x65, 0x78, 0xaa, 0xae, 0x03, 0xb9, 0x89, 0xd7, 0xd1, 0x52, 0x9f, 0x71, 0x22, 0x19, 0x28,
+	0x7f, 0xd9, 0x15, 0x35, 0xb1, 0x71, 0x07, 0xad, 0x28, 0x2d, 0x24, 0x19, 0x42, 0x98, 0x4a, 0x31,
+	0x62, 0x14, 0x64, 0xc8, 0xa8, 0x5f, 0xdb, 0xf0, 0x36, 0x6b, 0x41, 0x33, 0x0f, 0xed, 0xe5, 0x91,
+	0x5d, 0x6a, 0x1e, 0x1d, 0x89, 0x24, 0x95, 0xa0, 0x0c, 0xb5, 0x81, 0xd6, 0x2d, 0xb4, 0x36, 0xe5,
+	0xdd, 0xa5, 0xf8, 0x31, 0xaa, 0x0f, 0x32, 0x4e, 0x4d, 0x03, 0x52, 0x11, 0xb3, 0xe8, 0xd8, 0x6f,
+	0x6c, 0x78, 0x9b, 0xf5, 0xfb, 0x1b, 0x33, 0x86, 0xe4, 0x6b, 0x07, 0xdc, 0xb3, 0xb8, 0xa0, 0x36,
+	0x98, 0x36, 0xf1, 0x2d, 0x54, 0x35, 0xd5, 0x2b, 0x4d, 0x0e, 0x41, 0x2a, 0xff, 0xaa, 0xad, 0x1c,
+	0x25, 0xe4, 0xa8, 0xe7, 0x3c, 0xb8, 0x87, 0xb0, 0x6b, 0x3f, 0xc8, 0x50, 0x41, 0x0c, 0x91, 0xed,
+	0x64, 0xd3, 0x66, 0xfb, 0x74, 0x46, 0xb6, 0xfd, 0x1c, 0xdc, 0x9b, 0x60, 0x83, 0x66, 0xf6, 0xbe,
+	0x0b, 0x3f, 0x47, 0xcb, 0x23, 0x12, 0x33, 0x1a, 0xfe, 0x98, 0x09, 0x99, 0x25, 0x3e, 0x36, 0x5d,
+	0xdb, 0xea, 0x98, 0x31, 0xfe, 0xe3, 0xcd, 0xad, 0xbb, 0x43, 0xa6, 0x0f, 0xb2, 0x7e, 0x27, 0x12,
+	0x49, 0x37, 0x12, 0x2a, 0x11, 0x2a, 0xff, 0xf3, 0xa5, 0xa2, 0x87, 0x5d, 0x7d, 0x9c, 0x82, 0xea,
+	0xec, 0x40, 0x14, 0x54, 0x2d, 0xc7, 0x73, 0x4b, 0x81, 0xf7, 0x51, 0x9d, 0xf1, 0x53, 0xa4, 0x2b,
+	0x1f, 0x45, 0x5a, 0x63, 0x7c, 0x9a, 0xf6, 0x01, 0xaa, 0x8e, 0x84, 0x1d, 0xb4, 0x44, 0x50, 0xf0,
+	0x57, 0x6d, 0xdd, 0xff, 0x9f, 0x51, 0xf7, 0x0b, 0x8b, 0x7a, 0x2a, 0x28, 0x04, 0x68, 0x54, 0x9c,
+	0xf1, 0x6d, 0x54, 0x93, 0x30, 0x02, 0x12, 0x87, 0x63, 0xc6, 0xa9, 0x18, 0xfb, 0x6b, 0xb6, 0xc3,
+	0xcb, 0xce, 0xf9, 0xad, 0xf5, 0xb5, 0xdb, 0xe8, 0xaa, 0xdd, 0x49, 0xb3, 0x8d, 0x8f, 0x38, 0xe9,
+	0xc7, 0x40, 0xdf, 0x5f, 0xca, 0xf6, 0x6d, 0xd4, 0x2c, 0x30, 0x3b, 0x4c, 0xcd, 0x06, 0xfd, 0xe6,
+	0xa1, 0x9b, 0x16, 0x15, 0xb8, 0xe5, 0xdc, 0x4f, 0x87, 0x92, 0x50, 0xe8, 0x45, 0x07, 0x40, 0x33,
+	0x73, 0x61, 0x6a, 0x8d, 0xbd, 0xd3, 0x6b, 0x3c, 0x35, 0xde, 0xf3, 0xa7, 0xc7, 0xfb, 0x13, 0xb4,
+	0xac, 0x26, 0x04, 0x21, 0xd1, 0x76, 0xff, 0x4b, 0x41, 0xb5, 0xf0, 0x3d, 0xd4, 0x66, 0x03, 0x68,
+	0x26, 0xdd, 0x92, 0x95, 0x6c, 0xb8, 0xb0, 0x4f, 0x6d, 0xc7, 0xc2, 0x7b, 0xdb, 0x71, 0x07, 0xd5,
+	0xc9, 0x60, 0x00, 0x91, 0x06, 0x1a, 0x9a, 0x6e, 0x2a, 0xbf, 0xbc, 0x71, 0xc5, 0xac, 0xde, 0xc4,
+	0x6b, 0xaa, 0x55, 0xed, 0x70, 0x66, 0x55, 0xdb, 0x84, 0x47, 0x10, 0xff, 0x7d, 0x55, 0x67, 0x13,
+	0xcc, 0xcf, 0x4a, 0x70, 0xb2, 0x34, 0xf5, 0x1f, 0x70, 0x4a, 0x7e, 0xa6, 0xb9, 0xf8, 0x0b, 0xd4,
+	0x94, 0x64, 0x1c, 0x66, 0x36, 0x1c, 0x2a, 0x2d, 0x19, 0x1f, 0xe6, 0xbd, 0x6a, 0x48, 0x32, 0x76,
+	0xd7, 0x7a, 0xd6, 0x5d, 0x48, 0xe8, 0x95, 0xd9, 0x12, 0x5a, 0x9a, 0x2d, 0xa1, 0x0b, 0x33, 0x25,
+	0xb4, 0x7c, 0x4a, 0x42, 0xff, 0xe3, 0x2a, 0xf9, 0x01, 0xbd, 0xab, 0x9e, 0x5f, 0xef, 0x96, 0xcf,
+	0xa7, 0x77, 0xb5, 0x0b, 0xd1, 0xbb, 0xfa, 0x39, 0xf5, 0xae, 0x71, 0xb1, 0x7a, 0x77, 0xf5, 0x32,
+	0xf4, 0xae, 0x79, 0x09, 0x7a, 0x87, 0xff, 0xb5, 0xde, 0xad, 0x9c, 0xd5, 0x3b, 0xfc, 0x14, 0x99,
+	0x2b, 0x10, 0xaa, 0x98, 0xa8, 0x03, 0x7f, 0xb5, 0x78, 0xb7, 0xf7, 0x0f, 0xde, 0x5d, 0x31, 0x0c,
+	0x3d, 0x43, 0x60, 0xba, 0x9b, 0xaf, 0x84, 0x23, 0x5c, 0xfb, 0x28, 0xc2, 0xaa, 0xe3, 0x70, 0x94,
+	0x3d, 0x54, 0x33, 0x9b, 0x29, 0x32, 0x9d, 0x73, 0x5e, 0xfb, 0x28, 0xce, 0xe5, 0x9c, 0xc4, 0x92,
+	0xb6, 0x7f, 0xf5, 0x50, 0xcd, 0xaa, 0x8c, 0x99, 0x50, 0xfb, 0xcd, 0xeb, 0x3a, 0x5a, 0x34, 0x4d,
+	0x0d, 0x0b, 0x9d, 0x29, 0x1b, 0x73, 0xd7, 0x2a, 0x1a, 0xa1, 0xd4, 0xcc, 0xff, 0x44, 0x8d, 0x73,
+	0xd3, 0xe8, 0x02, 0x49, 0x44, 0xc6, 0x27, 0x3a, 0x9c, 0x5b, 0x46, 0x9d, 0xdc, 0x29, 0x4c, 0x41,
+	0xe6, 0x7b, 0x9a, 0x6b, 0x71, 0xc3, 0x05, 0xf6, 0x40, 0xba, 0x45, 0xc5, 0x37, 0x90, 0xfb, 0xd6,
+	0x65, 0xd4, 0x7c, 0xc1, 0x42, 0x16, 0xad, 0xfd, 0x50, 0xe3, 0x35, 0x54, 0x06, 0x6e, 0x65, 0xbe,
+	0x6c, 0x03, 0x0b, 0xc0, 0x8d, 0xc0, 0xaf, 0xa2, 0x05, 0x0a, 0x5c, 0x24, 0x56, 0x6b, 0x2a, 0x81,
+	0x33, 0xda, 0x32, 0xff, 0x2e, 0xb9, 0x03, 0x83, 0x4b, 0xa8, 0xa8, 0xc8, 0x59, 0x9a, 0xce, 0x49,
+	0xd1, 0xf5, 0xa2, 0x87, 0x8f, 0x89, 0xea, 0xa5, 0x82, 0x2b, 0x21, 0xd5, 0x01, 0x4b, 0x2f, 0x30,
+	0x77, 0xfb, 0x87, 0xfc, 0xd3, 0xf6, 0x5d, 0x06, 0xa0, 0x17, 0xc9, 0xdf, 0x47, 0x6b, 0xc5, 0xe7,
+	0x8d, 0xa9, 0x44, 0xd9, 0x09, 0xb9, 0xd8, 0x1c, 0x1d, 0xb4, 0x52, 0xe4, 0x78, 0x96, 0xe9, 0x67,
+	0x03, 0x9b, 0xe8, 0x83, 0x19, 0xb6, 0xb6, 0x5f, 0xbd, 0x6d, 0x79, 0xaf, 0xdf, 0xb6, 0xbc, 0x3f,
+	0xdf, 0xb6, 0xbc, 0x9f, 0x4e, 0x5a, 0x73, 0xaf, 0x4f, 0x5a, 0x73, 0xbf, 0x9f, 0xb4, 0xe6, 0xbe,
+	0xff, 0x7c, 0x6a, 0xdc, 0x9f, 0x7c, 0xf7, 0xe2, 0xd1, 0x37, 0xa0, 0xc7, 0x42, 0x1e, 0x76, 0xa3,
+	0x03, 0xc2, 0x78, 0xf7, 0xc8, 0xfd, 0x38, 0xb2, 0x53, 0xdf, 0x2f, 0xdb, 0x9f, 0x45, 0x5f, 0xfd,
+	0x35, 0x00, 0xa8, 0xdb, 0x13, 0xcd, 0x9f, 0x0d, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -1040,6 +1350,63 @@ func (m *EventCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevealWindow != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.VotingMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VotingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.InvalidQuorum.Size()
+		i -= size
+		if _, err := m.InvalidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.ValidQuorum.Size()
+		i -= size
+		if _, err := m.ValidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.UploaderSelection != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UploaderSelection))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxStakers != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxStakers))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.FundingPolicy != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FundingPolicy))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.CompressionId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.CompressionId))
 		i--
@@ -1323,6 +1690,101 @@ func (m *EventPoolUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimeoutSlash != nil {
+		{
+			size := m.TimeoutSlash.Size()
+			i -= size
+			if _, err := m.TimeoutSlash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.UploadSlash != nil {
+		{
+			size := m.UploadSlash.Size()
+			i -= size
+			if _, err := m.UploadSlash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.VoteSlash != nil {
+		{
+			size := m.VoteSlash.Size()
+			i -= size
+			if _, err := m.VoteSlash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.RevealWindow != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.VotingMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VotingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.InvalidQuorum.Size()
+		i -= size
+		if _, err := m.InvalidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.ValidQuorum.Size()
+		i -= size
+		if _, err := m.ValidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.UploaderSelection != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UploaderSelection))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxStakers != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxStakers))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.FundingPolicy != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FundingPolicy))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.CompressionId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.CompressionId))
 		i--
@@ -1416,6 +1878,28 @@ func (m *EventFundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AmountPerBundle != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AmountPerBundle))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Amount != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
 		i--
@@ -1456,6 +1940,13 @@ func (m *EventDefundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Amount != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
 		i--
@@ -1476,7 +1967,7 @@ func (m *EventDefundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPoolFundsSlashed) Marshal() (dAtA []byte, err error) {
+func (m *EventFundGasSponsorship) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1486,12 +1977,12 @@ func (m *EventPoolFundsSlashed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPoolFundsSlashed) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventFundGasSponsorship) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPoolFundsSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventFundGasSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1516,7 +2007,7 @@ func (m *EventPoolFundsSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPoolOutOfFunds) Marshal() (dAtA []byte, err error) {
+func (m *EventGasSponsored) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1526,18 +2017,98 @@ func (m *EventPoolOutOfFunds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPoolOutOfFunds) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventGasSponsored) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPoolOutOfFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventGasSponsored) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolFundsSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolFundsSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolFundsSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolOutOfFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolOutOfFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolOutOfFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
 		i--
 		dAtA[i] = 0x8
 	}
@@ -1627,6 +2198,25 @@ func (m *EventCreatePool) Size() (n int) {
 	if m.CompressionId != 0 {
 		n += 1 + sovEvents(uint64(m.CompressionId))
 	}
+	if m.FundingPolicy != 0 {
+		n += 1 + sovEvents(uint64(m.FundingPolicy))
+	}
+	if m.MaxStakers != 0 {
+		n += 2 + sovEvents(uint64(m.MaxStakers))
+	}
+	if m.UploaderSelection != 0 {
+		n += 2 + sovEvents(uint64(m.UploaderSelection))
+	}
+	l = m.ValidQuorum.Size()
+	n += 2 + l + sovEvents(uint64(l))
+	l = m.InvalidQuorum.Size()
+	n += 2 + l + sovEvents(uint64(l))
+	if m.VotingMode != 0 {
+		n += 2 + sovEvents(uint64(m.VotingMode))
+	}
+	if m.RevealWindow != 0 {
+		n += 2 + sovEvents(uint64(m.RevealWindow))
+	}
 	return n
 }
 
@@ -1755,6 +2345,37 @@ func (m *EventPoolUpdated) Size() (n int) {
 	if m.CompressionId != 0 {
 		n += 1 + sovEvents(uint64(m.CompressionId))
 	}
+	if m.FundingPolicy != 0 {
+		n += 1 + sovEvents(uint64(m.FundingPolicy))
+	}
+	if m.MaxStakers != 0 {
+		n += 1 + sovEvents(uint64(m.MaxStakers))
+	}
+	if m.UploaderSelection != 0 {
+		n += 1 + sovEvents(uint64(m.UploaderSelection))
+	}
+	l = m.ValidQuorum.Size()
+	n += 2 + l + sovEvents(uint64(l))
+	l = m.InvalidQuorum.Size()
+	n += 2 + l + sovEvents(uint64(l))
+	if m.VotingMode != 0 {
+		n += 2 + sovEvents(uint64(m.VotingMode))
+	}
+	if m.RevealWindow != 0 {
+		n += 2 + sovEvents(uint64(m.RevealWindow))
+	}
+	if m.VoteSlash != nil {
+		l = m.VoteSlash.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	if m.UploadSlash != nil {
+		l = m.UploadSlash.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	if m.TimeoutSlash != nil {
+		l = m.TimeoutSlash.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -1774,10 +2395,65 @@ func (m *EventFundPool) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovEvents(uint64(m.Amount))
 	}
+	if m.AmountPerBundle != 0 {
+		n += 1 + sovEvents(uint64(m.AmountPerBundle))
+	}
+	if m.StartAt != 0 {
+		n += 1 + sovEvents(uint64(m.StartAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovEvents(uint64(m.EndAt))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
 func (m *EventDefundPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFundGasSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventGasSponsored) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2367,61 +3043,11 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventPoolEnabled) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventPoolEnabled: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPoolEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPolicy", wireType)
 			}
-			m.Id = 0
+			m.FundingPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2431,66 +3057,16 @@ func (m *EventPoolEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.FundingPolicy |= FundingPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventPoolDisabled) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventPoolDisabled: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPoolDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakers", wireType)
 			}
-			m.Id = 0
+			m.MaxStakers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2500,21 +3076,284 @@ func (m *EventPoolDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.MaxStakers |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploaderSelection", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			m.UploaderSelection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploaderSelection |= UploaderSelection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InvalidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingMode", wireType)
+			}
+			m.VotingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingMode |= VotingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealWindow", wireType)
+			}
+			m.RevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -3266,9 +4105,489 @@ func (m *EventPoolUpdated) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPolicy", wireType)
+			}
+			m.FundingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingPolicy |= FundingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakers", wireType)
+			}
+			m.MaxStakers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploaderSelection", wireType)
+			}
+			m.UploaderSelection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploaderSelection |= UploaderSelection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InvalidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingMode", wireType)
+			}
+			m.VotingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingMode |= VotingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealWindow", wireType)
+			}
+			m.RevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteSlash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteSlash = &v
+			if err := m.VoteSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSlash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.UploadSlash = &v
+			if err := m.UploadSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSlash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TimeoutSlash = &v
+			if err := m.TimeoutSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBundle", wireType)
+			}
+			m.AmountPerBundle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountPerBundle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			}
+			m.StartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			m.EndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -3287,7 +4606,7 @@ func (m *EventPoolUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFundPool) Unmarshal(dAtA []byte) error {
+func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3310,10 +4629,162 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDefundPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDefundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundGasSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundGasSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundGasSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3407,7 +4878,7 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
+func (m *EventGasSponsored) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3430,10 +4901,10 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDefundPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGasSponsored: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDefundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGasSponsored: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
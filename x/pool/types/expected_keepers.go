@@ -14,4 +14,5 @@ type UpgradeKeeper interface {
 type StakersKeeper interface {
 	LeavePool(ctx sdk.Context, staker string, poolId uint64)
 	GetAllStakerAddressesOfPool(ctx sdk.Context, poolId uint64) (stakers []string)
+	RemoveExcessStakersOfPool(ctx sdk.Context, poolId uint64)
 }
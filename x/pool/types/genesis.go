@@ -24,7 +24,7 @@ func (gs GenesisState) Validate() error {
 		if elem.Id >= gs.PoolCount {
 			return fmt.Errorf("pool id higher than pool count %v", elem)
 		}
-		if len(elem.Funders) > MaxFunders {
+		if uint64(len(elem.Funders)) > gs.Params.MaxFunders {
 			return fmt.Errorf("more funders than allowed %v", elem)
 		}
 	}
@@ -18,10 +18,6 @@ const (
 	MemStoreKey = "mem_pool"
 )
 
-const (
-	MaxFunders = 50 // maximum amount of funders which are allowed
-)
-
 var (
 	// ParamsKey is the prefix for all module params defined in params.proto
 	ParamsKey = []byte{0}
@@ -31,8 +27,16 @@ var (
 
 	// PoolCountKey is the prefix for the pool counter defined in pool.proto
 	PoolCountKey = []byte{2}
+
+	// GasSponsorshipUsageKey is the prefix for the amount the gas sponsorship of
+	// a pool paid for a valaccount in the current block
+	GasSponsorshipUsageKey = []byte{3}
 )
 
 func PoolKeyPrefix(poolId uint64) []byte {
 	return util.GetByteKey(poolId)
 }
+
+func GasSponsorshipUsageKeyPrefix(poolId uint64, signer string) []byte {
+	return util.GetByteKey(poolId, signer)
+}
@@ -12,11 +12,12 @@ var (
 	_ sdk.Msg            = &MsgDefundPool{}
 )
 
-func NewMsgDefundPool(creator string, id uint64, amount uint64) *MsgDefundPool {
+func NewMsgDefundPool(creator string, id uint64, amount uint64, denom string) *MsgDefundPool {
 	return &MsgDefundPool{
 		Creator: creator,
 		Id:      id,
 		Amount:  amount,
+		Denom:   denom,
 	}
 }
 
@@ -47,5 +48,12 @@ func (msg *MsgDefundPool) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+
+	if msg.Denom != "" {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid denom: %s", err)
+		}
+	}
+
 	return nil
 }
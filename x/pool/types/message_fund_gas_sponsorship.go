@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgFundGasSponsorship{}
+	_ sdk.Msg            = &MsgFundGasSponsorship{}
+)
+
+func NewMsgFundGasSponsorship(creator string, id uint64, amount uint64) *MsgFundGasSponsorship {
+	return &MsgFundGasSponsorship{
+		Creator: creator,
+		Id:      id,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgFundGasSponsorship) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFundGasSponsorship) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFundGasSponsorship) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFundGasSponsorship) Type() string {
+	return "kyve/pool/MsgFundGasSponsorship"
+}
+
+func (msg *MsgFundGasSponsorship) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
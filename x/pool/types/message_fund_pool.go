@@ -3,6 +3,7 @@ package types
 import (
 	"cosmossdk.io/errors"
 	"github.com/KYVENetwork/chain/util"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
@@ -13,11 +14,16 @@ var (
 	_ sdk.Msg            = &MsgFundPool{}
 )
 
-func NewMsgFundPool(creator string, id uint64, amount uint64) *MsgFundPool {
+func NewMsgFundPool(creator string, id uint64, amount uint64, amountPerBundle uint64, startAt uint64, endAt uint64, denom string, clearSchedule bool) *MsgFundPool {
 	return &MsgFundPool{
-		Creator: creator,
-		Id:      id,
-		Amount:  amount,
+		Creator:         creator,
+		Id:              id,
+		Amount:          amount,
+		AmountPerBundle: amountPerBundle,
+		StartAt:         startAt,
+		EndAt:           endAt,
+		Denom:           denom,
+		ClearSchedule:   clearSchedule,
 	}
 }
 
@@ -52,5 +58,15 @@ func (msg *MsgFundPool) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid amount")
 	}
 
+	if msg.EndAt != 0 && msg.EndAt <= msg.StartAt {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "end time must be after start time")
+	}
+
+	if msg.Denom != "" && msg.Denom != globalTypes.Denom {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid denom: %s", err)
+		}
+	}
+
 	return nil
 }
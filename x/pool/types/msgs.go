@@ -48,6 +48,26 @@ func (msg *MsgCreatePool) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max bundle size")
 	}
 
+	if _, ok := FundingPolicy_name[int32(msg.FundingPolicy)]; !ok {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid funding policy")
+	}
+
+	if err := util.ValidateNumber(msg.MaxStakers); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max stakers")
+	}
+
+	if _, ok := UploaderSelection_name[int32(msg.UploaderSelection)]; !ok {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid uploader selection")
+	}
+
+	if err := ValidateQuorums(msg.ValidQuorum, msg.InvalidQuorum); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid quorum: %s", err)
+	}
+
+	if err := ValidateVotingMode(msg.VotingMode, msg.RevealWindow); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "%s", err)
+	}
+
 	return nil
 }
 
@@ -69,6 +89,21 @@ type PoolUpdate struct {
 	MaxBundleSize     *uint64
 	StorageProviderId *uint32
 	CompressionId     *uint32
+	FundingPolicy     *FundingPolicy
+	MaxStakers        *uint64
+	UploaderSelection *UploaderSelection
+	ValidQuorum       *sdk.Dec
+	InvalidQuorum     *sdk.Dec
+	VotingMode        *VotingMode
+	RevealWindow      *uint64
+	VoteSlash         *sdk.Dec
+	UploadSlash       *sdk.Dec
+	TimeoutSlash      *sdk.Dec
+	// The slash overrides are removed if they are cleared explicitly,
+	// so that the global params of the delegation module are used again
+	ClearVoteSlash    bool
+	ClearUploadSlash  bool
+	ClearTimeoutSlash bool
 }
 
 // ValidateBasic does a sanity check on the provided data.
@@ -106,6 +141,66 @@ func (msg *MsgUpdatePool) ValidateBasic() error {
 		}
 	}
 
+	if payload.FundingPolicy != nil {
+		if _, ok := FundingPolicy_name[int32(*payload.FundingPolicy)]; !ok {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid funding policy")
+		}
+	}
+
+	if payload.MaxStakers != nil {
+		if err := util.ValidateNumber(*payload.MaxStakers); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max stakers")
+		}
+	}
+
+	if payload.UploaderSelection != nil {
+		if _, ok := UploaderSelection_name[int32(*payload.UploaderSelection)]; !ok {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid uploader selection")
+		}
+	}
+
+	if payload.ValidQuorum != nil {
+		if err := ValidateValidQuorum(*payload.ValidQuorum); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid valid quorum: %s", err)
+		}
+	}
+
+	if payload.InvalidQuorum != nil {
+		if err := ValidateQuorum(*payload.InvalidQuorum); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid invalid quorum: %s", err)
+		}
+	}
+
+	if payload.VotingMode != nil {
+		if _, ok := VotingMode_name[int32(*payload.VotingMode)]; !ok {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid voting mode")
+		}
+	}
+
+	if payload.VoteSlash != nil {
+		if err := util.ValidatePercentage(*payload.VoteSlash); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid vote slash: %s", err)
+		}
+	}
+
+	if payload.UploadSlash != nil {
+		if err := util.ValidatePercentage(*payload.UploadSlash); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid upload slash: %s", err)
+		}
+	}
+
+	if payload.TimeoutSlash != nil {
+		if err := util.ValidatePercentage(*payload.TimeoutSlash); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid timeout slash: %s", err)
+		}
+	}
+
+	if (payload.VoteSlash != nil && payload.ClearVoteSlash) ||
+		(payload.UploadSlash != nil && payload.ClearUploadSlash) ||
+		(payload.TimeoutSlash != nil && payload.ClearTimeoutSlash) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "slash can not be set and cleared at once")
+	}
+
 	return nil
 }
 
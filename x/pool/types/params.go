@@ -1,7 +1,10 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/KYVENetwork/chain/util"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,14 +14,29 @@ var DefaultProtocolInflationShare = sdk.ZeroDec()
 // DefaultPoolInflationPayoutRate ...
 var DefaultPoolInflationPayoutRate = sdk.MustNewDecFromStr("0.05")
 
+// DefaultMaxFunders ...
+var DefaultMaxFunders = uint64(50)
+
+// DefaultWhitelistedDenoms ...
+var DefaultWhitelistedDenoms = []string{}
+
+// DefaultMaxGasSponsorshipPerBlock ...
+var DefaultMaxGasSponsorshipPerBlock = uint64(10_000_000)
+
 // NewParams creates a new Params instance
 func NewParams(
 	protocolInflationShare sdk.Dec,
 	poolInflationPayoutRate sdk.Dec,
+	maxFunders uint64,
+	whitelistedDenoms []string,
+	maxGasSponsorshipPerBlock uint64,
 ) Params {
 	return Params{
-		ProtocolInflationShare:  protocolInflationShare,
-		PoolInflationPayoutRate: poolInflationPayoutRate,
+		ProtocolInflationShare:    protocolInflationShare,
+		PoolInflationPayoutRate:   poolInflationPayoutRate,
+		MaxFunders:                maxFunders,
+		WhitelistedDenoms:         whitelistedDenoms,
+		MaxGasSponsorshipPerBlock: maxGasSponsorshipPerBlock,
 	}
 }
 
@@ -27,6 +45,9 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultProtocolInflationShare,
 		DefaultPoolInflationPayoutRate,
+		DefaultMaxFunders,
+		DefaultWhitelistedDenoms,
+		DefaultMaxGasSponsorshipPerBlock,
 	)
 }
 
@@ -40,5 +61,40 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidatePositiveNumber(p.MaxFunders); err != nil {
+		return err
+	}
+
+	if err := validateWhitelistedDenoms(p.WhitelistedDenoms); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePositiveNumber(p.MaxGasSponsorshipPerBlock); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateWhitelistedDenoms checks that all denoms are valid, unique
+// and not the native denom, which can always be used for funding.
+func validateWhitelistedDenoms(denoms []string) error {
+	seen := make(map[string]bool)
+
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+
+		if denom == globalTypes.Denom {
+			return fmt.Errorf("native denom %s can not be whitelisted", denom)
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate whitelisted denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
 	return nil
 }
@@ -30,6 +30,14 @@ type Params struct {
 	ProtocolInflationShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=protocol_inflation_share,json=protocolInflationShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_inflation_share"`
 	// pool_inflation_payout_rate ...
 	PoolInflationPayoutRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=pool_inflation_payout_rate,json=poolInflationPayoutRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_inflation_payout_rate"`
+	// max_funders is the maximum amount of funders a pool can have
+	MaxFunders uint64 `protobuf:"varint,3,opt,name=max_funders,json=maxFunders,proto3" json:"max_funders,omitempty"`
+	// whitelisted_denoms are the non-native denoms pools
+	// can be funded with
+	WhitelistedDenoms []string `protobuf:"bytes,4,rep,name=whitelisted_denoms,json=whitelistedDenoms,proto3" json:"whitelisted_denoms,omitempty"`
+	// max_gas_sponsorship_per_block is the maximum amount in ukyve
+	// the gas sponsorship of a pool pays per valaccount and block
+	MaxGasSponsorshipPerBlock uint64 `protobuf:"varint,5,opt,name=max_gas_sponsorship_per_block,json=maxGasSponsorshipPerBlock,proto3" json:"max_gas_sponsorship_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -65,6 +73,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxFunders() uint64 {
+	if m != nil {
+		return m.MaxFunders
+	}
+	return 0
+}
+
+func (m *Params) GetWhitelistedDenoms() []string {
+	if m != nil {
+		return m.WhitelistedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxGasSponsorshipPerBlock() uint64 {
+	if m != nil {
+		return m.MaxGasSponsorshipPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.pool.v1beta1.Params")
 }
@@ -72,24 +101,30 @@ func init() {
 func init() { proto.RegisterFile("kyve/pool/v1beta1/params.proto", fileDescriptor_7d8646dfa6da3b4d) }
 
 var fileDescriptor_7d8646dfa6da3b4d = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0xae, 0x2c, 0x4b,
-	0xd5, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0xc9, 0xeb, 0x81,
-	0xe4, 0xf5, 0xa0, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x59, 0x7d, 0x10, 0x0b, 0xa2,
-	0x50, 0xe9, 0x3e, 0x23, 0x17, 0x5b, 0x00, 0x58, 0xa7, 0x50, 0x06, 0x97, 0x04, 0x58, 0x2c, 0x39,
-	0x3f, 0x27, 0x3e, 0x33, 0x2f, 0x2d, 0x27, 0xb1, 0x24, 0x33, 0x3f, 0x2f, 0xbe, 0x38, 0x23, 0xb1,
-	0x28, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xef, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7,
-	0xe4, 0xd5, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x93, 0xf3, 0x8b,
-	0x73, 0xf3, 0x8b, 0xa1, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0xb1, 0x9e,
-	0x4b, 0x6a, 0x72, 0x90, 0x18, 0xcc, 0x3c, 0x4f, 0x98, 0x71, 0xc1, 0x20, 0xd3, 0x84, 0xb2, 0xb9,
-	0xa4, 0x40, 0x4e, 0x43, 0xb2, 0xa5, 0x20, 0xb1, 0x32, 0xbf, 0xb4, 0x24, 0xbe, 0x28, 0xb1, 0x24,
-	0x55, 0x82, 0x89, 0x2c, 0xbb, 0xc4, 0x41, 0x26, 0xc2, 0xed, 0x09, 0x00, 0x9b, 0x17, 0x94, 0x58,
-	0x92, 0xea, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0x48,
-	0x46, 0x7b, 0x47, 0x86, 0xb9, 0xfa, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0xeb, 0x27, 0x67, 0x24,
-	0x66, 0xe6, 0xe9, 0x57, 0x40, 0x82, 0x17, 0x6c, 0x43, 0x12, 0x1b, 0xd8, 0x27, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xd3, 0x1a, 0xf2, 0x59, 0x78, 0x01, 0x00, 0x00,
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcf, 0x6a, 0xe2, 0x40,
+	0x1c, 0xc7, 0x13, 0x75, 0x05, 0x67, 0x4f, 0x86, 0x65, 0x37, 0x2b, 0x6c, 0x94, 0x3d, 0x2c, 0xee,
+	0xc1, 0x0c, 0xb2, 0x2f, 0xb0, 0xb8, 0x6e, 0x4b, 0x29, 0x14, 0x89, 0x50, 0x68, 0x2f, 0xc3, 0x24,
+	0x19, 0x93, 0x90, 0x3f, 0xbf, 0x61, 0x66, 0xd4, 0xf8, 0x16, 0x7d, 0x2c, 0x8f, 0x1e, 0x4b, 0x0f,
+	0x52, 0xf4, 0x09, 0xfa, 0x06, 0x25, 0xa3, 0x52, 0x7b, 0xed, 0x29, 0xe1, 0xf7, 0xf9, 0xce, 0xe7,
+	0xcb, 0x6f, 0x06, 0x39, 0xe9, 0x6a, 0xc1, 0x30, 0x07, 0xc8, 0xf0, 0x62, 0xe8, 0x33, 0x45, 0x87,
+	0x98, 0x53, 0x41, 0x73, 0xe9, 0x72, 0x01, 0x0a, 0xac, 0x76, 0xc5, 0xdd, 0x8a, 0xbb, 0x47, 0xde,
+	0xf9, 0x12, 0x41, 0x04, 0x9a, 0xe2, 0xea, 0xef, 0x10, 0xfc, 0xf9, 0x52, 0x43, 0xcd, 0x89, 0x3e,
+	0x69, 0xc5, 0xc8, 0xd6, 0xb3, 0x00, 0x32, 0x92, 0x14, 0xb3, 0x8c, 0xaa, 0x04, 0x0a, 0x22, 0x63,
+	0x2a, 0x98, 0x6d, 0xf6, 0xcc, 0x7e, 0x6b, 0xe4, 0xae, 0xb7, 0x5d, 0xe3, 0x69, 0xdb, 0xfd, 0x15,
+	0x25, 0x2a, 0x9e, 0xfb, 0x6e, 0x00, 0x39, 0x0e, 0x40, 0xe6, 0x20, 0x8f, 0x9f, 0x81, 0x0c, 0x53,
+	0xac, 0x56, 0x9c, 0x49, 0x77, 0xcc, 0x02, 0xef, 0xeb, 0xc9, 0x77, 0x75, 0xd2, 0x4d, 0x2b, 0x9b,
+	0x95, 0xa2, 0x0e, 0x87, 0x77, 0x2d, 0x9c, 0xae, 0x60, 0xae, 0x88, 0xa0, 0x8a, 0xd9, 0xb5, 0x0f,
+	0x75, 0x7d, 0xe3, 0x70, 0xd6, 0x33, 0xd1, 0x3e, 0x8f, 0x2a, 0x66, 0x75, 0xd1, 0xe7, 0x9c, 0x96,
+	0x64, 0x36, 0x2f, 0x42, 0x26, 0xa4, 0x5d, 0xef, 0x99, 0xfd, 0x86, 0x87, 0x72, 0x5a, 0x5e, 0x1c,
+	0x26, 0xd6, 0x00, 0x59, 0xcb, 0x38, 0x51, 0x2c, 0x4b, 0xa4, 0x62, 0x21, 0x09, 0x59, 0x01, 0xb9,
+	0xb4, 0x1b, 0xbd, 0x7a, 0xbf, 0xe5, 0xb5, 0xcf, 0xc8, 0x58, 0x03, 0xeb, 0x2f, 0xfa, 0x51, 0xf9,
+	0x22, 0x2a, 0x89, 0xe4, 0x50, 0x48, 0x10, 0x32, 0x4e, 0x38, 0xe1, 0x4c, 0x10, 0x3f, 0x83, 0x20,
+	0xb5, 0x3f, 0xe9, 0x86, 0xef, 0x39, 0x2d, 0x2f, 0xa9, 0x9c, 0xbe, 0x45, 0x26, 0x4c, 0x8c, 0xaa,
+	0xc0, 0xe8, 0xdf, 0x7a, 0xe7, 0x98, 0x9b, 0x9d, 0x63, 0x3e, 0xef, 0x1c, 0xf3, 0x61, 0xef, 0x18,
+	0x9b, 0xbd, 0x63, 0x3c, 0xee, 0x1d, 0xe3, 0xfe, 0xf7, 0xd9, 0xb2, 0xd7, 0x77, 0xb7, 0xff, 0x6f,
+	0x98, 0x5a, 0x82, 0x48, 0x71, 0x10, 0xd3, 0xa4, 0xc0, 0xe5, 0xe1, 0xc1, 0xf5, 0xce, 0x7e, 0x53,
+	0xdf, 0xed, 0x9f, 0xd7, 0x01, 0x00, 0x85, 0x28, 0xf6, 0x39, 0x0a, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +147,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxGasSponsorshipPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGasSponsorshipPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WhitelistedDenoms) > 0 {
+		for iNdEx := len(m.WhitelistedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistedDenoms[iNdEx])
+			copy(dAtA[i:], m.WhitelistedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.WhitelistedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxFunders != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFunders))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.PoolInflationPayoutRate.Size()
 		i -= size
@@ -156,6 +210,18 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.PoolInflationPayoutRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MaxFunders != 0 {
+		n += 1 + sovParams(uint64(m.MaxFunders))
+	}
+	if len(m.WhitelistedDenoms) > 0 {
+		for _, s := range m.WhitelistedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxGasSponsorshipPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxGasSponsorshipPerBlock))
+	}
 	return n
 }
 
@@ -262,6 +328,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunders", wireType)
+			}
+			m.MaxFunders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFunders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedDenoms = append(m.WhitelistedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasSponsorshipPerBlock", wireType)
+			}
+			m.MaxGasSponsorshipPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasSponsorshipPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -32,7 +33,8 @@ func (m *Pool) AddAmountToFunder(funderAddress string, amount uint64) {
 }
 
 // SubtractAmountFromFunder subtracts the given amount form an existing funder
-// If the amount is grater or equal to the funders amount, the funder is removed.
+// If the amount is grater or equal to the funders amount, the funder is removed
+// unless he still has funds in other denoms.
 func (m *Pool) SubtractAmountFromFunder(funderAddress string, amount uint64) {
 	for i := range m.Funders {
 		if m.Funders[i].Address == funderAddress {
@@ -41,20 +43,165 @@ func (m *Pool) SubtractAmountFromFunder(funderAddress string, amount uint64) {
 				m.Funders[i].Amount -= amount
 			} else {
 				m.TotalFunds -= m.Funders[i].Amount
+				m.Funders[i].Amount = 0
 
-				// Remove funder
-				m.Funders[i] = m.Funders[len(m.Funders)-1]
-				m.Funders = m.Funders[:len(m.Funders)-1]
+				if m.Funders[i].Coins.IsZero() {
+					m.removeFunderAt(i)
+				}
 			}
 			return
 		}
 	}
 }
 
+// AddCoinsToFunder adds the given coins to an existing funder.
+// If the funder does not exist, a new funder is inserted.
+func (m *Pool) AddCoinsToFunder(funderAddress string, coins sdk.Coins) {
+	if coins.IsZero() {
+		return
+	}
+
+	m.TotalFundsPerDenom = m.TotalFundsPerDenom.Add(coins...)
+
+	for _, v := range m.Funders {
+		if v.Address == funderAddress {
+			v.Coins = v.Coins.Add(coins...)
+			return
+		}
+	}
+
+	// Funder was not found, insert new funder
+	m.Funders = append(m.Funders, &Funder{
+		Address: funderAddress,
+		Coins:   coins,
+	})
+}
+
+// SubtractCoinsFromFunder subtracts the given coins from an existing funder.
+// Every coin is capped by the funds the funder has left in its denom.
+// Denoms which are used up are also removed from the coins per bundle
+// of the funder. If the funder has no funds left at all, he is removed.
+func (m *Pool) SubtractCoinsFromFunder(funderAddress string, coins sdk.Coins) {
+	for i := range m.Funders {
+		if m.Funders[i].Address == funderAddress {
+			coins = coins.Min(m.Funders[i].Coins)
+
+			m.TotalFundsPerDenom = m.TotalFundsPerDenom.Sub(coins...)
+			m.Funders[i].Coins = m.Funders[i].Coins.Sub(coins...)
+
+			coinsPerBundle := sdk.NewCoins()
+			for _, coin := range m.Funders[i].CoinsPerBundle {
+				if m.Funders[i].Coins.AmountOf(coin.Denom).IsPositive() {
+					coinsPerBundle = coinsPerBundle.Add(coin)
+				}
+			}
+			m.Funders[i].CoinsPerBundle = coinsPerBundle
+
+			if m.Funders[i].Amount == 0 && m.Funders[i].Coins.IsZero() {
+				m.removeFunderAt(i)
+			}
+			return
+		}
+	}
+}
+
+// removeFunderAt removes the funder with the given index from the funders list.
+func (m *Pool) removeFunderAt(i int) {
+	m.Funders[i] = m.Funders[len(m.Funders)-1]
+	m.Funders = m.Funders[:len(m.Funders)-1]
+}
+
+// SetFunderSchedule sets the amount per bundle and the funding period of an existing funder.
+// Only the values which are set (non-zero) are updated, the others are kept.
+func (m *Pool) SetFunderSchedule(funderAddress string, amountPerBundle uint64, startAt uint64, endAt uint64) {
+	for _, v := range m.Funders {
+		if v.Address == funderAddress {
+			if amountPerBundle > 0 {
+				v.AmountPerBundle = amountPerBundle
+			}
+			if startAt > 0 {
+				v.StartAt = startAt
+			}
+			if endAt > 0 {
+				v.EndAt = endAt
+			}
+			return
+		}
+	}
+}
+
+// ClearFunderSchedule removes the amount per bundle of the given denom
+// and the funding period of an existing funder.
+func (m *Pool) ClearFunderSchedule(funderAddress string, denom string) {
+	for _, v := range m.Funders {
+		if v.Address == funderAddress {
+			if denom == globalTypes.Denom {
+				v.AmountPerBundle = 0
+			} else {
+				coinsPerBundle := sdk.NewCoins()
+				for _, coin := range v.CoinsPerBundle {
+					if coin.Denom != denom {
+						coinsPerBundle = coinsPerBundle.Add(coin)
+					}
+				}
+				v.CoinsPerBundle = coinsPerBundle
+			}
+			v.StartAt = 0
+			v.EndAt = 0
+			return
+		}
+	}
+}
+
+// SetFunderCoinSchedule sets the amount per bundle of a non-native denom
+// and the funding period of an existing funder.
+// Only the values which are set (non-zero) are updated, the others are kept.
+func (m *Pool) SetFunderCoinSchedule(funderAddress string, coinPerBundle sdk.Coin, startAt uint64, endAt uint64) {
+	for _, v := range m.Funders {
+		if v.Address == funderAddress {
+			if coinPerBundle.IsPositive() {
+				coinsPerBundle := sdk.NewCoins(coinPerBundle)
+				for _, coin := range v.CoinsPerBundle {
+					if coin.Denom != coinPerBundle.Denom {
+						coinsPerBundle = coinsPerBundle.Add(coin)
+					}
+				}
+				v.CoinsPerBundle = coinsPerBundle
+			}
+			if startAt > 0 {
+				v.StartAt = startAt
+			}
+			if endAt > 0 {
+				v.EndAt = endAt
+			}
+			return
+		}
+	}
+}
+
+// RemoveFunder removes the funder together with all his funds
+// in every denom.
 func (m *Pool) RemoveFunder(funderAddress string) {
+	for _, v := range m.Funders {
+		if v.Address == funderAddress {
+			m.SubtractCoinsFromFunder(funderAddress, v.Coins)
+			break
+		}
+	}
+
 	m.SubtractAmountFromFunder(funderAddress, math.MaxUint64)
 }
 
+// GetFunder returns the funder with the given address.
+func (m *Pool) GetFunder(address string) (Funder, bool) {
+	for _, v := range m.Funders {
+		if v.Address == address {
+			return *v, true
+		}
+	}
+	return Funder{}, false
+}
+
 func (m *Pool) GetFunderAmount(address string) uint64 {
 	for _, v := range m.Funders {
 		if v.Address == address {
@@ -83,3 +230,189 @@ func (m *Pool) GetLowestFunder() Funder {
 	}
 	return *lowestFunder
 }
+
+// GetLowestNativeFunder returns the funder with the lowest $KYVE amount,
+// ignoring funders who funded whitelisted denoms, because their funds can
+// not be compared. The second return value is false if there is no such funder.
+func (m *Pool) GetLowestNativeFunder() (Funder, bool) {
+	var lowestFunder *Funder
+	for _, v := range m.Funders {
+		if !v.Coins.IsZero() {
+			continue
+		}
+		if lowestFunder == nil || v.Amount < lowestFunder.Amount {
+			lowestFunder = v
+		}
+	}
+
+	if lowestFunder == nil {
+		return Funder{}, false
+	}
+	return *lowestFunder, true
+}
+
+func (m *Pool) GetHighestFunder() Funder {
+	if len(m.Funders) == 0 {
+		return Funder{}
+	}
+
+	highestFunder := m.Funders[0]
+	for _, v := range m.Funders {
+		if v.Amount > highestFunder.Amount {
+			highestFunder = v
+		}
+	}
+	return *highestFunder
+}
+
+// GetActiveFunders returns all funders which are charged at the given time.
+func (m *Pool) GetActiveFunders(time uint64) (funders []Funder) {
+	for _, v := range m.Funders {
+		if v.IsActive(time) {
+			funders = append(funders, *v)
+		}
+	}
+	return
+}
+
+// GetRunway returns the estimated number of bundles the pool can still pay
+// for in ukyve. Funders whose funding period has already ended or who have
+// only funded the pool with other denoms are not taken into account.
+// If the pool has no operating cost the runway is zero.
+func (m *Pool) GetRunway(time uint64) uint64 {
+	funds := uint64(0)
+	amountPerBundle := uint64(0)
+
+	for _, v := range m.Funders {
+		if v.Amount == 0 || (v.EndAt != 0 && v.EndAt <= time) {
+			continue
+		}
+
+		funds += v.Amount
+
+		if v.AmountPerBundle == 0 || v.AmountPerBundle > m.OperatingCost {
+			amountPerBundle += m.OperatingCost
+		} else {
+			amountPerBundle += v.AmountPerBundle
+		}
+	}
+
+	if amountPerBundle > m.OperatingCost {
+		amountPerBundle = m.OperatingCost
+	}
+
+	if amountPerBundle == 0 {
+		return 0
+	}
+
+	return funds / amountPerBundle
+}
+
+// IsActive returns true if the funder is charged at the given time.
+func (f *Funder) IsActive(time uint64) bool {
+	if f.StartAt != 0 && time < f.StartAt {
+		return false
+	}
+
+	if f.EndAt != 0 && time >= f.EndAt {
+		return false
+	}
+
+	return true
+}
+
+// DefaultQuorum is the fraction of the total voting power which is used as valid
+// and invalid quorum if a pool does not define its own.
+var DefaultQuorum = sdk.MustNewDecFromStr("0.5")
+
+// GetEffectiveValidQuorum returns the valid quorum of the pool.
+// If the pool has no valid quorum set the DefaultQuorum is returned.
+func (m *Pool) GetEffectiveValidQuorum() sdk.Dec {
+	if m.ValidQuorum.IsNil() || m.ValidQuorum.IsZero() {
+		return DefaultQuorum
+	}
+	return m.ValidQuorum
+}
+
+// GetEffectiveInvalidQuorum returns the invalid quorum of the pool.
+// If the pool has no invalid quorum set the DefaultQuorum is returned.
+func (m *Pool) GetEffectiveInvalidQuorum() sdk.Dec {
+	if m.InvalidQuorum.IsNil() || m.InvalidQuorum.IsZero() {
+		return DefaultQuorum
+	}
+	return m.InvalidQuorum
+}
+
+// ValidateQuorums checks that the given quorums are within their ranges and that
+// they add up to at least 1, so that a bundle can never be valid and invalid at
+// the same time. Quorums which are zero are replaced with the DefaultQuorum.
+func ValidateQuorums(validQuorum sdk.Dec, invalidQuorum sdk.Dec) error {
+	if err := ValidateValidQuorum(validQuorum); err != nil {
+		return err
+	}
+
+	if err := ValidateQuorum(invalidQuorum); err != nil {
+		return err
+	}
+
+	pool := Pool{ValidQuorum: validQuorum, InvalidQuorum: invalidQuorum}
+	validQuorum, invalidQuorum = pool.GetEffectiveValidQuorum(), pool.GetEffectiveInvalidQuorum()
+
+	if validQuorum.Add(invalidQuorum).LT(sdk.OneDec()) {
+		return fmt.Errorf("valid quorum %s and invalid quorum %s have to add up to at least 1", validQuorum, invalidQuorum)
+	}
+
+	return nil
+}
+
+// ValidateQuorum checks that a single quorum is within [0, 1],
+// where zero stands for the DefaultQuorum.
+func ValidateQuorum(quorum sdk.Dec) error {
+	if quorum.IsNil() {
+		return nil
+	}
+
+	if quorum.IsNegative() || quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("quorum has to be between 0 and 1: %s", quorum)
+	}
+
+	return nil
+}
+
+// ValidateValidQuorum checks that the valid quorum is within [0, 1). Since more
+// than the valid quorum has to vote valid a valid quorum of 1 could never be reached.
+func ValidateValidQuorum(quorum sdk.Dec) error {
+	if err := ValidateQuorum(quorum); err != nil {
+		return err
+	}
+
+	if !quorum.IsNil() && quorum.GTE(sdk.OneDec()) {
+		return fmt.Errorf("valid quorum has to be less than 1: %s", quorum)
+	}
+
+	return nil
+}
+
+// GetEffectiveRevealWindow returns the time in seconds after the upload interval
+// in which committed votes can be revealed. Pools which do not use commit-reveal
+// voting have no reveal window.
+func (m *Pool) GetEffectiveRevealWindow() uint64 {
+	if m.VotingMode != VOTING_MODE_COMMIT_REVEAL {
+		return 0
+	}
+	return m.RevealWindow
+}
+
+// ValidateVotingMode checks that the given voting mode exists and that
+// pools with commit-reveal voting have a reveal window.
+func ValidateVotingMode(votingMode VotingMode, revealWindow uint64) error {
+	if _, ok := VotingMode_name[int32(votingMode)]; !ok {
+		return fmt.Errorf("invalid voting mode: %d", votingMode)
+	}
+
+	if votingMode == VOTING_MODE_COMMIT_REVEAL && revealWindow == 0 {
+		return fmt.Errorf("commit-reveal voting requires a reveal window")
+	}
+
+	return nil
+}
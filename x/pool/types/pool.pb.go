@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -67,6 +69,98 @@ func (PoolStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_40c1730f47ff2ef8, []int{0}
 }
 
+// FundingPolicy defines how the funders of a pool get charged
+// for the rewards of a bundle
+type FundingPolicy int32
+
+const (
+	// FUNDING_POLICY_EQUAL_SPLIT splits the amount equally
+	// between all funders
+	FUNDING_POLICY_EQUAL_SPLIT FundingPolicy = 0
+	// FUNDING_POLICY_PRO_RATA charges every funder relative
+	// to its remaining funds
+	FUNDING_POLICY_PRO_RATA FundingPolicy = 1
+)
+
+var FundingPolicy_name = map[int32]string{
+	0: "FUNDING_POLICY_EQUAL_SPLIT",
+	1: "FUNDING_POLICY_PRO_RATA",
+}
+
+var FundingPolicy_value = map[string]int32{
+	"FUNDING_POLICY_EQUAL_SPLIT": 0,
+	"FUNDING_POLICY_PRO_RATA":    1,
+}
+
+func (x FundingPolicy) String() string {
+	return proto.EnumName(FundingPolicy_name, int32(x))
+}
+
+func (FundingPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{1}
+}
+
+// UploaderSelection defines how the next uploader of a pool
+// gets selected
+type UploaderSelection int32
+
+const (
+	// UPLOADER_SELECTION_ROUND_ROBIN selects the next uploader
+	// with a weighted round-robin
+	UPLOADER_SELECTION_ROUND_ROBIN UploaderSelection = 0
+	// UPLOADER_SELECTION_STAKE_WEIGHTED_RANDOM selects the next
+	// uploader pseudo-randomly weighted by delegation
+	UPLOADER_SELECTION_STAKE_WEIGHTED_RANDOM UploaderSelection = 1
+)
+
+var UploaderSelection_name = map[int32]string{
+	0: "UPLOADER_SELECTION_ROUND_ROBIN",
+	1: "UPLOADER_SELECTION_STAKE_WEIGHTED_RANDOM",
+}
+
+var UploaderSelection_value = map[string]int32{
+	"UPLOADER_SELECTION_ROUND_ROBIN":           0,
+	"UPLOADER_SELECTION_STAKE_WEIGHTED_RANDOM": 1,
+}
+
+func (x UploaderSelection) String() string {
+	return proto.EnumName(UploaderSelection_name, int32(x))
+}
+
+func (UploaderSelection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{2}
+}
+
+// VotingMode defines how the stakers of a pool vote
+// on bundle proposals
+type VotingMode int32
+
+const (
+	// VOTING_MODE_PLAIN publishes every vote directly
+	VOTING_MODE_PLAIN VotingMode = 0
+	// VOTING_MODE_COMMIT_REVEAL requires the stakers to commit
+	// to a hashed vote first and to reveal it afterwards
+	VOTING_MODE_COMMIT_REVEAL VotingMode = 1
+)
+
+var VotingMode_name = map[int32]string{
+	0: "VOTING_MODE_PLAIN",
+	1: "VOTING_MODE_COMMIT_REVEAL",
+}
+
+var VotingMode_value = map[string]int32{
+	"VOTING_MODE_PLAIN":         0,
+	"VOTING_MODE_COMMIT_REVEAL": 1,
+}
+
+func (x VotingMode) String() string {
+	return proto.EnumName(VotingMode_name, int32(x))
+}
+
+func (VotingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{3}
+}
+
 // Protocol holds all info about the current pool version and the
 // available binaries for participating as a validator in a pool
 type Protocol struct {
@@ -216,6 +310,21 @@ type Funder struct {
 	// amount is the current amount of funds in ukyve the funder has
 	// still funded the pool with
 	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// amount_per_bundle is the maximum amount in ukyve the funder
+	// gets charged per bundle. If zero the funder has no limit
+	AmountPerBundle uint64 `protobuf:"varint,3,opt,name=amount_per_bundle,json=amountPerBundle,proto3" json:"amount_per_bundle,omitempty"`
+	// start_at is the unix time the funder starts getting charged.
+	// If zero the funder is charged immediately
+	StartAt uint64 `protobuf:"varint,4,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// end_at is the unix time the funder stops getting charged.
+	// If zero the funder is charged until his funds are used up
+	EndAt uint64 `protobuf:"varint,5,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// coins are the current funds of the funder in whitelisted
+	// non-native denoms
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// coins_per_bundle is the amount of every non-native denom
+	// the funder gets charged per bundle
+	CoinsPerBundle github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=coins_per_bundle,json=coinsPerBundle,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins_per_bundle"`
 }
 
 func (m *Funder) Reset()         { *m = Funder{} }
@@ -265,6 +374,41 @@ func (m *Funder) GetAmount() uint64 {
 	return 0
 }
 
+func (m *Funder) GetAmountPerBundle() uint64 {
+	if m != nil {
+		return m.AmountPerBundle
+	}
+	return 0
+}
+
+func (m *Funder) GetStartAt() uint64 {
+	if m != nil {
+		return m.StartAt
+	}
+	return 0
+}
+
+func (m *Funder) GetEndAt() uint64 {
+	if m != nil {
+		return m.EndAt
+	}
+	return 0
+}
+
+func (m *Funder) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *Funder) GetCoinsPerBundle() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CoinsPerBundle
+	}
+	return nil
+}
+
 // Pool ...
 type Pool struct {
 	// id - unique identifier of the pool, can not be changed
@@ -311,6 +455,46 @@ type Pool struct {
 	CurrentStorageProviderId uint32 `protobuf:"varint,20,opt,name=current_storage_provider_id,json=currentStorageProviderId,proto3" json:"current_storage_provider_id,omitempty"`
 	// compression_id ...
 	CurrentCompressionId uint32 `protobuf:"varint,21,opt,name=current_compression_id,json=currentCompressionId,proto3" json:"current_compression_id,omitempty"`
+	// funding_policy defines how the funders get charged
+	FundingPolicy FundingPolicy `protobuf:"varint,22,opt,name=funding_policy,json=fundingPolicy,proto3,enum=kyve.pool.v1beta1.FundingPolicy" json:"funding_policy,omitempty"`
+	// total_funds_per_denom are the total funds of all funders
+	// in whitelisted non-native denoms
+	TotalFundsPerDenom github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,23,rep,name=total_funds_per_denom,json=totalFundsPerDenom,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_funds_per_denom"`
+	// max_stakers is the maximum number of stakers which can
+	// participate in the pool. If zero the default of the
+	// stakers module is used
+	MaxStakers uint64 `protobuf:"varint,24,opt,name=max_stakers,json=maxStakers,proto3" json:"max_stakers,omitempty"`
+	// uploader_selection defines how the next uploader gets selected
+	UploaderSelection UploaderSelection `protobuf:"varint,25,opt,name=uploader_selection,json=uploaderSelection,proto3,enum=kyve.pool.v1beta1.UploaderSelection" json:"uploader_selection,omitempty"`
+	// valid_quorum is the fraction of the total voting power which
+	// has to vote valid for a bundle to be finalized. If zero
+	// the default of 50% is used
+	ValidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=valid_quorum,json=validQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_quorum"`
+	// invalid_quorum is the fraction of the total voting power which
+	// has to vote invalid for a bundle to be dropped. If zero
+	// the default of 50% is used
+	InvalidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,27,opt,name=invalid_quorum,json=invalidQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"invalid_quorum"`
+	// voting_mode defines how the stakers vote on bundle proposals
+	VotingMode VotingMode `protobuf:"varint,28,opt,name=voting_mode,json=votingMode,proto3,enum=kyve.pool.v1beta1.VotingMode" json:"voting_mode,omitempty"`
+	// reveal_window is the time in seconds after the upload interval
+	// in which committed votes can be revealed. It is only used
+	// with the commit-reveal voting mode
+	RevealWindow uint64 `protobuf:"varint,29,opt,name=reveal_window,json=revealWindow,proto3" json:"reveal_window,omitempty"`
+	// vote_slash overrides the global vote slash of the
+	// delegation module for this pool. If not set the global
+	// param is used
+	VoteSlash *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,30,opt,name=vote_slash,json=voteSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_slash,omitempty"`
+	// upload_slash overrides the global upload slash of the
+	// delegation module for this pool. If not set the global
+	// param is used
+	UploadSlash *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,31,opt,name=upload_slash,json=uploadSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upload_slash,omitempty"`
+	// timeout_slash overrides the global timeout slash of the
+	// delegation module for this pool. If not set the global
+	// param is used
+	TimeoutSlash *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,32,opt,name=timeout_slash,json=timeoutSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"timeout_slash,omitempty"`
+	// gas_sponsorship is the budget in ukyve which pays the
+	// transaction fees of the bundle messages of the pool stakers
+	GasSponsorship uint64 `protobuf:"varint,33,opt,name=gas_sponsorship,json=gasSponsorship,proto3" json:"gas_sponsorship,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -493,8 +677,60 @@ func (m *Pool) GetCurrentCompressionId() uint32 {
 	return 0
 }
 
+func (m *Pool) GetFundingPolicy() FundingPolicy {
+	if m != nil {
+		return m.FundingPolicy
+	}
+	return FUNDING_POLICY_EQUAL_SPLIT
+}
+
+func (m *Pool) GetTotalFundsPerDenom() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFundsPerDenom
+	}
+	return nil
+}
+
+func (m *Pool) GetMaxStakers() uint64 {
+	if m != nil {
+		return m.MaxStakers
+	}
+	return 0
+}
+
+func (m *Pool) GetUploaderSelection() UploaderSelection {
+	if m != nil {
+		return m.UploaderSelection
+	}
+	return UPLOADER_SELECTION_ROUND_ROBIN
+}
+
+func (m *Pool) GetVotingMode() VotingMode {
+	if m != nil {
+		return m.VotingMode
+	}
+	return VOTING_MODE_PLAIN
+}
+
+func (m *Pool) GetRevealWindow() uint64 {
+	if m != nil {
+		return m.RevealWindow
+	}
+	return 0
+}
+
+func (m *Pool) GetGasSponsorship() uint64 {
+	if m != nil {
+		return m.GasSponsorship
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("kyve.pool.v1beta1.PoolStatus", PoolStatus_name, PoolStatus_value)
+	proto.RegisterEnum("kyve.pool.v1beta1.FundingPolicy", FundingPolicy_name, FundingPolicy_value)
+	proto.RegisterEnum("kyve.pool.v1beta1.UploaderSelection", UploaderSelection_name, UploaderSelection_value)
+	proto.RegisterEnum("kyve.pool.v1beta1.VotingMode", VotingMode_name, VotingMode_value)
 	proto.RegisterType((*Protocol)(nil), "kyve.pool.v1beta1.Protocol")
 	proto.RegisterType((*UpgradePlan)(nil), "kyve.pool.v1beta1.UpgradePlan")
 	proto.RegisterType((*Funder)(nil), "kyve.pool.v1beta1.Funder")
@@ -504,59 +740,93 @@ func init() {
 func init() { proto.RegisterFile("kyve/pool/v1beta1/pool.proto", fileDescriptor_40c1730f47ff2ef8) }
 
 var fileDescriptor_40c1730f47ff2ef8 = []byte{
-	// 818 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xdb, 0x36,
-	0x14, 0xc7, 0xad, 0xc4, 0x75, 0x6c, 0x3a, 0x71, 0x5c, 0x2e, 0xcd, 0xd8, 0x64, 0xf0, 0xdc, 0x0c,
-	0xdd, 0xbc, 0x1d, 0x6c, 0xb4, 0x1d, 0x30, 0x60, 0xc0, 0x0e, 0x8e, 0xed, 0x64, 0x42, 0x03, 0xdb,
-	0x90, 0xed, 0x02, 0xdb, 0x45, 0xa0, 0x45, 0x56, 0x21, 0x22, 0x91, 0x02, 0x49, 0x79, 0x71, 0x8f,
-	0x3b, 0xed, 0xb8, 0xef, 0xd0, 0xcf, 0xb1, 0xfb, 0x8e, 0x3d, 0xee, 0x38, 0x24, 0x5f, 0x64, 0x20,
-	0x25, 0x79, 0xee, 0xd6, 0x53, 0x6f, 0x7c, 0xbf, 0xff, 0xff, 0xe9, 0x3d, 0xf3, 0xf1, 0x19, 0x7c,
-	0x76, 0xb3, 0x5e, 0xd1, 0x5e, 0x22, 0x44, 0xd4, 0x5b, 0x3d, 0x5b, 0x52, 0x8d, 0x9f, 0xd9, 0xa0,
-	0x9b, 0x48, 0xa1, 0x05, 0x7c, 0x68, 0xd4, 0xae, 0x05, 0xb9, 0x7a, 0x72, 0x14, 0x8a, 0x50, 0x58,
-	0xb5, 0x67, 0x4e, 0x99, 0xf1, 0x2c, 0x00, 0xd5, 0xa9, 0x39, 0x04, 0x22, 0x82, 0x08, 0xec, 0xad,
-	0xa8, 0x54, 0x4c, 0x70, 0xe4, 0xb4, 0x9d, 0x4e, 0xcd, 0x2b, 0x42, 0x78, 0x02, 0xaa, 0x4b, 0xc6,
-	0xb1, 0x64, 0x54, 0xa1, 0x1d, 0x2b, 0x6d, 0x62, 0xf8, 0x04, 0xec, 0x47, 0x58, 0x69, 0x3f, 0x4d,
-	0x42, 0x89, 0x09, 0x45, 0xbb, 0x6d, 0xa7, 0x53, 0xf6, 0xea, 0x86, 0x2d, 0x32, 0x74, 0xf6, 0xab,
-	0x03, 0xea, 0xf9, 0x79, 0x1a, 0x61, 0xfe, 0xf1, 0x85, 0x54, 0x70, 0x4d, 0x49, 0x1a, 0x51, 0xe2,
-	0x63, 0x5d, 0x14, 0xda, 0xb0, 0xbe, 0x36, 0xe9, 0x24, 0x95, 0x58, 0x9b, 0x2f, 0x97, 0xad, 0xbc,
-	0x89, 0xcf, 0xbe, 0x07, 0x95, 0x8b, 0x94, 0x13, 0x2a, 0x4d, 0x79, 0x4c, 0x88, 0xa4, 0x4a, 0x15,
-	0xe5, 0xf3, 0x10, 0x1e, 0x83, 0x0a, 0x8e, 0x45, 0xca, 0xb5, 0x2d, 0x5e, 0xf6, 0xf2, 0xe8, 0xec,
-	0x6d, 0x05, 0x94, 0xa7, 0x42, 0x44, 0xb0, 0x01, 0x76, 0x18, 0xb1, 0x59, 0x65, 0x6f, 0x87, 0x11,
-	0x08, 0x41, 0x99, 0xe3, 0x98, 0xe6, 0xbd, 0xda, 0xb3, 0xf9, 0xbc, 0x4c, 0xb9, 0x66, 0x71, 0x76,
-	0x17, 0x35, 0xaf, 0x08, 0x8d, 0x3b, 0x12, 0xa1, 0xb0, 0xad, 0xd5, 0x3c, 0x7b, 0x36, 0x25, 0x03,
-	0xc1, 0x5f, 0xb3, 0x10, 0x3d, 0xb0, 0x34, 0x8f, 0xe0, 0x29, 0xa8, 0x29, 0x8d, 0xa5, 0xf6, 0x6f,
-	0xe8, 0x1a, 0x55, 0xb2, 0xab, 0xb0, 0xe0, 0x25, 0x5d, 0xc3, 0xcf, 0x41, 0x3d, 0x48, 0xa5, 0xa4,
-	0x3c, 0x93, 0xf7, 0xac, 0x0c, 0x72, 0x64, 0x0c, 0x5f, 0x81, 0xc3, 0xc2, 0xa0, 0xd2, 0x38, 0xc6,
-	0x72, 0x8d, 0xaa, 0xd6, 0xd4, 0xc8, 0xf1, 0x2c, 0xa3, 0xf0, 0x0b, 0x70, 0x50, 0x18, 0x19, 0x27,
-	0xf4, 0x16, 0xd5, 0xec, 0x6f, 0xdb, 0xcf, 0xa1, 0x6b, 0x98, 0x31, 0x69, 0xa1, 0x71, 0xe4, 0x2f,
-	0x53, 0x4e, 0x22, 0xaa, 0x10, 0xc8, 0x4c, 0x16, 0x9e, 0x67, 0xcc, 0x94, 0x4c, 0x93, 0x48, 0x60,
-	0xe2, 0x33, 0xae, 0xa9, 0x5c, 0xe1, 0x08, 0xd5, 0xad, 0xad, 0x91, 0x61, 0x37, 0xa7, 0xf0, 0x29,
-	0x68, 0x88, 0x84, 0x9a, 0xa9, 0xf0, 0xd0, 0x0f, 0x84, 0xd2, 0x68, 0xdf, 0xfa, 0x0e, 0x36, 0x74,
-	0x20, 0x94, 0x36, 0xb6, 0x98, 0x71, 0x9f, 0xd0, 0x88, 0x86, 0xd9, 0x44, 0x0f, 0x32, 0x5b, 0xcc,
-	0xf8, 0x70, 0x03, 0xe1, 0x97, 0xe0, 0x30, 0xc6, 0xb7, 0x79, 0x67, 0xbe, 0x62, 0x6f, 0x28, 0x6a,
-	0xe4, 0x3e, 0x7c, 0x9b, 0xf5, 0x36, 0x63, 0x6f, 0xa8, 0x7d, 0x1a, 0x4c, 0xe1, 0x65, 0x44, 0x09,
-	0x3a, 0x6c, 0x3b, 0x9d, 0xaa, 0xb7, 0x89, 0xe1, 0x0b, 0xb0, 0xf7, 0xda, 0x3e, 0x0d, 0x85, 0x9a,
-	0xed, 0xdd, 0x4e, 0xfd, 0xf9, 0xe3, 0xee, 0xff, 0xf6, 0xa7, 0x9b, 0x3d, 0x1e, 0xaf, 0x70, 0x9a,
-	0x19, 0x64, 0x97, 0x62, 0x80, 0x42, 0x0f, 0x6d, 0x51, 0x60, 0x91, 0xb1, 0x2a, 0xf8, 0x1d, 0xa8,
-	0x26, 0xf9, 0x6a, 0x21, 0xd8, 0x76, 0x3a, 0xf5, 0xe7, 0xa7, 0x1f, 0xf8, 0x6c, 0xb1, 0x7d, 0xde,
-	0xc6, 0x0c, 0xfb, 0x60, 0x3f, 0x5f, 0x26, 0x3f, 0x89, 0x30, 0x47, 0x9f, 0xd8, 0xe4, 0xd6, 0x07,
-	0x92, 0xb7, 0x96, 0xca, 0xab, 0xa7, 0x5b, 0x1b, 0xf6, 0x03, 0x38, 0xdd, 0xcc, 0x5f, 0x0b, 0x89,
-	0x43, 0xea, 0x27, 0x52, 0xac, 0x18, 0xa1, 0xd2, 0x67, 0x04, 0x1d, 0xb5, 0x9d, 0xce, 0x81, 0x87,
-	0x8a, 0xb7, 0x90, 0x39, 0xa6, 0xb9, 0xc1, 0x25, 0xf0, 0x5b, 0x70, 0x5c, 0xa4, 0x07, 0x22, 0x4e,
-	0xcc, 0x6e, 0x30, 0xc1, 0x4d, 0xe6, 0x23, 0x9b, 0x79, 0x94, 0xab, 0x83, 0x7f, 0x45, 0x97, 0x7c,
-	0xf3, 0x87, 0x03, 0x80, 0xd9, 0x92, 0x99, 0xc6, 0x3a, 0x55, 0xf0, 0x14, 0x7c, 0x3a, 0x9d, 0x4c,
-	0xae, 0xfc, 0xd9, 0xbc, 0x3f, 0x5f, 0xcc, 0xfc, 0xc5, 0x78, 0x36, 0x1d, 0x0d, 0xdc, 0x0b, 0x77,
-	0x34, 0x6c, 0x96, 0xe0, 0x31, 0x80, 0xdb, 0x62, 0x7f, 0x30, 0x77, 0x5f, 0x8d, 0x9a, 0x0e, 0x44,
-	0xe0, 0x68, 0x9b, 0x0f, 0xdd, 0x59, 0xff, 0xfc, 0x6a, 0x34, 0x6c, 0xee, 0xfc, 0x57, 0x19, 0x4f,
-	0xfc, 0x8b, 0xc5, 0x78, 0x38, 0x6b, 0xee, 0xc2, 0xa7, 0xe0, 0xc9, 0xfb, 0xca, 0xdc, 0x1f, 0x8d,
-	0x27, 0x8b, 0xcb, 0x1f, 0xfd, 0xe1, 0xe8, 0x6a, 0x74, 0xd9, 0x9f, 0xbb, 0x93, 0x71, 0xb3, 0x0c,
-	0x1f, 0x83, 0x47, 0xef, 0xf5, 0x33, 0xbd, 0xf4, 0xfa, 0x43, 0x77, 0x7c, 0xd9, 0x7c, 0x70, 0x52,
-	0xfe, 0xed, 0x6d, 0xab, 0x74, 0x3e, 0xf8, 0xf3, 0xae, 0xe5, 0xbc, 0xbb, 0x6b, 0x39, 0x7f, 0xdf,
-	0xb5, 0x9c, 0xdf, 0xef, 0x5b, 0xa5, 0x77, 0xf7, 0xad, 0xd2, 0x5f, 0xf7, 0xad, 0xd2, 0xcf, 0x5f,
-	0x87, 0x4c, 0x5f, 0xa7, 0xcb, 0x6e, 0x20, 0xe2, 0xde, 0xcb, 0x9f, 0x5e, 0x8d, 0xc6, 0x54, 0xff,
-	0x22, 0xe4, 0x4d, 0x2f, 0xb8, 0xc6, 0x8c, 0xf7, 0x6e, 0xb3, 0xbf, 0x61, 0xbd, 0x4e, 0xa8, 0x5a,
-	0x56, 0xec, 0x18, 0x5f, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0xec, 0x2d, 0x4a, 0x94, 0xa0, 0x05,
-	0x00, 0x00,
+	// 1374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0xb7, 0x52, 0xe7, 0x1f, 0x1d, 0x3b, 0x0e, 0x97, 0xa4, 0x4a, 0xd2, 0x3a, 0x6e, 0xb6, 0xb6,
+	0x5e, 0xb1, 0xd9, 0x6b, 0x3b, 0x60, 0xa7, 0x0d, 0x50, 0x6c, 0x35, 0x15, 0xea, 0xd8, 0xaa, 0x64,
+	0xa7, 0xe8, 0x2e, 0x04, 0x63, 0xb1, 0x8e, 0x10, 0x89, 0xf4, 0x44, 0xc9, 0x4d, 0x7a, 0xd8, 0x61,
+	0xa7, 0x1d, 0xb7, 0xcf, 0xb0, 0xdb, 0xbe, 0xc3, 0xee, 0x3d, 0xf6, 0x38, 0xec, 0xd0, 0x0d, 0xed,
+	0x37, 0xd8, 0x27, 0x18, 0x48, 0x4a, 0x8e, 0xd3, 0x66, 0xc0, 0x16, 0xf4, 0x64, 0xbe, 0xdf, 0xfb,
+	0xf1, 0xf7, 0x1e, 0xdf, 0x23, 0x9f, 0x0c, 0xae, 0x1d, 0x9f, 0x8e, 0x49, 0x63, 0xc4, 0x58, 0xd0,
+	0x18, 0xdf, 0x3d, 0x24, 0x31, 0xbe, 0x2b, 0x8d, 0xfa, 0x28, 0x62, 0x31, 0x83, 0x2b, 0xc2, 0x5b,
+	0x97, 0x40, 0xea, 0xdd, 0xac, 0x0c, 0x18, 0x0f, 0x19, 0x6f, 0x1c, 0x62, 0x4e, 0x26, 0x5b, 0x06,
+	0xcc, 0xa7, 0x6a, 0xcb, 0xe6, 0xea, 0x90, 0x0d, 0x99, 0x5c, 0x36, 0xc4, 0x4a, 0xa1, 0x3b, 0x03,
+	0xb0, 0x60, 0x8b, 0xc5, 0x80, 0x05, 0x50, 0x07, 0xf3, 0x63, 0x12, 0x71, 0x9f, 0x51, 0x5d, 0xab,
+	0x6a, 0xb5, 0x45, 0x27, 0x33, 0xe1, 0x26, 0x58, 0x38, 0xf4, 0x29, 0x8e, 0x7c, 0xc2, 0xf5, 0x19,
+	0xe9, 0x9a, 0xd8, 0xf0, 0x06, 0x58, 0x0a, 0x30, 0x8f, 0x51, 0x32, 0x1a, 0x46, 0xd8, 0x23, 0xfa,
+	0x95, 0xaa, 0x56, 0xcb, 0x3b, 0x05, 0x81, 0xf5, 0x15, 0xb4, 0xf3, 0x83, 0x06, 0x0a, 0xe9, 0xda,
+	0x0e, 0x30, 0xbd, 0x7c, 0x20, 0x3e, 0x38, 0x22, 0x5e, 0x12, 0x10, 0x0f, 0xe1, 0x38, 0x0b, 0x34,
+	0xc1, 0x8c, 0x58, 0x6c, 0xf7, 0x92, 0x08, 0xc7, 0x42, 0x39, 0x2f, 0xdd, 0x13, 0x7b, 0xe7, 0xef,
+	0x19, 0x30, 0xf7, 0x20, 0xa1, 0x1e, 0x89, 0x44, 0x7c, 0xec, 0x79, 0x11, 0xe1, 0x3c, 0x8b, 0x9f,
+	0x9a, 0x70, 0x1d, 0xcc, 0xe1, 0x90, 0x25, 0x34, 0x96, 0xd1, 0xf3, 0x4e, 0x6a, 0xc1, 0x3b, 0x60,
+	0x45, 0xad, 0xd0, 0x88, 0x44, 0xe8, 0x30, 0xa1, 0x5e, 0x90, 0x9d, 0x74, 0x59, 0x39, 0x6c, 0x12,
+	0xed, 0x4a, 0x18, 0x6e, 0x80, 0x05, 0x1e, 0xe3, 0x28, 0x16, 0x39, 0xaa, 0x24, 0xe6, 0xa5, 0x6d,
+	0xc4, 0x70, 0x0d, 0xcc, 0x11, 0x2a, 0x93, 0x9f, 0x95, 0x8e, 0x59, 0x42, 0x45, 0xda, 0x18, 0xcc,
+	0x8a, 0x46, 0x71, 0x7d, 0xae, 0x7a, 0xa5, 0x56, 0xb8, 0xb7, 0x51, 0x57, 0xad, 0xac, 0x8b, 0x56,
+	0x66, 0xfd, 0xad, 0x37, 0x99, 0x4f, 0x77, 0xbf, 0x78, 0xf9, 0x7a, 0x3b, 0xf7, 0xeb, 0x9f, 0xdb,
+	0xb5, 0xa1, 0x1f, 0x1f, 0x25, 0x87, 0xf5, 0x01, 0x0b, 0x1b, 0x69, 0xdf, 0xd5, 0xcf, 0xe7, 0xdc,
+	0x3b, 0x6e, 0xc4, 0xa7, 0x23, 0xc2, 0xe5, 0x06, 0xee, 0x28, 0x65, 0x98, 0x80, 0xb2, 0x5c, 0x4c,
+	0xe7, 0x3f, 0xff, 0xe1, 0xa3, 0x95, 0x64, 0x90, 0x49, 0x2d, 0x76, 0x7e, 0x2e, 0x82, 0xbc, 0xcd,
+	0x58, 0x00, 0x4b, 0x60, 0xc6, 0xf7, 0x64, 0xb5, 0xf3, 0xce, 0x8c, 0xef, 0x41, 0x08, 0xf2, 0x14,
+	0x87, 0x24, 0x6d, 0xb2, 0x5c, 0x8b, 0xb6, 0x44, 0x09, 0x8d, 0xfd, 0x50, 0x95, 0x76, 0xd1, 0xc9,
+	0x4c, 0xc1, 0x0e, 0xd8, 0x90, 0xc9, 0x72, 0x2e, 0x3a, 0x72, 0x2d, 0x5a, 0x35, 0x60, 0xf4, 0x99,
+	0x3f, 0x94, 0xb5, 0x5c, 0x74, 0x52, 0x0b, 0x6e, 0x81, 0x45, 0x55, 0xfe, 0x63, 0x72, 0xaa, 0xcf,
+	0xa9, 0x3b, 0x24, 0x81, 0x47, 0xe4, 0x14, 0x6e, 0x83, 0xc2, 0x20, 0x89, 0x22, 0x42, 0x95, 0x7b,
+	0x5e, 0xba, 0x41, 0x0a, 0x09, 0xc2, 0x6d, 0xb0, 0x9c, 0x11, 0x78, 0x12, 0x86, 0x38, 0x3a, 0xd5,
+	0x17, 0x24, 0xa9, 0x94, 0xc2, 0xae, 0x42, 0xe1, 0xc7, 0xa0, 0x98, 0x11, 0x7d, 0xea, 0x91, 0x13,
+	0x7d, 0x51, 0x9e, 0x6d, 0x29, 0x05, 0x2d, 0x81, 0x09, 0x52, 0xcc, 0x62, 0x1c, 0xa4, 0x15, 0xe7,
+	0x3a, 0x50, 0x24, 0x09, 0xaa, 0x12, 0x71, 0x11, 0x32, 0x19, 0x05, 0x0c, 0x7b, 0xc8, 0xa7, 0x31,
+	0x89, 0xc6, 0x38, 0xd0, 0x0b, 0x92, 0x56, 0x52, 0xb0, 0x95, 0xa2, 0xf0, 0x26, 0x28, 0xb1, 0x11,
+	0x11, 0xd7, 0x99, 0x0e, 0xd1, 0x80, 0xf1, 0x58, 0x5f, 0x92, 0xbc, 0xe2, 0x04, 0x6d, 0x32, 0x1e,
+	0x0b, 0x5a, 0xe8, 0x53, 0xe4, 0x91, 0x80, 0x0c, 0xd5, 0x53, 0x28, 0x2a, 0x5a, 0xe8, 0xd3, 0xd6,
+	0x04, 0x84, 0xb7, 0xc0, 0x72, 0x88, 0x4f, 0xd2, 0xcc, 0x10, 0xf7, 0x5f, 0x10, 0xbd, 0x94, 0xf2,
+	0xf0, 0x89, 0xca, 0xcd, 0xf5, 0x5f, 0x10, 0xf9, 0xa6, 0x7c, 0x8e, 0x0f, 0x03, 0xe2, 0xe9, 0xcb,
+	0x55, 0xad, 0xb6, 0xe0, 0x4c, 0x6c, 0x78, 0x1f, 0xcc, 0x3f, 0x93, 0x4f, 0x8a, 0xeb, 0xe5, 0xf4,
+	0x32, 0xbd, 0x37, 0x98, 0xea, 0xea, 0xd1, 0x39, 0x19, 0x53, 0xf4, 0x40, 0x15, 0x45, 0x00, 0x5c,
+	0x5f, 0x91, 0x41, 0x81, 0x84, 0x04, 0x95, 0xc3, 0xaf, 0xc0, 0xc2, 0x28, 0x9d, 0x49, 0x3a, 0xac,
+	0x6a, 0xb5, 0xc2, 0xbd, 0xad, 0x0b, 0x64, 0xb3, 0xb1, 0xe5, 0x4c, 0xc8, 0xd0, 0x00, 0x4b, 0xe9,
+	0x14, 0x42, 0xa3, 0x00, 0x53, 0xfd, 0x23, 0xb9, 0xb9, 0x72, 0xc1, 0xe6, 0xa9, 0x69, 0xe4, 0x14,
+	0x92, 0x33, 0x03, 0x7e, 0x0d, 0xb6, 0x26, 0xfd, 0x8f, 0x59, 0x84, 0x87, 0x04, 0x8d, 0x22, 0x36,
+	0xf6, 0x3d, 0x12, 0x21, 0xdf, 0xd3, 0x57, 0xab, 0x5a, 0xad, 0xe8, 0xe8, 0xd9, 0x5d, 0x50, 0x0c,
+	0x3b, 0x25, 0x58, 0x1e, 0xfc, 0x12, 0xac, 0x67, 0xdb, 0x07, 0x2c, 0x1c, 0x89, 0x99, 0xe2, 0x33,
+	0x2a, 0x76, 0xae, 0xc9, 0x9d, 0xab, 0xa9, 0xb7, 0x79, 0xe6, 0xb4, 0x3c, 0xb8, 0x07, 0x4a, 0xa2,
+	0x16, 0xa2, 0xad, 0x23, 0x16, 0xf8, 0x83, 0x53, 0x7d, 0xbd, 0xaa, 0xd5, 0x4a, 0xf7, 0xaa, 0xff,
+	0x52, 0x4d, 0x9f, 0x0e, 0x6d, 0xc9, 0x73, 0x8a, 0xcf, 0xa6, 0x4d, 0xf8, 0x3d, 0x58, 0x9b, 0x2a,
+	0xad, 0x7c, 0xeb, 0x1e, 0xa1, 0x2c, 0xd4, 0xaf, 0x7e, 0xf8, 0xa7, 0x0e, 0xcf, 0x3a, 0x66, 0x93,
+	0xa8, 0x25, 0xc2, 0x88, 0xd6, 0x8a, 0x3b, 0xc5, 0x63, 0x7c, 0x2c, 0xee, 0x84, 0xae, 0x5a, 0x1b,
+	0xe2, 0x13, 0x57, 0x21, 0xd0, 0x05, 0x50, 0x5d, 0x6a, 0x12, 0x21, 0x4e, 0x02, 0x32, 0x90, 0xf7,
+	0x73, 0x43, 0x9e, 0xf6, 0x93, 0x0b, 0xfb, 0xa4, 0xc8, 0x6e, 0xc6, 0x75, 0x56, 0x92, 0x77, 0x21,
+	0xf8, 0x18, 0x2c, 0x8d, 0x71, 0xe0, 0x7b, 0xe8, 0xbb, 0x84, 0x45, 0x49, 0xa8, 0x6f, 0x8a, 0x07,
+	0xbb, 0x5b, 0x17, 0x27, 0xfa, 0xe3, 0xf5, 0xf6, 0xad, 0xff, 0x70, 0xa2, 0x16, 0x19, 0x38, 0x05,
+	0xa9, 0xf1, 0x58, 0x4a, 0xc0, 0x3e, 0x28, 0xf9, 0xf4, 0x9c, 0xe8, 0xd6, 0xa5, 0x44, 0x8b, 0x3e,
+	0x9d, 0x96, 0xfd, 0x06, 0x14, 0xc6, 0x4c, 0x3e, 0xdf, 0x90, 0x79, 0x44, 0xbf, 0x26, 0xcf, 0x7d,
+	0xfd, 0x82, 0x73, 0x1f, 0x48, 0xd6, 0x3e, 0xf3, 0x88, 0x03, 0xc6, 0x93, 0xb5, 0x98, 0x27, 0x11,
+	0x19, 0x13, 0x1c, 0xa0, 0xe7, 0x3e, 0xf5, 0xd8, 0x73, 0xfd, 0xba, 0x9a, 0x27, 0x0a, 0x7c, 0x22,
+	0x31, 0xb8, 0x0f, 0xc4, 0x16, 0x82, 0x78, 0x80, 0xf9, 0x91, 0x5e, 0x99, 0xe4, 0xad, 0xfd, 0x8f,
+	0xbc, 0x17, 0x85, 0x82, 0x2b, 0x04, 0x44, 0x75, 0xd3, 0xf1, 0xa4, 0x04, 0xb7, 0x2f, 0x25, 0x58,
+	0x50, 0x1a, 0x4a, 0xd2, 0x05, 0x45, 0x31, 0xd6, 0x59, 0x12, 0xa7, 0x9a, 0xd5, 0x4b, 0x69, 0x2e,
+	0xa5, 0x22, 0x4a, 0xf4, 0x36, 0x58, 0x1e, 0x62, 0x8e, 0xf8, 0x88, 0x51, 0xce, 0x22, 0x7e, 0xe4,
+	0x8f, 0xf4, 0x1b, 0x6a, 0x8c, 0x0e, 0x31, 0x77, 0xcf, 0xd0, 0x3b, 0xbf, 0x69, 0x00, 0x88, 0x6f,
+	0x92, 0x1b, 0xe3, 0x38, 0xe1, 0x70, 0x0b, 0x5c, 0xb5, 0xbb, 0xdd, 0x36, 0x72, 0x7b, 0x46, 0xaf,
+	0xef, 0xa2, 0x7e, 0xc7, 0xb5, 0xcd, 0xa6, 0xf5, 0xc0, 0x32, 0x5b, 0xe5, 0x1c, 0x5c, 0x07, 0x70,
+	0xda, 0x69, 0x34, 0x7b, 0xd6, 0x81, 0x59, 0xd6, 0xa0, 0x0e, 0x56, 0xa7, 0xf1, 0x96, 0xe5, 0x1a,
+	0xbb, 0x6d, 0xb3, 0x55, 0x9e, 0x79, 0xd7, 0xd3, 0xe9, 0xa2, 0x07, 0xfd, 0x4e, 0xcb, 0x2d, 0x5f,
+	0x81, 0x37, 0xc1, 0x8d, 0xf3, 0x9e, 0x1e, 0x32, 0x3b, 0xdd, 0xfe, 0xde, 0x43, 0xd4, 0x32, 0xdb,
+	0xe6, 0x9e, 0xd1, 0xb3, 0xba, 0x9d, 0x72, 0x1e, 0x6e, 0x80, 0xb5, 0x73, 0xf9, 0xd8, 0x7b, 0x8e,
+	0xd1, 0xb2, 0x3a, 0x7b, 0xe5, 0xd9, 0xcd, 0xfc, 0x8f, 0xbf, 0x54, 0x72, 0x77, 0x1c, 0x50, 0x3c,
+	0x37, 0x04, 0x60, 0x05, 0x6c, 0x8a, 0x18, 0x56, 0x67, 0x0f, 0xd9, 0xdd, 0xb6, 0xd5, 0x7c, 0x8a,
+	0xcc, 0xc7, 0x7d, 0xa3, 0x8d, 0x5c, 0xbb, 0x6d, 0xf5, 0xca, 0x39, 0x71, 0xc2, 0x77, 0xfc, 0xb6,
+	0xd3, 0x45, 0x8e, 0xd1, 0x33, 0xca, 0x5a, 0xaa, 0x79, 0x0c, 0x56, 0xde, 0x7b, 0x6a, 0x70, 0x07,
+	0x54, 0xfa, 0x76, 0xbb, 0x6b, 0xb4, 0x4c, 0x07, 0xb9, 0x66, 0xdb, 0x6c, 0x8a, 0x0c, 0x91, 0xd3,
+	0xed, 0x77, 0x5a, 0xc8, 0xe9, 0xee, 0x5a, 0x9d, 0x72, 0x0e, 0x7e, 0x06, 0x6a, 0x17, 0x70, 0xdc,
+	0x9e, 0xf1, 0xc8, 0x44, 0x4f, 0x4c, 0x6b, 0xef, 0x61, 0xcf, 0x6c, 0x21, 0xc7, 0xe8, 0xb4, 0xba,
+	0xfb, 0x93, 0x60, 0x0f, 0x01, 0x38, 0xbb, 0xdf, 0x70, 0x0d, 0xac, 0x1c, 0x74, 0x7b, 0x22, 0xb9,
+	0xfd, 0x6e, 0xcb, 0x44, 0x76, 0xdb, 0x90, 0xc2, 0xd7, 0xc1, 0xc6, 0x34, 0xdc, 0xec, 0xee, 0xef,
+	0x5b, 0x3d, 0xe4, 0x98, 0x07, 0xa6, 0xd1, 0xce, 0x94, 0x76, 0x9b, 0x2f, 0xdf, 0x54, 0xb4, 0x57,
+	0x6f, 0x2a, 0xda, 0x5f, 0x6f, 0x2a, 0xda, 0x4f, 0x6f, 0x2b, 0xb9, 0x57, 0x6f, 0x2b, 0xb9, 0xdf,
+	0xdf, 0x56, 0x72, 0xdf, 0x7e, 0x3a, 0x75, 0x87, 0x1e, 0x3d, 0x3d, 0x30, 0x3b, 0x24, 0x7e, 0xce,
+	0xa2, 0xe3, 0xc6, 0xe0, 0x08, 0xfb, 0xb4, 0x71, 0xa2, 0xfe, 0x58, 0xcb, 0xab, 0x74, 0x38, 0x27,
+	0xbf, 0x1f, 0xf7, 0xff, 0x19, 0x00, 0x65, 0x46, 0x45, 0xcd, 0x72, 0x0b, 0x00, 0x00,
 }
 
 func (m *Protocol) Marshal() (dAtA []byte, err error) {
@@ -668,6 +938,49 @@ func (m *Funder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CoinsPerBundle) > 0 {
+		for iNdEx := len(m.CoinsPerBundle) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsPerBundle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.EndAt != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartAt != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.StartAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AmountPerBundle != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.AmountPerBundle))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Amount != 0 {
 		i = encodeVarintPool(dAtA, i, uint64(m.Amount))
 		i--
@@ -703,6 +1016,130 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasSponsorship != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.GasSponsorship))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.TimeoutSlash != nil {
+		{
+			size := m.TimeoutSlash.Size()
+			i -= size
+			if _, err := m.TimeoutSlash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.UploadSlash != nil {
+		{
+			size := m.UploadSlash.Size()
+			i -= size
+			if _, err := m.UploadSlash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.VoteSlash != nil {
+		{
+			size := m.VoteSlash.Size()
+			i -= size
+			if _, err := m.VoteSlash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.RevealWindow != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.RevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.VotingMode != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.VotingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	{
+		size := m.InvalidQuorum.Size()
+		i -= size
+		if _, err := m.InvalidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	{
+		size := m.ValidQuorum.Size()
+		i -= size
+		if _, err := m.ValidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if m.UploaderSelection != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.UploaderSelection))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.MaxStakers != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MaxStakers))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.TotalFundsPerDenom) > 0 {
+		for iNdEx := len(m.TotalFundsPerDenom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFundsPerDenom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.FundingPolicy != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.FundingPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	if m.CurrentCompressionId != 0 {
 		i = encodeVarintPool(dAtA, i, uint64(m.CurrentCompressionId))
 		i--
@@ -932,6 +1369,27 @@ func (m *Funder) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovPool(uint64(m.Amount))
 	}
+	if m.AmountPerBundle != 0 {
+		n += 1 + sovPool(uint64(m.AmountPerBundle))
+	}
+	if m.StartAt != 0 {
+		n += 1 + sovPool(uint64(m.StartAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovPool(uint64(m.EndAt))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.CoinsPerBundle) > 0 {
+		for _, e := range m.CoinsPerBundle {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1016,6 +1474,46 @@ func (m *Pool) Size() (n int) {
 	if m.CurrentCompressionId != 0 {
 		n += 2 + sovPool(uint64(m.CurrentCompressionId))
 	}
+	if m.FundingPolicy != 0 {
+		n += 2 + sovPool(uint64(m.FundingPolicy))
+	}
+	if len(m.TotalFundsPerDenom) > 0 {
+		for _, e := range m.TotalFundsPerDenom {
+			l = e.Size()
+			n += 2 + l + sovPool(uint64(l))
+		}
+	}
+	if m.MaxStakers != 0 {
+		n += 2 + sovPool(uint64(m.MaxStakers))
+	}
+	if m.UploaderSelection != 0 {
+		n += 2 + sovPool(uint64(m.UploaderSelection))
+	}
+	l = m.ValidQuorum.Size()
+	n += 2 + l + sovPool(uint64(l))
+	l = m.InvalidQuorum.Size()
+	n += 2 + l + sovPool(uint64(l))
+	if m.VotingMode != 0 {
+		n += 2 + sovPool(uint64(m.VotingMode))
+	}
+	if m.RevealWindow != 0 {
+		n += 2 + sovPool(uint64(m.RevealWindow))
+	}
+	if m.VoteSlash != nil {
+		l = m.VoteSlash.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.UploadSlash != nil {
+		l = m.UploadSlash.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.TimeoutSlash != nil {
+		l = m.TimeoutSlash.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.GasSponsorship != 0 {
+		n += 2 + sovPool(uint64(m.GasSponsorship))
+	}
 	return n
 }
 
@@ -1390,33 +1888,158 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPool(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBundle", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPool
+			m.AmountPerBundle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountPerBundle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+			m.StartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			m.EndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsPerBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinsPerBundle = append(m.CoinsPerBundle, types.Coin{})
+			if err := m.CoinsPerBundle[len(m.CoinsPerBundle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowPool
@@ -1980,6 +2603,330 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPolicy", wireType)
+			}
+			m.FundingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingPolicy |= FundingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFundsPerDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFundsPerDenom = append(m.TotalFundsPerDenom, types.Coin{})
+			if err := m.TotalFundsPerDenom[len(m.TotalFundsPerDenom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakers", wireType)
+			}
+			m.MaxStakers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploaderSelection", wireType)
+			}
+			m.UploaderSelection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploaderSelection |= UploaderSelection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InvalidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingMode", wireType)
+			}
+			m.VotingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingMode |= VotingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealWindow", wireType)
+			}
+			m.RevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteSlash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteSlash = &v
+			if err := m.VoteSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSlash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.UploadSlash = &v
+			if err := m.UploadSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSlash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TimeoutSlash = &v
+			if err := m.TimeoutSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSponsorship", wireType)
+			}
+			m.GasSponsorship = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasSponsorship |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
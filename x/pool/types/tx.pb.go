@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -36,6 +38,17 @@ type MsgFundPool struct {
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	// amount ...
 	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// amount_per_bundle ...
+	AmountPerBundle uint64 `protobuf:"varint,4,opt,name=amount_per_bundle,json=amountPerBundle,proto3" json:"amount_per_bundle,omitempty"`
+	// start_at ...
+	StartAt uint64 `protobuf:"varint,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// end_at ...
+	EndAt uint64 `protobuf:"varint,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// denom ...
+	Denom string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	// clear_schedule removes the amount per bundle of the denom and
+	// the funding period of the funder before the new values are set
+	ClearSchedule bool `protobuf:"varint,8,opt,name=clear_schedule,json=clearSchedule,proto3" json:"clear_schedule,omitempty"`
 }
 
 func (m *MsgFundPool) Reset()         { *m = MsgFundPool{} }
@@ -92,6 +105,41 @@ func (m *MsgFundPool) GetAmount() uint64 {
 	return 0
 }
 
+func (m *MsgFundPool) GetAmountPerBundle() uint64 {
+	if m != nil {
+		return m.AmountPerBundle
+	}
+	return 0
+}
+
+func (m *MsgFundPool) GetStartAt() uint64 {
+	if m != nil {
+		return m.StartAt
+	}
+	return 0
+}
+
+func (m *MsgFundPool) GetEndAt() uint64 {
+	if m != nil {
+		return m.EndAt
+	}
+	return 0
+}
+
+func (m *MsgFundPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgFundPool) GetClearSchedule() bool {
+	if m != nil {
+		return m.ClearSchedule
+	}
+	return false
+}
+
 // MsgFundPoolResponse defines the Msg/DefundPool response type.
 type MsgFundPoolResponse struct {
 }
@@ -137,6 +185,8 @@ type MsgDefundPool struct {
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	// amount ...
 	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// denom ...
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgDefundPool) Reset()         { *m = MsgDefundPool{} }
@@ -193,6 +243,13 @@ func (m *MsgDefundPool) GetAmount() uint64 {
 	return 0
 }
 
+func (m *MsgDefundPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // MsgDefundPoolResponse defines the Msg/DefundPool response type.
 type MsgDefundPoolResponse struct {
 }
@@ -230,6 +287,108 @@ func (m *MsgDefundPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDefundPoolResponse proto.InternalMessageInfo
 
+// MsgFundGasSponsorship defines a SDK message for funding the gas
+// sponsorship budget of a pool.
+type MsgFundGasSponsorship struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// amount ...
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgFundGasSponsorship) Reset()         { *m = MsgFundGasSponsorship{} }
+func (m *MsgFundGasSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgFundGasSponsorship) ProtoMessage()    {}
+func (*MsgFundGasSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{4}
+}
+func (m *MsgFundGasSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundGasSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundGasSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundGasSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundGasSponsorship.Merge(m, src)
+}
+func (m *MsgFundGasSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundGasSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundGasSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundGasSponsorship proto.InternalMessageInfo
+
+func (m *MsgFundGasSponsorship) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgFundGasSponsorship) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgFundGasSponsorship) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// MsgFundGasSponsorshipResponse defines the Msg/FundGasSponsorship response type.
+type MsgFundGasSponsorshipResponse struct {
+}
+
+func (m *MsgFundGasSponsorshipResponse) Reset()         { *m = MsgFundGasSponsorshipResponse{} }
+func (m *MsgFundGasSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundGasSponsorshipResponse) ProtoMessage()    {}
+func (*MsgFundGasSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{5}
+}
+func (m *MsgFundGasSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundGasSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundGasSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundGasSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundGasSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgFundGasSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundGasSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundGasSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundGasSponsorshipResponse proto.InternalMessageInfo
+
 // MsgCreatePool defines a SDK message for creating a new pool.
 type MsgCreatePool struct {
 	// authority is the address of the governance account.
@@ -260,13 +419,27 @@ type MsgCreatePool struct {
 	StorageProviderId uint32 `protobuf:"varint,13,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
 	// compression_id ...
 	CompressionId uint32 `protobuf:"varint,14,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
+	// funding_policy ...
+	FundingPolicy FundingPolicy `protobuf:"varint,15,opt,name=funding_policy,json=fundingPolicy,proto3,enum=kyve.pool.v1beta1.FundingPolicy" json:"funding_policy,omitempty"`
+	// max_stakers ...
+	MaxStakers uint64 `protobuf:"varint,16,opt,name=max_stakers,json=maxStakers,proto3" json:"max_stakers,omitempty"`
+	// uploader_selection ...
+	UploaderSelection UploaderSelection `protobuf:"varint,17,opt,name=uploader_selection,json=uploaderSelection,proto3,enum=kyve.pool.v1beta1.UploaderSelection" json:"uploader_selection,omitempty"`
+	// valid_quorum ...
+	ValidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=valid_quorum,json=validQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_quorum"`
+	// invalid_quorum ...
+	InvalidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=invalid_quorum,json=invalidQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"invalid_quorum"`
+	// voting_mode ...
+	VotingMode VotingMode `protobuf:"varint,20,opt,name=voting_mode,json=votingMode,proto3,enum=kyve.pool.v1beta1.VotingMode" json:"voting_mode,omitempty"`
+	// reveal_window ...
+	RevealWindow uint64 `protobuf:"varint,21,opt,name=reveal_window,json=revealWindow,proto3" json:"reveal_window,omitempty"`
 }
 
 func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
 func (m *MsgCreatePool) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePool) ProtoMessage()    {}
 func (*MsgCreatePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{4}
+	return fileDescriptor_20ddefdf83388ddc, []int{6}
 }
 func (m *MsgCreatePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,6 +566,41 @@ func (m *MsgCreatePool) GetCompressionId() uint32 {
 	return 0
 }
 
+func (m *MsgCreatePool) GetFundingPolicy() FundingPolicy {
+	if m != nil {
+		return m.FundingPolicy
+	}
+	return FUNDING_POLICY_EQUAL_SPLIT
+}
+
+func (m *MsgCreatePool) GetMaxStakers() uint64 {
+	if m != nil {
+		return m.MaxStakers
+	}
+	return 0
+}
+
+func (m *MsgCreatePool) GetUploaderSelection() UploaderSelection {
+	if m != nil {
+		return m.UploaderSelection
+	}
+	return UPLOADER_SELECTION_ROUND_ROBIN
+}
+
+func (m *MsgCreatePool) GetVotingMode() VotingMode {
+	if m != nil {
+		return m.VotingMode
+	}
+	return VOTING_MODE_PLAIN
+}
+
+func (m *MsgCreatePool) GetRevealWindow() uint64 {
+	if m != nil {
+		return m.RevealWindow
+	}
+	return 0
+}
+
 // MsgCreatePoolResponse defines the Msg/CreatePool response type.
 type MsgCreatePoolResponse struct {
 }
@@ -401,7 +609,7 @@ func (m *MsgCreatePoolResponse) Reset()         { *m = MsgCreatePoolResponse{} }
 func (m *MsgCreatePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePoolResponse) ProtoMessage()    {}
 func (*MsgCreatePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{5}
+	return fileDescriptor_20ddefdf83388ddc, []int{7}
 }
 func (m *MsgCreatePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,7 +652,7 @@ func (m *MsgUpdatePool) Reset()         { *m = MsgUpdatePool{} }
 func (m *MsgUpdatePool) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdatePool) ProtoMessage()    {}
 func (*MsgUpdatePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{6}
+	return fileDescriptor_20ddefdf83388ddc, []int{8}
 }
 func (m *MsgUpdatePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +710,7 @@ func (m *MsgUpdatePoolResponse) Reset()         { *m = MsgUpdatePoolResponse{} }
 func (m *MsgUpdatePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdatePoolResponse) ProtoMessage()    {}
 func (*MsgUpdatePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{7}
+	return fileDescriptor_20ddefdf83388ddc, []int{9}
 }
 func (m *MsgUpdatePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +751,7 @@ func (m *MsgDisablePool) Reset()         { *m = MsgDisablePool{} }
 func (m *MsgDisablePool) String() string { return proto.CompactTextString(m) }
 func (*MsgDisablePool) ProtoMessage()    {}
 func (*MsgDisablePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{8}
+	return fileDescriptor_20ddefdf83388ddc, []int{10}
 }
 func (m *MsgDisablePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,7 +802,7 @@ func (m *MsgDisablePoolResponse) Reset()         { *m = MsgDisablePoolResponse{}
 func (m *MsgDisablePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDisablePoolResponse) ProtoMessage()    {}
 func (*MsgDisablePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{9}
+	return fileDescriptor_20ddefdf83388ddc, []int{11}
 }
 func (m *MsgDisablePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +843,7 @@ func (m *MsgEnablePool) Reset()         { *m = MsgEnablePool{} }
 func (m *MsgEnablePool) String() string { return proto.CompactTextString(m) }
 func (*MsgEnablePool) ProtoMessage()    {}
 func (*MsgEnablePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{10}
+	return fileDescriptor_20ddefdf83388ddc, []int{12}
 }
 func (m *MsgEnablePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +894,7 @@ func (m *MsgEnablePoolResponse) Reset()         { *m = MsgEnablePoolResponse{} }
 func (m *MsgEnablePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEnablePoolResponse) ProtoMessage()    {}
 func (*MsgEnablePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{11}
+	return fileDescriptor_20ddefdf83388ddc, []int{13}
 }
 func (m *MsgEnablePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +943,7 @@ func (m *MsgScheduleRuntimeUpgrade) Reset()         { *m = MsgScheduleRuntimeUpg
 func (m *MsgScheduleRuntimeUpgrade) String() string { return proto.CompactTextString(m) }
 func (*MsgScheduleRuntimeUpgrade) ProtoMessage()    {}
 func (*MsgScheduleRuntimeUpgrade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{12}
+	return fileDescriptor_20ddefdf83388ddc, []int{14}
 }
 func (m *MsgScheduleRuntimeUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -814,7 +1022,7 @@ func (m *MsgScheduleRuntimeUpgradeResponse) Reset()         { *m = MsgScheduleRu
 func (m *MsgScheduleRuntimeUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgScheduleRuntimeUpgradeResponse) ProtoMessage()    {}
 func (*MsgScheduleRuntimeUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{13}
+	return fileDescriptor_20ddefdf83388ddc, []int{15}
 }
 func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +1063,7 @@ func (m *MsgCancelRuntimeUpgrade) Reset()         { *m = MsgCancelRuntimeUpgrade
 func (m *MsgCancelRuntimeUpgrade) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRuntimeUpgrade) ProtoMessage()    {}
 func (*MsgCancelRuntimeUpgrade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{14}
+	return fileDescriptor_20ddefdf83388ddc, []int{16}
 }
 func (m *MsgCancelRuntimeUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -906,7 +1114,7 @@ func (m *MsgCancelRuntimeUpgradeResponse) Reset()         { *m = MsgCancelRuntim
 func (m *MsgCancelRuntimeUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRuntimeUpgradeResponse) ProtoMessage()    {}
 func (*MsgCancelRuntimeUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{15}
+	return fileDescriptor_20ddefdf83388ddc, []int{17}
 }
 func (m *MsgCancelRuntimeUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1155,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{16}
+	return fileDescriptor_20ddefdf83388ddc, []int{18}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1206,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20ddefdf83388ddc, []int{17}
+	return fileDescriptor_20ddefdf83388ddc, []int{19}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,6 +1240,8 @@ func init() {
 	proto.RegisterType((*MsgFundPoolResponse)(nil), "kyve.pool.v1beta1.MsgFundPoolResponse")
 	proto.RegisterType((*MsgDefundPool)(nil), "kyve.pool.v1beta1.MsgDefundPool")
 	proto.RegisterType((*MsgDefundPoolResponse)(nil), "kyve.pool.v1beta1.MsgDefundPoolResponse")
+	proto.RegisterType((*MsgFundGasSponsorship)(nil), "kyve.pool.v1beta1.MsgFundGasSponsorship")
+	proto.RegisterType((*MsgFundGasSponsorshipResponse)(nil), "kyve.pool.v1beta1.MsgFundGasSponsorshipResponse")
 	proto.RegisterType((*MsgCreatePool)(nil), "kyve.pool.v1beta1.MsgCreatePool")
 	proto.RegisterType((*MsgCreatePoolResponse)(nil), "kyve.pool.v1beta1.MsgCreatePoolResponse")
 	proto.RegisterType((*MsgUpdatePool)(nil), "kyve.pool.v1beta1.MsgUpdatePool")
@@ -1051,63 +1261,84 @@ func init() {
 func init() { proto.RegisterFile("kyve/pool/v1beta1/tx.proto", fileDescriptor_20ddefdf83388ddc) }
 
 var fileDescriptor_20ddefdf83388ddc = []byte{
-	// 881 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xd1, 0x6e, 0x1b, 0x45,
-	0x14, 0x8d, 0x9d, 0xd4, 0x89, 0x6f, 0x62, 0x47, 0xdd, 0xb6, 0xe9, 0x64, 0x91, 0x4c, 0x62, 0x44,
-	0x49, 0x2b, 0xb0, 0xd5, 0x82, 0x78, 0x6f, 0xd2, 0x22, 0x45, 0x55, 0xa0, 0x6c, 0x54, 0x28, 0x20,
-	0xb1, 0x1a, 0xef, 0x4c, 0x37, 0xa3, 0xec, 0xce, 0x2c, 0x33, 0xb3, 0x26, 0xae, 0xf8, 0x08, 0x3e,
-	0x86, 0x8f, 0xe0, 0xb1, 0xe2, 0x89, 0x47, 0x94, 0xbc, 0xf1, 0xcc, 0x07, 0xa0, 0x99, 0x5d, 0xef,
-	0xae, 0xb1, 0xb7, 0x01, 0x9a, 0xbe, 0xf9, 0xde, 0x7b, 0x7c, 0xce, 0x9d, 0x99, 0x33, 0x77, 0x16,
-	0xdc, 0xd3, 0xc9, 0x98, 0x0e, 0x13, 0x21, 0xa2, 0xe1, 0xf8, 0xfe, 0x88, 0x6a, 0x7c, 0x7f, 0xa8,
-	0xcf, 0x06, 0x89, 0x14, 0x5a, 0x38, 0xd7, 0x4d, 0x6d, 0x60, 0x6a, 0x83, 0xbc, 0xe6, 0x6e, 0x07,
-	0x42, 0xc5, 0x42, 0xf9, 0x16, 0x30, 0xcc, 0x82, 0x0c, 0xdd, 0xff, 0x02, 0xd6, 0x8f, 0x54, 0xf8,
-	0x59, 0xca, 0xc9, 0x53, 0x21, 0x22, 0x07, 0xc1, 0x6a, 0x20, 0x29, 0xd6, 0x42, 0xa2, 0xc6, 0x4e,
-	0x63, 0xaf, 0xed, 0x4d, 0x43, 0xa7, 0x0b, 0x4d, 0x46, 0x50, 0x73, 0xa7, 0xb1, 0xb7, 0xe2, 0x35,
-	0x19, 0x71, 0xb6, 0xa0, 0x85, 0x63, 0x91, 0x72, 0x8d, 0x96, 0x6d, 0x2e, 0x8f, 0xfa, 0xb7, 0xe0,
-	0x46, 0x85, 0xd0, 0xa3, 0x2a, 0x11, 0x5c, 0xd1, 0xfe, 0x97, 0xd0, 0x39, 0x52, 0xe1, 0x23, 0xfa,
-	0xe2, 0xea, 0x94, 0x6e, 0xc3, 0xad, 0x19, 0xca, 0x42, 0xeb, 0xcf, 0x65, 0x2b, 0x76, 0x60, 0xf8,
-	0xa8, 0x15, 0xfb, 0x14, 0xda, 0x38, 0xd5, 0x27, 0x42, 0x32, 0x3d, 0xc9, 0xe4, 0xf6, 0xd1, 0x6f,
-	0xbf, 0x7c, 0x74, 0x33, 0xdf, 0x8a, 0x87, 0x84, 0x48, 0xaa, 0xd4, 0xb1, 0x96, 0x8c, 0x87, 0x5e,
-	0x09, 0x75, 0x1c, 0x58, 0xe1, 0x38, 0xa6, 0xb6, 0x99, 0xb6, 0x67, 0x7f, 0x9b, 0xc6, 0x65, 0xca,
-	0x35, 0x8b, 0xa9, 0xed, 0xa7, 0xed, 0x4d, 0x43, 0x83, 0x8e, 0x44, 0x28, 0xd0, 0x4a, 0x86, 0x36,
-	0xbf, 0x4d, 0xf3, 0x81, 0xe0, 0x2f, 0x58, 0x88, 0xae, 0xd9, 0x6c, 0x1e, 0x39, 0xef, 0x40, 0x5b,
-	0x69, 0x2c, 0xb5, 0x7f, 0x4a, 0x27, 0xa8, 0x65, 0x4b, 0x6b, 0x36, 0xf1, 0x84, 0x4e, 0x9c, 0x0f,
-	0x60, 0x33, 0x4d, 0x22, 0x81, 0x89, 0xcf, 0xb8, 0xa6, 0x72, 0x8c, 0x23, 0xb4, 0x6a, 0x97, 0xde,
-	0xcd, 0xd2, 0x87, 0x79, 0xd6, 0x79, 0x1f, 0xba, 0x22, 0xa1, 0x12, 0x6b, 0xc6, 0x43, 0x3f, 0x10,
-	0x4a, 0xa3, 0x35, 0x8b, 0xeb, 0x14, 0xd9, 0x03, 0xa1, 0xb4, 0x81, 0xc5, 0x8c, 0xfb, 0x84, 0x46,
-	0x34, 0xc4, 0x9a, 0x09, 0x8e, 0xda, 0x19, 0x2c, 0x66, 0xfc, 0x51, 0x91, 0x74, 0xee, 0xc0, 0x66,
-	0x8c, 0xcf, 0xfc, 0x51, 0xca, 0x49, 0x44, 0x7d, 0xc5, 0x5e, 0x52, 0x04, 0x39, 0x0e, 0x9f, 0xed,
-	0xdb, 0xec, 0x31, 0x7b, 0x69, 0x77, 0x60, 0x4c, 0xa5, 0x32, 0x3c, 0xeb, 0xd9, 0x0e, 0xe4, 0xa1,
-	0xe3, 0xc2, 0xda, 0x88, 0x71, 0x2c, 0x19, 0x55, 0x68, 0x23, 0x5b, 0xd4, 0x34, 0x76, 0x06, 0x70,
-	0x43, 0x69, 0x21, 0x71, 0x48, 0x8d, 0x0f, 0xc7, 0x8c, 0x50, 0xe9, 0x33, 0x82, 0x3a, 0x3b, 0x8d,
-	0xbd, 0x8e, 0x77, 0x3d, 0x2f, 0x3d, 0xcd, 0x2b, 0x87, 0xc4, 0x34, 0x1d, 0x88, 0x38, 0x31, 0x07,
-	0xc3, 0x04, 0x37, 0xd0, 0xae, 0x85, 0x76, 0x2a, 0xd9, 0x43, 0x92, 0xbb, 0xa0, 0x3c, 0xeb, 0xc2,
-	0x05, 0x3f, 0x58, 0x13, 0x3c, 0x4b, 0xc8, 0x9b, 0x9a, 0xe0, 0x9f, 0x7e, 0x44, 0xb0, 0x9a, 0xe0,
-	0x89, 0x39, 0x87, 0xa9, 0x01, 0xf2, 0x30, 0xef, 0xa5, 0x94, 0x2c, 0x7a, 0x79, 0x0e, 0x5d, 0x63,
-	0x55, 0xa6, 0xf0, 0x28, 0xba, 0xd2, 0x66, 0xfa, 0x08, 0xb6, 0x66, 0x99, 0x0b, 0xcd, 0xaf, 0xed,
-	0xfa, 0x1f, 0xf3, 0x2b, 0x97, 0xcc, 0x56, 0x59, 0x12, 0x17, 0x8a, 0xe7, 0x0d, 0xd8, 0x3e, 0x52,
-	0xe1, 0x71, 0x70, 0x42, 0x49, 0x1a, 0x51, 0x2f, 0xbb, 0x16, 0xcf, 0x92, 0x50, 0x62, 0x42, 0xff,
-	0xb7, 0x7c, 0xe5, 0xbe, 0x35, 0x67, 0xef, 0x5b, 0xc5, 0x87, 0xcb, 0xb3, 0x3e, 0xdc, 0x85, 0x0d,
-	0x95, 0x77, 0x41, 0x7c, 0xac, 0xed, 0x8d, 0x5c, 0xf1, 0xd6, 0x8b, 0xdc, 0x43, 0x6d, 0xac, 0x4a,
-	0x52, 0x99, 0xdd, 0x86, 0x6b, 0xb6, 0x5c, 0xc4, 0x33, 0x36, 0x6e, 0xcd, 0xda, 0xb8, 0xff, 0x1e,
-	0xec, 0xd6, 0xae, 0xb1, 0xd8, 0x89, 0x53, 0xb8, 0x6d, 0x4c, 0x89, 0x79, 0x40, 0xa3, 0xb7, 0xbd,
-	0x0d, 0xfd, 0x5d, 0x78, 0xb7, 0x46, 0xac, 0xe8, 0x27, 0x80, 0xcd, 0xd2, 0x98, 0x58, 0xe2, 0x58,
-	0xbd, 0x49, 0x1f, 0x53, 0xf7, 0x37, 0x67, 0xdd, 0xbf, 0x6d, 0x17, 0x5d, 0x15, 0x99, 0xea, 0x3f,
-	0xf8, 0xab, 0x05, 0xcb, 0x47, 0x2a, 0x74, 0x3c, 0x58, 0x2b, 0x9e, 0x9a, 0xde, 0x60, 0xee, 0xa1,
-	0x1a, 0x54, 0x5e, 0x0e, 0xf7, 0xce, 0xeb, 0xeb, 0x53, 0x6e, 0xe7, 0x39, 0x40, 0xe5, 0x59, 0xd9,
-	0x59, 0xfc, 0xaf, 0x12, 0xe1, 0xee, 0x5d, 0x86, 0xa8, 0x32, 0x57, 0xde, 0x90, 0x1a, 0xe6, 0x12,
-	0x51, 0xc7, 0x3c, 0x3f, 0x9b, 0x0c, 0x73, 0x65, 0x30, 0xd5, 0x30, 0x97, 0x88, 0x3a, 0xe6, 0xf9,
-	0x49, 0xe3, 0x7c, 0x07, 0xeb, 0xd5, 0x31, 0xb3, 0x5b, 0xb3, 0xd8, 0x12, 0xe2, 0xde, 0xbd, 0x14,
-	0x52, 0x6d, 0xbb, 0x32, 0x4f, 0x6a, 0xda, 0x2e, 0x11, 0x75, 0x6d, 0xcf, 0x8f, 0x0e, 0xe7, 0x27,
-	0xd8, 0xaa, 0x19, 0x1b, 0x1f, 0x2e, 0xe6, 0x58, 0x8c, 0x76, 0x3f, 0xf9, 0x2f, 0xe8, 0x42, 0x7d,
-	0x0c, 0x37, 0x17, 0xde, 0xd5, 0x7b, 0x35, 0x07, 0xba, 0x00, 0xeb, 0x3e, 0xf8, 0xf7, 0xd8, 0x42,
-	0xf7, 0x7b, 0xd8, 0x98, 0xb9, 0x93, 0xfd, 0xd7, 0x1e, 0xb3, 0xc5, 0xb8, 0xf7, 0x2e, 0xc7, 0x4c,
-	0xf9, 0xf7, 0x0f, 0x7e, 0x3d, 0xef, 0x35, 0x5e, 0x9d, 0xf7, 0x1a, 0x7f, 0x9c, 0xf7, 0x1a, 0x3f,
-	0x5f, 0xf4, 0x96, 0x5e, 0x5d, 0xf4, 0x96, 0x7e, 0xbf, 0xe8, 0x2d, 0x7d, 0x7b, 0x37, 0x64, 0xfa,
-	0x24, 0x1d, 0x0d, 0x02, 0x11, 0x0f, 0x9f, 0x7c, 0xf3, 0xd5, 0xe3, 0xcf, 0xa9, 0xfe, 0x51, 0xc8,
-	0xd3, 0x61, 0x70, 0x82, 0x19, 0x1f, 0x9e, 0x65, 0x9f, 0x96, 0x7a, 0x92, 0x50, 0x35, 0x6a, 0xd9,
-	0x0f, 0xc5, 0x8f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x68, 0x62, 0xc8, 0xb4, 0x74, 0x0a, 0x00,
-	0x00,
+	// 1218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5d, 0x6f, 0x13, 0x47,
+	0x17, 0x8e, 0x43, 0x48, 0xe2, 0xe3, 0xd8, 0x79, 0x33, 0x24, 0xb0, 0xd9, 0xb7, 0x38, 0x8e, 0x69,
+	0xa9, 0x41, 0xc5, 0x2e, 0xb4, 0xea, 0x65, 0xa5, 0xf0, 0x29, 0x84, 0x52, 0x85, 0xb5, 0xc2, 0x47,
+	0x2b, 0x75, 0x35, 0xd9, 0x19, 0x36, 0xa3, 0xec, 0xce, 0x2c, 0x33, 0x63, 0x13, 0xa3, 0xfe, 0x88,
+	0xfe, 0x98, 0x5e, 0xf4, 0x27, 0x70, 0x89, 0x7a, 0x55, 0xf5, 0x02, 0x55, 0x41, 0xea, 0x45, 0x7f,
+	0x45, 0xb5, 0x33, 0xeb, 0xf5, 0x1a, 0x6c, 0x42, 0x21, 0xbd, 0xca, 0x9e, 0x73, 0x9e, 0x3c, 0xe7,
+	0x63, 0xcf, 0x3c, 0x3b, 0x06, 0xf7, 0x60, 0xd0, 0xa7, 0x9d, 0x44, 0x88, 0xa8, 0xd3, 0xbf, 0xba,
+	0x47, 0x35, 0xbe, 0xda, 0xd1, 0x87, 0xed, 0x44, 0x0a, 0x2d, 0xd0, 0x4a, 0x1a, 0x6b, 0xa7, 0xb1,
+	0x76, 0x16, 0x73, 0xd7, 0x03, 0xa1, 0x62, 0xa1, 0x7c, 0x03, 0xe8, 0x58, 0xc3, 0xa2, 0xdd, 0xd5,
+	0x50, 0x84, 0xc2, 0xfa, 0xd3, 0xa7, 0xcc, 0xfb, 0xc9, 0xdb, 0xfc, 0x86, 0xd0, 0x44, 0x9b, 0x7f,
+	0x97, 0xa0, 0xb2, 0xad, 0xc2, 0xdb, 0x3d, 0x4e, 0x76, 0x84, 0x88, 0x90, 0x03, 0x0b, 0x81, 0xa4,
+	0x58, 0x0b, 0xe9, 0x94, 0x1a, 0xa5, 0x56, 0xd9, 0x1b, 0x9a, 0xa8, 0x06, 0xb3, 0x8c, 0x38, 0xb3,
+	0x8d, 0x52, 0x6b, 0xce, 0x9b, 0x65, 0x04, 0x9d, 0x85, 0x79, 0x1c, 0x8b, 0x1e, 0xd7, 0xce, 0x29,
+	0xe3, 0xcb, 0x2c, 0x74, 0x19, 0x56, 0xec, 0x93, 0x9f, 0x50, 0xe9, 0xef, 0xf5, 0x38, 0x89, 0xa8,
+	0x33, 0x67, 0x20, 0xcb, 0x36, 0xb0, 0x43, 0xe5, 0x75, 0xe3, 0x46, 0xeb, 0xb0, 0xa8, 0x34, 0x96,
+	0xda, 0xc7, 0xda, 0x39, 0x6d, 0x20, 0x0b, 0xc6, 0xde, 0xd2, 0x68, 0x0d, 0xe6, 0x29, 0x27, 0x69,
+	0x60, 0xde, 0x04, 0x4e, 0x53, 0x4e, 0xb6, 0x34, 0x5a, 0x85, 0xd3, 0x84, 0x72, 0x11, 0x3b, 0x0b,
+	0xa6, 0x3a, 0x6b, 0xa0, 0xcf, 0xa0, 0x16, 0x44, 0x14, 0x4b, 0x5f, 0x05, 0xfb, 0x94, 0xf4, 0x22,
+	0xea, 0x2c, 0x36, 0x4a, 0xad, 0x45, 0xaf, 0x6a, 0xbc, 0xdd, 0xcc, 0xd9, 0x5c, 0x83, 0x33, 0x85,
+	0x5e, 0x3d, 0xaa, 0x12, 0xc1, 0x15, 0x6d, 0x86, 0x50, 0xdd, 0x56, 0xe1, 0x4d, 0xfa, 0xe4, 0xe4,
+	0x86, 0x90, 0x97, 0x39, 0x57, 0x28, 0xb3, 0x79, 0x0e, 0xd6, 0xc6, 0x12, 0xe5, 0x15, 0x3c, 0x86,
+	0xb5, 0xac, 0xb0, 0x3b, 0x58, 0x75, 0x53, 0x9f, 0x90, 0x6a, 0x9f, 0x25, 0x1f, 0x5f, 0x49, 0x73,
+	0x03, 0xce, 0x4f, 0xa4, 0xce, 0x73, 0xff, 0xba, 0x60, 0xda, 0xbf, 0x91, 0xf2, 0x52, 0xd3, 0xfe,
+	0x37, 0x50, 0xc6, 0x3d, 0xbd, 0x2f, 0x24, 0xd3, 0x03, 0x9b, 0xf6, 0xba, 0xf3, 0xdb, 0x2f, 0x57,
+	0x56, 0xb3, 0x65, 0xdb, 0x22, 0x44, 0x52, 0xa5, 0xba, 0x5a, 0x32, 0x1e, 0x7a, 0x23, 0x28, 0x42,
+	0x30, 0xc7, 0x71, 0x4c, 0x4d, 0x51, 0x65, 0xcf, 0x3c, 0xa7, 0x0d, 0xc8, 0x1e, 0xd7, 0x2c, 0xa6,
+	0xa6, 0xae, 0xb2, 0x37, 0x34, 0x53, 0x74, 0x24, 0x42, 0x91, 0x4d, 0xc8, 0x3c, 0xa7, 0x4d, 0x04,
+	0x82, 0x3f, 0x61, 0xa1, 0xd9, 0x86, 0xb2, 0x97, 0x59, 0xe8, 0xff, 0x50, 0xb6, 0x7b, 0x72, 0x40,
+	0x07, 0x66, 0x1f, 0xca, 0x9e, 0x5d, 0x9c, 0x7b, 0x74, 0x80, 0x3e, 0x87, 0xe5, 0x5e, 0x12, 0x09,
+	0x4c, 0x7c, 0xc6, 0x35, 0x95, 0x7d, 0x1c, 0x99, 0xe5, 0x98, 0xf3, 0x6a, 0xd6, 0x7d, 0x37, 0xf3,
+	0xa6, 0x5b, 0x22, 0x12, 0x2a, 0xb1, 0x66, 0x3c, 0xf4, 0x03, 0xa1, 0xb4, 0xd9, 0x92, 0x39, 0xaf,
+	0x9a, 0x7b, 0x6f, 0x08, 0xa5, 0x53, 0x58, 0xcc, 0xb8, 0x4f, 0x68, 0x44, 0x43, 0xac, 0x99, 0xe0,
+	0x4e, 0xd9, 0xc2, 0x62, 0xc6, 0x6f, 0xe6, 0x4e, 0x74, 0x11, 0x96, 0x63, 0x7c, 0x98, 0x2d, 0xb8,
+	0xaf, 0xd8, 0x73, 0xea, 0x40, 0x86, 0xc3, 0x87, 0x76, 0xbf, 0xbb, 0xec, 0xb9, 0x99, 0x40, 0x9f,
+	0x4a, 0x95, 0xf2, 0x54, 0xec, 0x04, 0x32, 0x13, 0xb9, 0xb0, 0xb8, 0xc7, 0x38, 0x96, 0x8c, 0x2a,
+	0x67, 0xc9, 0x36, 0x35, 0xb4, 0x51, 0x1b, 0xce, 0x28, 0x2d, 0x24, 0x0e, 0x69, 0x7a, 0xd2, 0xfb,
+	0x8c, 0x50, 0xe9, 0x33, 0xe2, 0x54, 0x1b, 0xa5, 0x56, 0xd5, 0x5b, 0xc9, 0x42, 0x3b, 0x59, 0xe4,
+	0x2e, 0x31, 0x27, 0x40, 0xc4, 0x49, 0xfa, 0x62, 0x98, 0xe0, 0x29, 0xb4, 0x66, 0xa0, 0xd5, 0x82,
+	0xf7, 0x2e, 0x41, 0x77, 0xa0, 0x96, 0x2e, 0x5f, 0x3a, 0x80, 0x44, 0x44, 0x2c, 0x18, 0x38, 0xcb,
+	0x8d, 0x52, 0xab, 0x76, 0xad, 0xd1, 0x7e, 0x4b, 0x69, 0xda, 0xb7, 0x2d, 0x70, 0xc7, 0xe0, 0xbc,
+	0xea, 0x93, 0xa2, 0x89, 0x36, 0xa0, 0x92, 0x76, 0xaf, 0x34, 0x3e, 0xa0, 0x52, 0x39, 0xff, 0x33,
+	0x9d, 0x43, 0x8c, 0x0f, 0xbb, 0xd6, 0x83, 0xba, 0x80, 0xec, 0xf8, 0xa9, 0xf4, 0x15, 0x8d, 0x68,
+	0x60, 0x26, 0xb9, 0x62, 0xb2, 0x7d, 0x3a, 0x21, 0xdb, 0x6e, 0x06, 0xee, 0x0e, 0xb1, 0xde, 0x4a,
+	0xef, 0x4d, 0x17, 0xba, 0x0f, 0x4b, 0x7d, 0x1c, 0x31, 0xe2, 0x3f, 0xed, 0x09, 0xd9, 0x8b, 0x1d,
+	0x64, 0x96, 0xb3, 0xfd, 0xe2, 0xd5, 0xc6, 0xcc, 0x1f, 0xaf, 0x36, 0x2e, 0x86, 0x4c, 0xef, 0xf7,
+	0xf6, 0xda, 0x81, 0x88, 0x33, 0x61, 0xcc, 0xfe, 0x5c, 0x51, 0xe4, 0xa0, 0xa3, 0x07, 0x09, 0x55,
+	0xed, 0x9b, 0x34, 0xf0, 0x2a, 0x86, 0xe3, 0xbe, 0xa1, 0x40, 0xbb, 0x50, 0x63, 0x7c, 0x8c, 0xf4,
+	0xcc, 0x07, 0x91, 0x56, 0x19, 0x2f, 0xd2, 0x7e, 0x0b, 0x95, 0xbe, 0x30, 0x8b, 0x16, 0x0b, 0x42,
+	0x9d, 0x55, 0xd3, 0xf7, 0xf9, 0x09, 0x7d, 0x3f, 0x30, 0xa8, 0x6d, 0x41, 0xa8, 0x07, 0xfd, 0xfc,
+	0x19, 0x5d, 0x80, 0xaa, 0xa4, 0x7d, 0x8a, 0x23, 0xff, 0x19, 0xe3, 0x44, 0x3c, 0x73, 0xd6, 0xcc,
+	0x84, 0x97, 0xac, 0xf3, 0xa1, 0xf1, 0x65, 0x7a, 0x32, 0x3a, 0xb9, 0xf9, 0x99, 0x7e, 0x6a, 0x8e,
+	0xf4, 0x6e, 0x42, 0x3e, 0xf6, 0x48, 0xbf, 0xa9, 0x32, 0x0e, 0x2c, 0x24, 0x78, 0x90, 0xbe, 0x96,
+	0xe1, 0x71, 0xce, 0xcc, 0xac, 0x96, 0x51, 0xca, 0xbc, 0x96, 0x47, 0x50, 0x4b, 0x45, 0x8f, 0x29,
+	0xbc, 0x17, 0x9d, 0x68, 0x31, 0x4d, 0x07, 0xce, 0x8e, 0x33, 0xe7, 0x39, 0x1f, 0x9a, 0xfe, 0x6f,
+	0xf1, 0x13, 0x4f, 0x69, 0xbb, 0xbc, 0xc5, 0xdf, 0xca, 0x78, 0x54, 0x82, 0xf5, 0x6d, 0x15, 0x0e,
+	0x3f, 0x35, 0x9e, 0x15, 0xb9, 0xdd, 0x24, 0x94, 0x98, 0xd0, 0x0f, 0x4e, 0x5f, 0x50, 0xcf, 0xd9,
+	0x71, 0xf5, 0x2c, 0xa8, 0xca, 0xa9, 0x71, 0x55, 0xd9, 0x84, 0xa5, 0xe1, 0x57, 0xd0, 0x7c, 0x3e,
+	0xed, 0xa7, 0xb7, 0x92, 0xfb, 0xb6, 0x74, 0x2a, 0x3c, 0xa4, 0x27, 0xad, 0xb6, 0xd9, 0xcf, 0x6e,
+	0x6e, 0x8f, 0x89, 0xd2, 0xfc, 0xb8, 0x28, 0x35, 0x2f, 0xc0, 0xe6, 0xd4, 0x1e, 0xf3, 0x49, 0x1c,
+	0xc0, 0xb9, 0x74, 0x29, 0x31, 0x0f, 0x68, 0xf4, 0x5f, 0x8f, 0xa1, 0xb9, 0x09, 0x1b, 0x53, 0x92,
+	0xe5, 0xf5, 0x04, 0xb0, 0x3c, 0x5a, 0x4c, 0x2c, 0x71, 0xac, 0x3e, 0xa6, 0x8e, 0xe1, 0xf6, 0xcf,
+	0x8e, 0x6f, 0xff, 0x3a, 0x9c, 0x7b, 0x23, 0xc9, 0x30, 0xff, 0xb5, 0xbf, 0x16, 0xe0, 0xd4, 0xb6,
+	0x0a, 0x91, 0x07, 0x8b, 0xf9, 0x2d, 0xab, 0x3e, 0x41, 0x08, 0x0a, 0x37, 0x13, 0xf7, 0xe2, 0xbb,
+	0xe3, 0x43, 0x6e, 0xf4, 0x08, 0xa0, 0x70, 0x6d, 0x69, 0x4c, 0xfe, 0xaf, 0x11, 0xc2, 0x6d, 0x1d,
+	0x87, 0xc8, 0x99, 0x13, 0x40, 0x13, 0xae, 0x23, 0xad, 0xe9, 0x75, 0x8d, 0x23, 0xdd, 0x2f, 0xdf,
+	0x17, 0x59, 0xec, 0xa5, 0x70, 0x07, 0x99, 0xd2, 0xcb, 0x08, 0xe1, 0xb6, 0x8e, 0x43, 0x14, 0x99,
+	0x0b, 0x52, 0x38, 0x85, 0x79, 0x84, 0x70, 0x5b, 0xc7, 0x21, 0x72, 0xe6, 0x1f, 0xa0, 0x52, 0x14,
+	0xb6, 0xcd, 0x29, 0xe3, 0x1d, 0x41, 0xdc, 0x4b, 0xc7, 0x42, 0x8a, 0x65, 0x17, 0x14, 0x6c, 0x4a,
+	0xd9, 0x23, 0x84, 0xdb, 0x3a, 0x0e, 0x91, 0x33, 0xff, 0x04, 0x67, 0xa7, 0x08, 0xd5, 0x17, 0x93,
+	0x39, 0x26, 0xa3, 0xdd, 0xaf, 0xff, 0x0d, 0x3a, 0xcf, 0xde, 0x87, 0xd5, 0x89, 0xea, 0x70, 0x79,
+	0xca, 0x0b, 0x9d, 0x80, 0x75, 0xaf, 0xbd, 0x3f, 0x36, 0xcf, 0xfb, 0x23, 0x2c, 0x8d, 0xa9, 0x40,
+	0xf3, 0x9d, 0xaf, 0xd9, 0x60, 0xdc, 0xcb, 0xc7, 0x63, 0x86, 0xfc, 0xd7, 0x6f, 0xbc, 0x38, 0xaa,
+	0x97, 0x5e, 0x1e, 0xd5, 0x4b, 0x7f, 0x1e, 0xd5, 0x4b, 0x3f, 0xbf, 0xae, 0xcf, 0xbc, 0x7c, 0x5d,
+	0x9f, 0xf9, 0xfd, 0x75, 0x7d, 0xe6, 0xfb, 0x4b, 0x85, 0x3b, 0xc4, 0xbd, 0xc7, 0x0f, 0x6e, 0x7d,
+	0x47, 0xf5, 0x33, 0x21, 0x0f, 0x3a, 0xc1, 0x3e, 0x66, 0xbc, 0x73, 0x68, 0x7f, 0x9c, 0x99, 0xab,
+	0xc4, 0xde, 0xbc, 0xf9, 0x59, 0xf6, 0xd5, 0x3f, 0x03, 0x00, 0xee, 0xb1, 0x65, 0xa8, 0x16, 0x0e,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1126,6 +1357,8 @@ type MsgClient interface {
 	FundPool(ctx context.Context, in *MsgFundPool, opts ...grpc.CallOption) (*MsgFundPoolResponse, error)
 	// DefundPool ...
 	DefundPool(ctx context.Context, in *MsgDefundPool, opts ...grpc.CallOption) (*MsgDefundPoolResponse, error)
+	// FundGasSponsorship ...
+	FundGasSponsorship(ctx context.Context, in *MsgFundGasSponsorship, opts ...grpc.CallOption) (*MsgFundGasSponsorshipResponse, error)
 	// CreatePool defines a governance operation for creating a new pool.
 	// The authority is hard-coded to the x/gov module account.
 	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
@@ -1175,6 +1408,15 @@ func (c *msgClient) DefundPool(ctx context.Context, in *MsgDefundPool, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) FundGasSponsorship(ctx context.Context, in *MsgFundGasSponsorship, opts ...grpc.CallOption) (*MsgFundGasSponsorshipResponse, error) {
+	out := new(MsgFundGasSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/FundGasSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
 	out := new(MsgCreatePoolResponse)
 	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/CreatePool", in, out, opts...)
@@ -1244,6 +1486,8 @@ type MsgServer interface {
 	FundPool(context.Context, *MsgFundPool) (*MsgFundPoolResponse, error)
 	// DefundPool ...
 	DefundPool(context.Context, *MsgDefundPool) (*MsgDefundPoolResponse, error)
+	// FundGasSponsorship ...
+	FundGasSponsorship(context.Context, *MsgFundGasSponsorship) (*MsgFundGasSponsorshipResponse, error)
 	// CreatePool defines a governance operation for creating a new pool.
 	// The authority is hard-coded to the x/gov module account.
 	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
@@ -1277,6 +1521,9 @@ func (*UnimplementedMsgServer) FundPool(ctx context.Context, req *MsgFundPool) (
 func (*UnimplementedMsgServer) DefundPool(ctx context.Context, req *MsgDefundPool) (*MsgDefundPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DefundPool not implemented")
 }
+func (*UnimplementedMsgServer) FundGasSponsorship(ctx context.Context, req *MsgFundGasSponsorship) (*MsgFundGasSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundGasSponsorship not implemented")
+}
 func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
 }
@@ -1339,6 +1586,24 @@ func _Msg_DefundPool_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundGasSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundGasSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundGasSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/FundGasSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundGasSponsorship(ctx, req.(*MsgFundGasSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreatePool)
 	if err := dec(in); err != nil {
@@ -1477,6 +1742,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DefundPool",
 			Handler:    _Msg_DefundPool_Handler,
 		},
+		{
+			MethodName: "FundGasSponsorship",
+			Handler:    _Msg_FundGasSponsorship_Handler,
+		},
 		{
 			MethodName: "CreatePool",
 			Handler:    _Msg_CreatePool_Handler,
@@ -1530,6 +1799,38 @@ func (m *MsgFundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClearSchedule {
+		i--
+		if m.ClearSchedule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AmountPerBundle != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AmountPerBundle))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Amount != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
 		i--
@@ -1593,6 +1894,13 @@ func (m *MsgDefundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Amount != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
 		i--
@@ -1636,7 +1944,7 @@ func (m *MsgDefundPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
+func (m *MsgFundGasSponsorship) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1646,53 +1954,173 @@ func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFundGasSponsorship) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFundGasSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CompressionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompressionId))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StorageProviderId))
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x18
 	}
-	if len(m.Binaries) > 0 {
-		i -= len(m.Binaries)
-		copy(dAtA[i:], m.Binaries)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Binaries)))
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x10
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0xa
 	}
-	if m.MaxBundleSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxBundleSize))
-		i--
-		dAtA[i] = 0x50
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundGasSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MinDelegation != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MinDelegation))
-		i--
-		dAtA[i] = 0x48
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundGasSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundGasSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.OperatingCost != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OperatingCost))
-		i--
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevealWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.VotingMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VotingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.InvalidQuorum.Size()
+		i -= size
+		if _, err := m.InvalidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.ValidQuorum.Size()
+		i -= size
+		if _, err := m.ValidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.UploaderSelection != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UploaderSelection))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxStakers != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxStakers))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.FundingPolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FundingPolicy))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.CompressionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompressionId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxBundleSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinDelegation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinDelegation))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.OperatingCost != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OperatingCost))
+		i--
 		dAtA[i] = 0x40
 	}
 	if m.UploadInterval != 0 {
@@ -2180,6 +2608,22 @@ func (m *MsgFundPool) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovTx(uint64(m.Amount))
 	}
+	if m.AmountPerBundle != 0 {
+		n += 1 + sovTx(uint64(m.AmountPerBundle))
+	}
+	if m.StartAt != 0 {
+		n += 1 + sovTx(uint64(m.StartAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovTx(uint64(m.EndAt))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClearSchedule {
+		n += 2
+	}
 	return n
 }
 
@@ -2208,6 +2652,10 @@ func (m *MsgDefundPool) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovTx(uint64(m.Amount))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2220,6 +2668,34 @@ func (m *MsgDefundPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFundGasSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgFundGasSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgCreatePool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2276,6 +2752,25 @@ func (m *MsgCreatePool) Size() (n int) {
 	if m.CompressionId != 0 {
 		n += 1 + sovTx(uint64(m.CompressionId))
 	}
+	if m.FundingPolicy != 0 {
+		n += 1 + sovTx(uint64(m.FundingPolicy))
+	}
+	if m.MaxStakers != 0 {
+		n += 2 + sovTx(uint64(m.MaxStakers))
+	}
+	if m.UploaderSelection != 0 {
+		n += 2 + sovTx(uint64(m.UploaderSelection))
+	}
+	l = m.ValidQuorum.Size()
+	n += 2 + l + sovTx(uint64(l))
+	l = m.InvalidQuorum.Size()
+	n += 2 + l + sovTx(uint64(l))
+	if m.VotingMode != 0 {
+		n += 2 + sovTx(uint64(m.VotingMode))
+	}
+	if m.RevealWindow != 0 {
+		n += 2 + sovTx(uint64(m.RevealWindow))
+	}
 	return n
 }
 
@@ -2564,6 +3059,115 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBundle", wireType)
+			}
+			m.AmountPerBundle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountPerBundle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			}
+			m.StartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			m.EndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearSchedule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearSchedule = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2734,6 +3338,38 @@ func (m *MsgDefundPool) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2805,7 +3441,7 @@ func (m *MsgDefundPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgFundGasSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2828,15 +3464,15 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundGasSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundGasSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2864,13 +3500,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2880,18 +3516,188 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundGasSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundGasSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundGasSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
@@ -3204,6 +4010,169 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPolicy", wireType)
+			}
+			m.FundingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingPolicy |= FundingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakers", wireType)
+			}
+			m.MaxStakers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploaderSelection", wireType)
+			}
+			m.UploaderSelection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploaderSelection |= UploaderSelection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InvalidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingMode", wireType)
+			}
+			m.VotingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingMode |= VotingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealWindow", wireType)
+			}
+			m.RevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -33,6 +33,7 @@ func (k Keeper) AccountDelegationUnbondings(goCtx context.Context, req *types.Qu
 				Amount:       unbondingEntry.Amount,
 				CreationTime: unbondingEntry.CreationTime,
 				Staker:       k.GetFullStaker(ctx, unbondingEntry.Staker),
+				Index:        unbondingEntry.Index,
 			})
 		}
 		return true, nil
@@ -17,7 +17,7 @@ func (k Keeper) CurrentVoteStatus(c context.Context, req *types.QueryCurrentVote
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	_, found := k.poolKeeper.GetPool(ctx, req.PoolId)
+	pool, found := k.poolKeeper.GetPool(ctx, req.PoolId)
 	if !found {
 		return nil, sdkErrors.ErrKeyNotFound
 	}
@@ -29,5 +29,8 @@ func (k Keeper) CurrentVoteStatus(c context.Context, req *types.QueryCurrentVote
 		Invalid: voteDistribution.Invalid,
 		Abstain: voteDistribution.Abstain,
 		Total:   voteDistribution.Total,
+
+		ValidQuorum:   pool.GetEffectiveValidQuorum(),
+		InvalidQuorum: pool.GetEffectiveInvalidQuorum(),
 	}, nil
 }
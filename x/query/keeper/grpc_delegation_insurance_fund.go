@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/query/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) InsuranceFund(goCtx context.Context, req *types.QueryInsuranceFundRequest) (*types.QueryInsuranceFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryInsuranceFundResponse{
+		InsuranceFund: k.delegationKeeper.GetInsuranceFund(ctx),
+	}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/util"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/KYVENetwork/chain/x/query/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SlashesByStaker(goCtx context.Context, req *types.QuerySlashesByStakerRequest) (*types.QuerySlashesByStakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.delegationKeeper.StoreKey())
+	indexStore := prefix.NewStore(store, util.GetByteKey(delegationtypes.SlashRecordKeyPrefixIndex2, req.Staker))
+
+	slashes, pageRes, err := k.paginateSlashRecords(ctx, indexStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashesByStakerResponse{
+		Slashes:    slashes,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) SlashesByPool(goCtx context.Context, req *types.QuerySlashesByPoolRequest) (*types.QuerySlashesByPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.delegationKeeper.StoreKey())
+	indexStore := prefix.NewStore(store, util.GetByteKey(delegationtypes.SlashRecordKeyPrefixIndex3, req.PoolId))
+
+	slashes, pageRes, err := k.paginateSlashRecords(ctx, indexStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashesByPoolResponse{
+		Slashes:    slashes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateSlashRecords iterates the given slash record index store, whose remaining
+// keys are the ids of the slash records, and returns the full slash records.
+func (k Keeper) paginateSlashRecords(ctx sdk.Context, indexStore prefix.Store, pagination *query.PageRequest) ([]delegationtypes.SlashRecord, *query.PageResponse, error) {
+	slashes := make([]delegationtypes.SlashRecord, 0)
+
+	pageRes, err := query.FilteredPaginate(indexStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			slashRecord, found := k.delegationKeeper.GetSlashRecord(ctx, binary.BigEndian.Uint64(key[0:8]))
+			if !found {
+				return false, nil
+			}
+
+			slashes = append(slashes, slashRecord)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return slashes, pageRes, nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - grpc_delegation_slashes.go
+
+* Call slashes by staker without any slashes
+* Call slashes by staker after the staker got slashed
+* Call slashes by pool after stakers got slashed in different pools
+* Call slashes by staker with pagination
+
+*/
+
+var _ = Describe("grpc_delegation_slashes.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:        "PoolTest",
+			Protocol:    &pooltypes.Protocol{},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:        "PoolTest2",
+			Protocol:    &pooltypes.Protocol{},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     1,
+			Valaddress: i.VALADDRESS_1,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Call slashes by staker without any slashes", func() {
+		// ACT
+		res, err := s.App().QueryKeeper.SlashesByStaker(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByStakerRequest{
+			Staker: i.STAKER_0,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(res.Slashes).To(BeEmpty())
+	})
+
+	It("Call slashes by staker after the staker got slashed", func() {
+		// ARRANGE
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.STAKER_0, delegationtypes.SLASH_TYPE_UPLOAD, 3)
+
+		// ACT
+		res, err := s.App().QueryKeeper.SlashesByStaker(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByStakerRequest{
+			Staker: i.STAKER_0,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(res.Slashes).To(HaveLen(1))
+
+		uploadSlash := s.App().DelegationKeeper.GetUploadSlash(s.Ctx())
+
+		Expect(res.Slashes[0].Id).To(BeZero())
+		Expect(res.Slashes[0].Staker).To(Equal(i.STAKER_0))
+		Expect(res.Slashes[0].PoolId).To(BeZero())
+		Expect(res.Slashes[0].SlashType).To(Equal(delegationtypes.SLASH_TYPE_UPLOAD))
+		Expect(res.Slashes[0].Fraction).To(Equal(uploadSlash))
+		Expect(res.Slashes[0].Amount).To(Equal(uint64(sdk.NewDec(int64(100 * i.KYVE)).Mul(uploadSlash).TruncateInt64())))
+		Expect(res.Slashes[0].Height).To(Equal(uint64(s.Ctx().BlockHeight())))
+		Expect(res.Slashes[0].BundleId).To(Equal(uint64(3)))
+
+		Expect(s.App().DelegationKeeper.GetSlashRecordCount(s.Ctx())).To(Equal(uint64(1)))
+	})
+
+	It("Call slashes by pool after stakers got slashed in different pools", func() {
+		// ARRANGE
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.STAKER_0, delegationtypes.SLASH_TYPE_TIMEOUT, 0)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 1, i.STAKER_1, delegationtypes.SLASH_TYPE_VOTE, 0)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.STAKER_0, delegationtypes.SLASH_TYPE_VOTE, 1)
+
+		// ACT
+		resPool0, errPool0 := s.App().QueryKeeper.SlashesByPool(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByPoolRequest{
+			PoolId: 0,
+		})
+		resPool1, errPool1 := s.App().QueryKeeper.SlashesByPool(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByPoolRequest{
+			PoolId: 1,
+		})
+
+		// ASSERT
+		Expect(errPool0).To(BeNil())
+		Expect(resPool0.Slashes).To(HaveLen(2))
+		Expect(resPool0.Slashes[0].Id).To(Equal(uint64(0)))
+		Expect(resPool0.Slashes[0].SlashType).To(Equal(delegationtypes.SLASH_TYPE_TIMEOUT))
+		Expect(resPool0.Slashes[1].Id).To(Equal(uint64(2)))
+		Expect(resPool0.Slashes[1].SlashType).To(Equal(delegationtypes.SLASH_TYPE_VOTE))
+		Expect(resPool0.Slashes[1].BundleId).To(Equal(uint64(1)))
+
+		Expect(errPool1).To(BeNil())
+		Expect(resPool1.Slashes).To(HaveLen(1))
+		Expect(resPool1.Slashes[0].Id).To(Equal(uint64(1)))
+		Expect(resPool1.Slashes[0].Staker).To(Equal(i.STAKER_1))
+	})
+
+	It("Call slashes by staker with pagination", func() {
+		// ARRANGE
+		for r := uint64(0); r < 5; r++ {
+			s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.STAKER_0, delegationtypes.SLASH_TYPE_TIMEOUT, r)
+		}
+
+		// ACT
+		res, err := s.App().QueryKeeper.SlashesByStaker(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByStakerRequest{
+			Staker: i.STAKER_0,
+			Pagination: &query.PageRequest{
+				Offset:     1,
+				Limit:      2,
+				CountTotal: true,
+			},
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(res.Slashes).To(HaveLen(2))
+		Expect(res.Slashes[0].BundleId).To(Equal(uint64(1)))
+		Expect(res.Slashes[1].BundleId).To(Equal(uint64(2)))
+		Expect(res.Pagination.Total).To(Equal(uint64(5)))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/KYVENetwork/chain/x/query/types"
+	stakersTypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,11 +25,13 @@ func (k Keeper) CanValidate(c context.Context, req *types.QueryCanValidateReques
 	}
 
 	var staker string
+	var jailed bool
 
 	// Check if valaddress has a valaccount in pool
 	for _, valaccount := range k.stakerKeeper.GetAllValaccountsOfPool(ctx, req.PoolId) {
 		if valaccount.Valaddress == req.Valaddress {
 			staker = valaccount.Staker
+			jailed = valaccount.Jailed
 			break
 		}
 	}
@@ -40,6 +43,13 @@ func (k Keeper) CanValidate(c context.Context, req *types.QueryCanValidateReques
 		}, nil
 	}
 
+	if jailed {
+		return &types.QueryCanValidateResponse{
+			Possible: false,
+			Reason:   stakersTypes.ErrValaccountJailed.Error(),
+		}, nil
+	}
+
 	return &types.QueryCanValidateResponse{
 		Possible: true,
 		Reason:   staker,
@@ -20,6 +20,7 @@ TEST CASES - grpc_query_can_validate.go
 * Call can validate if valaddress does not exist
 * Call can validate with a valaddress which belongs to another pool
 * Call can validate with a valid valaddress
+* Call can validate with a jailed valaccount
 
 */
 
@@ -131,4 +132,21 @@ var _ = Describe("grpc_query_can_validate.go", Ordered, func() {
 		Expect(canValidate.Possible).To(BeTrue())
 		Expect(canValidate.Reason).To(Equal(i.STAKER_0))
 	})
+
+	It("Call can validate with a jailed valaccount", func() {
+		// ARRANGE
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		// ACT
+		canValidate, err := s.App().QueryKeeper.CanValidate(sdk.WrapSDKContext(s.Ctx()), &querytypes.QueryCanValidateRequest{
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(canValidate.Possible).To(BeFalse())
+		Expect(canValidate.Reason).To(Equal(stakertypes.ErrValaccountJailed.Error()))
+	})
 })
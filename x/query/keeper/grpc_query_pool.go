@@ -73,5 +73,6 @@ func (k Keeper) parsePoolResponse(ctx sdk.Context, pool *poolTypes.Pool) types.P
 		Status:              k.GetPoolStatus(ctx, pool),
 		Account:             poolAccount.String(),
 		AccountBalance:      poolBalance,
+		Runway:              pool.GetRunway(uint64(ctx.BlockTime().Unix())),
 	}
 }
@@ -28,6 +28,7 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) *types.Full
 		Details:                 staker.Details,
 		PendingCommissionChange: commissionChangeEntry,
 		CommissionRewards:       staker.CommissionRewards,
+		CommissionCoinRewards:   staker.CommissionCoinRewards,
 	}
 
 	delegationData, _ := k.delegationKeeper.GetDelegationData(ctx, staker.Address)
@@ -54,10 +55,13 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) *types.Full
 					TotalDelegation: k.delegationKeeper.GetDelegationOfPool(ctx, pool.Id),
 					Status:          k.GetPoolStatus(ctx, &pool),
 				},
-				Points:     valaccount.Points,
-				IsLeaving:  valaccount.IsLeaving,
-				Valaddress: valaccount.Valaddress,
-				Balance:    balanceValaccount,
+				Points:           valaccount.Points,
+				IsLeaving:        valaccount.IsLeaving,
+				Valaddress:       valaccount.Valaddress,
+				Balance:          balanceValaccount,
+				ConsecutiveVotes: valaccount.ConsecutiveVotes,
+				Jailed:           valaccount.Jailed,
+				JailedUntil:      valaccount.JailedUntil,
 			},
 		)
 	}
@@ -92,7 +96,7 @@ func (k Keeper) GetPoolStatus(ctx sdk.Context, pool *pooltypes.Pool) pooltypes.P
 		poolStatus = pooltypes.POOL_STATUS_DISABLED
 	} else if totalDelegation < pool.MinDelegation {
 		poolStatus = pooltypes.POOL_STATUS_NOT_ENOUGH_DELEGATION
-	} else if pool.TotalFunds == 0 {
+	} else if pool.TotalFunds == 0 && pool.TotalFundsPerDenom.IsZero() {
 		poolStatus = pooltypes.POOL_STATUS_NO_FUNDS
 	} else {
 		poolStatus = pooltypes.POOL_STATUS_ACTIVE
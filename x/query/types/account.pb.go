@@ -298,6 +298,8 @@ type DelegationUnbonding struct {
 	CreationTime uint64 `protobuf:"varint,2,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
 	// staker
 	Staker *FullStaker `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
+	// index is the index of the undelegation queue entry
+	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
 }
 
 func (m *DelegationUnbonding) Reset()         { *m = DelegationUnbonding{} }
@@ -354,6 +356,13 @@ func (m *DelegationUnbonding) GetStaker() *FullStaker {
 	return nil
 }
 
+func (m *DelegationUnbonding) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 // QueryAccountFundedListRequest is the request type for the account queries with pagination
 type QueryAccountFundedListRequest struct {
 	// address ...
@@ -674,63 +683,64 @@ func init() {
 func init() { proto.RegisterFile("kyve/query/v1beta1/account.proto", fileDescriptor_51ca316755261aec) }
 
 var fileDescriptor_51ca316755261aec = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
+	// 905 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x26, 0xc1, 0x15, 0x2f, 0x2d, 0xa5, 0x13, 0x54, 0x99, 0x0d, 0x5e, 0x47, 0x8b, 0xc0,
-	0xa1, 0x82, 0x5d, 0xd9, 0x09, 0xa8, 0x25, 0x70, 0xa8, 0x9b, 0x84, 0x43, 0x01, 0x95, 0x35, 0x20,
-	0xb5, 0x97, 0xd5, 0x78, 0x77, 0xbc, 0x59, 0x65, 0xbd, 0xe3, 0xee, 0x8c, 0x13, 0x2c, 0xc4, 0x85,
-	0x13, 0x02, 0x0e, 0x48, 0xfc, 0x05, 0xfe, 0x02, 0xb7, 0x9e, 0x38, 0xf5, 0x58, 0x89, 0x0b, 0x17,
-	0x10, 0x4a, 0xf8, 0x21, 0x68, 0x67, 0x66, 0xed, 0x31, 0x5e, 0xdb, 0xa4, 0xb7, 0xdd, 0x37, 0xef,
-	0x7b, 0xf3, 0x7d, 0xf3, 0xbe, 0x37, 0x03, 0xdb, 0x27, 0xa3, 0x53, 0xe2, 0x3e, 0x1e, 0x92, 0x6c,
-	0xe4, 0x9e, 0x36, 0xbb, 0x84, 0xe3, 0xa6, 0x8b, 0x83, 0x80, 0x0e, 0x53, 0xee, 0x0c, 0x32, 0xca,
-	0x29, 0x42, 0x79, 0x86, 0x23, 0x32, 0x1c, 0x95, 0x61, 0xde, 0x0a, 0x28, 0xeb, 0x53, 0xe6, 0x76,
-	0x31, 0xfb, 0x2f, 0x78, 0x80, 0xa3, 0x38, 0xc5, 0x3c, 0xa6, 0xa9, 0xc4, 0x9b, 0xaf, 0x44, 0x34,
-	0xa2, 0xe2, 0xd3, 0xcd, 0xbf, 0x54, 0xf4, 0xb5, 0x88, 0xd2, 0x28, 0x21, 0x2e, 0x1e, 0xc4, 0x2e,
-	0x4e, 0x53, 0xca, 0x05, 0x84, 0xa9, 0x55, 0xab, 0x84, 0x95, 0x64, 0x20, 0xd6, 0xed, 0x77, 0xe1,
-	0xd5, 0xcf, 0xf2, 0xdf, 0xbb, 0x92, 0xe9, 0x5d, 0xc6, 0x08, 0x67, 0x1e, 0x79, 0x3c, 0x24, 0x8c,
-	0xa3, 0x2a, 0x5c, 0xc1, 0x61, 0x98, 0x11, 0xc6, 0xaa, 0xc6, 0xb6, 0xb1, 0xf3, 0xa2, 0x57, 0xfc,
-	0xda, 0xdf, 0xad, 0x81, 0x59, 0x86, 0x63, 0x03, 0x9a, 0x32, 0x92, 0x03, 0xbb, 0x38, 0xc1, 0x69,
-	0x40, 0x04, 0x70, 0xdd, 0x2b, 0x7e, 0xd1, 0x6d, 0xa8, 0x8a, 0x8d, 0x03, 0x9a, 0xf8, 0x8c, 0x24,
-	0x3d, 0x3f, 0x24, 0x09, 0x89, 0x04, 0xe5, 0xea, 0xaa, 0x48, 0xbd, 0x59, 0xac, 0x77, 0x48, 0xd2,
-	0x3b, 0x18, 0xaf, 0xa2, 0xfb, 0x60, 0xcf, 0x43, 0xfa, 0xc3, 0xb4, 0x4b, 0xd3, 0x30, 0x4e, 0xa3,
-	0xea, 0x9a, 0xa8, 0x51, 0x2f, 0xaf, 0xf1, 0x45, 0x91, 0x86, 0x5c, 0xd8, 0x1c, 0x17, 0xd3, 0x18,
-	0xac, 0x0b, 0x34, 0x2a, 0x96, 0xb4, 0xdd, 0xdb, 0x50, 0x2b, 0x01, 0x68, 0x1b, 0xbf, 0x20, 0xa0,
-	0x5b, 0xb3, 0xd0, 0xc9, 0xa6, 0x6f, 0xc1, 0xcb, 0xe3, 0x1a, 0x19, 0x39, 0xc3, 0x59, 0xc8, 0xaa,
-	0x15, 0x01, 0xbb, 0x5e, 0xc4, 0x3d, 0x19, 0x9e, 0x4a, 0xed, 0x0d, 0xe5, 0x0e, 0x57, 0xa6, 0x53,
-	0x8f, 0x64, 0xd8, 0xfe, 0xc1, 0x80, 0x86, 0xde, 0x8a, 0x92, 0x9d, 0xc7, 0x0d, 0x3d, 0x02, 0x98,
-	0xb8, 0x4a, 0xb4, 0x66, 0xa3, 0xf5, 0xa6, 0x23, 0x2d, 0xe8, 0xe4, 0x16, 0x9c, 0x76, 0xa7, 0xf3,
-	0x00, 0x47, 0x44, 0x61, 0x3d, 0x0d, 0xa9, 0x1b, 0x63, 0x75, 0xda, 0x18, 0xbf, 0x19, 0xb0, 0xb3,
-	0x9c, 0x8d, 0xb2, 0xc9, 0x27, 0x00, 0xe3, 0x03, 0xcc, 0x2d, 0xb6, 0xb6, 0xb3, 0xd1, 0x6a, 0x38,
-	0xb3, 0x53, 0xe2, 0x94, 0x54, 0x69, 0xaf, 0x3f, 0xfd, 0xab, 0xbe, 0xe2, 0x69, 0x05, 0xd0, 0x47,
-	0x53, 0xea, 0x56, 0x85, 0xba, 0xc6, 0x52, 0x75, 0x92, 0x8b, 0x2e, 0xcf, 0xfe, 0xde, 0x80, 0xcd,
-	0xb2, 0x06, 0xde, 0x84, 0x0a, 0xee, 0xe7, 0xaa, 0x94, 0xab, 0xd5, 0x1f, 0x7a, 0x1d, 0xae, 0x05,
-	0x19, 0x91, 0x8e, 0xe0, 0x71, 0x9f, 0x28, 0x27, 0x5f, 0x2d, 0x82, 0x9f, 0xc7, 0x7d, 0x82, 0xde,
-	0x83, 0x0a, 0xe3, 0xf8, 0x84, 0x64, 0xc2, 0xa3, 0x1b, 0x2d, 0xab, 0x4c, 0xe8, 0xd1, 0x30, 0x49,
-	0x3a, 0x22, 0xcb, 0x53, 0xd9, 0xf6, 0x1d, 0xa8, 0xe9, 0x07, 0x9a, 0xb7, 0x9d, 0x84, 0x1f, 0xc7,
-	0x8c, 0x2f, 0x9f, 0xd2, 0x47, 0x60, 0xcd, 0x83, 0xaa, 0x0e, 0xdc, 0x86, 0x4a, 0x4f, 0x44, 0xd5,
-	0xe9, 0x9b, 0xe5, 0xa4, 0xf2, 0x0c, 0x75, 0xe0, 0x2a, 0xdf, 0xee, 0x40, 0x45, 0xc6, 0xe7, 0x9e,
-	0x4a, 0x13, 0xd6, 0x07, 0x94, 0x26, 0xaa, 0x11, 0xb5, 0xb2, 0xca, 0x6d, 0xcc, 0xe2, 0xe0, 0x01,
-	0xa5, 0x89, 0x27, 0x52, 0xed, 0x7d, 0xa8, 0xeb, 0x84, 0x3d, 0x32, 0x19, 0xb5, 0xe5, 0x6a, 0x9f,
-	0x18, 0xb0, 0x3d, 0x1f, 0xad, 0x04, 0x53, 0xa8, 0x65, 0x5a, 0xdc, 0x0f, 0x28, 0x4d, 0x42, 0x7a,
-	0x96, 0xfa, 0x24, 0xe5, 0x59, 0x4c, 0x0a, 0x17, 0xbe, 0x51, 0xc6, 0x56, 0x2f, 0x78, 0x98, 0xf2,
-	0x6c, 0xa4, 0x8e, 0x64, 0x4b, 0xaf, 0x78, 0x4f, 0x15, 0x3c, 0x94, 0xf5, 0x50, 0x03, 0xae, 0xe3,
-	0x53, 0x1c, 0x27, 0xb8, 0x9b, 0x10, 0x9f, 0x25, 0x94, 0x33, 0xe5, 0x8e, 0x97, 0xc6, 0xe1, 0x4e,
-	0x1e, 0xb5, 0x1f, 0xc2, 0x8d, 0x99, 0x0d, 0xa6, 0x9c, 0x15, 0x62, 0x5e, 0x5c, 0xa7, 0x63, 0x67,
-	0x1d, 0x60, 0x4e, 0x50, 0x1d, 0x36, 0x7a, 0x71, 0x1a, 0xb3, 0x63, 0x99, 0x22, 0xcb, 0x83, 0x0c,
-	0xe5, 0x09, 0xad, 0x1f, 0x2b, 0x70, 0x55, 0x3f, 0x19, 0xf4, 0x8b, 0x01, 0xd7, 0xa6, 0x6e, 0x6e,
-	0xf4, 0x4e, 0x99, 0xe0, 0xb9, 0x2f, 0x83, 0xe9, 0xfc, 0xdf, 0x74, 0x79, 0xec, 0xf6, 0xde, 0xb7,
-	0xbf, 0xff, 0xf3, 0xf3, 0xaa, 0x83, 0xde, 0x76, 0xe7, 0xbf, 0x92, 0x3e, 0x16, 0x18, 0xf7, 0x6b,
-	0xd5, 0xd0, 0x6f, 0xd0, 0x9f, 0x06, 0x6c, 0x2d, 0xb8, 0x47, 0xd0, 0xfe, 0x32, 0x16, 0x0b, 0xee,
-	0x42, 0xf3, 0x83, 0xe7, 0x03, 0x2b, 0x41, 0xf7, 0x84, 0xa0, 0x0f, 0xd1, 0xfe, 0x22, 0x41, 0x65,
-	0x0f, 0x85, 0xae, 0xef, 0x57, 0x03, 0x6e, 0xcc, 0xcc, 0x26, 0x6a, 0x2e, 0x23, 0x36, 0x73, 0x05,
-	0x98, 0xad, 0xcb, 0x40, 0x94, 0x82, 0x3b, 0x42, 0xc1, 0x2e, 0x6a, 0x2e, 0x52, 0x20, 0x87, 0xdd,
-	0x4f, 0x62, 0xc6, 0x35, 0xde, 0x4f, 0x0c, 0xd8, 0x2c, 0x19, 0x32, 0xb4, 0xbb, 0x8c, 0x46, 0xc9,
-	0x40, 0x9b, 0x7b, 0x97, 0x03, 0x29, 0xf6, 0xef, 0x0b, 0xf6, 0x7b, 0xa8, 0xb5, 0x88, 0xbd, 0x3e,
-	0x97, 0x13, 0xfa, 0xed, 0x83, 0xa7, 0xe7, 0x96, 0xf1, 0xec, 0xdc, 0x32, 0xfe, 0x3e, 0xb7, 0x8c,
-	0x9f, 0x2e, 0xac, 0x95, 0x67, 0x17, 0xd6, 0xca, 0x1f, 0x17, 0xd6, 0xca, 0xa3, 0x5b, 0x51, 0xcc,
-	0x8f, 0x87, 0x5d, 0x27, 0xa0, 0x7d, 0xf7, 0xfe, 0xc3, 0x2f, 0x0f, 0x3f, 0x25, 0xfc, 0x8c, 0x66,
-	0x27, 0x6e, 0x70, 0x8c, 0xe3, 0xd4, 0xfd, 0x4a, 0x6d, 0xc3, 0x47, 0x03, 0xc2, 0xba, 0x15, 0xf1,
-	0x10, 0xef, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x5b, 0x16, 0x72, 0xf8, 0x09, 0x00, 0x00,
+	0x14, 0xce, 0x26, 0xa9, 0x2b, 0x5e, 0x5a, 0x4a, 0x27, 0x55, 0x65, 0x1c, 0xb2, 0x89, 0x16, 0x41,
+	0x42, 0x05, 0xbb, 0xb2, 0x13, 0x50, 0x4b, 0xe0, 0xd0, 0x34, 0x09, 0x87, 0x02, 0x2a, 0x1b, 0x40,
+	0x6a, 0x2f, 0xab, 0xf1, 0xee, 0x78, 0x33, 0xca, 0x7a, 0xc6, 0xdd, 0x19, 0x27, 0x8d, 0x10, 0x17,
+	0x4e, 0x48, 0x70, 0x40, 0xe2, 0x0f, 0x70, 0xe0, 0x2f, 0x70, 0xeb, 0x89, 0x53, 0x8f, 0x95, 0xb8,
+	0x70, 0x01, 0xa1, 0x84, 0x1f, 0x82, 0x76, 0x66, 0xd6, 0x1e, 0xe3, 0xb5, 0x4d, 0xb9, 0xed, 0xbc,
+	0x79, 0xdf, 0x9b, 0xef, 0x9b, 0xf7, 0xbd, 0x59, 0x58, 0x3f, 0x3e, 0x3b, 0x21, 0xc1, 0xe3, 0x3e,
+	0xc9, 0xcf, 0x82, 0x93, 0x66, 0x9b, 0x48, 0xdc, 0x0c, 0x70, 0x1c, 0xf3, 0x3e, 0x93, 0x7e, 0x2f,
+	0xe7, 0x92, 0x23, 0x54, 0x64, 0xf8, 0x2a, 0xc3, 0x37, 0x19, 0x8d, 0x5b, 0x31, 0x17, 0x5d, 0x2e,
+	0x82, 0x36, 0x16, 0xff, 0x06, 0xf7, 0x70, 0x4a, 0x19, 0x96, 0x94, 0x33, 0x8d, 0x6f, 0xdc, 0x48,
+	0x79, 0xca, 0xd5, 0x67, 0x50, 0x7c, 0x99, 0xe8, 0x6b, 0x29, 0xe7, 0x69, 0x46, 0x02, 0xdc, 0xa3,
+	0x01, 0x66, 0x8c, 0x4b, 0x05, 0x11, 0x66, 0xd7, 0xad, 0x60, 0xa5, 0x19, 0xa8, 0x7d, 0xef, 0x5d,
+	0x78, 0xf5, 0xb3, 0x62, 0x79, 0x57, 0x33, 0xbd, 0x2b, 0x04, 0x91, 0x22, 0x24, 0x8f, 0xfb, 0x44,
+	0x48, 0x54, 0x87, 0xcb, 0x38, 0x49, 0x72, 0x22, 0x44, 0xdd, 0x59, 0x77, 0x36, 0x5f, 0x0a, 0xcb,
+	0xa5, 0xf7, 0xed, 0x02, 0x34, 0xaa, 0x70, 0xa2, 0xc7, 0x99, 0x20, 0x05, 0xb0, 0x8d, 0x33, 0xcc,
+	0x62, 0xa2, 0x80, 0x8b, 0x61, 0xb9, 0x44, 0xb7, 0xa1, 0xae, 0x0e, 0x8e, 0x79, 0x16, 0x09, 0x92,
+	0x75, 0xa2, 0x84, 0x64, 0x24, 0x55, 0x94, 0xeb, 0xf3, 0x2a, 0xf5, 0x66, 0xb9, 0x7f, 0x48, 0xb2,
+	0xce, 0xde, 0x60, 0x17, 0xdd, 0x07, 0x6f, 0x12, 0x32, 0xea, 0xb3, 0x36, 0x67, 0x09, 0x65, 0x69,
+	0x7d, 0x41, 0xd5, 0x58, 0xab, 0xae, 0xf1, 0x45, 0x99, 0x86, 0x02, 0x58, 0x1e, 0x14, 0xb3, 0x18,
+	0x2c, 0x2a, 0x34, 0x2a, 0xb7, 0xac, 0xd3, 0x77, 0x61, 0xb5, 0x02, 0x60, 0x1d, 0x7c, 0x49, 0x41,
+	0x57, 0xc6, 0xa1, 0xc3, 0x43, 0xdf, 0x82, 0x57, 0x06, 0x35, 0x72, 0x72, 0x8a, 0xf3, 0x44, 0xd4,
+	0x6b, 0x0a, 0x76, 0xad, 0x8c, 0x87, 0x3a, 0x3c, 0x92, 0xda, 0xe9, 0xeb, 0x13, 0x2e, 0x8f, 0xa6,
+	0x1e, 0xe8, 0xb0, 0xf7, 0x9d, 0x03, 0x1b, 0x76, 0x2b, 0x2a, 0x4e, 0x1e, 0x34, 0xf4, 0x00, 0x60,
+	0xe8, 0x2a, 0xd5, 0x9a, 0xa5, 0xd6, 0x9b, 0xbe, 0xb6, 0xa0, 0x5f, 0x58, 0x70, 0xd4, 0x9d, 0xfe,
+	0x03, 0x9c, 0x12, 0x83, 0x0d, 0x2d, 0xa4, 0x6d, 0x8c, 0xf9, 0x51, 0x63, 0xfc, 0xea, 0xc0, 0xe6,
+	0x6c, 0x36, 0xc6, 0x26, 0x9f, 0x00, 0x0c, 0x2e, 0xb0, 0xb0, 0xd8, 0xc2, 0xe6, 0x52, 0x6b, 0xc3,
+	0x1f, 0x9f, 0x12, 0xbf, 0xa2, 0xca, 0xee, 0xe2, 0xb3, 0x3f, 0xd7, 0xe6, 0x42, 0xab, 0x00, 0xfa,
+	0x68, 0x44, 0xdd, 0xbc, 0x52, 0xb7, 0x31, 0x53, 0x9d, 0xe6, 0x62, 0xcb, 0xf3, 0x7e, 0x72, 0x60,
+	0xb9, 0xaa, 0x81, 0x37, 0xa1, 0x86, 0xbb, 0x85, 0x2a, 0xe3, 0x6a, 0xb3, 0x42, 0xaf, 0xc3, 0xd5,
+	0x38, 0x27, 0xda, 0x11, 0x92, 0x76, 0x89, 0x71, 0xf2, 0x95, 0x32, 0xf8, 0x39, 0xed, 0x12, 0xf4,
+	0x1e, 0xd4, 0x84, 0xc4, 0xc7, 0x24, 0x57, 0x1e, 0x5d, 0x6a, 0xb9, 0x55, 0x42, 0x0f, 0xfa, 0x59,
+	0x76, 0xa8, 0xb2, 0x42, 0x93, 0x8d, 0x6e, 0xc0, 0x25, 0xca, 0x12, 0xf2, 0xc4, 0x98, 0x53, 0x2f,
+	0xbc, 0x3b, 0xb0, 0x6a, 0x5f, 0x73, 0x61, 0x06, 0x92, 0x7c, 0x4c, 0x85, 0x9c, 0x3d, 0xbb, 0x8f,
+	0xc0, 0x9d, 0x04, 0x35, 0x7d, 0xb9, 0x0d, 0xb5, 0x8e, 0x8a, 0x9a, 0x9e, 0x34, 0xaa, 0xa9, 0x16,
+	0x19, 0xa6, 0x0d, 0x26, 0xdf, 0x3b, 0x84, 0x9a, 0x8e, 0x4f, 0xbc, 0xab, 0x26, 0x2c, 0xf6, 0x38,
+	0xcf, 0x4c, 0x7b, 0x56, 0xab, 0x2a, 0xef, 0x62, 0x41, 0xe3, 0x07, 0x9c, 0x67, 0xa1, 0x4a, 0xf5,
+	0x76, 0x60, 0xcd, 0x26, 0x1c, 0x92, 0xe1, 0x00, 0xce, 0x56, 0xfb, 0xd4, 0x81, 0xf5, 0xc9, 0x68,
+	0x23, 0x98, 0xc3, 0x6a, 0x6e, 0xc5, 0xa3, 0x98, 0xf3, 0x2c, 0xe1, 0xa7, 0x2c, 0x22, 0x4c, 0xe6,
+	0x94, 0x94, 0xde, 0x7c, 0xa3, 0x8a, 0xad, 0x5d, 0x70, 0x9f, 0xc9, 0xfc, 0xcc, 0x5c, 0xc9, 0x8a,
+	0x5d, 0xf1, 0x9e, 0x29, 0xb8, 0xaf, 0xeb, 0xa1, 0x0d, 0xb8, 0x86, 0x4f, 0x30, 0xcd, 0x70, 0x3b,
+	0x23, 0x91, 0xc8, 0xb8, 0x14, 0xc6, 0x33, 0x2f, 0x0f, 0xc2, 0x87, 0x45, 0xd4, 0x7b, 0x08, 0xd7,
+	0xc7, 0x0e, 0x18, 0xf1, 0x5b, 0x82, 0x65, 0xf9, 0xc8, 0x0e, 0xfc, 0xb6, 0x87, 0x25, 0x41, 0x6b,
+	0xb0, 0xd4, 0xa1, 0x8c, 0x8a, 0x23, 0x9d, 0xa2, 0xcb, 0x83, 0x0e, 0x15, 0x09, 0xad, 0xef, 0x6b,
+	0x70, 0xc5, 0xbe, 0x19, 0xf4, 0xb3, 0x03, 0x57, 0x47, 0xde, 0x73, 0xf4, 0x4e, 0x95, 0xe0, 0x89,
+	0xff, 0x8b, 0x86, 0xff, 0x5f, 0xd3, 0xf5, 0xb5, 0x7b, 0xdb, 0xdf, 0xfc, 0xf6, 0xf7, 0x8f, 0xf3,
+	0x3e, 0x7a, 0x3b, 0x98, 0xfc, 0xef, 0x8c, 0xb0, 0xc2, 0x04, 0x5f, 0x99, 0x86, 0x7e, 0x8d, 0xfe,
+	0x70, 0x60, 0x65, 0xca, 0xeb, 0x82, 0x76, 0x66, 0xb1, 0x98, 0xf2, 0x42, 0x36, 0x3e, 0xf8, 0x7f,
+	0x60, 0x23, 0xe8, 0x9e, 0x12, 0xf4, 0x21, 0xda, 0x99, 0x26, 0xa8, 0xea, 0xf7, 0x61, 0xeb, 0xfb,
+	0xc5, 0x81, 0xeb, 0x63, 0xb3, 0x89, 0x9a, 0xb3, 0x88, 0x8d, 0x3d, 0x01, 0x8d, 0xd6, 0x8b, 0x40,
+	0x8c, 0x82, 0x3b, 0x4a, 0xc1, 0x16, 0x6a, 0x4e, 0x53, 0xa0, 0x87, 0x3d, 0xca, 0xa8, 0x90, 0x16,
+	0xef, 0xa7, 0x0e, 0x2c, 0x57, 0x0c, 0x19, 0xda, 0x9a, 0x45, 0xa3, 0x62, 0xa0, 0x1b, 0xdb, 0x2f,
+	0x06, 0x32, 0xec, 0xdf, 0x57, 0xec, 0xb7, 0x51, 0x6b, 0x1a, 0x7b, 0x7b, 0x2e, 0x87, 0xf4, 0x77,
+	0xf7, 0x9e, 0x9d, 0xbb, 0xce, 0xf3, 0x73, 0xd7, 0xf9, 0xeb, 0xdc, 0x75, 0x7e, 0xb8, 0x70, 0xe7,
+	0x9e, 0x5f, 0xb8, 0x73, 0xbf, 0x5f, 0xb8, 0x73, 0x8f, 0x6e, 0xa5, 0x54, 0x1e, 0xf5, 0xdb, 0x7e,
+	0xcc, 0xbb, 0xc1, 0xfd, 0x87, 0x5f, 0xee, 0x7f, 0x4a, 0xe4, 0x29, 0xcf, 0x8f, 0x83, 0xf8, 0x08,
+	0x53, 0x16, 0x3c, 0x31, 0xc7, 0xc8, 0xb3, 0x1e, 0x11, 0xed, 0x9a, 0xfa, 0x3d, 0x6f, 0xfd, 0x33,
+	0x00, 0xcd, 0xff, 0x61, 0xe3, 0x0e, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1128,6 +1138,11 @@ func (m *DelegationUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Index != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Staker != nil {
 		{
 			size, err := m.Staker.MarshalToSizedBuffer(dAtA[:i])
@@ -1471,6 +1486,9 @@ func (m *DelegationUnbonding) Size() (n int) {
 		l = m.Staker.Size()
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if m.Index != 0 {
+		n += 1 + sovAccount(uint64(m.Index))
+	}
 	return n
 }
 
@@ -2176,6 +2194,25 @@ func (m *DelegationUnbonding) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -62,6 +63,8 @@ type FinalizedBundle struct {
 	// stake_security defines the amount of stake which was present in the pool during the finalization of the bundle.
 	// This field was added in schema version 2. Bundles finalized before that return `null`.
 	StakeSecurity *StakeSecurity `protobuf:"bytes,14,opt,name=stake_security,json=stakeSecurity,proto3" json:"stake_security,omitempty"`
+	// invalidated is true if the bundle was successfully challenged after its finalization
+	Invalidated bool `protobuf:"varint,15,opt,name=invalidated,proto3" json:"invalidated,omitempty"`
 }
 
 func (m *FinalizedBundle) Reset()         { *m = FinalizedBundle{} }
@@ -195,6 +198,13 @@ func (m *FinalizedBundle) GetStakeSecurity() *StakeSecurity {
 	return nil
 }
 
+func (m *FinalizedBundle) GetInvalidated() bool {
+	if m != nil {
+		return m.Invalidated
+	}
+	return false
+}
+
 // FinalizedAt stores information about finalization block and time.
 type FinalizedAt struct {
 	// height is the block height in which the bundle got finalized.
@@ -245,11 +255,17 @@ func (m *FinalizedAt) GetTimestamp() string {
 
 // StakeSecurity represents the relative security of a finalized bundle
 type StakeSecurity struct {
-	// valid_vote_power gives the amount of $KYVE stake that voted `valid`.
+	// valid_vote_power gives the effective voting power of all stakers that voted `valid`.
 	ValidVotePower *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=valid_vote_power,json=validVotePower,proto3,customtype=cosmossdk.io/math.Int" json:"valid_vote_power,omitempty"`
-	// total_vote_power gives the amount of total $KYVE stake that was present in the pool
+	// total_vote_power gives the total effective voting power that was present in the pool
 	// during finalization.
 	TotalVotePower *cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_vote_power,json=totalVotePower,proto3,customtype=cosmossdk.io/math.Int" json:"total_vote_power,omitempty"`
+	// raw_valid_vote_power gives the amount of $KYVE stake that voted `valid` before
+	// the voting power curve was applied.
+	RawValidVotePower *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=raw_valid_vote_power,json=rawValidVotePower,proto3,customtype=cosmossdk.io/math.Int" json:"raw_valid_vote_power,omitempty"`
+	// raw_total_vote_power gives the amount of total $KYVE stake that was present in the pool
+	// during finalization before the voting power curve was applied.
+	RawTotalVotePower *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=raw_total_vote_power,json=rawTotalVotePower,proto3,customtype=cosmossdk.io/math.Int" json:"raw_total_vote_power,omitempty"`
 }
 
 func (m *StakeSecurity) Reset()         { *m = StakeSecurity{} }
@@ -562,6 +578,12 @@ type QueryCurrentVoteStatusResponse struct {
 	Abstain uint64 `protobuf:"varint,3,opt,name=abstain,proto3" json:"abstain,omitempty"`
 	// total ...
 	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// valid_quorum is the fraction of the total voting power
+	// which has to vote valid for the bundle to be finalized
+	ValidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=valid_quorum,json=validQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_quorum"`
+	// invalid_quorum is the fraction of the total voting power
+	// which has to vote invalid for the bundle to be dropped
+	InvalidQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=invalid_quorum,json=invalidQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"invalid_quorum"`
 }
 
 func (m *QueryCurrentVoteStatusResponse) Reset()         { *m = QueryCurrentVoteStatusResponse{} }
@@ -1012,82 +1034,88 @@ func init() {
 func init() { proto.RegisterFile("kyve/query/v1beta1/bundles.proto", fileDescriptor_b49b126c38ac815c) }
 
 var fileDescriptor_b49b126c38ac815c = []byte{
-	// 1191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xba, 0x8e, 0x7f, 0x3c, 0x37, 0xa1, 0x99, 0x26, 0xe9, 0xd6, 0x4d, 0x9d, 0x74, 0x11,
-	0x24, 0x4a, 0xd1, 0x2e, 0x49, 0x0f, 0xd0, 0x13, 0x22, 0x2d, 0xa1, 0x6e, 0x4b, 0x15, 0x36, 0x52,
-	0x24, 0x38, 0x60, 0x8d, 0xbd, 0x13, 0x7b, 0x15, 0x7b, 0x67, 0xbb, 0x33, 0x76, 0x6b, 0x22, 0x4b,
-	0x08, 0x71, 0xe2, 0x84, 0x84, 0x8a, 0xc4, 0x8d, 0x2b, 0xdc, 0x39, 0x70, 0xe3, 0xd8, 0x63, 0x25,
-	0x2e, 0x88, 0x43, 0x85, 0x12, 0xfe, 0x10, 0x34, 0x3f, 0xd6, 0xde, 0x38, 0x76, 0x9c, 0xb6, 0x37,
-	0xbf, 0x37, 0xef, 0xbd, 0xf9, 0xde, 0xb7, 0xef, 0x7d, 0xbb, 0x86, 0x95, 0x83, 0x6e, 0x87, 0x38,
-	0x8f, 0xdb, 0x24, 0xea, 0x3a, 0x9d, 0x8d, 0x2a, 0xe1, 0x78, 0xc3, 0xa9, 0xb6, 0x03, 0xaf, 0x49,
-	0x98, 0x1d, 0x46, 0x94, 0x53, 0x84, 0x44, 0x84, 0x2d, 0x23, 0x6c, 0x1d, 0x51, 0x5c, 0xaf, 0x51,
-	0xd6, 0xa2, 0xcc, 0xa9, 0x62, 0x36, 0x9c, 0x1c, 0xe2, 0xba, 0x1f, 0x60, 0xee, 0xd3, 0x40, 0xe5,
-	0x17, 0xe7, 0xeb, 0xb4, 0x4e, 0xe5, 0x4f, 0x47, 0xfc, 0xd2, 0xde, 0xa5, 0x3a, 0xa5, 0xf5, 0x26,
-	0x71, 0x70, 0xe8, 0x3b, 0x38, 0x08, 0x28, 0x97, 0x29, 0xfa, 0x4e, 0xeb, 0xfb, 0x34, 0xbc, 0xb5,
-	0xed, 0x07, 0xb8, 0xe9, 0x7f, 0x4d, 0xbc, 0x2d, 0x09, 0x07, 0x5d, 0x81, 0x6c, 0x48, 0x69, 0xb3,
-	0xe2, 0x7b, 0xa6, 0xb1, 0x62, 0xac, 0xa5, 0xdd, 0x8c, 0x30, 0xcb, 0x1e, 0x9a, 0x85, 0x94, 0xef,
-	0x99, 0x29, 0xe9, 0x4b, 0xf9, 0x1e, 0xba, 0x0e, 0xc0, 0x38, 0x8d, 0x70, 0x9d, 0x88, 0xd8, 0x0b,
-	0x2b, 0xc6, 0x5a, 0xde, 0xcd, 0x6b, 0x4f, 0xd9, 0x43, 0x45, 0xc8, 0xb5, 0xc3, 0x26, 0xc5, 0x1e,
-	0x89, 0xcc, 0xb4, 0x3c, 0xec, 0xdb, 0x22, 0x75, 0x3f, 0xa2, 0xad, 0x8a, 0x1f, 0x78, 0xe4, 0xa9,
-	0x39, 0x2d, 0x4b, 0xe6, 0x85, 0xa7, 0x2c, 0x1c, 0xe8, 0x2a, 0xe4, 0x38, 0xd5, 0x87, 0x19, 0x79,
-	0x98, 0xe5, 0xb4, 0x7f, 0x24, 0x33, 0x0f, 0x48, 0xd7, 0x2c, 0xc8, 0xaa, 0x59, 0x61, 0x3f, 0x20,
-	0x5d, 0xb4, 0x00, 0x19, 0x4e, 0xe5, 0x41, 0x56, 0x1e, 0x4c, 0x73, 0x2a, 0xdc, 0xef, 0xc0, 0xac,
-	0x22, 0xba, 0xc2, 0xda, 0xad, 0x16, 0x8e, 0xba, 0x66, 0x4e, 0x1e, 0xcf, 0x28, 0xef, 0xae, 0x72,
-	0xa2, 0x6b, 0x90, 0xf7, 0x30, 0xc7, 0x95, 0x06, 0x66, 0x0d, 0x33, 0xaf, 0xf0, 0x0a, 0xc7, 0x3d,
-	0xcc, 0x1a, 0x68, 0x0b, 0x2e, 0xee, 0xc7, 0x34, 0x55, 0x30, 0x37, 0x61, 0xc5, 0x58, 0x2b, 0x6c,
-	0x2e, 0xdb, 0xa7, 0x1f, 0x99, 0xdd, 0xa7, 0xf3, 0x63, 0xee, 0x16, 0xf6, 0x07, 0x06, 0xb2, 0xe1,
-	0x72, 0x4c, 0x57, 0x18, 0xd1, 0x8e, 0xef, 0x91, 0x48, 0xf0, 0x76, 0x51, 0xf6, 0x37, 0xa7, 0x8f,
-	0x76, 0xf4, 0x49, 0xd9, 0x13, 0xb8, 0x6b, 0xb4, 0x15, 0x46, 0x84, 0x31, 0x9f, 0x06, 0x22, 0x74,
-	0x46, 0x86, 0xce, 0x24, 0xbc, 0x65, 0x0f, 0xdd, 0x83, 0x59, 0xc6, 0xf1, 0x01, 0xa9, 0x30, 0x52,
-	0x6b, 0x47, 0x3e, 0xef, 0x9a, 0xb3, 0x12, 0xdc, 0x8d, 0x51, 0xe0, 0x76, 0x45, 0xe4, 0xae, 0x0e,
-	0x74, 0x67, 0x58, 0xd2, 0xb4, 0xbe, 0x82, 0x42, 0x02, 0x3c, 0xda, 0x80, 0x4c, 0x83, 0xf8, 0xf5,
-	0x06, 0x97, 0x63, 0x90, 0xdf, 0xba, 0xfa, 0xcf, 0xcb, 0xe5, 0x05, 0x35, 0x8f, 0xcc, 0x3b, 0xb0,
-	0x7d, 0xea, 0xb4, 0x30, 0x6f, 0xd8, 0xe5, 0x80, 0xbb, 0x3a, 0x10, 0x2d, 0x41, 0x9e, 0xfb, 0x2d,
-	0xc2, 0x38, 0x6e, 0x85, 0x72, 0x50, 0xf2, 0xee, 0xc0, 0x61, 0xfd, 0x6c, 0xc0, 0xcc, 0x09, 0x00,
-	0xe8, 0x0e, 0x5c, 0xea, 0xe0, 0xa6, 0xef, 0x55, 0x3a, 0x94, 0x93, 0x4a, 0x48, 0x9f, 0x90, 0x68,
-	0xf2, 0x65, 0xb3, 0x32, 0x65, 0x8f, 0x72, 0xb2, 0x23, 0x12, 0x44, 0x11, 0x4e, 0x39, 0x6e, 0x26,
-	0x8b, 0xa4, 0x26, 0x16, 0x91, 0x29, 0xfd, 0x22, 0xd6, 0x33, 0x03, 0x96, 0x3e, 0x17, 0x54, 0x0d,
-	0x6d, 0x03, 0x73, 0xc9, 0xe3, 0x36, 0x61, 0x1c, 0x6d, 0x03, 0x0c, 0x36, 0x4e, 0x82, 0x2c, 0x6c,
-	0xbe, 0x6b, 0xab, 0xe2, 0xb6, 0x58, 0xcf, 0x21, 0xa6, 0x77, 0x70, 0x9d, 0xe8, 0x5c, 0x37, 0x91,
-	0x99, 0xdc, 0xae, 0xd4, 0x89, 0xed, 0x9a, 0x87, 0x69, 0x35, 0xf0, 0x6a, 0x91, 0x94, 0x61, 0xfd,
-	0x69, 0xc0, 0xf5, 0x31, 0xb8, 0x58, 0x48, 0x03, 0x46, 0xd0, 0x1e, 0xcc, 0x0d, 0x46, 0x53, 0x2b,
-	0x8a, 0x69, 0xac, 0x5c, 0x58, 0x2b, 0x6c, 0xbe, 0x7d, 0xe6, 0x7c, 0xaa, 0x42, 0x5b, 0xe9, 0xe7,
-	0x2f, 0x97, 0xa7, 0xdc, 0x4b, 0xfb, 0x43, 0xf5, 0xd1, 0xa7, 0x27, 0x1a, 0x4e, 0xc9, 0x86, 0x57,
-	0x27, 0x36, 0xac, 0x40, 0x25, 0x3b, 0xb6, 0xb6, 0xe1, 0xda, 0xa8, 0x0e, 0x62, 0x62, 0xcf, 0x2b,
-	0x37, 0x56, 0x67, 0xf4, 0x13, 0x9a, 0x44, 0x84, 0xf1, 0x86, 0x44, 0x58, 0x1f, 0xea, 0x27, 0x70,
-	0xa7, 0x1d, 0x45, 0x24, 0xe0, 0x62, 0x66, 0x76, 0x39, 0xe6, 0x6d, 0x36, 0xa9, 0x03, 0xeb, 0x1b,
-	0x03, 0x4a, 0xe3, 0x52, 0x35, 0xe8, 0x79, 0x98, 0x96, 0xe3, 0xac, 0x33, 0x95, 0x81, 0x4c, 0xc8,
-	0xfa, 0x81, 0xf2, 0xab, 0xfe, 0x63, 0x53, 0x9c, 0xe0, 0x2a, 0xe3, 0xd8, 0x0f, 0xe4, 0x9c, 0xa4,
-	0xdd, 0xd8, 0x14, 0x95, 0xe4, 0x4c, 0x4b, 0xad, 0x4d, 0xbb, 0xca, 0xb0, 0x5c, 0xb8, 0xa2, 0x10,
-	0xe0, 0x60, 0x4f, 0x14, 0xc0, 0x7c, 0x32, 0xf1, 0x25, 0x80, 0x0e, 0x6e, 0x62, 0xcf, 0x13, 0x22,
-	0xa3, 0xd7, 0x38, 0xe1, 0xb1, 0x1e, 0x81, 0x79, 0xba, 0xa6, 0xee, 0xa7, 0x08, 0xb9, 0x90, 0x32,
-	0xe6, 0x57, 0x9b, 0x44, 0x56, 0xcd, 0xb9, 0x7d, 0x1b, 0x2d, 0x42, 0x26, 0x22, 0x98, 0xe9, 0x69,
-	0xca, 0xbb, 0xda, 0xb2, 0xbe, 0x33, 0x60, 0x31, 0x2e, 0xb8, 0x13, 0xd1, 0x90, 0xb2, 0xc9, 0x18,
-	0x17, 0x21, 0x23, 0xc5, 0x2b, 0x8a, 0x6b, 0x29, 0x4b, 0xde, 0xaf, 0x4a, 0x44, 0x7a, 0x91, 0xfa,
-	0xf6, 0xd0, 0x4b, 0x27, 0x3d, 0xf4, 0xd2, 0xb1, 0x3e, 0x1b, 0x50, 0xd5, 0x47, 0xf1, 0x06, 0x5d,
-	0x1d, 0xc2, 0xe5, 0x3e, 0x4b, 0x94, 0xbf, 0x7e, 0x47, 0x62, 0x42, 0x28, 0xef, 0xb7, 0xa3, 0x8c,
-	0xa1, 0x77, 0x6f, 0x7a, 0xe8, 0xdd, 0x6b, 0xdd, 0x87, 0xf9, 0x93, 0x97, 0xbf, 0x7e, 0x23, 0x9b,
-	0x3f, 0xe5, 0xe0, 0xa2, 0x2c, 0x16, 0x2b, 0xc3, 0x2f, 0x06, 0x2c, 0x0c, 0xcb, 0x91, 0x0c, 0x40,
-	0xef, 0x8f, 0xda, 0xb3, 0xb3, 0x64, 0xb5, 0xb8, 0xf1, 0x0a, 0x19, 0xaa, 0x07, 0xcb, 0xfa, 0xf6,
-	0xaf, 0xff, 0x7e, 0x4c, 0x2d, 0xa1, 0xa2, 0x23, 0x3f, 0xa9, 0x3a, 0xfd, 0xef, 0x28, 0xe7, 0x50,
-	0x33, 0xdb, 0x43, 0xcf, 0x0c, 0x98, 0x1f, 0x2a, 0xa0, 0x10, 0x3a, 0xe7, 0xbd, 0x2f, 0x06, 0x78,
-	0x1e, 0xe9, 0xb0, 0x56, 0x25, 0xa4, 0x1b, 0x68, 0x79, 0x3c, 0x24, 0xe7, 0x50, 0xe0, 0xfa, 0xdd,
-	0x80, 0xb9, 0x53, 0x62, 0x80, 0xc6, 0x93, 0x30, 0x4e, 0x73, 0x8a, 0x9b, 0xaf, 0x92, 0xa2, 0x89,
-	0xbb, 0x2d, 0x51, 0xde, 0x42, 0x1b, 0xce, 0x88, 0x6f, 0xd1, 0x9a, 0x4a, 0x53, 0x2f, 0x51, 0x26,
-	0x13, 0x13, 0x7c, 0xfe, 0x6a, 0x40, 0x21, 0xb1, 0xee, 0xe8, 0xe6, 0xf8, 0xeb, 0x4f, 0x09, 0x4d,
-	0xf1, 0xbd, 0xf3, 0x05, 0x6b, 0x94, 0x1f, 0x49, 0x94, 0xb7, 0xd1, 0x07, 0x23, 0x51, 0xe2, 0xa0,
-	0xd2, 0xd1, 0x19, 0x49, 0x6e, 0x07, 0xea, 0xd4, 0x43, 0x7f, 0x18, 0x00, 0x83, 0x1d, 0x46, 0xeb,
-	0x67, 0xdd, 0x7e, 0x52, 0x6e, 0x8a, 0x37, 0xcf, 0x15, 0xab, 0x81, 0xba, 0x12, 0xe8, 0x43, 0x74,
-	0x7f, 0x1c, 0x50, 0x2d, 0x3c, 0x49, 0x9c, 0x6a, 0xa7, 0x7b, 0xce, 0x61, 0x2c, 0x4a, 0x3d, 0xe7,
-	0x70, 0xa0, 0x49, 0x3d, 0xf4, 0x9b, 0x01, 0x59, 0xbd, 0xb3, 0x68, 0xf5, 0x4c, 0xda, 0x06, 0x92,
-	0x52, 0x5c, 0x9b, 0x1c, 0xa8, 0x21, 0x3f, 0x94, 0x90, 0xb7, 0xd1, 0xdd, 0xb1, 0xdc, 0x52, 0x3e,
-	0x1a, 0xaf, 0x54, 0x1d, 0xe9, 0x88, 0x45, 0xa7, 0xb7, 0x75, 0xf7, 0xf9, 0x51, 0xc9, 0x78, 0x71,
-	0x54, 0x32, 0xfe, 0x3d, 0x2a, 0x19, 0x3f, 0x1c, 0x97, 0xa6, 0x5e, 0x1c, 0x97, 0xa6, 0xfe, 0x3e,
-	0x2e, 0x4d, 0x7d, 0xb9, 0x5e, 0xf7, 0x79, 0xa3, 0x5d, 0xb5, 0x6b, 0xb4, 0xe5, 0x3c, 0xf8, 0x62,
-	0xef, 0x93, 0x47, 0x84, 0x3f, 0xa1, 0xd1, 0x81, 0x53, 0x6b, 0x60, 0x3f, 0x70, 0x9e, 0xea, 0x8b,
-	0x79, 0x37, 0x24, 0xac, 0x9a, 0x91, 0xff, 0x44, 0x6e, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x9f,
-	0x9d, 0x46, 0xd3, 0x21, 0x0d, 0x00, 0x00,
+	// 1292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6f, 0x13, 0xc7,
+	0x1b, 0xce, 0x1a, 0xc7, 0xb1, 0x5f, 0x27, 0x81, 0x0c, 0x01, 0x16, 0x13, 0x1c, 0xb3, 0x3f, 0xfd,
+	0x20, 0x82, 0x76, 0xb7, 0x09, 0x87, 0x96, 0x53, 0xd5, 0x40, 0x53, 0x02, 0x14, 0x85, 0x4d, 0x1b,
+	0xa9, 0x3d, 0xd4, 0x1a, 0x7b, 0x27, 0xf6, 0xca, 0xf6, 0xce, 0xb2, 0x33, 0x76, 0x70, 0xa3, 0x5c,
+	0xaa, 0xfe, 0x01, 0x95, 0x2a, 0x7a, 0xee, 0xb5, 0xa8, 0x97, 0x1e, 0x7a, 0xe8, 0xad, 0x47, 0x8e,
+	0x48, 0xbd, 0x54, 0x1c, 0x50, 0x05, 0xfd, 0x43, 0xaa, 0xf9, 0xb0, 0xbd, 0xfe, 0x8a, 0x4d, 0x38,
+	0xc5, 0xef, 0xd7, 0x33, 0xcf, 0xfb, 0xee, 0xcc, 0x33, 0x13, 0x28, 0xd4, 0xda, 0x2d, 0xe2, 0x3c,
+	0x6e, 0x92, 0xa8, 0xed, 0xb4, 0xd6, 0x4b, 0x84, 0xe3, 0x75, 0xa7, 0xd4, 0x0c, 0xbc, 0x3a, 0x61,
+	0x76, 0x18, 0x51, 0x4e, 0x11, 0x12, 0x19, 0xb6, 0xcc, 0xb0, 0x75, 0x46, 0xee, 0x7a, 0x99, 0xb2,
+	0x06, 0x65, 0x4e, 0x09, 0xb3, 0xc1, 0xe2, 0x10, 0x57, 0xfc, 0x00, 0x73, 0x9f, 0x06, 0xaa, 0x3e,
+	0xb7, 0x5c, 0xa1, 0x15, 0x2a, 0x7f, 0x3a, 0xe2, 0x97, 0xf6, 0xae, 0x54, 0x28, 0xad, 0xd4, 0x89,
+	0x83, 0x43, 0xdf, 0xc1, 0x41, 0x40, 0xb9, 0x2c, 0xd1, 0x6b, 0x5a, 0xbf, 0x26, 0xe1, 0xf4, 0x96,
+	0x1f, 0xe0, 0xba, 0xff, 0x2d, 0xf1, 0x36, 0x25, 0x1d, 0x74, 0x01, 0xe6, 0x42, 0x4a, 0xeb, 0x45,
+	0xdf, 0x33, 0x8d, 0x82, 0xb1, 0x96, 0x74, 0x53, 0xc2, 0xdc, 0xf6, 0xd0, 0x22, 0x24, 0x7c, 0xcf,
+	0x4c, 0x48, 0x5f, 0xc2, 0xf7, 0xd0, 0x65, 0x00, 0xc6, 0x69, 0x84, 0x2b, 0x44, 0xe4, 0x9e, 0x2a,
+	0x18, 0x6b, 0x19, 0x37, 0xa3, 0x3d, 0xdb, 0x1e, 0xca, 0x41, 0xba, 0x19, 0xd6, 0x29, 0xf6, 0x48,
+	0x64, 0x26, 0x65, 0xb0, 0x6b, 0x8b, 0xd2, 0xfd, 0x88, 0x36, 0x8a, 0x7e, 0xe0, 0x91, 0x27, 0xe6,
+	0xac, 0x84, 0xcc, 0x08, 0xcf, 0xb6, 0x70, 0xa0, 0x8b, 0x90, 0xe6, 0x54, 0x07, 0x53, 0x32, 0x38,
+	0xc7, 0x69, 0x37, 0x24, 0x2b, 0x6b, 0xa4, 0x6d, 0x66, 0x25, 0xea, 0x9c, 0xb0, 0xef, 0x93, 0x36,
+	0x3a, 0x07, 0x29, 0x4e, 0x65, 0x60, 0x4e, 0x06, 0x66, 0x39, 0x15, 0xee, 0xff, 0xc3, 0xa2, 0x1a,
+	0x74, 0x91, 0x35, 0x1b, 0x0d, 0x1c, 0xb5, 0xcd, 0xb4, 0x0c, 0x2f, 0x28, 0xef, 0xae, 0x72, 0xa2,
+	0x4b, 0x90, 0xf1, 0x30, 0xc7, 0xc5, 0x2a, 0x66, 0x55, 0x33, 0xa3, 0xf8, 0x0a, 0xc7, 0x5d, 0xcc,
+	0xaa, 0x68, 0x13, 0xe6, 0xf7, 0x3b, 0x63, 0x2a, 0x62, 0x6e, 0x42, 0xc1, 0x58, 0xcb, 0x6e, 0xac,
+	0xda, 0xc3, 0x9f, 0xcc, 0xee, 0x8e, 0xf3, 0x13, 0xee, 0x66, 0xf7, 0x7b, 0x06, 0xb2, 0xe1, 0x6c,
+	0x67, 0x5c, 0x61, 0x44, 0x5b, 0xbe, 0x47, 0x22, 0x31, 0xb7, 0x79, 0xd9, 0xdf, 0x92, 0x0e, 0xed,
+	0xe8, 0xc8, 0xb6, 0x27, 0x78, 0x97, 0x69, 0x23, 0x8c, 0x08, 0x63, 0x3e, 0x0d, 0x44, 0xea, 0x82,
+	0x4c, 0x5d, 0x88, 0x79, 0xb7, 0x3d, 0x74, 0x17, 0x16, 0x19, 0xc7, 0x35, 0x52, 0x64, 0xa4, 0xdc,
+	0x8c, 0x7c, 0xde, 0x36, 0x17, 0x25, 0xb9, 0x2b, 0xa3, 0xc8, 0xed, 0x8a, 0xcc, 0x5d, 0x9d, 0xe8,
+	0x2e, 0xb0, 0xb8, 0x89, 0x0a, 0x90, 0xf5, 0x83, 0x16, 0xae, 0xfb, 0x1e, 0xe6, 0xc4, 0x33, 0x4f,
+	0x17, 0x8c, 0xb5, 0xb4, 0x1b, 0x77, 0x59, 0xdf, 0x40, 0x36, 0xd6, 0x1e, 0x5a, 0x87, 0x54, 0x95,
+	0xf8, 0x95, 0x2a, 0x97, 0x1b, 0x25, 0xb3, 0x79, 0xf1, 0xe5, 0xab, 0xd5, 0x73, 0x6a, 0xc7, 0x32,
+	0xaf, 0x66, 0xfb, 0xd4, 0x69, 0x60, 0x5e, 0xb5, 0xb7, 0x03, 0xee, 0xea, 0x44, 0xb4, 0x02, 0x19,
+	0xee, 0x37, 0x08, 0xe3, 0xb8, 0x11, 0xca, 0xad, 0x94, 0x71, 0x7b, 0x0e, 0xeb, 0xb7, 0x04, 0x2c,
+	0xf4, 0x51, 0x44, 0xb7, 0xe1, 0x8c, 0x5c, 0xbe, 0xd8, 0xa2, 0x9c, 0x14, 0x43, 0x7a, 0x40, 0xa2,
+	0xc9, 0x8b, 0x2d, 0xca, 0x92, 0x3d, 0xca, 0xc9, 0x8e, 0x28, 0x10, 0x20, 0x9c, 0x72, 0x5c, 0x8f,
+	0x83, 0x24, 0x26, 0x82, 0xc8, 0x92, 0x1e, 0xc8, 0x3d, 0x58, 0x8e, 0xf0, 0x41, 0x71, 0x88, 0xcd,
+	0xa9, 0x49, 0x40, 0x4b, 0x11, 0x3e, 0xd8, 0xeb, 0x27, 0xa4, 0xb1, 0x86, 0x48, 0x25, 0xa7, 0xc1,
+	0xfa, 0xa2, 0x8f, 0x97, 0xf5, 0xd4, 0x80, 0x95, 0x47, 0xe2, 0x23, 0x0f, 0x9c, 0x63, 0xe6, 0x92,
+	0xc7, 0x4d, 0xc2, 0x38, 0xda, 0x02, 0xe8, 0x69, 0x85, 0x1c, 0x5e, 0x76, 0xe3, 0xaa, 0xad, 0xf0,
+	0x6d, 0x21, 0x2c, 0x03, 0x7b, 0x64, 0x07, 0x57, 0x88, 0xae, 0x75, 0x63, 0x95, 0x71, 0x5d, 0x48,
+	0xf4, 0xe9, 0xc2, 0x32, 0xcc, 0xaa, 0xa3, 0xaa, 0x24, 0x40, 0x19, 0xd6, 0x9f, 0x06, 0x5c, 0x1e,
+	0xc3, 0x8b, 0x85, 0x34, 0x60, 0x04, 0xed, 0xc1, 0x52, 0xef, 0x50, 0x69, 0x2d, 0x34, 0x8d, 0xc2,
+	0xa9, 0xb5, 0xec, 0xc6, 0xff, 0x8e, 0x3d, 0x59, 0x0a, 0x68, 0x33, 0xf9, 0xfc, 0xd5, 0xea, 0x8c,
+	0x7b, 0x66, 0x7f, 0x00, 0x1f, 0x7d, 0xd6, 0xd7, 0x70, 0x42, 0x36, 0x7c, 0x6d, 0x62, 0xc3, 0x8a,
+	0x54, 0xbc, 0x63, 0x6b, 0x0b, 0x2e, 0x8d, 0xea, 0xa0, 0x33, 0xd8, 0x69, 0x85, 0xd2, 0x6a, 0x8d,
+	0xfe, 0x42, 0x93, 0x06, 0x61, 0xbc, 0xe3, 0x20, 0xac, 0x8f, 0xf4, 0x17, 0xb8, 0xdd, 0x8c, 0x22,
+	0x12, 0x70, 0xb1, 0x67, 0x76, 0x39, 0xe6, 0x4d, 0x36, 0xa9, 0x03, 0xeb, 0x59, 0x02, 0xf2, 0xe3,
+	0x4a, 0x35, 0xe9, 0x65, 0x98, 0x95, 0x67, 0x41, 0x57, 0x2a, 0x03, 0x99, 0x30, 0xa7, 0x05, 0x43,
+	0xf7, 0xdf, 0x31, 0x45, 0x04, 0x97, 0x18, 0xc7, 0x7e, 0x20, 0xf7, 0x49, 0xd2, 0xed, 0x98, 0x02,
+	0x49, 0x9e, 0x04, 0xb9, 0xfd, 0x93, 0xae, 0x32, 0xd0, 0x23, 0x98, 0x57, 0x67, 0xed, 0x71, 0x93,
+	0x46, 0xcd, 0x86, 0xbc, 0x24, 0x32, 0x9b, 0xb6, 0x68, 0xf5, 0xe5, 0xab, 0xd5, 0xab, 0x15, 0x9f,
+	0x57, 0x9b, 0x25, 0xbb, 0x4c, 0x1b, 0x8e, 0xbe, 0x23, 0xd5, 0x9f, 0xf7, 0x99, 0x57, 0x73, 0x78,
+	0x3b, 0x24, 0xcc, 0xbe, 0x43, 0xca, 0x6e, 0x56, 0x62, 0x3c, 0x92, 0x10, 0xe8, 0x4b, 0x58, 0xf4,
+	0x83, 0x3e, 0xd0, 0xd4, 0x89, 0x40, 0x17, 0xfc, 0x20, 0x06, 0x6b, 0xb9, 0x70, 0x41, 0xcd, 0x0a,
+	0x07, 0x7b, 0x5a, 0x2a, 0x27, 0x6e, 0x91, 0x3c, 0x40, 0x0b, 0xd7, 0xb1, 0xe7, 0x09, 0x21, 0xd7,
+	0x42, 0x18, 0xf3, 0x58, 0x0f, 0xc1, 0x1c, 0xc6, 0xd4, 0x93, 0xcf, 0x41, 0x3a, 0xa4, 0x8c, 0xf9,
+	0xa5, 0x3a, 0x91, 0xa8, 0x69, 0xb7, 0x6b, 0xa3, 0xf3, 0x90, 0x8a, 0x08, 0x66, 0x7a, 0xdf, 0x67,
+	0x5c, 0x6d, 0x59, 0xdf, 0x1b, 0x70, 0xbe, 0x03, 0xb8, 0x13, 0xd1, 0x90, 0xb2, 0xc9, 0x1c, 0xcf,
+	0x43, 0x4a, 0x5e, 0x10, 0x51, 0x07, 0x4b, 0x59, 0x72, 0x7d, 0x05, 0xa1, 0xd5, 0xcf, 0xed, 0xda,
+	0x03, 0x17, 0x7b, 0x72, 0xe0, 0x62, 0xb7, 0x3e, 0x87, 0x0b, 0x43, 0x2c, 0xde, 0xa1, 0xab, 0x43,
+	0x38, 0xdb, 0x9d, 0x12, 0xe5, 0x27, 0xef, 0x48, 0xec, 0x65, 0xca, 0xbb, 0xed, 0x28, 0x63, 0xe0,
+	0x7d, 0x93, 0x1c, 0x78, 0xdf, 0x58, 0xf7, 0x60, 0xb9, 0x7f, 0xf1, 0x93, 0x37, 0xb2, 0xf1, 0x53,
+	0x1a, 0xe6, 0x25, 0x58, 0x47, 0xc3, 0x7e, 0x36, 0xe0, 0xdc, 0xa0, 0x70, 0xca, 0x04, 0xf4, 0xc1,
+	0x28, 0x45, 0x38, 0xee, 0x02, 0xc8, 0xad, 0xbf, 0x45, 0x85, 0xea, 0xc1, 0xb2, 0xbe, 0xfb, 0xeb,
+	0xdf, 0x1f, 0x13, 0x2b, 0x28, 0xe7, 0x88, 0x52, 0xa7, 0xd5, 0x7d, 0xab, 0x3a, 0x87, 0x7a, 0xb2,
+	0x47, 0xe8, 0xa9, 0x01, 0xcb, 0x03, 0x00, 0x8a, 0xa1, 0x33, 0xed, 0x7a, 0x1d, 0x82, 0xd3, 0x88,
+	0x9c, 0x75, 0x4d, 0x52, 0xba, 0x82, 0x56, 0xc7, 0x53, 0x72, 0x0e, 0x05, 0xaf, 0xdf, 0x0d, 0x58,
+	0x1a, 0x92, 0x2d, 0x34, 0x7e, 0x08, 0xe3, 0xd4, 0x31, 0xb7, 0xf1, 0x36, 0x25, 0x7a, 0x70, 0xb7,
+	0x24, 0xcb, 0x9b, 0x68, 0xdd, 0x19, 0xf1, 0xde, 0x2f, 0xab, 0x32, 0x75, 0xe3, 0x33, 0x59, 0x18,
+	0x9b, 0xe7, 0x2f, 0x06, 0x64, 0x63, 0xc7, 0x1d, 0xdd, 0x18, 0xbf, 0xfc, 0x90, 0xd0, 0xe4, 0xde,
+	0x9b, 0x2e, 0x59, 0xb3, 0xfc, 0x58, 0xb2, 0xbc, 0x85, 0x3e, 0x1c, 0xc9, 0x12, 0x07, 0xc5, 0xce,
+	0x93, 0x2f, 0x3e, 0xdb, 0x9e, 0x3a, 0x1d, 0xa1, 0x3f, 0x0c, 0x80, 0xde, 0x19, 0x46, 0xd7, 0x8f,
+	0x5b, 0xbd, 0x5f, 0x6e, 0x72, 0x37, 0xa6, 0xca, 0xd5, 0x44, 0x5d, 0x49, 0xf4, 0x01, 0xba, 0x37,
+	0x8e, 0xa8, 0x16, 0x9e, 0x38, 0x4f, 0x75, 0xa6, 0x8f, 0x9c, 0x43, 0x1d, 0x13, 0x3f, 0x7b, 0x9a,
+	0x74, 0x84, 0x9e, 0x19, 0x30, 0xa7, 0xcf, 0x2c, 0xba, 0x76, 0xec, 0xd8, 0x7a, 0x92, 0x92, 0x5b,
+	0x9b, 0x9c, 0xa8, 0x29, 0x3f, 0x90, 0x94, 0xb7, 0xd0, 0x9d, 0xb1, 0xb3, 0xa5, 0x7c, 0x34, 0x5f,
+	0x11, 0x88, 0xa4, 0xa3, 0x23, 0x3a, 0x47, 0x9b, 0x77, 0x9e, 0xbf, 0xce, 0x1b, 0x2f, 0x5e, 0xe7,
+	0x8d, 0x7f, 0x5e, 0xe7, 0x8d, 0x1f, 0xde, 0xe4, 0x67, 0x5e, 0xbc, 0xc9, 0xcf, 0xfc, 0xfd, 0x26,
+	0x3f, 0xf3, 0xf5, 0xf5, 0xd8, 0x65, 0x75, 0xff, 0xab, 0xbd, 0x4f, 0x1f, 0x12, 0x7e, 0x40, 0xa3,
+	0x9a, 0x53, 0xae, 0x62, 0x3f, 0x70, 0x9e, 0xe8, 0x85, 0xe5, 0xa5, 0x55, 0x4a, 0xc9, 0xff, 0xf6,
+	0x6e, 0xfe, 0x37, 0x00, 0x2b, 0x58, 0x4f, 0x40, 0x85, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1382,6 +1410,16 @@ func (m *FinalizedBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Invalidated {
+		i--
+		if m.Invalidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.StakeSecurity != nil {
 		{
 			size, err := m.StakeSecurity.MarshalToSizedBuffer(dAtA[:i])
@@ -1543,6 +1581,30 @@ func (m *StakeSecurity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RawTotalVotePower != nil {
+		{
+			size := m.RawTotalVotePower.Size()
+			i -= size
+			if _, err := m.RawTotalVotePower.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBundles(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RawValidVotePower != nil {
+		{
+			size := m.RawValidVotePower.Size()
+			i -= size
+			if _, err := m.RawValidVotePower.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBundles(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TotalVotePower != nil {
 		{
 			size := m.TotalVotePower.Size()
@@ -1780,6 +1842,26 @@ func (m *QueryCurrentVoteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.InvalidQuorum.Size()
+		i -= size
+		if _, err := m.InvalidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBundles(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ValidQuorum.Size()
+		i -= size
+		if _, err := m.ValidQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBundles(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.Total != 0 {
 		i = encodeVarintBundles(dAtA, i, uint64(m.Total))
 		i--
@@ -2121,6 +2203,9 @@ func (m *FinalizedBundle) Size() (n int) {
 		l = m.StakeSecurity.Size()
 		n += 1 + l + sovBundles(uint64(l))
 	}
+	if m.Invalidated {
+		n += 2
+	}
 	return n
 }
 
@@ -2155,6 +2240,14 @@ func (m *StakeSecurity) Size() (n int) {
 		l = m.TotalVotePower.Size()
 		n += 1 + l + sovBundles(uint64(l))
 	}
+	if m.RawValidVotePower != nil {
+		l = m.RawValidVotePower.Size()
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if m.RawTotalVotePower != nil {
+		l = m.RawTotalVotePower.Size()
+		n += 1 + l + sovBundles(uint64(l))
+	}
 	return n
 }
 
@@ -2253,6 +2346,10 @@ func (m *QueryCurrentVoteStatusResponse) Size() (n int) {
 	if m.Total != 0 {
 		n += 1 + sovBundles(uint64(m.Total))
 	}
+	l = m.ValidQuorum.Size()
+	n += 1 + l + sovBundles(uint64(l))
+	l = m.InvalidQuorum.Size()
+	n += 1 + l + sovBundles(uint64(l))
 	return n
 }
 
@@ -2780,6 +2877,26 @@ func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invalidated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBundles(dAtA[iNdEx:])
@@ -3020,6 +3137,78 @@ func (m *StakeSecurity) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawValidVotePower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RawValidVotePower = &v
+			if err := m.RawValidVotePower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawTotalVotePower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RawTotalVotePower = &v
+			if err := m.RawTotalVotePower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBundles(dAtA[iNdEx:])
@@ -3643,6 +3832,74 @@ func (m *QueryCurrentVoteStatusResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InvalidQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBundles(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/KYVENetwork/chain/x/delegation/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -515,6 +516,309 @@ func (m *DelegationForStakerResponse) GetDelegationAmount() uint64 {
 	return 0
 }
 
+// QuerySlashesByStakerRequest ...
+type QuerySlashesByStakerRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+}
+
+func (m *QuerySlashesByStakerRequest) Reset()         { *m = QuerySlashesByStakerRequest{} }
+func (m *QuerySlashesByStakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesByStakerRequest) ProtoMessage()    {}
+func (*QuerySlashesByStakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{8}
+}
+func (m *QuerySlashesByStakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesByStakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesByStakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesByStakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesByStakerRequest.Merge(m, src)
+}
+func (m *QuerySlashesByStakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesByStakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesByStakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesByStakerRequest proto.InternalMessageInfo
+
+func (m *QuerySlashesByStakerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QuerySlashesByStakerRequest) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+// QuerySlashesByStakerResponse ...
+type QuerySlashesByStakerResponse struct {
+	// slashes ...
+	Slashes []types.SlashRecord `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashesByStakerResponse) Reset()         { *m = QuerySlashesByStakerResponse{} }
+func (m *QuerySlashesByStakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesByStakerResponse) ProtoMessage()    {}
+func (*QuerySlashesByStakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{9}
+}
+func (m *QuerySlashesByStakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesByStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesByStakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesByStakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesByStakerResponse.Merge(m, src)
+}
+func (m *QuerySlashesByStakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesByStakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesByStakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesByStakerResponse proto.InternalMessageInfo
+
+func (m *QuerySlashesByStakerResponse) GetSlashes() []types.SlashRecord {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
+func (m *QuerySlashesByStakerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySlashesByPoolRequest ...
+type QuerySlashesByPoolRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QuerySlashesByPoolRequest) Reset()         { *m = QuerySlashesByPoolRequest{} }
+func (m *QuerySlashesByPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesByPoolRequest) ProtoMessage()    {}
+func (*QuerySlashesByPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{10}
+}
+func (m *QuerySlashesByPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesByPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesByPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesByPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesByPoolRequest.Merge(m, src)
+}
+func (m *QuerySlashesByPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesByPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesByPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesByPoolRequest proto.InternalMessageInfo
+
+func (m *QuerySlashesByPoolRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QuerySlashesByPoolRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// QuerySlashesByPoolResponse ...
+type QuerySlashesByPoolResponse struct {
+	// slashes ...
+	Slashes []types.SlashRecord `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashesByPoolResponse) Reset()         { *m = QuerySlashesByPoolResponse{} }
+func (m *QuerySlashesByPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesByPoolResponse) ProtoMessage()    {}
+func (*QuerySlashesByPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{11}
+}
+func (m *QuerySlashesByPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesByPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesByPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesByPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesByPoolResponse.Merge(m, src)
+}
+func (m *QuerySlashesByPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesByPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesByPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesByPoolResponse proto.InternalMessageInfo
+
+func (m *QuerySlashesByPoolResponse) GetSlashes() []types.SlashRecord {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
+func (m *QuerySlashesByPoolResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInsuranceFundRequest ...
+type QueryInsuranceFundRequest struct {
+}
+
+func (m *QueryInsuranceFundRequest) Reset()         { *m = QueryInsuranceFundRequest{} }
+func (m *QueryInsuranceFundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundRequest) ProtoMessage()    {}
+func (*QueryInsuranceFundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{12}
+}
+func (m *QueryInsuranceFundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInsuranceFundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundRequest.Merge(m, src)
+}
+func (m *QueryInsuranceFundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundRequest proto.InternalMessageInfo
+
+// QueryInsuranceFundResponse ...
+type QueryInsuranceFundResponse struct {
+	// insurance_fund ...
+	InsuranceFund types.InsuranceFund `protobuf:"bytes,1,opt,name=insurance_fund,json=insuranceFund,proto3" json:"insurance_fund"`
+}
+
+func (m *QueryInsuranceFundResponse) Reset()         { *m = QueryInsuranceFundResponse{} }
+func (m *QueryInsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundResponse) ProtoMessage()    {}
+func (*QueryInsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{13}
+}
+func (m *QueryInsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundResponse.Merge(m, src)
+}
+func (m *QueryInsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundResponse proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundResponse) GetInsuranceFund() types.InsuranceFund {
+	if m != nil {
+		return m.InsuranceFund
+	}
+	return types.InsuranceFund{}
+}
+
 func init() {
 	proto.RegisterType((*QueryDelegatorRequest)(nil), "kyve.query.v1beta1.QueryDelegatorRequest")
 	proto.RegisterType((*QueryDelegatorResponse)(nil), "kyve.query.v1beta1.QueryDelegatorResponse")
@@ -524,6 +828,12 @@ func init() {
 	proto.RegisterType((*QueryStakersByDelegatorRequest)(nil), "kyve.query.v1beta1.QueryStakersByDelegatorRequest")
 	proto.RegisterType((*QueryStakersByDelegatorResponse)(nil), "kyve.query.v1beta1.QueryStakersByDelegatorResponse")
 	proto.RegisterType((*DelegationForStakerResponse)(nil), "kyve.query.v1beta1.DelegationForStakerResponse")
+	proto.RegisterType((*QuerySlashesByStakerRequest)(nil), "kyve.query.v1beta1.QuerySlashesByStakerRequest")
+	proto.RegisterType((*QuerySlashesByStakerResponse)(nil), "kyve.query.v1beta1.QuerySlashesByStakerResponse")
+	proto.RegisterType((*QuerySlashesByPoolRequest)(nil), "kyve.query.v1beta1.QuerySlashesByPoolRequest")
+	proto.RegisterType((*QuerySlashesByPoolResponse)(nil), "kyve.query.v1beta1.QuerySlashesByPoolResponse")
+	proto.RegisterType((*QueryInsuranceFundRequest)(nil), "kyve.query.v1beta1.QueryInsuranceFundRequest")
+	proto.RegisterType((*QueryInsuranceFundResponse)(nil), "kyve.query.v1beta1.QueryInsuranceFundResponse")
 }
 
 func init() {
@@ -531,51 +841,66 @@ func init() {
 }
 
 var fileDescriptor_5e1c28c162a0498a = []byte{
-	// 699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0x69, 0xe8, 0x8f, 0x4e, 0xf9, 0xd9, 0x3a, 0xda, 0x1a, 0x62, 0xd9, 0x96, 0x88,
-	0x7f, 0xda, 0xc2, 0x0e, 0x4d, 0x4b, 0x41, 0xf1, 0x62, 0xac, 0x15, 0x11, 0xff, 0x34, 0x82, 0xa0,
-	0x97, 0x30, 0xd9, 0x0e, 0xdb, 0xd0, 0x74, 0x27, 0x9d, 0x99, 0x6d, 0x0d, 0xa5, 0x20, 0x1e, 0x3c,
-	0x0b, 0x5e, 0xc5, 0x8b, 0x27, 0xf1, 0x0d, 0x08, 0xbe, 0x81, 0x1e, 0x0b, 0x5e, 0xf4, 0x22, 0xd2,
-	0xf8, 0x42, 0x64, 0x67, 0x27, 0xd9, 0xd9, 0xcd, 0x26, 0x6d, 0x45, 0x6f, 0xc9, 0x33, 0xcf, 0x33,
-	0xf3, 0x9d, 0xcf, 0xf7, 0x79, 0x66, 0xe1, 0xa5, 0xcd, 0xd6, 0x0e, 0xc5, 0xdb, 0x3e, 0xe5, 0x2d,
-	0xbc, 0xb3, 0x50, 0xa3, 0x92, 0x2c, 0xe0, 0x75, 0xda, 0xa0, 0x2e, 0x91, 0x75, 0xe6, 0xd9, 0x4d,
-	0xce, 0x24, 0x43, 0x28, 0x48, 0xb2, 0x55, 0x92, 0xad, 0x93, 0x0a, 0x73, 0x0e, 0x13, 0x5b, 0x4c,
-	0xe0, 0x1a, 0x11, 0xc9, 0xfa, 0x26, 0x71, 0xeb, 0x9e, 0x51, 0x5f, 0x38, 0xef, 0x32, 0x97, 0xa9,
-	0x9f, 0x38, 0xf8, 0xa5, 0xa3, 0x53, 0x2e, 0x63, 0x6e, 0x83, 0x62, 0xd2, 0xac, 0x63, 0xe2, 0x79,
-	0x4c, 0xaa, 0x12, 0xa1, 0x57, 0xad, 0x14, 0x61, 0xa1, 0x02, 0xb5, 0x5e, 0x7c, 0x00, 0x27, 0xd6,
-	0x82, 0xbf, 0x2b, 0xa1, 0x58, 0xc6, 0x2b, 0x74, 0xdb, 0xa7, 0x42, 0xa2, 0x49, 0x38, 0x2c, 0x24,
-	0xd9, 0xa4, 0x3c, 0x0f, 0x66, 0xc0, 0xb5, 0x91, 0x8a, 0xfe, 0x87, 0xa6, 0xe0, 0xc8, 0x7a, 0x27,
-	0x37, 0x9f, 0x55, 0x4b, 0x51, 0xa0, 0xe8, 0xc0, 0xc9, 0xe4, 0x76, 0xa2, 0xc9, 0x3c, 0x41, 0xd1,
-	0x3d, 0xb3, 0x2e, 0xd8, 0x72, 0xb4, 0x34, 0x6f, 0xf7, 0x02, 0xb1, 0x9f, 0xa8, 0x63, 0x7a, 0xea,
-	0xcd, 0x43, 0x3e, 0x00, 0x78, 0xa1, 0x4f, 0x5a, 0x5c, 0x1e, 0x48, 0xc8, 0x43, 0x97, 0xe1, 0x19,
-	0xc7, 0xe7, 0x9c, 0x7a, 0xb2, 0xca, 0xe9, 0x2e, 0xe1, 0xeb, 0xea, 0x06, 0xb9, 0xca, 0xff, 0x3a,
-	0x5a, 0x51, 0x41, 0x34, 0x0f, 0xcf, 0x46, 0xe6, 0x55, 0xc9, 0x16, 0xf3, 0x3d, 0x99, 0x1f, 0x52,
-	0x99, 0xe3, 0xd1, 0xc2, 0x2d, 0x15, 0x37, 0x40, 0xe5, 0x4c, 0x50, 0xc5, 0x97, 0x00, 0x5a, 0x71,
-	0x16, 0xa2, 0xdc, 0x0a, 0x65, 0x77, 0x18, 0xaf, 0x42, 0x18, 0x99, 0xac, 0xa1, 0x5c, 0xb1, 0xc3,
-	0x8e, 0xb0, 0x83, 0x8e, 0x48, 0xb0, 0x79, 0x4c, 0x5c, 0xaa, 0x6b, 0x2b, 0x46, 0xa5, 0x21, 0x21,
-	0x1b, 0x93, 0xf0, 0x2e, 0x0b, 0xa7, 0xfb, 0x4a, 0xd0, 0xc0, 0xd6, 0x20, 0xec, 0xf2, 0x11, 0x79,
-	0x30, 0x33, 0x74, 0x4a, 0x63, 0xca, 0xb9, 0x83, 0x1f, 0xd3, 0x99, 0x8a, 0xb1, 0x09, 0x9a, 0x85,
-	0xe3, 0x92, 0x49, 0xd2, 0xa8, 0x46, 0xac, 0x34, 0xe7, 0x31, 0x15, 0x5f, 0xe9, 0x86, 0x51, 0x09,
-	0x4e, 0xc4, 0x52, 0x19, 0xaf, 0x3a, 0x06, 0xed, 0x73, 0x66, 0x3e, 0xe3, 0xb7, 0x15, 0xf0, 0xbb,
-	0x31, 0x6a, 0x39, 0x45, 0xed, 0xea, 0xb1, 0xd4, 0x74, 0x1b, 0x19, 0xa5, 0xc5, 0xd7, 0x1d, 0x87,
-	0xc2, 0xab, 0x89, 0x72, 0xef, 0x14, 0xfc, 0x2d, 0x87, 0x06, 0x4f, 0xcd, 0x77, 0xa0, 0x7d, 0x4a,
-	0x13, 0x72, 0xa2, 0xc6, 0x7e, 0x04, 0xff, 0x0b, 0x3d, 0x17, 0xf9, 0xac, 0xb2, 0x10, 0xa7, 0x59,
-	0x18, 0x81, 0x5f, 0x65, 0x3c, 0xde, 0x07, 0xda, 0xc6, 0xce, 0x2e, 0x09, 0xc8, 0x43, 0x7f, 0x0e,
-	0xf9, 0x23, 0x80, 0x17, 0x07, 0x9c, 0x8b, 0x96, 0x63, 0xef, 0xcc, 0x68, 0xc9, 0x4a, 0x13, 0xbe,
-	0xea, 0x37, 0x1a, 0xba, 0xae, 0xf3, 0x0e, 0xfd, 0x83, 0x51, 0x2e, 0x7d, 0xca, 0xc1, 0x31, 0x73,
-	0x5e, 0x02, 0xe7, 0xde, 0x03, 0x38, 0xd2, 0x75, 0x03, 0xcd, 0xa6, 0xa9, 0x4b, 0x7d, 0x40, 0x0b,
-	0x73, 0x27, 0x49, 0x0d, 0x21, 0x14, 0x6f, 0xbc, 0xfa, 0xfa, 0xeb, 0x6d, 0x76, 0x09, 0x95, 0x70,
-	0xff, 0xef, 0x08, 0xe3, 0x78, 0x2f, 0xbc, 0xfb, 0x3e, 0xde, 0xeb, 0xc6, 0xf6, 0xd1, 0x67, 0x00,
-	0x51, 0xef, 0x7c, 0xa3, 0xd2, 0xf1, 0xc7, 0x27, 0xdf, 0xa3, 0xc2, 0xe2, 0xa9, 0x6a, 0xb4, 0xf6,
-	0xeb, 0x4a, 0xfb, 0x22, 0x5a, 0x18, 0xa8, 0x5d, 0x54, 0x6b, 0xad, 0x6a, 0x28, 0xbf, 0x7b, 0x0d,
-	0xf4, 0x05, 0x40, 0xd4, 0xdb, 0xf2, 0x03, 0xa4, 0xf7, 0x1d, 0xd4, 0x01, 0xd2, 0xfb, 0xcf, 0x54,
-	0xf1, 0xa6, 0x92, 0xbe, 0x8c, 0x96, 0xd2, 0xa4, 0xeb, 0x49, 0x08, 0x74, 0x1b, 0x0e, 0x44, 0xe0,
-	0xcb, 0x2b, 0x07, 0x47, 0x16, 0x38, 0x3c, 0xb2, 0xc0, 0xcf, 0x23, 0x0b, 0xbc, 0x69, 0x5b, 0x99,
-	0xc3, 0xb6, 0x95, 0xf9, 0xd6, 0xb6, 0x32, 0xcf, 0xe7, 0xdc, 0xba, 0xdc, 0xf0, 0x6b, 0xb6, 0xc3,
-	0xb6, 0xf0, 0xfd, 0x67, 0x4f, 0xef, 0x3c, 0xa4, 0x72, 0x97, 0xf1, 0x4d, 0xec, 0x6c, 0x90, 0xba,
-	0x87, 0x5f, 0xe8, 0x83, 0x64, 0xab, 0x49, 0x45, 0x6d, 0x58, 0x7d, 0x87, 0x17, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x39, 0x97, 0xee, 0x37, 0x42, 0x08, 0x00, 0x00,
+	// 936 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0x9b, 0x28, 0x21, 0xaf, 0x4a, 0x53, 0x06, 0xda, 0x2e, 0x4e, 0xe4, 0x54, 0xa6,
+	0x40, 0x9b, 0xaa, 0x9e, 0x66, 0x53, 0x22, 0x81, 0xb8, 0x10, 0x96, 0x45, 0x11, 0x02, 0xda, 0xad,
+	0x84, 0x04, 0x17, 0xcb, 0xeb, 0x1d, 0x1c, 0x2b, 0x8e, 0x67, 0xe3, 0xb1, 0x5b, 0x56, 0x61, 0x25,
+	0xc4, 0x81, 0x33, 0x12, 0x37, 0x84, 0xe0, 0x80, 0x38, 0x20, 0xe0, 0x8e, 0xc4, 0x17, 0xe8, 0xb1,
+	0x12, 0x17, 0xb8, 0x20, 0x94, 0xf0, 0x41, 0x90, 0xc7, 0xb3, 0xde, 0xb1, 0xd7, 0xde, 0xdd, 0x54,
+	0x05, 0x71, 0x5b, 0xbf, 0x79, 0x6f, 0xe6, 0xf7, 0xfe, 0x6f, 0xde, 0xb3, 0x17, 0x9e, 0x3f, 0xe8,
+	0xdf, 0xa7, 0xe4, 0x28, 0xa6, 0x61, 0x9f, 0xdc, 0xdf, 0xea, 0xd0, 0xc8, 0xde, 0x22, 0x5d, 0xea,
+	0x53, 0xd7, 0x8e, 0x3c, 0x16, 0x98, 0xbd, 0x90, 0x45, 0x0c, 0xe3, 0xc4, 0xc9, 0x14, 0x4e, 0xa6,
+	0x74, 0xd2, 0x36, 0x1d, 0xc6, 0x0f, 0x19, 0x27, 0x1d, 0x9b, 0x17, 0xe3, 0x7b, 0xb6, 0xeb, 0x05,
+	0x4a, 0xbc, 0xf6, 0xac, 0xcb, 0x5c, 0x26, 0x7e, 0x92, 0xe4, 0x97, 0xb4, 0xae, 0xbb, 0x8c, 0xb9,
+	0x3e, 0x25, 0x76, 0xcf, 0x23, 0x76, 0x10, 0xb0, 0x48, 0x84, 0x70, 0xb9, 0x7a, 0x4d, 0x80, 0x8d,
+	0x50, 0x2a, 0xe9, 0x34, 0xbd, 0x24, 0x85, 0x94, 0x55, 0xac, 0x1b, 0xef, 0xc0, 0xc5, 0xbb, 0xc9,
+	0x63, 0x33, 0x0d, 0x64, 0x61, 0x9b, 0x1e, 0xc5, 0x94, 0x47, 0xf8, 0x12, 0x2c, 0xf2, 0xc8, 0x3e,
+	0xa0, 0x61, 0x1d, 0x5d, 0x41, 0xd7, 0x96, 0xdb, 0xf2, 0x09, 0xaf, 0xc3, 0x72, 0x77, 0xe8, 0x5b,
+	0xaf, 0x89, 0xa5, 0x91, 0xc1, 0x70, 0xe0, 0x52, 0x71, 0x3b, 0xde, 0x63, 0x01, 0xa7, 0x78, 0x4f,
+	0x8d, 0x4b, 0xb6, 0x3c, 0xd7, 0xb8, 0x61, 0x8e, 0x4b, 0x67, 0xde, 0x13, 0xc7, 0x8c, 0xc5, 0xab,
+	0x87, 0x7c, 0x87, 0xe0, 0x72, 0x85, 0x1b, 0x5e, 0x2f, 0x1e, 0xa3, 0xe2, 0xe1, 0x17, 0xe0, 0xbc,
+	0x13, 0x87, 0x21, 0x0d, 0x22, 0x2b, 0xa4, 0x0f, 0xec, 0xb0, 0x2b, 0x32, 0x58, 0x68, 0xaf, 0x48,
+	0x6b, 0x5b, 0x18, 0xf1, 0x0d, 0x78, 0x7a, 0x24, 0xa4, 0x65, 0x1f, 0xb2, 0x38, 0x88, 0xea, 0xf3,
+	0xc2, 0xf3, 0xc2, 0x68, 0xe1, 0x75, 0x61, 0x57, 0x84, 0x5a, 0x50, 0x85, 0x32, 0x3e, 0x45, 0xa0,
+	0xe7, 0xb5, 0xe0, 0xbb, 0xfd, 0x14, 0x7b, 0xa8, 0x71, 0x0b, 0x60, 0x74, 0x1d, 0xa4, 0x28, 0x2f,
+	0x9a, 0xe9, 0xdd, 0x31, 0x93, 0xbb, 0x53, 0xd0, 0xe6, 0x8e, 0xed, 0x52, 0x19, 0xdb, 0x56, 0x22,
+	0x15, 0x84, 0x5a, 0x0e, 0xe1, 0xeb, 0x1a, 0x6c, 0x54, 0x22, 0x48, 0xc1, 0xee, 0x02, 0x64, 0xfa,
+	0xf0, 0x3a, 0xba, 0x32, 0x7f, 0xc6, 0xc2, 0xec, 0x2e, 0x3c, 0xfc, 0x73, 0x63, 0xae, 0xad, 0x6c,
+	0x82, 0xaf, 0xc3, 0x85, 0x88, 0x45, 0xb6, 0x6f, 0x8d, 0xb4, 0x92, 0x3a, 0xaf, 0x0a, 0x7b, 0x33,
+	0x33, 0xe3, 0x06, 0x5c, 0xcc, 0xb9, 0xb2, 0xd0, 0x72, 0x14, 0xb5, 0x9f, 0x51, 0xfd, 0x59, 0xf8,
+	0x86, 0x10, 0xfc, 0xad, 0x9c, 0x6a, 0x0b, 0x42, 0xb5, 0x97, 0xa6, 0xaa, 0x26, 0xaf, 0x91, 0x12,
+	0x6a, 0x7c, 0x3e, 0xac, 0x50, 0x9a, 0x1a, 0xdf, 0x1d, 0xef, 0x82, 0x27, 0x55, 0xa1, 0xc9, 0x5d,
+	0xf3, 0x07, 0x82, 0x8d, 0x4a, 0x90, 0x99, 0x2e, 0xf6, 0x7b, 0xb0, 0x94, 0xd6, 0x9c, 0xd7, 0x6b,
+	0xa2, 0x84, 0xa4, 0xac, 0x84, 0x23, 0xe1, 0x5b, 0x2c, 0xcc, 0xdf, 0x03, 0x59, 0xc6, 0xe1, 0x2e,
+	0x05, 0x91, 0xe7, 0x1f, 0x5f, 0xe4, 0x1f, 0x10, 0xac, 0x4d, 0x38, 0x17, 0xef, 0xe4, 0xe6, 0xcc,
+	0xb9, 0x86, 0x5e, 0x06, 0xde, 0x8a, 0x7d, 0x5f, 0xc6, 0x0d, 0xe7, 0xd0, 0xbf, 0xd0, 0xca, 0xc6,
+	0x00, 0xd6, 0xd2, 0x32, 0xf8, 0x36, 0xdf, 0xa7, 0xff, 0x79, 0xbb, 0xfe, 0x8c, 0x60, 0xbd, 0xfc,
+	0x7c, 0xa9, 0x55, 0x13, 0x96, 0x78, 0xba, 0x24, 0x1b, 0xf5, 0x6a, 0x2a, 0x96, 0x32, 0xf5, 0xb3,
+	0x6e, 0x4d, 0xfc, 0xda, 0xd4, 0x61, 0x61, 0x37, 0x2b, 0x6d, 0x1a, 0x5a, 0x28, 0x6d, 0xed, 0xf1,
+	0x4b, 0xfb, 0x09, 0x3c, 0x97, 0xc7, 0xbd, 0xc3, 0x98, 0xff, 0xa4, 0xc5, 0xba, 0x0c, 0x4b, 0x3d,
+	0xc6, 0x7c, 0xcb, 0x1b, 0x16, 0x78, 0x31, 0x79, 0xdc, 0xeb, 0x1a, 0x3f, 0x22, 0xd0, 0xca, 0x8e,
+	0xff, 0x7f, 0x6a, 0xb5, 0x26, 0xb5, 0xda, 0x0b, 0x78, 0x1c, 0xda, 0x81, 0x43, 0x5b, 0x71, 0xd0,
+	0x95, 0xf9, 0x1a, 0x47, 0xa0, 0x95, 0x2d, 0xca, 0x4c, 0xee, 0xc1, 0x79, 0x6f, 0xb8, 0x60, 0x7d,
+	0x14, 0x07, 0xdd, 0x4c, 0xcd, 0xaa, 0x84, 0x72, 0xfb, 0xc8, 0x94, 0x56, 0x3c, 0xd5, 0xd8, 0xf8,
+	0xf6, 0x29, 0x58, 0x55, 0x5f, 0x0d, 0x89, 0xd4, 0xdf, 0x20, 0x58, 0xce, 0x06, 0x0f, 0xbe, 0x5e,
+	0xd6, 0x88, 0xa5, 0xdf, 0x0a, 0xda, 0xe6, 0x2c, 0xae, 0x69, 0x36, 0xc6, 0xab, 0x9f, 0xfd, 0xf6,
+	0xf7, 0x97, 0xb5, 0xdb, 0xb8, 0x41, 0xaa, 0x3f, 0xae, 0x58, 0x48, 0x8e, 0xd3, 0x9e, 0x18, 0x90,
+	0xe3, 0xcc, 0x36, 0xc0, 0xbf, 0x20, 0xc0, 0xe3, 0xaf, 0x32, 0xdc, 0x98, 0x7e, 0x7c, 0xb1, 0x97,
+	0xb5, 0xed, 0x33, 0xc5, 0x48, 0xf6, 0x57, 0x04, 0xfb, 0x36, 0xde, 0x9a, 0xc8, 0xce, 0xad, 0x4e,
+	0xdf, 0x4a, 0xf1, 0xb3, 0x34, 0xf0, 0xaf, 0x08, 0xf0, 0xf8, 0x74, 0x9f, 0x80, 0x5e, 0xf9, 0x4e,
+	0xd2, 0xb6, 0xcf, 0x14, 0x23, 0xd1, 0x5f, 0x13, 0xe8, 0x3b, 0xf8, 0x76, 0x19, 0xba, 0x1c, 0xfa,
+	0x09, 0xb7, 0x52, 0x01, 0x45, 0xf8, 0x9f, 0x10, 0xac, 0x16, 0x86, 0x12, 0x26, 0xd5, 0x18, 0xa5,
+	0xe3, 0x53, 0xbb, 0x35, 0x7b, 0x80, 0x84, 0xde, 0x11, 0xd0, 0xb7, 0xb0, 0x59, 0x0a, 0x9d, 0x06,
+	0x95, 0x89, 0xfd, 0x3d, 0x82, 0x95, 0xdc, 0x54, 0xc0, 0x37, 0xa7, 0x9f, 0xad, 0x0c, 0x2f, 0xcd,
+	0x9c, 0xd5, 0x5d, 0x82, 0xbe, 0x2c, 0x40, 0x09, 0xbe, 0x39, 0x05, 0x34, 0x19, 0x5d, 0xe4, 0x58,
+	0xce, 0xb3, 0x01, 0xfe, 0x0a, 0xc1, 0x4a, 0xae, 0x57, 0x27, 0x70, 0x96, 0x0d, 0x0e, 0xcd, 0x9c,
+	0xd5, 0x5d, 0x72, 0x6e, 0x0a, 0xce, 0xab, 0xd8, 0x28, 0xe3, 0xcc, 0x0f, 0x99, 0xdd, 0xe6, 0xc3,
+	0x13, 0x1d, 0x3d, 0x3a, 0xd1, 0xd1, 0x5f, 0x27, 0x3a, 0xfa, 0xe2, 0x54, 0x9f, 0x7b, 0x74, 0xaa,
+	0xcf, 0xfd, 0x7e, 0xaa, 0xcf, 0x7d, 0xb8, 0xe9, 0x7a, 0xd1, 0x7e, 0xdc, 0x31, 0x1d, 0x76, 0x48,
+	0xde, 0xfe, 0xe0, 0xfd, 0x37, 0xdf, 0xa5, 0xd1, 0x03, 0x16, 0x1e, 0x10, 0x67, 0xdf, 0xf6, 0x02,
+	0xf2, 0xb1, 0xdc, 0x36, 0xea, 0xf7, 0x28, 0xef, 0x2c, 0x8a, 0xbf, 0x19, 0xdb, 0xff, 0x0c, 0x00,
+	0x4b, 0x45, 0x3b, 0xc0, 0x4b, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -598,6 +923,14 @@ type QueryDelegationClient interface {
 	// StakersByPoolAndDelegator returns all stakers the given delegator has delegated to.
 	// This query is paginated.
 	StakersByDelegator(ctx context.Context, in *QueryStakersByDelegatorRequest, opts ...grpc.CallOption) (*QueryStakersByDelegatorResponse, error)
+	// SlashesByStaker returns all slashes which happened to the given staker.
+	// This query is paginated.
+	SlashesByStaker(ctx context.Context, in *QuerySlashesByStakerRequest, opts ...grpc.CallOption) (*QuerySlashesByStakerResponse, error)
+	// SlashesByPool returns all slashes which happened in the given pool.
+	// This query is paginated.
+	SlashesByPool(ctx context.Context, in *QuerySlashesByPoolRequest, opts ...grpc.CallOption) (*QuerySlashesByPoolResponse, error)
+	// InsuranceFund returns the current state of the insurance fund.
+	InsuranceFund(ctx context.Context, in *QueryInsuranceFundRequest, opts ...grpc.CallOption) (*QueryInsuranceFundResponse, error)
 }
 
 type queryDelegationClient struct {
@@ -635,6 +968,33 @@ func (c *queryDelegationClient) StakersByDelegator(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *queryDelegationClient) SlashesByStaker(ctx context.Context, in *QuerySlashesByStakerRequest, opts ...grpc.CallOption) (*QuerySlashesByStakerResponse, error) {
+	out := new(QuerySlashesByStakerResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryDelegation/SlashesByStaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryDelegationClient) SlashesByPool(ctx context.Context, in *QuerySlashesByPoolRequest, opts ...grpc.CallOption) (*QuerySlashesByPoolResponse, error) {
+	out := new(QuerySlashesByPoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryDelegation/SlashesByPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryDelegationClient) InsuranceFund(ctx context.Context, in *QueryInsuranceFundRequest, opts ...grpc.CallOption) (*QueryInsuranceFundResponse, error) {
+	out := new(QueryInsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryDelegation/InsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryDelegationServer is the server API for QueryDelegation service.
 type QueryDelegationServer interface {
 	// Delegator returns delegation information for a specific delegator of a specific staker.
@@ -645,6 +1005,14 @@ type QueryDelegationServer interface {
 	// StakersByPoolAndDelegator returns all stakers the given delegator has delegated to.
 	// This query is paginated.
 	StakersByDelegator(context.Context, *QueryStakersByDelegatorRequest) (*QueryStakersByDelegatorResponse, error)
+	// SlashesByStaker returns all slashes which happened to the given staker.
+	// This query is paginated.
+	SlashesByStaker(context.Context, *QuerySlashesByStakerRequest) (*QuerySlashesByStakerResponse, error)
+	// SlashesByPool returns all slashes which happened in the given pool.
+	// This query is paginated.
+	SlashesByPool(context.Context, *QuerySlashesByPoolRequest) (*QuerySlashesByPoolResponse, error)
+	// InsuranceFund returns the current state of the insurance fund.
+	InsuranceFund(context.Context, *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error)
 }
 
 // UnimplementedQueryDelegationServer can be embedded to have forward compatible implementations.
@@ -660,6 +1028,15 @@ func (*UnimplementedQueryDelegationServer) DelegatorsByStaker(ctx context.Contex
 func (*UnimplementedQueryDelegationServer) StakersByDelegator(ctx context.Context, req *QueryStakersByDelegatorRequest) (*QueryStakersByDelegatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StakersByDelegator not implemented")
 }
+func (*UnimplementedQueryDelegationServer) SlashesByStaker(ctx context.Context, req *QuerySlashesByStakerRequest) (*QuerySlashesByStakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashesByStaker not implemented")
+}
+func (*UnimplementedQueryDelegationServer) SlashesByPool(ctx context.Context, req *QuerySlashesByPoolRequest) (*QuerySlashesByPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashesByPool not implemented")
+}
+func (*UnimplementedQueryDelegationServer) InsuranceFund(ctx context.Context, req *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFund not implemented")
+}
 
 func RegisterQueryDelegationServer(s grpc1.Server, srv QueryDelegationServer) {
 	s.RegisterService(&_QueryDelegation_serviceDesc, srv)
@@ -719,6 +1096,60 @@ func _QueryDelegation_StakersByDelegator_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryDelegation_SlashesByStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashesByStakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryDelegationServer).SlashesByStaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryDelegation/SlashesByStaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryDelegationServer).SlashesByStaker(ctx, req.(*QuerySlashesByStakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryDelegation_SlashesByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashesByPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryDelegationServer).SlashesByPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryDelegation/SlashesByPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryDelegationServer).SlashesByPool(ctx, req.(*QuerySlashesByPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryDelegation_InsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryDelegationServer).InsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryDelegation/InsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryDelegationServer).InsuranceFund(ctx, req.(*QueryInsuranceFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryDelegation_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.query.v1beta1.QueryDelegation",
 	HandlerType: (*QueryDelegationServer)(nil),
@@ -735,6 +1166,18 @@ var _QueryDelegation_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StakersByDelegator",
 			Handler:    _QueryDelegation_StakersByDelegator_Handler,
 		},
+		{
+			MethodName: "SlashesByStaker",
+			Handler:    _QueryDelegation_SlashesByStaker_Handler,
+		},
+		{
+			MethodName: "SlashesByPool",
+			Handler:    _QueryDelegation_SlashesByPool_Handler,
+		},
+		{
+			MethodName: "InsuranceFund",
+			Handler:    _QueryDelegation_InsuranceFund_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/query/v1beta1/delegation.proto",
@@ -1103,9 +1546,245 @@ func (m *DelegationForStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDelegation(v)
-	base := offset
+func (m *QuerySlashesByStakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesByStakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesByStakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesByStakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesByStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesByStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesByPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesByPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesByPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesByPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesByPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesByPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InsuranceFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegation(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -1242,39 +1921,758 @@ func (m *QueryStakersByDelegatorResponse) Size() (n int) {
 			n += 1 + l + sovDelegation(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovDelegation(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationForStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Staker != nil {
+		l = m.Staker.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.CurrentReward != 0 {
+		n += 1 + sovDelegation(uint64(m.CurrentReward))
+	}
+	if m.DelegationAmount != 0 {
+		n += 1 + sovDelegation(uint64(m.DelegationAmount))
+	}
+	return n
+}
+
+func (m *QuerySlashesByStakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashesByStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashesByPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovDelegation(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QuerySlashesByPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InsuranceFund.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	return n
+}
+
+func sovDelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelegation(x uint64) (n int) {
+	return sovDelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delegator == nil {
+				m.Delegator = &StakerDelegatorResponse{}
+			}
+			if err := m.Delegator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerDelegatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
+			}
+			m.CurrentReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			}
+			m.DelegationAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorsByStakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorsByStakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorsByStakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorsByStakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorsByStakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorsByStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, StakerDelegatorResponse{})
+			if err := m.Delegators[len(m.Delegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			}
+			m.TotalDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatorCount", wireType)
+			}
+			m.TotalDelegatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDelegatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *DelegationForStakerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Staker != nil {
-		l = m.Staker.Size()
-		n += 1 + l + sovDelegation(uint64(l))
-	}
-	if m.CurrentReward != 0 {
-		n += 1 + sovDelegation(uint64(m.CurrentReward))
-	}
-	if m.DelegationAmount != 0 {
-		n += 1 + sovDelegation(uint64(m.DelegationAmount))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDelegation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDelegation(x uint64) (n int) {
-	return sovDelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakersByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1297,17 +2695,17 @@ func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakersByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakersByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1317,23 +2715,27 @@ func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDelegation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1388,7 +2790,7 @@ func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakersByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1411,16 +2813,48 @@ func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakersByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakersByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -1446,10 +2880,44 @@ func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delegator == nil {
-				m.Delegator = &StakerDelegatorResponse{}
+			m.Stakers = append(m.Stakers, DelegationForStakerResponse{})
+			if err := m.Stakers[len(m.Stakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Delegator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1474,7 +2942,7 @@ func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1497,17 +2965,17 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakerDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationForStakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakerDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationForStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1517,23 +2985,27 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDelegation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			if m.Staker == nil {
+				m.Staker = &FullStaker{}
+			}
+			if err := m.Staker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1573,38 +3045,6 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -1626,7 +3066,7 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorsByStakerRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashesByStakerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1649,10 +3089,10 @@ func (m *QueryDelegatorsByStakerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorsByStakerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashesByStakerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorsByStakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashesByStakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1744,7 +3184,7 @@ func (m *QueryDelegatorsByStakerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorsByStakerResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashesByStakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1767,15 +3207,15 @@ func (m *QueryDelegatorsByStakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorsByStakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashesByStakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorsByStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashesByStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1802,50 +3242,12 @@ func (m *QueryDelegatorsByStakerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegators = append(m.Delegators, StakerDelegatorResponse{})
-			if err := m.Delegators[len(m.Delegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slashes = append(m.Slashes, types.SlashRecord{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
-			}
-			m.TotalDelegation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalDelegation |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatorCount", wireType)
-			}
-			m.TotalDelegatorCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalDelegatorCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1902,7 +3304,7 @@ func (m *QueryDelegatorsByStakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakersByDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashesByPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1925,10 +3327,10 @@ func (m *QueryStakersByDelegatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakersByDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashesByPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakersByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashesByPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1968,10 +3370,10 @@ func (m *QueryStakersByDelegatorRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1981,24 +3383,11 @@ func (m *QueryStakersByDelegatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -2020,7 +3409,7 @@ func (m *QueryStakersByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakersByDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashesByPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2043,47 +3432,15 @@ func (m *QueryStakersByDelegatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakersByDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashesByPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakersByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashesByPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDelegation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2110,12 +3467,12 @@ func (m *QueryStakersByDelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stakers = append(m.Stakers, DelegationForStakerResponse{})
-			if err := m.Stakers[len(m.Stakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slashes = append(m.Slashes, types.SlashRecord{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2172,7 +3529,7 @@ func (m *QueryStakersByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInsuranceFundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,15 +3552,65 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationForStakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInsuranceFundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationForStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInsuranceFundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2230,51 +3637,10 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Staker == nil {
-				m.Staker = &FullStaker{}
-			}
-			if err := m.Staker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InsuranceFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
-			}
-			m.CurrentReward = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentReward |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
-			}
-			m.DelegationAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DelegationAmount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
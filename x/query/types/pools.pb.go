@@ -189,6 +189,9 @@ type PoolResponse struct {
 	Account string `protobuf:"bytes,8,opt,name=account,proto3" json:"account,omitempty"`
 	// account_balance ...
 	AccountBalance uint64 `protobuf:"varint,9,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
+	// runway is the estimated number of bundles the pool
+	// can still pay for with its current funds
+	Runway uint64 `protobuf:"varint,10,opt,name=runway,proto3" json:"runway,omitempty"`
 }
 
 func (m *PoolResponse) Reset()         { *m = PoolResponse{} }
@@ -287,6 +290,13 @@ func (m *PoolResponse) GetAccountBalance() uint64 {
 	return 0
 }
 
+func (m *PoolResponse) GetRunway() uint64 {
+	if m != nil {
+		return m.Runway
+	}
+	return 0
+}
+
 // QueryPoolRequest is the request type for the Query/Pool RPC method.
 type QueryPoolRequest struct {
 	// id defines the unique ID of the pool.
@@ -390,51 +400,51 @@ func init() {
 func init() { proto.RegisterFile("kyve/query/v1beta1/pools.proto", fileDescriptor_b627739c2d7723dc) }
 
 var fileDescriptor_b627739c2d7723dc = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4f, 0xd4, 0x4e,
-	0x18, 0xde, 0xee, 0x76, 0x17, 0x18, 0x7e, 0xbf, 0x05, 0x06, 0x3f, 0xea, 0x2a, 0xa5, 0x36, 0x7c,
-	0xac, 0x98, 0xb4, 0x61, 0x8d, 0x17, 0xe3, 0x69, 0x83, 0x1a, 0x63, 0xd4, 0xb5, 0x24, 0x26, 0x7a,
-	0xd9, 0x4c, 0xdb, 0xa1, 0x34, 0x94, 0x4e, 0xe9, 0x4c, 0xd1, 0xd5, 0x78, 0xe1, 0x6e, 0x62, 0xe2,
-	0xd1, 0x7f, 0x88, 0x23, 0x89, 0x17, 0xbd, 0x18, 0x03, 0xfe, 0x21, 0xa6, 0x33, 0xd3, 0xda, 0x15,
-	0x11, 0x6f, 0xf3, 0xce, 0xfb, 0x3c, 0xef, 0xfb, 0xbc, 0x1f, 0x33, 0x40, 0xdf, 0x19, 0xed, 0x63,
-	0x7b, 0x2f, 0xc3, 0xe9, 0xc8, 0xde, 0x5f, 0x77, 0x31, 0x43, 0xeb, 0x76, 0x42, 0x48, 0x44, 0xad,
-	0x24, 0x25, 0x8c, 0x40, 0x98, 0xfb, 0x2d, 0xee, 0xb7, 0xa4, 0xbf, 0xb3, 0xe6, 0x11, 0xba, 0x4b,
-	0xa8, 0xed, 0x22, 0x7a, 0x8a, 0x8a, 0x82, 0x30, 0x46, 0x2c, 0x24, 0xb1, 0xe0, 0x77, 0x2e, 0x04,
-	0x24, 0x20, 0xfc, 0x68, 0xe7, 0x27, 0x79, 0x7b, 0x2d, 0x20, 0x24, 0x88, 0xb0, 0x8d, 0x92, 0xd0,
-	0x46, 0x71, 0x4c, 0x18, 0xa7, 0xc8, 0x9c, 0x1d, 0x93, 0x6b, 0x72, 0xb3, 0xd8, 0x8f, 0x30, 0x2d,
-	0x43, 0x4b, 0xbb, 0x88, 0xc0, 0x31, 0xb9, 0xd2, 0x31, 0xd9, 0xc2, 0x6b, 0x7e, 0x55, 0xc0, 0xdc,
-	0xb3, 0x5c, 0xd8, 0x20, 0x2f, 0xc5, 0xc1, 0x7b, 0x19, 0xa6, 0x0c, 0xde, 0x07, 0xe0, 0x97, 0x3e,
-	0x4d, 0x31, 0x94, 0xee, 0x74, 0x6f, 0xc5, 0x12, 0xc5, 0x58, 0x79, 0x31, 0xe3, 0x75, 0x5a, 0x03,
-	0x14, 0x60, 0xc9, 0x75, 0x2a, 0x4c, 0x78, 0x09, 0xb4, 0x28, 0x46, 0xa9, 0xb7, 0xad, 0xd5, 0x0d,
-	0xa5, 0x3b, 0xe5, 0x48, 0x0b, 0x6a, 0x60, 0x22, 0xcd, 0x62, 0x16, 0xee, 0x62, 0xad, 0xc1, 0x1d,
-	0x85, 0x09, 0x3b, 0x60, 0xd2, 0x0f, 0x29, 0x72, 0x23, 0xec, 0x6b, 0xaa, 0xa1, 0x74, 0x27, 0x9d,
-	0xd2, 0x86, 0x16, 0x98, 0xa7, 0x8c, 0xa4, 0x28, 0xc0, 0xc3, 0x24, 0x25, 0xfb, 0xa1, 0x8f, 0xd3,
-	0x61, 0xe8, 0x6b, 0x4d, 0x43, 0xe9, 0xfe, 0xef, 0xcc, 0x49, 0xd7, 0x40, 0x7a, 0x1e, 0xfa, 0xe6,
-	0x27, 0x05, 0xc0, 0x6a, 0x6d, 0x34, 0x21, 0x31, 0xc5, 0xf0, 0x2e, 0x68, 0xf2, 0xb9, 0x69, 0x8a,
-	0xd1, 0xe8, 0x4e, 0xf7, 0x0c, 0xeb, 0xf4, 0xe0, 0xac, 0x9c, 0x51, 0x10, 0xfa, 0xea, 0xe1, 0xb7,
-	0xc5, 0x9a, 0x23, 0x48, 0xf0, 0xc1, 0x58, 0x6b, 0xea, 0xbc, 0x35, 0xab, 0xe7, 0xb6, 0x46, 0x44,
-	0xaa, 0xf6, 0xc6, 0x7c, 0xdf, 0x00, 0xff, 0x55, 0xd3, 0xc0, 0x36, 0xa8, 0x87, 0x3e, 0x6f, 0xb6,
-	0xea, 0xd4, 0x43, 0x1f, 0xde, 0x04, 0xaa, 0x8f, 0x18, 0x92, 0x39, 0x2e, 0x0b, 0x99, 0x7c, 0x74,
-	0x63, 0x2a, 0x39, 0x08, 0x3e, 0x06, 0x33, 0x62, 0xec, 0x79, 0x6b, 0x12, 0x42, 0x51, 0xc4, 0x3b,
-	0x3b, 0xdd, 0x5b, 0x12, 0xbc, 0x62, 0x27, 0x0a, 0x6a, 0x9f, 0xdb, 0x03, 0x89, 0x75, 0xda, 0xee,
-	0x98, 0x9d, 0x0f, 0x88, 0x32, 0xb4, 0x83, 0x53, 0xaa, 0xa9, 0x46, 0x23, 0x1f, 0x90, 0x34, 0x61,
-	0x0f, 0x5c, 0x64, 0x84, 0xa1, 0x68, 0x48, 0x71, 0xb4, 0x35, 0xf4, 0x71, 0x84, 0x03, 0xd1, 0x8a,
-	0x26, 0x17, 0x3e, 0xcf, 0x9d, 0x9b, 0x38, 0xda, 0xda, 0x28, 0x5d, 0xf0, 0x06, 0x98, 0x15, 0x9c,
-	0x0a, 0xbc, 0xc5, 0xe1, 0x33, 0xfc, 0xbe, 0x02, 0xbd, 0x0d, 0x5a, 0x94, 0x21, 0x96, 0x51, 0x6d,
-	0xc2, 0x50, 0xba, 0xed, 0xde, 0xc2, 0x19, 0x65, 0x6f, 0x72, 0x90, 0x23, 0xc1, 0xb9, 0x5e, 0xe4,
-	0x79, 0x24, 0x8b, 0x99, 0x36, 0x29, 0x16, 0x4a, 0x9a, 0x70, 0x15, 0xcc, 0xc8, 0xe3, 0xd0, 0x45,
-	0x11, 0x8a, 0x3d, 0xac, 0x4d, 0xf1, 0xd4, 0x6d, 0x79, 0xdd, 0x17, 0xb7, 0xa6, 0x09, 0x66, 0xcb,
-	0x65, 0x29, 0xde, 0xc1, 0x6f, 0x23, 0x31, 0x9f, 0x56, 0x1e, 0x4b, 0x39, 0xb7, 0x3b, 0x40, 0xcd,
-	0xe5, 0xc9, 0x67, 0xf2, 0xaf, 0xeb, 0xc4, 0x39, 0xbd, 0x83, 0x3a, 0x98, 0x2a, 0x23, 0xc2, 0x11,
-	0x68, 0xf2, 0x55, 0x85, 0xcb, 0x7f, 0x0a, 0x72, 0xea, 0x99, 0x76, 0x56, 0xce, 0x83, 0x89, 0x8c,
-	0xe6, 0xf5, 0x83, 0xcf, 0x3f, 0x3e, 0xd6, 0xaf, 0xc2, 0x2b, 0xf6, 0x59, 0x7f, 0x18, 0x7c, 0x03,
-	0x54, 0x2e, 0x61, 0xe9, 0xaf, 0x21, 0x8b, 0xc4, 0xcb, 0xe7, 0xa0, 0x64, 0xde, 0x65, 0x9e, 0x77,
-	0x11, 0x2e, 0x9c, 0x95, 0xd7, 0x7e, 0x1b, 0xfa, 0xef, 0xfa, 0x1b, 0x87, 0xc7, 0xba, 0x72, 0x74,
-	0xac, 0x2b, 0xdf, 0x8f, 0x75, 0xe5, 0xc3, 0x89, 0x5e, 0x3b, 0x3a, 0xd1, 0x6b, 0x5f, 0x4e, 0xf4,
-	0xda, 0xcb, 0xb5, 0x20, 0x64, 0xdb, 0x99, 0x6b, 0x79, 0x64, 0xd7, 0x7e, 0xf4, 0xe2, 0xf9, 0xbd,
-	0x27, 0x98, 0xbd, 0x22, 0xe9, 0x8e, 0xed, 0x6d, 0xa3, 0x30, 0xb6, 0x5f, 0xcb, 0x88, 0x6c, 0x94,
-	0x60, 0xea, 0xb6, 0xf8, 0x87, 0x76, 0xeb, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0x63, 0x05,
-	0xb9, 0xa8, 0x05, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x6e, 0xda, 0xb4, 0xdb, 0x3c, 0xe8, 0x36, 0x8f, 0x8f, 0x50, 0x58, 0x16, 0xa2, 0x7d, 0x94,
+	0x21, 0x25, 0x5a, 0x11, 0x17, 0xc4, 0xa9, 0x1a, 0x20, 0x84, 0x80, 0x92, 0x49, 0x48, 0x70, 0xa9,
+	0x9c, 0xc4, 0xcb, 0xa2, 0x65, 0x71, 0x16, 0x3b, 0x1b, 0x05, 0x71, 0xd9, 0x2f, 0x40, 0xe2, 0xc8,
+	0x99, 0xff, 0xb2, 0xe3, 0x24, 0x2e, 0x70, 0x41, 0x68, 0xe3, 0x87, 0xa0, 0xd8, 0x4e, 0x49, 0x19,
+	0xa3, 0xdc, 0xfc, 0xfa, 0x7d, 0x9e, 0xf7, 0x7d, 0xde, 0x0f, 0x1b, 0xe8, 0x3b, 0x83, 0x7d, 0x6c,
+	0xef, 0x65, 0x38, 0x1d, 0xd8, 0xfb, 0xeb, 0x2e, 0x66, 0x68, 0xdd, 0x4e, 0x08, 0x89, 0xa8, 0x95,
+	0xa4, 0x84, 0x11, 0x08, 0x73, 0xbf, 0xc5, 0xfd, 0x96, 0xf4, 0xb7, 0xd6, 0x3c, 0x42, 0x77, 0x09,
+	0xb5, 0x5d, 0x44, 0xcf, 0x50, 0x51, 0x10, 0xc6, 0x88, 0x85, 0x24, 0x16, 0xfc, 0xd6, 0xa5, 0x80,
+	0x04, 0x84, 0x1f, 0xed, 0xfc, 0x24, 0x6f, 0x6f, 0x04, 0x84, 0x04, 0x11, 0xb6, 0x51, 0x12, 0xda,
+	0x28, 0x8e, 0x09, 0xe3, 0x14, 0x99, 0xb3, 0x65, 0x72, 0x4d, 0x6e, 0x16, 0xfb, 0x11, 0xa6, 0xc3,
+	0xd0, 0xd2, 0x2e, 0x22, 0x70, 0x4c, 0xae, 0x74, 0x44, 0xb6, 0xf0, 0x9a, 0xdf, 0x14, 0x30, 0xf7,
+	0x22, 0x17, 0xd6, 0xcb, 0x4b, 0x71, 0xf0, 0x5e, 0x86, 0x29, 0x83, 0x0f, 0x01, 0xf8, 0xad, 0x4f,
+	0x53, 0x0c, 0xa5, 0x3d, 0xdd, 0x59, 0xb1, 0x44, 0x31, 0x56, 0x5e, 0xcc, 0x68, 0x9d, 0x56, 0x0f,
+	0x05, 0x58, 0x72, 0x9d, 0x12, 0x13, 0x5e, 0x01, 0x0d, 0x8a, 0x51, 0xea, 0x6d, 0x6b, 0x55, 0x43,
+	0x69, 0x4f, 0x39, 0xd2, 0x82, 0x1a, 0x98, 0x48, 0xb3, 0x98, 0x85, 0xbb, 0x58, 0xab, 0x71, 0x47,
+	0x61, 0xc2, 0x16, 0x98, 0xf4, 0x43, 0x8a, 0xdc, 0x08, 0xfb, 0x9a, 0x6a, 0x28, 0xed, 0x49, 0x67,
+	0x68, 0x43, 0x0b, 0xcc, 0x53, 0x46, 0x52, 0x14, 0xe0, 0x7e, 0x92, 0x92, 0xfd, 0xd0, 0xc7, 0x69,
+	0x3f, 0xf4, 0xb5, 0xba, 0xa1, 0xb4, 0x2f, 0x3a, 0x73, 0xd2, 0xd5, 0x93, 0x9e, 0xc7, 0xbe, 0xf9,
+	0x49, 0x01, 0xb0, 0x5c, 0x1b, 0x4d, 0x48, 0x4c, 0x31, 0xbc, 0x0f, 0xea, 0x7c, 0x6e, 0x9a, 0x62,
+	0xd4, 0xda, 0xd3, 0x1d, 0xc3, 0x3a, 0x3b, 0x38, 0x2b, 0x67, 0x14, 0x84, 0xae, 0x7a, 0xf4, 0x7d,
+	0xb1, 0xe2, 0x08, 0x12, 0x7c, 0x34, 0xd2, 0x9a, 0x2a, 0x6f, 0xcd, 0xea, 0xd8, 0xd6, 0x88, 0x48,
+	0xe5, 0xde, 0x98, 0x9f, 0x6b, 0xe0, 0x42, 0x39, 0x0d, 0x6c, 0x82, 0x6a, 0xe8, 0xf3, 0x66, 0xab,
+	0x4e, 0x35, 0xf4, 0xe1, 0x6d, 0xa0, 0xfa, 0x88, 0x21, 0x99, 0xe3, 0xaa, 0x90, 0xc9, 0x47, 0x37,
+	0xa2, 0x92, 0x83, 0xe0, 0x53, 0x30, 0x23, 0xc6, 0x9e, 0xb7, 0x26, 0x21, 0x14, 0x45, 0xbc, 0xb3,
+	0xd3, 0x9d, 0x25, 0xc1, 0x2b, 0x76, 0xa2, 0xa0, 0x76, 0xb9, 0xdd, 0x93, 0x58, 0xa7, 0xe9, 0x8e,
+	0xd8, 0xf9, 0x80, 0x28, 0x43, 0x3b, 0x38, 0xa5, 0x9a, 0x6a, 0xd4, 0xf2, 0x01, 0x49, 0x13, 0x76,
+	0xc0, 0x65, 0x46, 0x18, 0x8a, 0xfa, 0x14, 0x47, 0x5b, 0x7d, 0x1f, 0x47, 0x38, 0x10, 0xad, 0xa8,
+	0x73, 0xe1, 0xf3, 0xdc, 0xb9, 0x89, 0xa3, 0xad, 0x8d, 0xa1, 0x0b, 0xde, 0x02, 0xb3, 0x82, 0x53,
+	0x82, 0x37, 0x38, 0x7c, 0x86, 0xdf, 0x97, 0xa0, 0x77, 0x41, 0x83, 0x32, 0xc4, 0x32, 0xaa, 0x4d,
+	0x18, 0x4a, 0xbb, 0xd9, 0x59, 0x38, 0xa7, 0xec, 0x4d, 0x0e, 0x72, 0x24, 0x38, 0xd7, 0x8b, 0x3c,
+	0x8f, 0x64, 0x31, 0xd3, 0x26, 0xc5, 0x42, 0x49, 0x13, 0xae, 0x82, 0x19, 0x79, 0xec, 0xbb, 0x28,
+	0x42, 0xb1, 0x87, 0xb5, 0x29, 0x9e, 0xba, 0x29, 0xaf, 0xbb, 0xe2, 0x36, 0xdf, 0xd5, 0x34, 0x8b,
+	0x0f, 0xd0, 0x40, 0x03, 0xdc, 0x2f, 0x2d, 0xd3, 0x04, 0xb3, 0xc3, 0x25, 0x2a, 0xde, 0xc7, 0x1f,
+	0xa3, 0x32, 0x9f, 0x97, 0x1e, 0xd1, 0x70, 0x9e, 0xf7, 0x80, 0x9a, 0xcb, 0x96, 0xcf, 0xe7, 0x7f,
+	0xd7, 0x8c, 0x73, 0x3a, 0x87, 0x55, 0x30, 0x35, 0x8c, 0x08, 0x07, 0xa0, 0xde, 0xe3, 0xcb, 0xb7,
+	0xfc, 0xb7, 0x20, 0x67, 0x9e, 0x6f, 0x6b, 0x65, 0x1c, 0x4c, 0x64, 0x34, 0x6f, 0x1e, 0x7e, 0xf9,
+	0xf9, 0xb1, 0x7a, 0x1d, 0x5e, 0xb3, 0xcf, 0xfb, 0xdb, 0xe0, 0x5b, 0xa0, 0x72, 0x09, 0x4b, 0xff,
+	0x0c, 0x59, 0x24, 0x5e, 0x1e, 0x83, 0x92, 0x79, 0x97, 0x79, 0xde, 0x45, 0xb8, 0x70, 0x5e, 0x5e,
+	0xfb, 0x5d, 0xe8, 0xbf, 0xef, 0x6e, 0x1c, 0x9d, 0xe8, 0xca, 0xf1, 0x89, 0xae, 0xfc, 0x38, 0xd1,
+	0x95, 0x0f, 0xa7, 0x7a, 0xe5, 0xf8, 0x54, 0xaf, 0x7c, 0x3d, 0xd5, 0x2b, 0xaf, 0xd7, 0x82, 0x90,
+	0x6d, 0x67, 0xae, 0xe5, 0x91, 0x5d, 0xfb, 0xc9, 0xab, 0x97, 0x0f, 0x9e, 0x61, 0x76, 0x40, 0xd2,
+	0x1d, 0xdb, 0xdb, 0x46, 0x61, 0x6c, 0xbf, 0x91, 0x11, 0xd9, 0x20, 0xc1, 0xd4, 0x6d, 0xf0, 0x8f,
+	0xee, 0xce, 0xaf, 0x01, 0x00, 0xb6, 0x08, 0x72, 0x44, 0xc0, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -690,6 +700,11 @@ func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Runway != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Runway))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.AccountBalance != 0 {
 		i = encodeVarintPools(dAtA, i, uint64(m.AccountBalance))
 		i--
@@ -915,6 +930,9 @@ func (m *PoolResponse) Size() (n int) {
 	if m.AccountBalance != 0 {
 		n += 1 + sovPools(uint64(m.AccountBalance))
 	}
+	if m.Runway != 0 {
+		n += 1 + sovPools(uint64(m.Runway))
+	}
 	return n
 }
 
@@ -1516,6 +1534,25 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runway", wireType)
+			}
+			m.Runway = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runway |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPools(dAtA[iNdEx:])
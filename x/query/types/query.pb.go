@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	types "github.com/KYVENetwork/chain/x/pool/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -282,6 +283,8 @@ type StakerMetadata struct {
 	PendingCommissionChange *CommissionChangeEntry `protobuf:"bytes,7,opt,name=pending_commission_change,json=pendingCommissionChange,proto3" json:"pending_commission_change,omitempty"`
 	// commission_rewards are the rewards in $KYVE earned through commission
 	CommissionRewards uint64 `protobuf:"varint,8,opt,name=commission_rewards,json=commissionRewards,proto3" json:"commission_rewards,omitempty"`
+	// commission_coin_rewards are the rewards in non-native denoms earned through commission
+	CommissionCoinRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=commission_coin_rewards,json=commissionCoinRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission_coin_rewards"`
 }
 
 func (m *StakerMetadata) Reset()         { *m = StakerMetadata{} }
@@ -366,6 +369,13 @@ func (m *StakerMetadata) GetCommissionRewards() uint64 {
 	return 0
 }
 
+func (m *StakerMetadata) GetCommissionCoinRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommissionCoinRewards
+	}
+	return nil
+}
+
 // CommissionChangeEntry shows when the old commission
 // of a staker will change to the new commission
 type CommissionChangeEntry struct {
@@ -423,9 +433,9 @@ type PoolMembership struct {
 	// pool contains useful information about the pool
 	Pool *BasicPool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
 	// points indicates if the staker is inactive
-	// If the staker misses a vote, a point is added.
-	// After 5 points the staker is removed from
-	// the stakers set.
+	// If the staker misses a vote or an upload, points
+	// are added. After max_points the staker is removed
+	// from the stakers set.
 	Points uint64 `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
 	// is_leaving indicates if a user has scheduled a
 	// a PoolLeave entry. After the leave-time is over
@@ -439,6 +449,16 @@ type PoolMembership struct {
 	// whether or not the valaccount needs additional funds to
 	// pay for gas fees
 	Balance uint64 `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	// consecutive_votes is the number of successful votes
+	// since the last point was added or removed. Once it
+	// reaches points_decay_votes a point is removed.
+	ConsecutiveVotes uint64 `protobuf:"varint,6,opt,name=consecutive_votes,json=consecutiveVotes,proto3" json:"consecutive_votes,omitempty"`
+	// jailed indicates if the valaccount is currently jailed
+	// and therefore not allowed to upload or vote.
+	Jailed bool `protobuf:"varint,7,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// jailed_until is the unix timestamp after which the
+	// staker is allowed to unjail the valaccount.
+	JailedUntil uint64 `protobuf:"varint,8,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
 }
 
 func (m *PoolMembership) Reset()         { *m = PoolMembership{} }
@@ -509,6 +529,27 @@ func (m *PoolMembership) GetBalance() uint64 {
 	return 0
 }
 
+func (m *PoolMembership) GetConsecutiveVotes() uint64 {
+	if m != nil {
+		return m.ConsecutiveVotes
+	}
+	return 0
+}
+
+func (m *PoolMembership) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *PoolMembership) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BasicPool)(nil), "kyve.query.v1beta1.BasicPool")
 	proto.RegisterType((*FullStaker)(nil), "kyve.query.v1beta1.FullStaker")
@@ -520,56 +561,63 @@ func init() {
 func init() { proto.RegisterFile("kyve/query/v1beta1/query.proto", fileDescriptor_6b41255feae93a15) }
 
 var fileDescriptor_6b41255feae93a15 = []byte{
-	// 782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x8f, 0xe3, 0x34,
-	0x14, 0xc7, 0x9b, 0xb6, 0xd3, 0x6d, 0x5f, 0xd9, 0x16, 0x2c, 0x60, 0xb3, 0x23, 0x36, 0x33, 0x2a,
-	0x82, 0x9d, 0x45, 0xda, 0x54, 0x33, 0x08, 0x09, 0x71, 0xe0, 0x30, 0xed, 0xae, 0x84, 0x60, 0x57,
-	0xc8, 0x08, 0x24, 0xb8, 0x44, 0x6e, 0xe2, 0x4d, 0xad, 0x26, 0x76, 0xb0, 0x9d, 0x0e, 0xfd, 0x0c,
-	0x70, 0xe0, 0xa3, 0x70, 0xe0, 0x43, 0xec, 0x71, 0x8e, 0xc0, 0x61, 0x84, 0x66, 0xbe, 0x08, 0xb2,
-	0x9d, 0x64, 0xda, 0xa1, 0xdc, 0x38, 0x25, 0xef, 0xff, 0xfe, 0x79, 0xb1, 0x7f, 0xef, 0xd9, 0x10,
-	0xac, 0x36, 0x6b, 0x3a, 0xfd, 0xb1, 0xa4, 0x72, 0x33, 0x5d, 0x9f, 0x2e, 0xa8, 0x26, 0xa7, 0x2e,
-	0x0a, 0x0b, 0x29, 0xb4, 0x40, 0xc8, 0xe4, 0x43, 0xa7, 0x54, 0xf9, 0xc3, 0xb7, 0x53, 0x91, 0x0a,
-	0x9b, 0x9e, 0x9a, 0x37, 0xe7, 0x3c, 0x7c, 0xcf, 0x56, 0x2a, 0x84, 0xc8, 0x9a, 0x42, 0x26, 0x70,
-	0xd9, 0xc9, 0x6f, 0x6d, 0x18, 0x9c, 0x13, 0xc5, 0xe2, 0xaf, 0x85, 0xc8, 0xd0, 0x08, 0xda, 0x2c,
-	0xf1, 0xbd, 0x63, 0xef, 0xa4, 0x8b, 0xdb, 0x2c, 0x41, 0x08, 0xba, 0x9c, 0xe4, 0xd4, 0x6f, 0x1f,
-	0x7b, 0x27, 0x03, 0x6c, 0xdf, 0x91, 0x0f, 0xf7, 0x64, 0xc9, 0x35, 0xcb, 0xa9, 0xdf, 0xb1, 0x72,
-	0x1d, 0x1a, 0x77, 0x26, 0x52, 0xe1, 0x77, 0x9d, 0xdb, 0xbc, 0xa3, 0x0f, 0x60, 0x24, 0x0a, 0x2a,
-	0x89, 0x66, 0x3c, 0x8d, 0x62, 0xa1, 0xb4, 0x7f, 0x60, 0xab, 0xdf, 0x6f, 0xd4, 0x99, 0x50, 0x1a,
-	0x3d, 0x86, 0x71, 0x59, 0x64, 0x82, 0x24, 0x11, 0xe3, 0x9a, 0xca, 0x35, 0xc9, 0xfc, 0x9e, 0xf5,
-	0x8d, 0x9c, 0xfc, 0x45, 0xa5, 0xa2, 0x23, 0x18, 0x6a, 0xa1, 0x49, 0x16, 0xbd, 0x2a, 0x79, 0xa2,
-	0xfc, 0x7b, 0xd6, 0x04, 0x56, 0x7a, 0x6e, 0x14, 0xf4, 0x04, 0xde, 0x74, 0x86, 0x84, 0x66, 0x34,
-	0x25, 0x9a, 0x09, 0xee, 0xf7, 0xad, 0x6b, 0x6c, 0xf5, 0x79, 0x23, 0xa3, 0x4f, 0xa0, 0xa7, 0x34,
-	0xd1, 0xa5, 0xf2, 0x07, 0xc7, 0xde, 0xc9, 0xe8, 0xec, 0x51, 0x68, 0xa1, 0x5a, 0x3a, 0x15, 0xaa,
-	0xd0, 0x60, 0xf9, 0xc6, 0x9a, 0x70, 0x65, 0x9e, 0xfc, 0xd9, 0x06, 0x78, 0x5e, 0x66, 0x46, 0x5e,
-	0x51, 0x69, 0x78, 0x90, 0x24, 0x91, 0x54, 0x29, 0x0b, 0x6e, 0x80, 0xeb, 0x10, 0x7d, 0x0e, 0xfd,
-	0x9c, 0x6a, 0x92, 0x10, 0x4d, 0x2c, 0xc1, 0xe1, 0xd9, 0x24, 0xfc, 0x77, 0xdb, 0x42, 0x57, 0xe7,
-	0x45, 0xe5, 0xc4, 0xcd, 0x37, 0x06, 0x8a, 0xa2, 0xd9, 0xab, 0xed, 0x9d, 0x74, 0x1c, 0x14, 0x23,
-	0x6f, 0x6d, 0xe4, 0x33, 0x78, 0x78, 0xc7, 0x18, 0x95, 0x7c, 0x21, 0x78, 0xc2, 0x78, 0x6a, 0xbb,
-	0xd1, 0xc5, 0x0f, 0x76, 0x3f, 0xf9, 0xb6, 0x4e, 0xef, 0xe5, 0x75, 0xb0, 0x9f, 0xd7, 0x63, 0x18,
-	0x57, 0x26, 0x21, 0xa3, 0x58, 0x94, 0x5c, 0xd7, 0x4d, 0x6a, 0xe4, 0x99, 0x51, 0xd1, 0xa7, 0x70,
-	0x60, 0x20, 0x9a, 0xf6, 0x74, 0xfe, 0x6b, 0xd7, 0x06, 0xec, 0x0b, 0x9a, 0x2f, 0xa8, 0x54, 0x4b,
-	0x56, 0x60, 0xf7, 0xc1, 0xe4, 0xe7, 0x0e, 0x8c, 0x76, 0x79, 0xa0, 0x97, 0x00, 0xb1, 0xc8, 0x73,
-	0xa6, 0x94, 0x59, 0x9a, 0x45, 0x7c, 0x1e, 0xbe, 0xbe, 0x3a, 0x6a, 0xfd, 0x75, 0x75, 0xf4, 0x61,
-	0xca, 0xf4, 0xb2, 0x5c, 0x84, 0xb1, 0xc8, 0xa7, 0xb1, 0x50, 0xb9, 0x50, 0xd5, 0xe3, 0xa9, 0x4a,
-	0x56, 0x53, 0xbd, 0x29, 0xa8, 0x0a, 0xe7, 0x34, 0xc6, 0x5b, 0x15, 0x4c, 0xbf, 0x72, 0xc1, 0xd9,
-	0x8a, 0xca, 0x6a, 0xac, 0xeb, 0xd0, 0x64, 0x2e, 0xe8, 0x42, 0x31, 0xdd, 0x4c, 0x76, 0x15, 0xa2,
-	0x43, 0xe8, 0xb3, 0x84, 0x72, 0xcd, 0xf4, 0xa6, 0x9a, 0xee, 0x26, 0x36, 0x00, 0x15, 0x8d, 0x4b,
-	0xc9, 0xf4, 0x26, 0x8a, 0x05, 0xd7, 0x24, 0x76, 0x33, 0x3e, 0xc0, 0xe3, 0x5a, 0x9f, 0x39, 0xd9,
-	0xfc, 0x20, 0xa1, 0x9a, 0xb0, 0x4c, 0x59, 0x70, 0x03, 0x5c, 0x87, 0x88, 0xc2, 0xc3, 0x82, 0xda,
-	0x86, 0x44, 0xb7, 0x4b, 0x8d, 0xe2, 0x25, 0xe1, 0x29, 0xb5, 0x43, 0x3e, 0x3c, 0x7b, 0xb2, 0x8f,
-	0xe2, 0xac, 0x31, 0xcf, 0xac, 0xf7, 0x19, 0xd7, 0x72, 0x83, 0x1f, 0x54, 0xb5, 0xee, 0x66, 0xd1,
-	0x53, 0x40, 0x5b, 0xe5, 0x25, 0xbd, 0x20, 0x32, 0x51, 0xd5, 0xf1, 0x78, 0xeb, 0x36, 0x83, 0x5d,
-	0x62, 0xf2, 0x8b, 0x07, 0xef, 0xec, 0xfd, 0xc3, 0xff, 0xde, 0x94, 0xf7, 0xe1, 0x7e, 0x2c, 0xa9,
-	0x1b, 0xdd, 0x84, 0x68, 0x77, 0xe3, 0x74, 0xf0, 0x1b, 0xb5, 0x38, 0x27, 0x9a, 0x4e, 0x7e, 0xf7,
-	0x60, 0xb4, 0x3b, 0x36, 0xe8, 0x14, 0xba, 0x66, 0x70, 0xec, 0x0a, 0x86, 0xf5, 0x01, 0xde, 0x45,
-	0xd4, 0xdc, 0x6e, 0xd8, 0x5a, 0xd1, 0xbb, 0xd0, 0x2b, 0x04, 0xe3, 0x5a, 0xd9, 0x7f, 0x74, 0x71,
-	0x15, 0xa1, 0x47, 0x00, 0x4c, 0x45, 0x19, 0x25, 0x6b, 0x73, 0x6a, 0xcc, 0x00, 0xf4, 0xf1, 0x80,
-	0xa9, 0xaf, 0x9c, 0x80, 0x02, 0x80, 0x35, 0xc9, 0xea, 0x93, 0xee, 0x86, 0x60, 0x4b, 0x31, 0xbd,
-	0x5d, 0x90, 0x8c, 0xf0, 0x98, 0x56, 0xc7, 0xa7, 0x0e, 0xcf, 0xe7, 0xaf, 0xaf, 0x03, 0xef, 0xf2,
-	0x3a, 0xf0, 0xfe, 0xbe, 0x0e, 0xbc, 0x5f, 0x6f, 0x82, 0xd6, 0xe5, 0x4d, 0xd0, 0xfa, 0xe3, 0x26,
-	0x68, 0xfd, 0xf0, 0xd1, 0x16, 0xa9, 0x2f, 0xbf, 0xff, 0xee, 0xd9, 0x4b, 0xaa, 0x2f, 0x84, 0x5c,
-	0x4d, 0xe3, 0x25, 0x61, 0x7c, 0xfa, 0x53, 0x75, 0xfd, 0x5b, 0x62, 0x8b, 0x9e, 0xbd, 0xaf, 0x3f,
-	0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x50, 0x8f, 0x9d, 0x37, 0x19, 0x06, 0x00, 0x00,
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0x8e, 0x1d, 0x37, 0xb5, 0x9f, 0x5b, 0xa7, 0x1d, 0x51, 0xb2, 0x89, 0xc8, 0x26, 0x18, 0x41,
+	0x53, 0x50, 0xd7, 0x24, 0x08, 0x09, 0x71, 0xe0, 0x10, 0xa7, 0x95, 0x10, 0xb4, 0x42, 0x83, 0x5a,
+	0x09, 0x2e, 0xab, 0xf1, 0xee, 0xab, 0x33, 0x78, 0x77, 0xc6, 0xec, 0xcc, 0x3a, 0xf8, 0xcc, 0x95,
+	0x03, 0xdc, 0xf9, 0x0b, 0x38, 0xf1, 0x67, 0xf4, 0xd8, 0x23, 0x70, 0x28, 0x28, 0xf9, 0x47, 0xd0,
+	0xfc, 0xd8, 0xcd, 0xa6, 0x18, 0x89, 0x03, 0x27, 0xcf, 0xfb, 0xde, 0xf7, 0x66, 0xdf, 0x7c, 0xef,
+	0x9b, 0x31, 0x84, 0xb3, 0xe5, 0x02, 0x47, 0xdf, 0x96, 0x58, 0x2c, 0x47, 0x8b, 0xc3, 0x09, 0x6a,
+	0x76, 0xe8, 0xa2, 0x68, 0x5e, 0x48, 0x2d, 0x09, 0x31, 0xf9, 0xc8, 0x21, 0x3e, 0xbf, 0x13, 0x26,
+	0x52, 0xe5, 0x52, 0x8d, 0x26, 0x4c, 0x61, 0x5d, 0x94, 0x48, 0x2e, 0x5c, 0xcd, 0xce, 0x6b, 0x53,
+	0x39, 0x95, 0x76, 0x39, 0x32, 0x2b, 0x8f, 0xbe, 0x61, 0xbf, 0x34, 0x97, 0x32, 0xab, 0x6b, 0x4c,
+	0xe0, 0xb2, 0xc3, 0x5f, 0xdb, 0xd0, 0x3b, 0x66, 0x8a, 0x27, 0x5f, 0x48, 0x99, 0x91, 0x01, 0xb4,
+	0x79, 0x1a, 0xb4, 0xf6, 0x5b, 0x07, 0x1d, 0xda, 0xe6, 0x29, 0x21, 0xd0, 0x11, 0x2c, 0xc7, 0xa0,
+	0xbd, 0xdf, 0x3a, 0xe8, 0x51, 0xbb, 0x26, 0x01, 0x5c, 0x2f, 0x4a, 0xa1, 0x79, 0x8e, 0xc1, 0xba,
+	0x85, 0xab, 0xd0, 0xb0, 0x33, 0x39, 0x95, 0x41, 0xc7, 0xb1, 0xcd, 0x9a, 0xbc, 0x0d, 0x03, 0x39,
+	0xc7, 0x82, 0x69, 0x2e, 0xa6, 0x71, 0x22, 0x95, 0x0e, 0xae, 0xd9, 0xdd, 0x6f, 0xd6, 0xe8, 0x58,
+	0x2a, 0x4d, 0xee, 0xc2, 0x66, 0x39, 0xcf, 0x24, 0x4b, 0x63, 0x2e, 0x34, 0x16, 0x0b, 0x96, 0x05,
+	0x1b, 0x96, 0x37, 0x70, 0xf0, 0xa7, 0x1e, 0x25, 0x7b, 0xd0, 0xd7, 0x52, 0xb3, 0x2c, 0x7e, 0x56,
+	0x8a, 0x54, 0x05, 0xd7, 0x2d, 0x09, 0x2c, 0xf4, 0xd0, 0x20, 0xe4, 0x1e, 0xdc, 0x72, 0x84, 0x14,
+	0x33, 0x9c, 0x32, 0xcd, 0xa5, 0x08, 0xba, 0x96, 0xb5, 0x69, 0xf1, 0x93, 0x1a, 0x26, 0x1f, 0xc2,
+	0x86, 0xd2, 0x4c, 0x97, 0x2a, 0xe8, 0xed, 0xb7, 0x0e, 0x06, 0x47, 0xbb, 0x91, 0x15, 0xdd, 0xaa,
+	0xe3, 0xa5, 0x8a, 0x8c, 0x2c, 0x5f, 0x5a, 0x12, 0xf5, 0xe4, 0xe1, 0xef, 0x6d, 0x80, 0x87, 0x65,
+	0x66, 0xe0, 0x19, 0x16, 0x46, 0x0f, 0x96, 0xa6, 0x05, 0x2a, 0x65, 0x85, 0xeb, 0xd1, 0x2a, 0x24,
+	0x9f, 0x40, 0x37, 0x47, 0xcd, 0x52, 0xa6, 0x99, 0x55, 0xb0, 0x7f, 0x34, 0x8c, 0xfe, 0x39, 0xd6,
+	0xc8, 0xed, 0xf3, 0xc8, 0x33, 0x69, 0x5d, 0x63, 0x44, 0x51, 0x98, 0x3d, 0x6b, 0x9e, 0x64, 0xdd,
+	0x89, 0x62, 0xe0, 0xc6, 0x41, 0x3e, 0x86, 0xed, 0x57, 0x88, 0x71, 0x29, 0x26, 0x52, 0xa4, 0x5c,
+	0x4c, 0xed, 0x34, 0x3a, 0x74, 0xeb, 0x6a, 0xc9, 0x93, 0x2a, 0xbd, 0x52, 0xaf, 0x6b, 0xab, 0xf5,
+	0xba, 0x0b, 0x9b, 0x9e, 0x24, 0x8b, 0x38, 0x91, 0xa5, 0xd0, 0xd5, 0x90, 0x6a, 0x78, 0x6c, 0x50,
+	0xf2, 0x11, 0x5c, 0x33, 0x22, 0x9a, 0xf1, 0xac, 0xff, 0xdb, 0xa9, 0x8d, 0xb0, 0x8f, 0x30, 0x9f,
+	0x60, 0xa1, 0x4e, 0xf9, 0x9c, 0xba, 0x82, 0xe1, 0x4f, 0x1d, 0x18, 0x5c, 0xd5, 0x83, 0x3c, 0x06,
+	0x48, 0x64, 0x9e, 0x73, 0xa5, 0x4c, 0x6b, 0x56, 0xe2, 0xe3, 0xe8, 0xf9, 0xcb, 0xbd, 0xb5, 0x3f,
+	0x5e, 0xee, 0xbd, 0x33, 0xe5, 0xfa, 0xb4, 0x9c, 0x44, 0x89, 0xcc, 0x47, 0xfe, 0x72, 0xb8, 0x9f,
+	0xfb, 0x2a, 0x9d, 0x8d, 0xf4, 0x72, 0x8e, 0x2a, 0x3a, 0xc1, 0x84, 0x36, 0x76, 0x30, 0xf3, 0xca,
+	0xa5, 0xe0, 0x33, 0x2c, 0xbc, 0xad, 0xab, 0xd0, 0x64, 0xce, 0x70, 0xa2, 0xb8, 0xae, 0x9d, 0xed,
+	0x43, 0xb2, 0x03, 0x5d, 0x9e, 0xa2, 0xd0, 0x5c, 0x2f, 0xbd, 0xbb, 0xeb, 0xd8, 0x08, 0xa8, 0x30,
+	0x29, 0x0b, 0xae, 0x97, 0x71, 0x22, 0x85, 0x66, 0x89, 0xf3, 0x78, 0x8f, 0x6e, 0x56, 0xf8, 0xd8,
+	0xc1, 0xe6, 0x03, 0x29, 0x6a, 0xc6, 0x33, 0x65, 0x85, 0xeb, 0xd1, 0x2a, 0x24, 0x08, 0xdb, 0x73,
+	0xb4, 0x03, 0x89, 0x2f, 0x5b, 0x8d, 0x93, 0x53, 0x26, 0xa6, 0x68, 0x4d, 0xde, 0x3f, 0xba, 0xb7,
+	0x4a, 0xc5, 0x71, 0x4d, 0x1e, 0x5b, 0xee, 0x03, 0xa1, 0x8b, 0x25, 0xdd, 0xf2, 0x7b, 0xbd, 0x9a,
+	0x25, 0xf7, 0x81, 0x34, 0xb6, 0x2f, 0xf0, 0x8c, 0x15, 0xa9, 0xf2, 0xd7, 0xe3, 0xf6, 0x65, 0x86,
+	0xba, 0x04, 0xf9, 0xbe, 0x05, 0x5b, 0xcd, 0x76, 0x24, 0xbf, 0x2c, 0xea, 0xd9, 0xd1, 0x6e, 0x47,
+	0x4e, 0xef, 0xc8, 0xbc, 0x49, 0x8d, 0xae, 0xb8, 0x38, 0x7e, 0xdf, 0xcc, 0xe8, 0x97, 0x3f, 0xf7,
+	0x0e, 0xfe, 0xc3, 0x8c, 0x4c, 0x81, 0xa2, 0x77, 0x2e, 0xbf, 0x65, 0x00, 0xdf, 0xc5, 0xf0, 0x87,
+	0x16, 0xdc, 0x59, 0x79, 0xce, 0xff, 0xdd, 0x1a, 0x6f, 0xc1, 0xcd, 0xa4, 0x40, 0x77, 0x81, 0x52,
+	0xa6, 0xdd, 0xbb, 0xb7, 0x4e, 0x6f, 0x54, 0xe0, 0x09, 0xd3, 0x38, 0xfc, 0xb9, 0x0d, 0x83, 0xab,
+	0xe6, 0x25, 0x87, 0xd0, 0x31, 0xf6, 0xb5, 0x1d, 0xf4, 0x8f, 0x76, 0x57, 0x0d, 0xaa, 0x7e, 0x63,
+	0xa9, 0xa5, 0x92, 0xd7, 0x61, 0x63, 0x2e, 0xb9, 0xd0, 0xca, 0x7e, 0xa3, 0x43, 0x7d, 0x44, 0x76,
+	0x01, 0xb8, 0x8a, 0x33, 0x64, 0x0b, 0x73, 0x77, 0x8d, 0x0d, 0xbb, 0xb4, 0xc7, 0xd5, 0xe7, 0x0e,
+	0x20, 0x21, 0xc0, 0x82, 0x65, 0xd5, 0x7b, 0xe3, 0xac, 0xd8, 0x40, 0x8c, 0xc3, 0x26, 0x2c, 0x63,
+	0x22, 0x41, 0x7f, 0x89, 0xab, 0x90, 0xbc, 0x07, 0xb7, 0x13, 0x29, 0x8c, 0x23, 0x35, 0x5f, 0x60,
+	0xbc, 0x90, 0x1a, 0x95, 0xbf, 0xbe, 0xb7, 0x1a, 0x89, 0xa7, 0x06, 0x37, 0xdd, 0x7d, 0xc3, 0x78,
+	0x86, 0xa9, 0xf5, 0x5e, 0x97, 0xfa, 0x88, 0xbc, 0x09, 0x37, 0xdc, 0x2a, 0x36, 0x4f, 0x7e, 0xe6,
+	0x9d, 0xd3, 0x77, 0xd8, 0x13, 0x03, 0x1d, 0x9f, 0x3c, 0x3f, 0x0f, 0x5b, 0x2f, 0xce, 0xc3, 0xd6,
+	0x5f, 0xe7, 0x61, 0xeb, 0xc7, 0x8b, 0x70, 0xed, 0xc5, 0x45, 0xb8, 0xf6, 0xdb, 0x45, 0xb8, 0xf6,
+	0xf5, 0xbb, 0x8d, 0x89, 0x7c, 0xf6, 0xd5, 0xd3, 0x07, 0x8f, 0x51, 0x9f, 0xc9, 0x62, 0x36, 0x4a,
+	0x4e, 0x19, 0x17, 0xa3, 0xef, 0xfc, 0x9f, 0xa1, 0x9d, 0xcc, 0x64, 0xc3, 0xfe, 0x3b, 0x7d, 0xf0,
+	0xf7, 0x00, 0x3b, 0xc5, 0xd1, 0x53, 0x27, 0x07, 0x00, 0x00,
 }
 
 func (m *BasicPool) Marshal() (dAtA []byte, err error) {
@@ -742,6 +790,20 @@ func (m *StakerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionCoinRewards) > 0 {
+		for iNdEx := len(m.CommissionCoinRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionCoinRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.CommissionRewards != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.CommissionRewards))
 		i--
@@ -865,6 +927,26 @@ func (m *PoolMembership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ConsecutiveVotes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsecutiveVotes))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Balance != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Balance))
 		i--
@@ -1027,6 +1109,12 @@ func (m *StakerMetadata) Size() (n int) {
 	if m.CommissionRewards != 0 {
 		n += 1 + sovQuery(uint64(m.CommissionRewards))
 	}
+	if len(m.CommissionCoinRewards) > 0 {
+		for _, e := range m.CommissionCoinRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1067,6 +1155,15 @@ func (m *PoolMembership) Size() (n int) {
 	if m.Balance != 0 {
 		n += 1 + sovQuery(uint64(m.Balance))
 	}
+	if m.ConsecutiveVotes != 0 {
+		n += 1 + sovQuery(uint64(m.ConsecutiveVotes))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovQuery(uint64(m.JailedUntil))
+	}
 	return n
 }
 
@@ -1842,6 +1939,40 @@ func (m *StakerMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionCoinRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionCoinRewards = append(m.CommissionCoinRewards, types1.Coin{})
+			if err := m.CommissionCoinRewards[len(m.CommissionCoinRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2121,6 +2252,64 @@ func (m *PoolMembership) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveVotes", wireType)
+			}
+			m.ConsecutiveVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
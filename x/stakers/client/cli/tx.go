@@ -22,6 +22,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateStaker())
 	cmd.AddCommand(CmdJoinPool())
 	cmd.AddCommand(CmdLeavePool())
+	cmd.AddCommand(CmdUnjail())
 	cmd.AddCommand(CmdUpdateCommission())
 	cmd.AddCommand(CmdClaimCommissionRewards())
 	cmd.AddCommand(CmdUpdateMetadata())
@@ -5,10 +5,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
 
+const FlagCoins = "coins"
+
 func CmdClaimCommissionRewards() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim-commission-rewards [amount]",
@@ -25,9 +28,20 @@ func CmdClaimCommissionRewards() *cobra.Command {
 				return err
 			}
 
+			argCoins, err := cmd.Flags().GetString(FlagCoins)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(argCoins)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgClaimCommissionRewards{
 				Creator: clientCtx.GetFromAddress().String(),
 				Amount:  argAmount,
+				Coins:   coins,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -38,6 +52,7 @@ func CmdClaimCommissionRewards() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagCoins, "", "commission rewards in other denoms to claim, e.g. 100uatom")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdUnjail() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail [pool_id]",
+		Short: "Broadcast message unjail",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnjail{
+				Creator: clientCtx.GetFromAddress().String(),
+				PoolId:  argPoolId,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -26,6 +26,18 @@ func (k Keeper) LeavePool(ctx sdk.Context, staker string, poolId uint64) {
 	})
 }
 
+// RemoveExcessStakersOfPool removes the stakers with the lowest delegation
+// from the given pool until the number of stakers does not exceed
+// the maximum number of stakers of that pool anymore.
+func (k Keeper) RemoveExcessStakersOfPool(ctx sdk.Context, poolId uint64) {
+	maxStakers := k.GetMaxStakersOfPool(ctx, poolId)
+
+	for k.GetStakerCountOfPool(ctx, poolId) > maxStakers {
+		lowestStaker, _ := k.getLowestStaker(ctx, poolId)
+		k.LeavePool(ctx, lowestStaker.Address, poolId)
+	}
+}
+
 // GetAllStakerAddressesOfPool returns a list of all stakers
 // which have currently a valaccount registered for the given pool
 // and are therefore allowed to participate in that pool.
@@ -37,6 +49,49 @@ func (k Keeper) GetAllStakerAddressesOfPool(ctx sdk.Context, poolId uint64) (sta
 	return stakers
 }
 
+// GetAllUnjailedStakerAddressesOfPool returns a list of all stakers
+// which have currently a valaccount registered for the given pool
+// which is not jailed. Only those stakers are allowed to upload and vote.
+func (k Keeper) GetAllUnjailedStakerAddressesOfPool(ctx sdk.Context, poolId uint64) (stakers []string) {
+	for _, valaccount := range k.GetAllValaccountsOfPool(ctx, poolId) {
+		if !valaccount.Jailed {
+			stakers = append(stakers, valaccount.Staker)
+		}
+	}
+
+	return stakers
+}
+
+// IsValaccountJailed returns true if the staker has a valaccount in the
+// given pool and this valaccount is currently jailed.
+func (k Keeper) IsValaccountJailed(ctx sdk.Context, poolId uint64, stakerAddress string) bool {
+	valaccount, _ := k.GetValaccount(ctx, poolId, stakerAddress)
+	return valaccount.Jailed
+}
+
+// JailValaccount jails the valaccount of the staker in the given pool for the
+// duration of the `JailTime` param. The staker keeps his slot in the pool, but
+// can neither upload nor vote until he unjails his valaccount again.
+// All points of the valaccount are reset.
+func (k Keeper) JailValaccount(ctx sdk.Context, poolId uint64, stakerAddress string) {
+	valaccount, found := k.GetValaccount(ctx, poolId, stakerAddress)
+	if !found {
+		return
+	}
+
+	valaccount.Jailed = true
+	valaccount.JailedUntil = uint64(ctx.BlockTime().Unix()) + k.GetJailTime(ctx)
+	valaccount.Points = 0
+	valaccount.ConsecutiveVotes = 0
+	k.SetValaccount(ctx, valaccount)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventJail{
+		PoolId:      poolId,
+		Staker:      stakerAddress,
+		JailedUntil: valaccount.JailedUntil,
+	})
+}
+
 // GetCommission returns the commission of a staker as a parsed sdk.Dec
 func (k Keeper) GetCommission(ctx sdk.Context, stakerAddress string) sdk.Dec {
 	staker, _ := k.GetStaker(ctx, stakerAddress)
@@ -58,6 +113,10 @@ func (k Keeper) AssertValaccountAuthorized(ctx sdk.Context, poolId uint64, stake
 		return types.ErrValaccountUnauthorized
 	}
 
+	if valaccount.Jailed {
+		return types.ErrValaccountJailed
+	}
+
 	return nil
 }
 
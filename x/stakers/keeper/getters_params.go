@@ -28,6 +28,11 @@ func (k Keeper) GetLeavePoolTime(ctx sdk.Context) (res uint64) {
 	return k.GetParams(ctx).LeavePoolTime
 }
 
+// GetJailTime returns the JailTime param
+func (k Keeper) GetJailTime(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).JailTime
+}
+
 // SetParams sets the x/stakers module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := ctx.KVStore(k.storeKey)
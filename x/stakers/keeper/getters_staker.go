@@ -42,6 +42,15 @@ func (k Keeper) updateStakerCommissionRewards(ctx sdk.Context, address string, a
 	}
 }
 
+// updateStakerCommissionCoinRewards ...
+func (k Keeper) updateStakerCommissionCoinRewards(ctx sdk.Context, address string, coins sdk.Coins) {
+	staker, found := k.GetStaker(ctx, address)
+	if found {
+		staker.CommissionCoinRewards = staker.CommissionCoinRewards.Add(coins...)
+		k.setStaker(ctx, staker)
+	}
+}
+
 // UpdateStakerCommission ...
 func (k Keeper) UpdateStakerCommission(ctx sdk.Context, address string, commission sdk.Dec) {
 	staker, found := k.GetStaker(ctx, address)
@@ -9,12 +9,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// IncrementPoints increments to Points for a staker in a given pool.
-// Returns the amount of the current points (including the current incrementation)
-func (k Keeper) IncrementPoints(ctx sdk.Context, poolId uint64, stakerAddress string) uint64 {
+// IncreasePoints increases the Points for a staker in a given pool by the given amount
+// and resets the consecutive votes of the staker.
+// Returns the amount of the current points (including the current increase)
+func (k Keeper) IncreasePoints(ctx sdk.Context, poolId uint64, stakerAddress string, amount uint64) uint64 {
 	valaccount, found := k.GetValaccount(ctx, poolId, stakerAddress)
 	if found {
-		valaccount.Points += 1
+		valaccount.Points += amount
+		valaccount.ConsecutiveVotes = 0
 		k.SetValaccount(ctx, valaccount)
 	}
 	return valaccount.Points
@@ -27,11 +29,33 @@ func (k Keeper) ResetPoints(ctx sdk.Context, poolId uint64, stakerAddress string
 	if found {
 		previousPoints = valaccount.Points
 		valaccount.Points = 0
+		valaccount.ConsecutiveVotes = 0
 		k.SetValaccount(ctx, valaccount)
 	}
 	return
 }
 
+// DecayPoints registers a successful vote of a staker in the given pool. Once the staker
+// has voted `decayVotes` times in a row one point is removed again.
+// Returns the amount of the current points and whether a point was removed.
+func (k Keeper) DecayPoints(ctx sdk.Context, poolId uint64, stakerAddress string, decayVotes uint64) (currentPoints uint64, decayed bool) {
+	valaccount, found := k.GetValaccount(ctx, poolId, stakerAddress)
+
+	// only track the consecutive votes if there are points which can decay
+	if found && valaccount.Points > 0 {
+		valaccount.ConsecutiveVotes += 1
+
+		if valaccount.ConsecutiveVotes >= decayVotes {
+			valaccount.Points -= 1
+			valaccount.ConsecutiveVotes = 0
+			decayed = true
+		}
+
+		k.SetValaccount(ctx, valaccount)
+	}
+	return valaccount.Points, decayed
+}
+
 // GetAllValaccountsOfPool returns a list of all valaccount
 func (k Keeper) GetAllValaccountsOfPool(ctx sdk.Context, poolId uint64) (val []*types.Valaccount) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValaccountPrefix)
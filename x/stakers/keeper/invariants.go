@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all stakers invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valaccounts", ValaccountsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the stakers module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ValaccountsInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the stakers module account holds
+// at least the sum of all unclaimed commission rewards.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := uint64(0)
+		expectedCoins := sdk.NewCoins()
+		for _, staker := range k.GetAllStakers(ctx) {
+			expected += staker.CommissionRewards
+			expectedCoins = expectedCoins.Add(staker.CommissionCoinRewards...)
+		}
+
+		moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balance := k.bankKeeper.GetBalance(ctx, moduleAddress, globalTypes.Denom).Amount.Uint64()
+
+		broken := balance < expected
+		for _, coin := range expectedCoins {
+			if k.bankKeeper.GetBalance(ctx, moduleAddress, coin.Denom).IsLT(coin) {
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account",
+			fmt.Sprintf("\tstakers module balance: %d\n\tsum of commission rewards: %d\n\tsum of commission coin rewards: %s\n", balance, expected, expectedCoins),
+		), broken
+	}
+}
+
+// ValaccountsInvariant checks that every valaccount belongs to an existing
+// staker and that the staker count of every pool matches its valaccounts.
+func ValaccountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		stakerCount := make(map[uint64]uint64)
+
+		for _, valaccount := range k.GetAllValaccounts(ctx) {
+			if !k.DoesStakerExist(ctx, valaccount.Staker) {
+				broken = true
+				msg += fmt.Sprintf("\tvalaccount of non-existing staker %s in pool %d\n", valaccount.Staker, valaccount.PoolId)
+			}
+
+			stakerCount[valaccount.PoolId] += 1
+		}
+
+		for _, pool := range k.poolKeeper.GetAllPools(ctx) {
+			if count := k.GetStakerCountOfPool(ctx, pool.Id); count != stakerCount[pool.Id] {
+				broken = true
+				msg += fmt.Sprintf("\tpool %d: staker count %d, actual valaccounts %d\n", pool.Id, count, stakerCount[pool.Id])
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "valaccounts", msg), broken
+	}
+}
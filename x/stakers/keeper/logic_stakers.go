@@ -35,6 +35,28 @@ func (k Keeper) IncreaseStakerCommissionRewards(ctx sdk.Context, address string,
 	return nil
 }
 
+// IncreaseStakerCommissionCoinRewards sets the uploader's commission rewards in non-native denoms and
+// transfers the coins from the pool module to the stakers module, so they can be later claimed
+func (k Keeper) IncreaseStakerCommissionCoinRewards(ctx sdk.Context, address string, coins sdk.Coins) error {
+	// Assert there are coins
+	if coins.IsZero() {
+		return nil
+	}
+
+	// Assert the staker exists
+	if _, found := k.GetStaker(ctx, address); !found {
+		return errors.Wrapf(sdkErrors.ErrNotFound, "Staker does not exist.")
+	}
+
+	// transfer coins from pool to stakers module
+	if err := util.TransferCoinsFromModuleToModule(k.bankKeeper, ctx, poolTypes.ModuleName, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	k.updateStakerCommissionCoinRewards(ctx, address, coins)
+	return nil
+}
+
 // getLowestStaker returns the staker with the lowest total stake
 // (self-delegation + delegation) of a given pool.
 // If all pool slots are taken, this is the staker who then
@@ -53,9 +75,20 @@ func (k Keeper) getLowestStaker(ctx sdk.Context, poolId uint64) (val types.Stake
 	return
 }
 
+// GetMaxStakersOfPool returns the maximum number of stakers of a given pool.
+// If the pool does not define a limit the default of MaxStakers is used.
+func (k Keeper) GetMaxStakersOfPool(ctx sdk.Context, poolId uint64) uint64 {
+	pool, _ := k.poolKeeper.GetPoolWithError(ctx, poolId)
+	if pool.MaxStakers > 0 {
+		return pool.MaxStakers
+	}
+
+	return types.MaxStakers
+}
+
 // ensureFreeSlot makes sure that a staker can join a given pool.
 // If this is not possible an appropriate error is returned.
-// A pool has a fixed amount of slots. If there are still free slots
+// A pool has a fixed amount of slots defined by its max stakers. If there are still free slots
 // a staker can just join (even with the smallest stake possible).
 // If all slots are taken, it checks if the new staker has more stake
 // than the current lowest staker in that pool.
@@ -63,7 +96,7 @@ func (k Keeper) getLowestStaker(ctx sdk.Context, poolId uint64) (val types.Stake
 // new staker can join.
 func (k Keeper) ensureFreeSlot(ctx sdk.Context, poolId uint64, stakerAddress string) error {
 	// check if slots are still available
-	if k.GetStakerCountOfPool(ctx, poolId) >= types.MaxStakers {
+	if k.GetStakerCountOfPool(ctx, poolId) >= k.GetMaxStakersOfPool(ctx, poolId) {
 		// if not - get lowest staker
 		lowestStaker, _ := k.getLowestStaker(ctx, poolId)
 
@@ -26,18 +26,30 @@ func (k msgServer) ClaimCommissionRewards(goCtx context.Context, msg *types.MsgC
 		return nil, types.ErrNotEnoughRewards.Wrapf("%d > %d", msg.Amount, staker.CommissionRewards)
 	}
 
-	// send commission rewards from stakers module to claimer
-	if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, msg.Creator, msg.Amount); err != nil {
+	// Check if coins can be claimed
+	if !staker.CommissionCoinRewards.IsAllGTE(msg.Coins) {
+		return nil, types.ErrNotEnoughRewards.Wrapf("%s > %s", msg.Coins, staker.CommissionCoinRewards)
+	}
+
+	// send commission rewards from stakers module to the withdraw address of the claimer
+	withdrawAddress := k.delegationKeeper.GetWithdrawAddress(ctx, msg.Creator)
+	if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, withdrawAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, withdrawAddress, msg.Coins); err != nil {
 		return nil, err
 	}
 
 	// calculate new commission rewards and save
 	staker.CommissionRewards -= msg.Amount
+	staker.CommissionCoinRewards = staker.CommissionCoinRewards.Sub(msg.Coins...)
 	k.setStaker(ctx, staker)
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventClaimCommissionRewards{
 		Staker: msg.Creator,
 		Amount: msg.Amount,
+		Coins:  msg.Coins,
 	})
 
 	return &types.MsgClaimCommissionRewardsResponse{}, nil
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
 	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +22,8 @@ TEST CASES - msg_server_claim_commission_rewards.go
 * Claim partial rewards
 * Claim partial rewards twice
 * Claim all rewards
+* Claim rewards to a withdraw address
+* Claim more coin rewards than available
 
 */
 
@@ -341,4 +344,47 @@ var _ = Describe("msg_server_claim_commission_rewards.go", Ordered, func() {
 
 		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(initialBalanceStaker0 + rewards))
 	})
+
+	It("Claim rewards to a withdraw address", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgSetWithdrawAddress{
+			Creator:         i.STAKER_0,
+			WithdrawAddress: i.CHARLIE,
+		})
+
+		initialBalanceCharlie := s.GetBalanceFromAddress(i.CHARLIE)
+
+		// ACT
+		uploader, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		rewards := uploader.CommissionRewards
+
+		_, err := s.RunTx(&stakertypes.MsgClaimCommissionRewards{
+			Creator: i.STAKER_0,
+			Amount:  rewards,
+		})
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+
+		uploader, _ = s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(uploader.CommissionRewards).To(BeZero())
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(initialBalanceStaker0))
+		Expect(s.GetBalanceFromAddress(i.CHARLIE)).To(Equal(initialBalanceCharlie + rewards))
+	})
+
+	It("Claim more coin rewards than available", func() {
+		// ACT
+		_, err := s.RunTx(&stakertypes.MsgClaimCommissionRewards{
+			Creator: i.STAKER_0,
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(i.IBC_DENOM, 1)),
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		uploader, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(uploader.CommissionCoinRewards).To(BeEmpty())
+		Expect(s.GetBalanceOfDenomFromAddress(i.STAKER_0, i.IBC_DENOM)).To(BeZero())
+	})
 })
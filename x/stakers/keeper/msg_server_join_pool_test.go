@@ -34,6 +34,7 @@ TEST CASES - msg_server_join_pool.go
 * Fail to kick out lowest staker because not enough stake
 * Kick out lowest staker with respect to stake + delegation
 * Fail to kick out lowest staker because not enough stake + delegation
+* Kick out lowest staker by joining a full pool with custom max stakers
 
 */
 
@@ -862,4 +863,48 @@ var _ = Describe("msg_server_join_pool.go", Ordered, func() {
 		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ContainElement(i.STAKER_0))
 		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).NotTo(ContainElement(i.STAKER_1))
 	})
+
+	It("Kick out lowest staker by joining a full pool with custom max stakers", func() {
+		// Arrange
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.MaxStakers = 1
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+			Amount:     1,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  50 * i.KYVE,
+		})
+
+		// Act
+		s.RunTxStakersError(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1,
+			Amount:     1,
+		})
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.ALICE,
+			Staker:  i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1,
+			Amount:     1,
+		})
+
+		// Assert
+		Expect(s.App().StakersKeeper.GetStakerCountOfPool(s.Ctx(), 0)).To(Equal(uint64(1)))
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ConsistOf(i.STAKER_1))
+	})
 })
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Unjail handles the SDK message of unjailing a valaccount.
+// After a valaccount got jailed for reaching the maximum amount of points
+// the staker can unjail it once `JailTime` is over. Afterwards the
+// valaccount is allowed to upload and vote again.
+func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valaccount, valaccountFound := k.GetValaccount(ctx, msg.PoolId, msg.Creator)
+	if !valaccountFound {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrAlreadyLeftPool.Error())
+	}
+
+	if !valaccount.Jailed {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrValaccountNotJailed.Error())
+	}
+
+	if uint64(ctx.BlockTime().Unix()) < valaccount.JailedUntil {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrJailTimeNotOver.Error(), valaccount.JailedUntil)
+	}
+
+	valaccount.Jailed = false
+	valaccount.JailedUntil = 0
+	k.SetValaccount(ctx, valaccount)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUnjail{
+		PoolId: msg.PoolId,
+		Staker: msg.Creator,
+	})
+
+	return &types.MsgUnjailResponse{}, nil
+}
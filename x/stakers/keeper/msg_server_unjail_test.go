@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_unjail.go
+
+* Jail a valaccount
+* Jailed valaccount is not authorized anymore
+* Try to unjail a valaccount which is not jailed
+* Try to unjail a valaccount before the jail time is over
+* Unjail a valaccount after the jail time is over
+* Try to unjail in a pool the staker has never joined
+
+*/
+
+var _ = Describe("msg_server_unjail.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pool
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name: "PoolTest",
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// join pool
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Jail a valaccount", func() {
+		// ARRANGE
+		s.App().StakersKeeper.IncreasePoints(s.Ctx(), 0, i.STAKER_0, 3)
+
+		// ACT
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(valaccount.Jailed).To(BeTrue())
+		Expect(valaccount.JailedUntil).To(Equal(uint64(s.Ctx().BlockTime().Unix()) + s.App().StakersKeeper.GetJailTime(s.Ctx())))
+		Expect(valaccount.Points).To(BeZero())
+
+		Expect(s.App().StakersKeeper.IsValaccountJailed(s.Ctx(), 0, i.STAKER_0)).To(BeTrue())
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(HaveLen(1))
+		Expect(s.App().StakersKeeper.GetAllUnjailedStakerAddressesOfPool(s.Ctx(), 0)).To(BeEmpty())
+	})
+
+	It("Jailed valaccount is not authorized anymore", func() {
+		// ARRANGE
+		Expect(s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0)).To(Succeed())
+
+		// ACT
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		// ASSERT
+		err := s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0)
+		Expect(err).To(MatchError(stakerstypes.ErrValaccountJailed))
+	})
+
+	It("Try to unjail a valaccount which is not jailed", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUnjail{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Jailed).To(BeFalse())
+	})
+
+	It("Try to unjail a valaccount before the jail time is over", func() {
+		// ARRANGE
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetJailTime(s.Ctx()) / 2)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUnjail{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Jailed).To(BeTrue())
+	})
+
+	It("Unjail a valaccount after the jail time is over", func() {
+		// ARRANGE
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetJailTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUnjail{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(valaccount.Jailed).To(BeFalse())
+		Expect(valaccount.JailedUntil).To(BeZero())
+
+		Expect(s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0)).To(Succeed())
+		Expect(s.App().StakersKeeper.GetAllUnjailedStakerAddressesOfPool(s.Ctx(), 0)).To(HaveLen(1))
+	})
+
+	It("Try to unjail in a pool the staker has never joined", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUnjail{
+			Creator: i.STAKER_0,
+			PoolId:  1,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_0)
+		Expect(found).To(BeFalse())
+	})
+})
@@ -33,6 +33,9 @@ TEST CASES - msg_server_update_params.go
 * Update leave pool time
 * Update leave pool time with invalid value
 
+* Update jail time
+* Update jail time with invalid value
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -63,6 +66,7 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 
 		Expect(params.CommissionChangeTime).To(Equal(types.DefaultCommissionChangeTime))
 		Expect(params.LeavePoolTime).To(Equal(types.DefaultLeavePoolTime))
+		Expect(params.JailTime).To(Equal(types.DefaultJailTime))
 	})
 
 	It("Invalid authority (transaction)", func() {
@@ -102,7 +106,8 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		payload := `{
 			"unbonding_staking_time": 5,
 			"commission_change_time": 5,
-			"leave_pool_time": 5
+			"leave_pool_time": 5,
+			"jail_time": 5
 		}`
 
 		msg := &types.MsgUpdateParams{
@@ -133,6 +138,7 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 
 		Expect(updatedParams.CommissionChangeTime).To(Equal(uint64(5)))
 		Expect(updatedParams.LeavePoolTime).To(Equal(uint64(5)))
+		Expect(updatedParams.JailTime).To(Equal(uint64(5)))
 	})
 
 	It("Update no params", func() {
@@ -299,6 +305,7 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 
 		Expect(updatedParams.CommissionChangeTime).To(Equal(types.DefaultCommissionChangeTime))
 		Expect(updatedParams.LeavePoolTime).To(Equal(uint64(5)))
+		Expect(updatedParams.JailTime).To(Equal(types.DefaultJailTime))
 	})
 
 	It("Update leave pool time with invalid value", func() {
@@ -330,4 +337,72 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.CommissionChangeTime).To(Equal(types.DefaultCommissionChangeTime))
 		Expect(updatedParams.LeavePoolTime).To(Equal(types.DefaultLeavePoolTime))
 	})
+
+	It("Update jail time", func() {
+		// ARRANGE
+		payload := `{
+			"jail_time": 5
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().StakersKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.CommissionChangeTime).To(Equal(types.DefaultCommissionChangeTime))
+		Expect(updatedParams.LeavePoolTime).To(Equal(types.DefaultLeavePoolTime))
+		Expect(updatedParams.JailTime).To(Equal(uint64(5)))
+	})
+
+	It("Update jail time with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"jail_time": -5
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().StakersKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.CommissionChangeTime).To(Equal(types.DefaultCommissionChangeTime))
+		Expect(updatedParams.LeavePoolTime).To(Equal(types.DefaultLeavePoolTime))
+		Expect(updatedParams.JailTime).To(Equal(types.DefaultJailTime))
+	})
 })
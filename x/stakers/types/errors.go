@@ -21,4 +21,7 @@ var (
 
 	ErrPoolLeaveAlreadyInProgress = errors.Register(ModuleName, 1117, "Pool leave is already in progress")
 	ErrValaccountUnauthorized     = errors.Register(ModuleName, 1118, "valaccount unauthorized")
+	ErrValaccountJailed           = errors.Register(ModuleName, 1119, "valaccount is jailed")
+	ErrValaccountNotJailed        = errors.Register(ModuleName, 1120, "valaccount is not jailed")
+	ErrJailTimeNotOver            = errors.Register(ModuleName, 1121, "jail time is not over yet, jailed until %v")
 )
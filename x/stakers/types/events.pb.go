@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -295,6 +296,8 @@ type EventClaimCommissionRewards struct {
 	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
 	// amount ...
 	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// coins are the claimed commission rewards in non-native denoms
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 }
 
 func (m *EventClaimCommissionRewards) Reset()         { *m = EventClaimCommissionRewards{} }
@@ -344,6 +347,13 @@ func (m *EventClaimCommissionRewards) GetAmount() uint64 {
 	return 0
 }
 
+func (m *EventClaimCommissionRewards) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 // EventJoinPool ...
 // emitted_by: MsgJoinPool
 type EventJoinPool struct {
@@ -475,6 +485,128 @@ func (m *EventLeavePool) GetStaker() string {
 	return ""
 }
 
+// EventJail is an event emitted when a valaccount reached the
+// maximum amount of points and got jailed.
+// emitted_by: MsgSubmitBundleProposal, EndBlock
+type EventJail struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// jailed_until is the unix timestamp after which the staker can unjail.
+	JailedUntil uint64 `protobuf:"varint,3,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *EventJail) Reset()         { *m = EventJail{} }
+func (m *EventJail) String() string { return proto.CompactTextString(m) }
+func (*EventJail) ProtoMessage()    {}
+func (*EventJail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{7}
+}
+func (m *EventJail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventJail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventJail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventJail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventJail.Merge(m, src)
+}
+func (m *EventJail) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventJail) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventJail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventJail proto.InternalMessageInfo
+
+func (m *EventJail) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventJail) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventJail) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+// EventUnjail is an event emitted when a staker unjails their valaccount.
+// emitted_by: MsgUnjail
+type EventUnjail struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+}
+
+func (m *EventUnjail) Reset()         { *m = EventUnjail{} }
+func (m *EventUnjail) String() string { return proto.CompactTextString(m) }
+func (*EventUnjail) ProtoMessage()    {}
+func (*EventUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{8}
+}
+func (m *EventUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnjail.Merge(m, src)
+}
+func (m *EventUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnjail proto.InternalMessageInfo
+
+func (m *EventUnjail) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventUnjail) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateParams)(nil), "kyve.stakers.v1beta1.EventUpdateParams")
 	proto.RegisterType((*EventCreateStaker)(nil), "kyve.stakers.v1beta1.EventCreateStaker")
@@ -483,46 +615,53 @@ func init() {
 	proto.RegisterType((*EventClaimCommissionRewards)(nil), "kyve.stakers.v1beta1.EventClaimCommissionRewards")
 	proto.RegisterType((*EventJoinPool)(nil), "kyve.stakers.v1beta1.EventJoinPool")
 	proto.RegisterType((*EventLeavePool)(nil), "kyve.stakers.v1beta1.EventLeavePool")
+	proto.RegisterType((*EventJail)(nil), "kyve.stakers.v1beta1.EventJail")
+	proto.RegisterType((*EventUnjail)(nil), "kyve.stakers.v1beta1.EventUnjail")
 }
 
 func init() { proto.RegisterFile("kyve/stakers/v1beta1/events.proto", fileDescriptor_7a1b3dc9634155a0) }
 
 var fileDescriptor_7a1b3dc9634155a0 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0x10, 0x52, 0xb2, 0x88, 0x2f, 0x53, 0xc0, 0x0a, 0xc8, 0x2d, 0x39, 0xa0, 0x22,
-	0x81, 0xad, 0xc2, 0x13, 0x34, 0xa1, 0x48, 0x7c, 0xb4, 0xaa, 0x8c, 0x40, 0x82, 0x4b, 0x34, 0xf1,
-	0x8e, 0xd2, 0x55, 0x6c, 0x8f, 0xe5, 0xdd, 0x24, 0xcd, 0x89, 0x57, 0x40, 0xe2, 0x51, 0x78, 0x03,
-	0x4e, 0x3d, 0xf6, 0x88, 0x38, 0x54, 0x28, 0x79, 0x11, 0xe4, 0xf5, 0x3a, 0x75, 0xa4, 0x56, 0xe2,
-	0xe3, 0x94, 0xfc, 0x77, 0xfe, 0xfe, 0xfd, 0x67, 0x66, 0xb5, 0xec, 0xe1, 0x68, 0x36, 0x41, 0x5f,
-	0x2a, 0x18, 0x61, 0x26, 0xfd, 0xc9, 0xf6, 0x00, 0x15, 0x6c, 0xfb, 0x38, 0xc1, 0x44, 0x49, 0x2f,
-	0xcd, 0x48, 0x91, 0xbd, 0x9e, 0x5b, 0x3c, 0x63, 0xf1, 0x8c, 0xa5, 0xbd, 0x3e, 0xa4, 0x21, 0x69,
-	0x83, 0x9f, 0xff, 0x2b, 0xbc, 0xed, 0xf3, 0x71, 0x29, 0x64, 0x10, 0x1b, 0x5c, 0xe7, 0x9b, 0xc5,
-	0x6e, 0xed, 0xe6, 0xfc, 0xf7, 0x29, 0x07, 0x85, 0x07, 0xba, 0x66, 0xef, 0x30, 0x46, 0x11, 0xef,
-	0x17, 0x4e, 0xc7, 0xda, 0xb4, 0xb6, 0xae, 0x3e, 0x7b, 0xe0, 0x9d, 0x97, 0xec, 0x15, 0x5f, 0x74,
-	0x1b, 0xc7, 0xa7, 0x1b, 0xb5, 0xa0, 0x45, 0x11, 0x3f, 0x43, 0x24, 0x38, 0x2d, 0x11, 0xf5, 0x3f,
-	0x47, 0x24, 0x38, 0x35, 0x08, 0x87, 0xad, 0xa5, 0x30, 0x8b, 0x08, 0xb8, 0x73, 0x69, 0xd3, 0xda,
-	0x6a, 0x05, 0xa5, 0xec, 0x7c, 0x2d, 0xbb, 0xee, 0x65, 0x08, 0x0a, 0xdf, 0x69, 0xa0, 0x7d, 0x97,
-	0x35, 0x0b, 0xb4, 0xee, 0xb8, 0x15, 0x18, 0x95, 0x9f, 0x43, 0x4c, 0xe3, 0x44, 0xe9, 0x36, 0x1a,
-	0x81, 0x51, 0xf6, 0x3e, 0x63, 0x21, 0xc5, 0xb1, 0x90, 0x52, 0x50, 0x52, 0x44, 0x74, 0xbd, 0xbc,
-	0x89, 0x9f, 0xa7, 0x1b, 0x8f, 0x86, 0x42, 0x1d, 0x8e, 0x07, 0x5e, 0x48, 0xb1, 0x1f, 0x92, 0x8c,
-	0x49, 0x9a, 0x9f, 0xa7, 0x92, 0x8f, 0x7c, 0x35, 0x4b, 0x51, 0x7a, 0x2f, 0x30, 0x0c, 0x2a, 0x84,
-	0xce, 0x77, 0x8b, 0xdd, 0xae, 0xec, 0x72, 0x0f, 0x15, 0x70, 0x50, 0x70, 0x61, 0x5f, 0x0e, 0x5b,
-	0x8b, 0x29, 0x11, 0x79, 0xa1, 0x5e, 0xcc, 0x67, 0x64, 0x5e, 0x99, 0xe2, 0x40, 0x0a, 0x85, 0xe5,
-	0xe4, 0x46, 0xda, 0x6d, 0x76, 0x45, 0x70, 0x4c, 0x94, 0x50, 0x33, 0xa7, 0xa1, 0x4b, 0x4b, 0x6d,
-	0x3f, 0x66, 0x37, 0x25, 0x86, 0xe3, 0x4c, 0xa8, 0x59, 0x3f, 0xa4, 0x44, 0x41, 0xa8, 0x9c, 0xcb,
-	0xda, 0x73, 0xa3, 0x3c, 0xef, 0x15, 0xc7, 0x79, 0x00, 0x47, 0x05, 0x22, 0x92, 0x4e, 0xb3, 0x08,
-	0x30, 0xb2, 0xf3, 0x99, 0xdd, 0xa9, 0xcc, 0xd0, 0x5b, 0x4e, 0x77, 0xe1, 0x14, 0xab, 0x5b, 0xac,
-	0xff, 0xf7, 0x16, 0xf7, 0xd8, 0xfd, 0xe2, 0x6a, 0x23, 0x10, 0xf1, 0x59, 0x7e, 0x80, 0x53, 0xc8,
-	0xb8, 0xfc, 0xdb, 0x4b, 0xee, 0x1c, 0xb1, 0x6b, 0x1a, 0xf7, 0x9a, 0x44, 0x72, 0x40, 0x14, 0xd9,
-	0xf7, 0xd8, 0x5a, 0x4a, 0x14, 0xf5, 0x05, 0xd7, 0x84, 0x46, 0xd0, 0xcc, 0xe5, 0x2b, 0x5e, 0x21,
-	0xd7, 0x57, 0xc8, 0x2e, 0x63, 0x13, 0x88, 0x80, 0xf3, 0x0c, 0xa5, 0x34, 0xf7, 0x51, 0x39, 0xa9,
-	0x24, 0x37, 0x56, 0x92, 0x77, 0xd8, 0x75, 0x9d, 0xfc, 0x16, 0x61, 0x82, 0xff, 0x14, 0xdd, 0x7d,
-	0x79, 0x3c, 0x77, 0xad, 0x93, 0xb9, 0x6b, 0xfd, 0x9a, 0xbb, 0xd6, 0x97, 0x85, 0x5b, 0x3b, 0x59,
-	0xb8, 0xb5, 0x1f, 0x0b, 0xb7, 0xf6, 0xe9, 0x49, 0x65, 0xb3, 0x6f, 0x3e, 0x7e, 0xd8, 0xdd, 0x47,
-	0x35, 0xa5, 0x6c, 0xe4, 0x87, 0x87, 0x20, 0x12, 0xff, 0x68, 0xf9, 0xe8, 0xf5, 0x8e, 0x07, 0x4d,
-	0xfd, 0xd8, 0x9f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xc3, 0xc5, 0xf4, 0x60, 0x04, 0x00,
-	0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x34, 0x4d, 0xc9, 0x86, 0x4f, 0x53, 0xc0, 0x14, 0xe4, 0xb6, 0x3e, 0xa0, 0x20,
+	0x81, 0x4d, 0xcb, 0x1d, 0xa9, 0x09, 0x45, 0xe2, 0xab, 0xaa, 0x8c, 0x8a, 0x04, 0x97, 0x68, 0xe3,
+	0x1d, 0xb5, 0xdb, 0xd8, 0xbb, 0x96, 0x77, 0x93, 0x34, 0x27, 0x5e, 0x01, 0x89, 0xb7, 0xe0, 0x84,
+	0xc4, 0x1b, 0x70, 0xea, 0xb1, 0x47, 0xc4, 0xa1, 0xa0, 0xf6, 0x45, 0xd0, 0xee, 0x3a, 0xae, 0x2b,
+	0xb5, 0x52, 0x09, 0x27, 0x7b, 0x66, 0x67, 0xff, 0xff, 0xdf, 0xec, 0xd8, 0x8b, 0x96, 0xfb, 0xe3,
+	0x21, 0x04, 0x42, 0xe2, 0x3e, 0x64, 0x22, 0x18, 0xae, 0xf4, 0x40, 0xe2, 0x95, 0x00, 0x86, 0xc0,
+	0xa4, 0xf0, 0xd3, 0x8c, 0x4b, 0x6e, 0xcf, 0xab, 0x12, 0x3f, 0x2f, 0xf1, 0xf3, 0x92, 0x05, 0x37,
+	0xe2, 0x22, 0xe1, 0x22, 0xe8, 0x61, 0x01, 0xc5, 0xbe, 0x88, 0x53, 0x66, 0x76, 0x2d, 0xcc, 0x6f,
+	0xf3, 0x6d, 0xae, 0x5f, 0x03, 0xf5, 0x96, 0x67, 0xcf, 0xb6, 0x4b, 0x71, 0x86, 0x93, 0xdc, 0xce,
+	0xfb, 0x6e, 0xa1, 0x1b, 0xeb, 0xca, 0x7f, 0x2b, 0x25, 0x58, 0xc2, 0xa6, 0x5e, 0xb3, 0xd7, 0x10,
+	0xe2, 0x31, 0xe9, 0x9a, 0x4a, 0xc7, 0x5a, 0xb2, 0x5a, 0xcd, 0xd5, 0xfb, 0xfe, 0x59, 0x64, 0xbe,
+	0xd9, 0xd1, 0xae, 0xed, 0x1f, 0x2e, 0x56, 0xc2, 0x06, 0x8f, 0xc9, 0x89, 0x04, 0x83, 0xd1, 0x44,
+	0xa2, 0x7a, 0x71, 0x09, 0x06, 0xa3, 0x5c, 0xc2, 0x41, 0x73, 0x29, 0x1e, 0xc7, 0x1c, 0x13, 0x67,
+	0x66, 0xc9, 0x6a, 0x35, 0xc2, 0x49, 0xe8, 0x7d, 0x99, 0x50, 0x77, 0x32, 0xc0, 0x12, 0xde, 0x69,
+	0x41, 0xfb, 0x36, 0xaa, 0x1b, 0x69, 0x4d, 0xdc, 0x08, 0xeb, 0xa2, 0xc8, 0xe3, 0x84, 0x0f, 0x98,
+	0xd4, 0x18, 0xb5, 0x30, 0x8f, 0xec, 0x0d, 0x84, 0x22, 0x9e, 0x24, 0x54, 0x08, 0xca, 0x99, 0xb1,
+	0x68, 0xfb, 0x0a, 0xe2, 0xd7, 0xe1, 0xe2, 0x83, 0x6d, 0x2a, 0x77, 0x06, 0x3d, 0x3f, 0xe2, 0x49,
+	0x90, 0x9f, 0xbd, 0x79, 0x3c, 0x16, 0xa4, 0x1f, 0xc8, 0x71, 0x0a, 0xc2, 0x7f, 0x0e, 0x51, 0x58,
+	0x52, 0xf0, 0x7e, 0x58, 0xe8, 0x66, 0xe9, 0x2c, 0xdf, 0x82, 0xc4, 0x04, 0x4b, 0x7c, 0x2e, 0x97,
+	0x83, 0xe6, 0x12, 0xce, 0xa8, 0x5a, 0xa8, 0x9a, 0xfe, 0xf2, 0x50, 0xad, 0x8c, 0xa0, 0x27, 0xa8,
+	0x84, 0x49, 0xe7, 0x79, 0x68, 0x2f, 0xa0, 0x4b, 0x94, 0x00, 0x93, 0x54, 0x8e, 0x9d, 0x9a, 0x5e,
+	0x2a, 0x62, 0xfb, 0x21, 0xba, 0x2e, 0x20, 0x1a, 0x64, 0x54, 0x8e, 0xbb, 0x11, 0x67, 0x12, 0x47,
+	0xd2, 0x99, 0xd5, 0x35, 0xd7, 0x26, 0xf9, 0x8e, 0x49, 0x2b, 0x03, 0x02, 0x12, 0xd3, 0x58, 0x38,
+	0x75, 0x63, 0x90, 0x87, 0xde, 0x27, 0x74, 0xab, 0xd4, 0x43, 0xa7, 0xe8, 0xee, 0xdc, 0x2e, 0x4e,
+	0x9f, 0x62, 0xf5, 0xbf, 0x4f, 0xf1, 0x9b, 0x85, 0xee, 0x99, 0xd9, 0xc6, 0x98, 0x26, 0x27, 0x00,
+	0x21, 0x8c, 0x70, 0x46, 0xc4, 0x3f, 0x4f, 0x19, 0xa3, 0x59, 0xf5, 0xa3, 0x08, 0x67, 0x66, 0x69,
+	0xa6, 0xd5, 0x5c, 0xbd, 0xeb, 0x1b, 0x02, 0x5f, 0xfd, 0x4a, 0xc5, 0x27, 0xd8, 0xe1, 0x94, 0xb5,
+	0x9f, 0x28, 0xea, 0xaf, 0xbf, 0x17, 0x5b, 0x17, 0xa0, 0x56, 0x1b, 0x44, 0x68, 0x94, 0xbd, 0x3d,
+	0x74, 0x45, 0x13, 0xbf, 0xe2, 0x94, 0x6d, 0x72, 0x1e, 0xdb, 0x77, 0xd0, 0x5c, 0xca, 0x79, 0xdc,
+	0xa5, 0x44, 0x43, 0xd6, 0xc2, 0xba, 0x0a, 0x5f, 0x92, 0x12, 0x7c, 0xf5, 0x14, 0xbc, 0x8b, 0xd0,
+	0x10, 0xc7, 0x98, 0x90, 0x0c, 0x84, 0xc8, 0x67, 0x5e, 0xca, 0x94, 0x9a, 0xab, 0x95, 0x9b, 0xf3,
+	0xd6, 0xd0, 0x55, 0xed, 0xfc, 0x06, 0xf0, 0x10, 0xa6, 0xb2, 0xf6, 0xba, 0xa8, 0x61, 0xe0, 0x31,
+	0x9d, 0x02, 0x7c, 0x19, 0x5d, 0xde, 0xc5, 0x34, 0x06, 0xd2, 0x1d, 0x30, 0x49, 0x63, 0x8d, 0x5e,
+	0x0b, 0x9b, 0x26, 0xb7, 0xa5, 0x52, 0xde, 0x33, 0xd4, 0x34, 0x5f, 0x14, 0xdb, 0x9d, 0xc6, 0xa2,
+	0xfd, 0x62, 0xff, 0xc8, 0xb5, 0x0e, 0x8e, 0x5c, 0xeb, 0xcf, 0x91, 0x6b, 0x7d, 0x3e, 0x76, 0x2b,
+	0x07, 0xc7, 0x6e, 0xe5, 0xe7, 0xb1, 0x5b, 0xf9, 0xf8, 0xa8, 0x34, 0xa8, 0xd7, 0x1f, 0xde, 0xaf,
+	0x6f, 0x80, 0x1c, 0xf1, 0xac, 0x1f, 0x44, 0x3b, 0x98, 0xb2, 0x60, 0xaf, 0xb8, 0xf9, 0xf4, 0xc8,
+	0x7a, 0x75, 0x7d, 0xe3, 0x3d, 0xfd, 0x3b, 0x00, 0xf8, 0x1b, 0x44, 0xbb, 0x85, 0x05, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -745,6 +884,20 @@ func (m *EventClaimCommissionRewards) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Amount != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
 		i--
@@ -842,6 +995,81 @@ func (m *EventLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventJail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventJail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventJail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -949,6 +1177,12 @@ func (m *EventClaimCommissionRewards) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovEvents(uint64(m.Amount))
 	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -991,6 +1225,41 @@ func (m *EventLeavePool) Size() (n int) {
 	return n
 }
 
+func (m *EventJail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func (m *EventUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1718,6 +1987,40 @@ func (m *EventClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1992,6 +2295,227 @@ func (m *EventLeavePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventJail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
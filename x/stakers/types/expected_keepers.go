@@ -21,11 +21,13 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
 type PoolKeeper interface {
 	AssertPoolExists(ctx sdk.Context, poolId uint64) error
 	GetPoolWithError(ctx sdk.Context, poolId uint64) (poolTypes.Pool, error)
+	GetAllPools(ctx sdk.Context) (list []poolTypes.Pool)
 }
 
 type UpgradeKeeper interface {
@@ -39,5 +39,9 @@ func (msg *MsgClaimCommissionRewards) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid validator address: %s", err)
 	}
 
+	if err := msg.Coins.Validate(); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidCoins, "invalid coins: %s", err)
+	}
+
 	return nil
 }
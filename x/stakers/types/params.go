@@ -10,14 +10,19 @@ var DefaultCommissionChangeTime = uint64(60 * 60 * 24 * 5)
 // DefaultLeavePoolTime ...
 var DefaultLeavePoolTime = uint64(60 * 60 * 24 * 5)
 
+// DefaultJailTime ...
+var DefaultJailTime = uint64(60 * 60 * 24)
+
 // NewParams creates a new Params instance
 func NewParams(
 	commissionChangeTime uint64,
 	leavePoolTime uint64,
+	jailTime uint64,
 ) Params {
 	return Params{
 		CommissionChangeTime: commissionChangeTime,
 		LeavePoolTime:        leavePoolTime,
+		JailTime:             jailTime,
 	}
 }
 
@@ -26,6 +31,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultCommissionChangeTime,
 		DefaultLeavePoolTime,
+		DefaultJailTime,
 	)
 }
 
@@ -39,5 +45,9 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidateNumber(p.JailTime); err != nil {
+		return err
+	}
+
 	return nil
 }
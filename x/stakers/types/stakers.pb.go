@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -43,6 +44,8 @@ type Staker struct {
 	Details string `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
 	// commission_rewards are the rewards in $KYVE earned through commission
 	CommissionRewards uint64 `protobuf:"varint,8,opt,name=commission_rewards,json=commissionRewards,proto3" json:"commission_rewards,omitempty"`
+	// commission_coin_rewards are the rewards in non-native denoms earned through commission
+	CommissionCoinRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=commission_coin_rewards,json=commissionCoinRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission_coin_rewards"`
 }
 
 func (m *Staker) Reset()         { *m = Staker{} }
@@ -127,6 +130,13 @@ func (m *Staker) GetCommissionRewards() uint64 {
 	return 0
 }
 
+func (m *Staker) GetCommissionCoinRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommissionCoinRewards
+	}
+	return nil
+}
+
 // Valaccount gets authorized by a staker to
 // vote in a given pool by favor of the staker.
 type Valaccount struct {
@@ -140,10 +150,21 @@ type Valaccount struct {
 	Valaddress string `protobuf:"bytes,3,opt,name=valaddress,proto3" json:"valaddress,omitempty"`
 	// When a node is inactive (does not vote at all)
 	// A point is added, after a certain amount of points
-	// is reached the node gets kicked out.
+	// is reached the node gets jailed.
 	Points uint64 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
 	// isLeaving indicates if a staker is leaving the given pool.
 	IsLeaving bool `protobuf:"varint,5,opt,name=is_leaving,json=isLeaving,proto3" json:"is_leaving,omitempty"`
+	// consecutive_votes is the number of successful votes since
+	// the last point was added or removed. It is used to decay
+	// the points of the node.
+	ConsecutiveVotes uint64 `protobuf:"varint,6,opt,name=consecutive_votes,json=consecutiveVotes,proto3" json:"consecutive_votes,omitempty"`
+	// jailed indicates if the valaccount got jailed after reaching
+	// the maximum amount of points. A jailed valaccount can neither
+	// upload nor vote until it gets unjailed by the staker.
+	Jailed bool `protobuf:"varint,7,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// jailed_until is the unix timestamp after which the staker
+	// is allowed to unjail the valaccount again.
+	JailedUntil uint64 `protobuf:"varint,8,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
 }
 
 func (m *Valaccount) Reset()         { *m = Valaccount{} }
@@ -214,6 +235,27 @@ func (m *Valaccount) GetIsLeaving() bool {
 	return false
 }
 
+func (m *Valaccount) GetConsecutiveVotes() uint64 {
+	if m != nil {
+		return m.ConsecutiveVotes
+	}
+	return 0
+}
+
+func (m *Valaccount) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *Valaccount) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
 // CommissionChangeEntry stores the information for an
 // upcoming commission change. A commission change is never
 // instant, so delegators have time to redelegate in case
@@ -436,41 +478,48 @@ func init() {
 }
 
 var fileDescriptor_d209d1a2a74d375d = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xdd, 0x6a, 0x13, 0x41,
-	0x18, 0xcd, 0x36, 0x69, 0x7e, 0x3e, 0xfc, 0x1d, 0xa2, 0x2e, 0x95, 0x6e, 0x4b, 0x04, 0xa9, 0x60,
-	0xb3, 0x14, 0xdf, 0xa0, 0x69, 0xc5, 0xa2, 0x14, 0xdd, 0x42, 0x41, 0x6f, 0xc2, 0x64, 0xf6, 0x63,
-	0x33, 0x64, 0x33, 0x13, 0x76, 0x26, 0x49, 0x03, 0x3e, 0x84, 0x17, 0x3e, 0x8b, 0x17, 0x3e, 0x41,
-	0x2f, 0x7b, 0x29, 0x5e, 0x14, 0x49, 0x5e, 0x44, 0x66, 0x66, 0x37, 0xdd, 0x5e, 0x08, 0xa2, 0x57,
-	0xbb, 0xe7, 0x9c, 0x6f, 0xe7, 0x3b, 0x7b, 0x0e, 0x03, 0x9d, 0xd1, 0x62, 0x86, 0xa1, 0xd2, 0x74,
-	0x84, 0x99, 0x0a, 0x67, 0x07, 0x03, 0xd4, 0xf4, 0xa0, 0xc0, 0xdd, 0x49, 0x26, 0xb5, 0x24, 0x6d,
-	0x33, 0xd3, 0x2d, 0xb8, 0x7c, 0x66, 0xab, 0x9d, 0xc8, 0x44, 0xda, 0x81, 0xd0, 0xbc, 0xb9, 0xd9,
-	0xce, 0xb7, 0x0d, 0xa8, 0x9f, 0xd9, 0x49, 0xe2, 0x43, 0x83, 0xc6, 0x71, 0x86, 0x4a, 0xf9, 0xde,
-	0xae, 0xb7, 0xd7, 0x8a, 0x0a, 0x48, 0x4e, 0x01, 0x98, 0x1c, 0x8f, 0xb9, 0x52, 0x5c, 0x0a, 0x7f,
-	0xc3, 0x88, 0x87, 0xdd, 0xcb, 0xeb, 0x9d, 0xca, 0xcf, 0xeb, 0x9d, 0xe7, 0x09, 0xd7, 0xc3, 0xe9,
-	0xa0, 0xcb, 0xe4, 0x38, 0x64, 0x52, 0x8d, 0xa5, 0xca, 0x1f, 0xfb, 0x2a, 0x1e, 0x85, 0x7a, 0x31,
-	0x41, 0xd5, 0x3d, 0x42, 0x16, 0x95, 0x4e, 0x30, 0x9b, 0xc6, 0x52, 0xf0, 0x11, 0x66, 0x7e, 0xd5,
-	0x6d, 0xca, 0xa1, 0x51, 0xe6, 0x38, 0x50, 0x5c, 0xa3, 0x5f, 0x73, 0x4a, 0x0e, 0xc9, 0x16, 0x34,
-	0x79, 0x8c, 0x42, 0x73, 0xbd, 0xf0, 0x37, 0xad, 0xb4, 0xc6, 0xe4, 0x05, 0x3c, 0x50, 0xc8, 0xa6,
-	0x19, 0xd7, 0x8b, 0x3e, 0x93, 0x42, 0x53, 0xa6, 0xfd, 0xba, 0x9d, 0xb9, 0x5f, 0xf0, 0x3d, 0x47,
-	0x9b, 0x05, 0x31, 0x6a, 0xca, 0x53, 0xe5, 0x37, 0xdc, 0x82, 0x1c, 0x92, 0x7d, 0x20, 0x37, 0x16,
-	0xfb, 0x19, 0xce, 0x69, 0x16, 0x2b, 0xbf, 0xb9, 0xeb, 0xed, 0xd5, 0xa2, 0x87, 0x37, 0x4a, 0xe4,
-	0x84, 0xce, 0x57, 0x0f, 0xe0, 0x9c, 0xa6, 0x94, 0x31, 0x39, 0x15, 0x9a, 0x3c, 0x81, 0xc6, 0x44,
-	0xca, 0xb4, 0xcf, 0x63, 0x1b, 0x5e, 0x2d, 0xaa, 0x1b, 0x78, 0x12, 0x93, 0xc7, 0x50, 0x77, 0x4d,
-	0xb8, 0xdc, 0xa2, 0x1c, 0x91, 0x00, 0x60, 0x46, 0xd3, 0x22, 0x70, 0x17, 0x43, 0x89, 0x31, 0xdf,
-	0x4d, 0x24, 0x17, 0x5a, 0xd9, 0x20, 0xec, 0x79, 0x06, 0x91, 0x6d, 0x00, 0xae, 0xfa, 0x29, 0xd2,
-	0x19, 0x17, 0x89, 0x4d, 0xa2, 0x19, 0xb5, 0xb8, 0x7a, 0xe7, 0x88, 0xce, 0x77, 0x0f, 0x1e, 0xf5,
-	0xd6, 0x66, 0x7b, 0x43, 0x2a, 0x12, 0x3c, 0x16, 0x3a, 0x5b, 0x90, 0x36, 0x6c, 0x72, 0x11, 0xe3,
-	0x45, 0xee, 0xcf, 0x81, 0x3f, 0xda, 0xbb, 0x5d, 0x79, 0xf5, 0xbf, 0x2b, 0x7f, 0x06, 0x77, 0x59,
-	0x86, 0x54, 0x9b, 0x6c, 0x63, 0x9a, 0xd7, 0x5b, 0x8d, 0xee, 0x14, 0xe4, 0x11, 0xd5, 0xd8, 0xf9,
-	0x0c, 0xf7, 0xcc, 0x7f, 0xe0, 0x7b, 0x29, 0xd3, 0x7f, 0x31, 0x5d, 0x2a, 0xa1, 0x7a, 0xab, 0x84,
-	0xbf, 0xda, 0xfe, 0x06, 0xe0, 0xc3, 0x14, 0xa7, 0x78, 0xa6, 0xa9, 0x46, 0xf2, 0x14, 0x5a, 0xa9,
-	0x9c, 0xf7, 0xcb, 0xdb, 0x9b, 0xa9, 0x9c, 0x9f, 0x58, 0x03, 0xdb, 0x00, 0x43, 0x9e, 0x0c, 0x73,
-	0x75, 0xc3, 0xaa, 0x2d, 0xc3, 0x58, 0xf9, 0xf0, 0xf5, 0xe5, 0x32, 0xf0, 0xae, 0x96, 0x81, 0xf7,
-	0x6b, 0x19, 0x78, 0x5f, 0x56, 0x41, 0xe5, 0x6a, 0x15, 0x54, 0x7e, 0xac, 0x82, 0xca, 0xa7, 0x97,
-	0xa5, 0xe8, 0xde, 0x7e, 0x3c, 0x3f, 0x3e, 0x45, 0x3d, 0x97, 0xd9, 0x28, 0x64, 0x43, 0xca, 0x45,
-	0x78, 0xb1, 0xbe, 0xd8, 0x36, 0xc4, 0x41, 0xdd, 0xde, 0xd1, 0x57, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x97, 0x01, 0x39, 0x24, 0xf5, 0x03, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0x8e, 0x9b, 0x34, 0x4d, 0x5e, 0x0b, 0xb4, 0xa3, 0x96, 0x9a, 0xa2, 0xba, 0x25, 0x48, 0x28,
+	0x08, 0x6a, 0x53, 0xb8, 0x41, 0x7f, 0x10, 0x15, 0xa8, 0x02, 0x57, 0x54, 0x82, 0x8d, 0x35, 0xb1,
+	0x9f, 0x92, 0x21, 0xce, 0x4c, 0xe4, 0x99, 0x24, 0x8d, 0xc4, 0x8e, 0x0b, 0x70, 0x0e, 0x8e, 0x80,
+	0x38, 0x40, 0x97, 0x5d, 0x22, 0x16, 0x05, 0xb5, 0xd7, 0x60, 0x81, 0xc6, 0x63, 0x27, 0xee, 0x02,
+	0xa9, 0x82, 0x95, 0xe7, 0xfb, 0xbe, 0x79, 0xf3, 0x9e, 0xbe, 0xef, 0xc9, 0xd0, 0xe8, 0x8e, 0x87,
+	0xe8, 0x49, 0x45, 0xbb, 0x98, 0x48, 0x6f, 0xb8, 0xdd, 0x42, 0x45, 0xb7, 0x73, 0xec, 0xf6, 0x13,
+	0xa1, 0x04, 0x59, 0xd6, 0x77, 0xdc, 0x9c, 0xcb, 0xee, 0xac, 0x39, 0xa1, 0x90, 0x3d, 0x21, 0xbd,
+	0x16, 0x95, 0x38, 0x29, 0x0c, 0x05, 0xe3, 0xa6, 0x6a, 0x6d, 0xb9, 0x2d, 0xda, 0x22, 0x3d, 0x7a,
+	0xfa, 0x64, 0xd8, 0xc6, 0xb7, 0x32, 0x54, 0x8f, 0xd2, 0x97, 0x88, 0x0d, 0x73, 0x34, 0x8a, 0x12,
+	0x94, 0xd2, 0xb6, 0x36, 0xad, 0x66, 0xdd, 0xcf, 0x21, 0x39, 0x04, 0x08, 0x45, 0xaf, 0xc7, 0xa4,
+	0x64, 0x82, 0xdb, 0x33, 0x5a, 0xdc, 0x71, 0x4f, 0xcf, 0x37, 0x4a, 0x3f, 0xce, 0x37, 0x1e, 0xb4,
+	0x99, 0xea, 0x0c, 0x5a, 0x6e, 0x28, 0x7a, 0x5e, 0x36, 0x81, 0xf9, 0x6c, 0xc9, 0xa8, 0xeb, 0xa9,
+	0x71, 0x1f, 0xa5, 0xbb, 0x87, 0xa1, 0x5f, 0x78, 0x41, 0x77, 0xea, 0x09, 0xce, 0xba, 0x98, 0xd8,
+	0x65, 0xd3, 0x29, 0x83, 0x5a, 0x19, 0x61, 0x4b, 0x32, 0x85, 0x76, 0xc5, 0x28, 0x19, 0x24, 0x6b,
+	0x50, 0x63, 0x11, 0x72, 0xc5, 0xd4, 0xd8, 0x9e, 0x4d, 0xa5, 0x09, 0x26, 0x0f, 0x61, 0x51, 0x62,
+	0x38, 0x48, 0x98, 0x1a, 0x07, 0xa1, 0xe0, 0x8a, 0x86, 0xca, 0xae, 0xa6, 0x77, 0x6e, 0xe5, 0xfc,
+	0xae, 0xa1, 0x75, 0x83, 0x08, 0x15, 0x65, 0xb1, 0xb4, 0xe7, 0x4c, 0x83, 0x0c, 0x92, 0x2d, 0x20,
+	0xd3, 0x11, 0x83, 0x04, 0x47, 0x34, 0x89, 0xa4, 0x5d, 0xdb, 0xb4, 0x9a, 0x15, 0x7f, 0x69, 0xaa,
+	0xf8, 0x46, 0x20, 0x9f, 0x2c, 0x58, 0x2d, 0xdc, 0xd7, 0x46, 0x4f, 0x8a, 0xea, 0x9b, 0xe5, 0xe6,
+	0xfc, 0xd3, 0x3b, 0xae, 0x31, 0xc2, 0xd5, 0x89, 0xe4, 0x31, 0xb9, 0xbb, 0x82, 0xf1, 0x9d, 0x27,
+	0xda, 0xbc, 0x2f, 0x3f, 0x37, 0x9a, 0xd7, 0x30, 0x4f, 0x17, 0x48, 0x7f, 0x65, 0xda, 0x4b, 0x13,
+	0xd9, 0x14, 0x8d, 0xdf, 0x16, 0xc0, 0x31, 0x8d, 0x69, 0x18, 0x8a, 0x01, 0x57, 0x64, 0x15, 0xe6,
+	0xfa, 0x42, 0xc4, 0x01, 0x8b, 0xd2, 0x08, 0x2b, 0x7e, 0x55, 0xc3, 0x83, 0x88, 0xdc, 0x86, 0xaa,
+	0xd9, 0x17, 0x93, 0x9e, 0x9f, 0x21, 0xe2, 0x00, 0x0c, 0x69, 0x9c, 0xc7, 0x6e, 0xc2, 0x28, 0x30,
+	0xba, 0xae, 0x2f, 0x18, 0x57, 0xd2, 0xae, 0xe4, 0xef, 0x69, 0x44, 0xd6, 0x01, 0x98, 0x0c, 0x62,
+	0xa4, 0x43, 0xc6, 0xdb, 0x69, 0x1e, 0x35, 0xbf, 0xce, 0xe4, 0x2b, 0x43, 0x90, 0x47, 0xb0, 0x14,
+	0x0a, 0xae, 0xbd, 0x57, 0x6c, 0x88, 0xc1, 0x50, 0x28, 0x94, 0x69, 0x22, 0x15, 0x7f, 0xb1, 0x20,
+	0x1c, 0x6b, 0x5e, 0xf7, 0xf8, 0x40, 0x59, 0x8c, 0x51, 0x9a, 0x48, 0xcd, 0xcf, 0x10, 0xb9, 0x07,
+	0x0b, 0xe6, 0x14, 0x0c, 0xb8, 0x62, 0x71, 0x16, 0xc5, 0xbc, 0xe1, 0xde, 0x6a, 0xaa, 0xf1, 0xd5,
+	0x82, 0x95, 0xdd, 0xa9, 0x31, 0x1d, 0xca, 0xdb, 0xb8, 0xcf, 0x55, 0x32, 0x26, 0xcb, 0x30, 0xcb,
+	0x78, 0x84, 0x27, 0x99, 0x0f, 0x06, 0xfc, 0xd5, 0x86, 0xab, 0x0b, 0x5e, 0xfe, 0xef, 0x05, 0xbf,
+	0x0f, 0x37, 0xc2, 0x04, 0xa9, 0xd2, 0x9b, 0x11, 0xd1, 0x6c, 0x99, 0xcb, 0xfe, 0x42, 0x4e, 0xee,
+	0x51, 0x85, 0x8d, 0x8f, 0x70, 0x53, 0xfb, 0x85, 0xaf, 0x85, 0x88, 0xff, 0x65, 0xe8, 0x42, 0xd8,
+	0xe5, 0x2b, 0x61, 0x5f, 0xab, 0xfb, 0x0b, 0x80, 0x37, 0x03, 0x1c, 0xe0, 0x91, 0xa2, 0x0a, 0xc9,
+	0x5d, 0xa8, 0xc7, 0x62, 0x14, 0x14, 0xbb, 0xd7, 0x62, 0x31, 0x3a, 0x48, 0x07, 0x58, 0x07, 0xe8,
+	0xb0, 0x76, 0x27, 0x53, 0x67, 0x52, 0xb5, 0xae, 0x99, 0x54, 0xde, 0x79, 0x7e, 0x7a, 0xe1, 0x58,
+	0x67, 0x17, 0x8e, 0xf5, 0xeb, 0xc2, 0xb1, 0x3e, 0x5f, 0x3a, 0xa5, 0xb3, 0x4b, 0xa7, 0xf4, 0xfd,
+	0xd2, 0x29, 0xbd, 0x7f, 0x5c, 0xb0, 0xee, 0xe5, 0xbb, 0xe3, 0xfd, 0x43, 0x54, 0x23, 0x91, 0x74,
+	0xbd, 0xb0, 0x43, 0x19, 0xf7, 0x4e, 0x26, 0xbf, 0xb9, 0xd4, 0xc4, 0x56, 0x35, 0xfd, 0x23, 0x3d,
+	0xfb, 0x33, 0x00, 0x7c, 0x82, 0xdf, 0xba, 0x03, 0x05, 0x00, 0x00,
 }
 
 func (m *Staker) Marshal() (dAtA []byte, err error) {
@@ -493,6 +542,20 @@ func (m *Staker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionCoinRewards) > 0 {
+		for iNdEx := len(m.CommissionCoinRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionCoinRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStakers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.CommissionRewards != 0 {
 		i = encodeVarintStakers(dAtA, i, uint64(m.CommissionRewards))
 		i--
@@ -573,6 +636,26 @@ func (m *Valaccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ConsecutiveVotes != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.ConsecutiveVotes))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.IsLeaving {
 		i--
 		if m.IsLeaving {
@@ -784,6 +867,12 @@ func (m *Staker) Size() (n int) {
 	if m.CommissionRewards != 0 {
 		n += 1 + sovStakers(uint64(m.CommissionRewards))
 	}
+	if len(m.CommissionCoinRewards) > 0 {
+		for _, e := range m.CommissionCoinRewards {
+			l = e.Size()
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -810,6 +899,15 @@ func (m *Valaccount) Size() (n int) {
 	if m.IsLeaving {
 		n += 2
 	}
+	if m.ConsecutiveVotes != 0 {
+		n += 1 + sovStakers(uint64(m.ConsecutiveVotes))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovStakers(uint64(m.JailedUntil))
+	}
 	return n
 }
 
@@ -1151,6 +1249,40 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionCoinRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionCoinRewards = append(m.CommissionCoinRewards, types.Coin{})
+			if err := m.CommissionCoinRewards[len(m.CommissionCoinRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakers(dAtA[iNdEx:])
@@ -1323,6 +1455,64 @@ func (m *Valaccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsLeaving = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveVotes", wireType)
+			}
+			m.ConsecutiveVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakers(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -344,6 +345,8 @@ type MsgClaimCommissionRewards struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	// amount ...
 	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// coins are the commission rewards in non-native denoms to claim
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 }
 
 func (m *MsgClaimCommissionRewards) Reset()         { *m = MsgClaimCommissionRewards{} }
@@ -393,6 +396,13 @@ func (m *MsgClaimCommissionRewards) GetAmount() uint64 {
 	return 0
 }
 
+func (m *MsgClaimCommissionRewards) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 // MsgClaimCommissionRewardsResponse ...
 type MsgClaimCommissionRewardsResponse struct {
 }
@@ -632,6 +642,99 @@ func (m *MsgLeavePoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeavePoolResponse proto.InternalMessageInfo
 
+// MsgUnjail defines a SDK message for unjailing the valaccount of a staker
+// after the jail time has passed.
+type MsgUnjail struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *MsgUnjail) Reset()         { *m = MsgUnjail{} }
+func (m *MsgUnjail) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjail) ProtoMessage()    {}
+func (*MsgUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{12}
+}
+func (m *MsgUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjail.Merge(m, src)
+}
+func (m *MsgUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjail proto.InternalMessageInfo
+
+func (m *MsgUnjail) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUnjail) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// MsgUnjailResponse defines the Msg/Unjail response type.
+type MsgUnjailResponse struct {
+}
+
+func (m *MsgUnjailResponse) Reset()         { *m = MsgUnjailResponse{} }
+func (m *MsgUnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailResponse) ProtoMessage()    {}
+func (*MsgUnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{13}
+}
+func (m *MsgUnjailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailResponse.Merge(m, src)
+}
+func (m *MsgUnjailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a SDK message for updating the module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -644,7 +747,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{12}
+	return fileDescriptor_f52b730e69b9fb06, []int{14}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +798,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{13}
+	return fileDescriptor_f52b730e69b9fb06, []int{15}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,6 +840,8 @@ func init() {
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "kyve.stakers.v1beta1.MsgJoinPoolResponse")
 	proto.RegisterType((*MsgLeavePool)(nil), "kyve.stakers.v1beta1.MsgLeavePool")
 	proto.RegisterType((*MsgLeavePoolResponse)(nil), "kyve.stakers.v1beta1.MsgLeavePoolResponse")
+	proto.RegisterType((*MsgUnjail)(nil), "kyve.stakers.v1beta1.MsgUnjail")
+	proto.RegisterType((*MsgUnjailResponse)(nil), "kyve.stakers.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "kyve.stakers.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateParamsResponse")
 }
@@ -744,52 +849,57 @@ func init() {
 func init() { proto.RegisterFile("kyve/stakers/v1beta1/tx.proto", fileDescriptor_f52b730e69b9fb06) }
 
 var fileDescriptor_f52b730e69b9fb06 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5b, 0x4f, 0xd4, 0x4c,
-	0x18, 0xde, 0x72, 0xde, 0xf7, 0x23, 0x1f, 0x7c, 0xfd, 0x56, 0x28, 0x25, 0x14, 0xa8, 0x41, 0xc1,
-	0x48, 0x1b, 0x34, 0xd1, 0x6b, 0x58, 0x35, 0xf1, 0x50, 0x42, 0x4a, 0x34, 0x1e, 0x2e, 0xc8, 0x6c,
-	0x3b, 0x29, 0xe3, 0x6e, 0x3b, 0x4d, 0x67, 0x76, 0x61, 0xaf, 0x4c, 0xfc, 0x05, 0x26, 0xfe, 0x15,
-	0x7f, 0x81, 0x57, 0x5c, 0x12, 0xaf, 0x8c, 0x17, 0xc4, 0xc0, 0xa5, 0x7f, 0xc2, 0xf4, 0x34, 0x74,
-	0x61, 0x0f, 0xa0, 0x57, 0xbb, 0xef, 0xbc, 0xcf, 0x3c, 0xcf, 0x33, 0xd3, 0x79, 0x66, 0x60, 0xa1,
-	0xde, 0x6e, 0x61, 0x93, 0x71, 0x54, 0xc7, 0x11, 0x33, 0x5b, 0x1b, 0x35, 0xcc, 0xd1, 0x86, 0xc9,
-	0x0f, 0x8d, 0x30, 0xa2, 0x9c, 0xca, 0x95, 0xb8, 0x6d, 0x64, 0x6d, 0x23, 0x6b, 0xab, 0x73, 0x0e,
-	0x65, 0x3e, 0x65, 0x7b, 0x09, 0xc6, 0x4c, 0x8b, 0x74, 0x82, 0x5a, 0xf1, 0xa8, 0x47, 0xd3, 0xf1,
-	0xf8, 0x5f, 0x3a, 0xaa, 0x7f, 0x96, 0x60, 0xca, 0x62, 0x5e, 0x35, 0xc2, 0x88, 0xe3, 0xdd, 0x84,
-	0x4d, 0x56, 0x60, 0xdc, 0x89, 0x6b, 0x1a, 0x29, 0xd2, 0x92, 0xb4, 0x5a, 0xb6, 0xf3, 0x52, 0x9e,
-	0x81, 0x31, 0xe4, 0xd3, 0x66, 0xc0, 0x95, 0xa1, 0x25, 0x69, 0x75, 0xc4, 0xce, 0x2a, 0x79, 0x1b,
-	0xc0, 0xa1, 0xbe, 0x4f, 0x18, 0x23, 0x34, 0x50, 0x86, 0xe3, 0x49, 0x5b, 0xc6, 0xd1, 0xc9, 0x62,
-	0xe9, 0xc7, 0xc9, 0xe2, 0x2d, 0x8f, 0xf0, 0xfd, 0x66, 0xcd, 0x70, 0xa8, 0x9f, 0x19, 0xca, 0x7e,
-	0xd6, 0x99, 0x5b, 0x37, 0x79, 0x3b, 0xc4, 0xcc, 0x78, 0x84, 0x1d, 0xbb, 0xc0, 0xa0, 0xcf, 0xc1,
-	0xec, 0x05, 0x53, 0x36, 0x66, 0x21, 0x0d, 0x18, 0xd6, 0xbf, 0x4a, 0xf0, 0x9f, 0xc5, 0xbc, 0x97,
-	0xa1, 0x8b, 0x38, 0xb6, 0x30, 0x47, 0x2e, 0xe2, 0xa8, 0x8f, 0x65, 0x05, 0xc6, 0x7d, 0x1a, 0x90,
-	0x3a, 0x8e, 0x12, 0xcf, 0x65, 0x3b, 0x2f, 0xe3, 0xce, 0x01, 0xae, 0x31, 0xc2, 0x71, 0xea, 0xd8,
-	0xce, 0x4b, 0x59, 0x85, 0x09, 0xe2, 0xe2, 0x80, 0x13, 0xde, 0x56, 0x46, 0x92, 0x96, 0xa8, 0xe5,
-	0x35, 0x98, 0x66, 0xd8, 0x69, 0x46, 0x84, 0xb7, 0xf7, 0x1c, 0x1a, 0x70, 0xe4, 0x70, 0x65, 0x34,
-	0xc1, 0x4c, 0xe5, 0xe3, 0xd5, 0x74, 0x38, 0x16, 0x70, 0x31, 0x47, 0xa4, 0xc1, 0x94, 0xb1, 0x54,
-	0x20, 0x2b, 0xf5, 0x79, 0x98, 0xbb, 0xb4, 0x06, 0xb1, 0xc2, 0x0f, 0xf0, 0xbf, 0x68, 0x56, 0xc5,
-	0x9e, 0xf4, 0x59, 0x62, 0xe7, 0xee, 0x0f, 0xfd, 0xf5, 0xee, 0x2f, 0xc0, 0x7c, 0x17, 0x03, 0xc2,
-	0x9f, 0x95, 0x98, 0xaf, 0x36, 0x10, 0xf1, 0x8b, 0xdd, 0x03, 0x14, 0xb9, 0xec, 0xfa, 0x67, 0x47,
-	0xbf, 0x09, 0xcb, 0x3d, 0xe9, 0x84, 0xe6, 0x21, 0xfc, 0x63, 0x31, 0xef, 0x19, 0x25, 0xc1, 0x0e,
-	0xa5, 0x8d, 0x3e, 0x2a, 0xb3, 0x30, 0x1e, 0x52, 0xda, 0xd8, 0x23, 0x6e, 0x2e, 0x13, 0x97, 0x4f,
-	0x5d, 0x59, 0x03, 0x68, 0xa1, 0x06, 0x72, 0xdd, 0x08, 0x33, 0x96, 0x7d, 0xf0, 0xc2, 0x48, 0xc1,
-	0xde, 0x48, 0x87, 0xbd, 0x1b, 0xc9, 0xd7, 0xc8, 0x95, 0x85, 0xa1, 0x4d, 0x98, 0xb4, 0x98, 0xf7,
-	0x02, 0xa3, 0x16, 0xfe, 0x43, 0x47, 0xfa, 0x0c, 0x54, 0x8a, 0x14, 0x82, 0xda, 0x49, 0x12, 0x99,
-	0x6e, 0xff, 0x0e, 0x8a, 0x90, 0xcf, 0xe4, 0x07, 0x50, 0x46, 0x4d, 0xbe, 0x4f, 0xe3, 0xd3, 0x95,
-	0xf2, 0x6f, 0x29, 0xdf, 0xbe, 0xac, 0x57, 0xb2, 0x80, 0x6f, 0xa6, 0x6b, 0xd8, 0xe5, 0x11, 0x09,
-	0x3c, 0xfb, 0x1c, 0x1a, 0xbb, 0x0a, 0x51, 0xbb, 0x41, 0x91, 0x9b, 0x1f, 0xfe, 0xac, 0xcc, 0x12,
-	0x56, 0x14, 0xc9, 0xf5, 0xef, 0xfd, 0x1a, 0x85, 0x61, 0x8b, 0x79, 0xb2, 0x0b, 0x93, 0x1d, 0xd7,
-	0xc2, 0x8a, 0xd1, 0xed, 0xca, 0x31, 0x2e, 0x04, 0x55, 0x5d, 0xbf, 0x12, 0x2c, 0x57, 0x93, 0xdf,
-	0xc3, 0xbf, 0x17, 0xb2, 0x7c, 0xbb, 0x27, 0x41, 0x27, 0x50, 0x35, 0xaf, 0x08, 0x14, 0x5a, 0x21,
-	0x4c, 0x5f, 0x8a, 0xd5, 0xda, 0x00, 0x92, 0x73, 0xa8, 0xba, 0x71, 0x65, 0xa8, 0x50, 0xfc, 0x28,
-	0xc1, 0x4c, 0x8f, 0xa4, 0xf4, 0x76, 0xdf, 0x7d, 0x82, 0xfa, 0xf0, 0x9a, 0x13, 0x84, 0x89, 0xd7,
-	0x30, 0x21, 0x92, 0xb3, 0xdc, 0x93, 0x24, 0x87, 0xa8, 0x6b, 0x03, 0x21, 0x82, 0xf9, 0x1d, 0x94,
-	0xcf, 0x23, 0xa0, 0xf7, 0x9c, 0x27, 0x30, 0xea, 0x9d, 0xc1, 0x18, 0x41, 0xee, 0xc2, 0x64, 0x47,
-	0x08, 0x56, 0x06, 0x6c, 0x7f, 0x0a, 0xeb, 0x73, 0xfe, 0xba, 0x9d, 0xf6, 0xad, 0x27, 0x47, 0xa7,
-	0x9a, 0x74, 0x7c, 0xaa, 0x49, 0x3f, 0x4f, 0x35, 0xe9, 0xd3, 0x99, 0x56, 0x3a, 0x3e, 0xd3, 0x4a,
-	0xdf, 0xcf, 0xb4, 0xd2, 0xdb, 0xbb, 0x85, 0xab, 0xf3, 0xf9, 0x9b, 0x57, 0x8f, 0xb7, 0x31, 0x3f,
-	0xa0, 0x51, 0xdd, 0x74, 0xf6, 0x11, 0x09, 0xcc, 0x43, 0xf1, 0x34, 0x27, 0x97, 0x68, 0x6d, 0x2c,
-	0x79, 0x4f, 0xef, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x68, 0xe4, 0x47, 0xc0, 0xb7, 0x07, 0x00,
-	0x00,
+	// 794 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x4e, 0xeb, 0x56,
+	0x10, 0x8e, 0x09, 0x04, 0x32, 0xa0, 0x02, 0x26, 0x05, 0xc7, 0x08, 0x07, 0x5c, 0x51, 0x42, 0xd5,
+	0xd8, 0x85, 0x4a, 0xed, 0xae, 0x12, 0x49, 0x5b, 0xa9, 0x3f, 0x41, 0xc8, 0xa8, 0x55, 0x7f, 0x16,
+	0xe8, 0xc4, 0x3e, 0x32, 0x87, 0xc4, 0x3e, 0x91, 0xcf, 0x49, 0x20, 0xab, 0x4a, 0x7d, 0x82, 0x4a,
+	0x7d, 0x8b, 0xae, 0xba, 0xe8, 0xbe, 0x52, 0x57, 0x2c, 0x51, 0x57, 0x57, 0x77, 0xc1, 0xbd, 0x82,
+	0x17, 0xb9, 0xf2, 0xdf, 0xc1, 0x01, 0x42, 0x72, 0xb9, 0x2b, 0x98, 0x33, 0xdf, 0x7c, 0xf3, 0xcd,
+	0x78, 0x66, 0x14, 0xd8, 0x68, 0x0f, 0xfa, 0xd8, 0x64, 0x1c, 0xb5, 0x71, 0xc0, 0xcc, 0xfe, 0x5e,
+	0x0b, 0x73, 0xb4, 0x67, 0xf2, 0x0b, 0xa3, 0x1b, 0x50, 0x4e, 0xe5, 0x52, 0xe8, 0x36, 0x12, 0xb7,
+	0x91, 0xb8, 0x55, 0xcd, 0xa6, 0xcc, 0xa3, 0xcc, 0x6c, 0x21, 0x86, 0x45, 0x8c, 0x4d, 0x89, 0x1f,
+	0x47, 0xa9, 0xe5, 0xd8, 0x7f, 0x12, 0x59, 0x66, 0x6c, 0x24, 0xae, 0x92, 0x4b, 0x5d, 0x1a, 0xbf,
+	0x87, 0xff, 0xc5, 0xaf, 0xfa, 0x9f, 0x12, 0x2c, 0x36, 0x99, 0xdb, 0x08, 0x30, 0xe2, 0xf8, 0x38,
+	0xca, 0x26, 0x2b, 0x30, 0x6b, 0x87, 0x36, 0x0d, 0x14, 0x69, 0x53, 0xaa, 0x16, 0xad, 0xd4, 0x94,
+	0x57, 0xa1, 0x80, 0x3c, 0xda, 0xf3, 0xb9, 0x32, 0xb5, 0x29, 0x55, 0xa7, 0xad, 0xc4, 0x92, 0x0f,
+	0x01, 0x6c, 0xea, 0x79, 0x84, 0x31, 0x42, 0x7d, 0x25, 0x1f, 0x06, 0xd5, 0x8d, 0xcb, 0xeb, 0x4a,
+	0xee, 0xe5, 0x75, 0xe5, 0x43, 0x97, 0xf0, 0xd3, 0x5e, 0xcb, 0xb0, 0xa9, 0x97, 0x08, 0x4a, 0xfe,
+	0xd4, 0x98, 0xd3, 0x36, 0xf9, 0xa0, 0x8b, 0x99, 0xf1, 0x25, 0xb6, 0xad, 0x0c, 0x83, 0x5e, 0x86,
+	0xb5, 0x7b, 0xa2, 0x2c, 0xcc, 0xba, 0xd4, 0x67, 0x58, 0xff, 0x4f, 0x82, 0xe5, 0x26, 0x73, 0x7f,
+	0xe8, 0x3a, 0x88, 0xe3, 0x26, 0xe6, 0xc8, 0x41, 0x1c, 0x3d, 0x21, 0x59, 0x81, 0x59, 0x8f, 0xfa,
+	0xa4, 0x8d, 0x83, 0x48, 0x73, 0xd1, 0x4a, 0xcd, 0xd0, 0x73, 0x8e, 0x5b, 0x8c, 0x70, 0x1c, 0x2b,
+	0xb6, 0x52, 0x53, 0x56, 0x61, 0x8e, 0x38, 0xd8, 0xe7, 0x84, 0x0f, 0x94, 0xe9, 0xc8, 0x25, 0x6c,
+	0x79, 0x17, 0x96, 0x18, 0xb6, 0x7b, 0x01, 0xe1, 0x83, 0x13, 0x9b, 0xfa, 0x1c, 0xd9, 0x5c, 0x99,
+	0x89, 0x30, 0x8b, 0xe9, 0x7b, 0x23, 0x7e, 0x0e, 0x13, 0x38, 0x98, 0x23, 0xd2, 0x61, 0x4a, 0x21,
+	0x4e, 0x90, 0x98, 0xfa, 0x3a, 0x94, 0x1f, 0xd4, 0x20, 0x2a, 0xfc, 0x0d, 0x56, 0x84, 0xb3, 0x21,
+	0x7a, 0xf2, 0x44, 0x89, 0xc3, 0xdd, 0x9f, 0x7a, 0xe7, 0xee, 0x6f, 0xc0, 0xfa, 0x23, 0x02, 0x84,
+	0xbe, 0xbf, 0xa5, 0x48, 0x7d, 0xa3, 0x83, 0x88, 0x97, 0x75, 0x9f, 0xa3, 0xc0, 0x61, 0xcf, 0x18,
+	0x1e, 0x04, 0x33, 0xe1, 0x04, 0x33, 0x25, 0xbf, 0x99, 0xaf, 0xce, 0xef, 0x97, 0x8d, 0x64, 0x6c,
+	0xc3, 0x19, 0x4f, 0x07, 0xdf, 0x68, 0x50, 0xe2, 0xd7, 0x3f, 0x09, 0x8b, 0xfa, 0xeb, 0x55, 0xa5,
+	0x3a, 0x41, 0x51, 0x61, 0x00, 0xb3, 0x62, 0x66, 0xfd, 0x03, 0xd8, 0x1a, 0xa9, 0x58, 0xd4, 0x75,
+	0x01, 0xf3, 0x4d, 0xe6, 0x7e, 0x4b, 0x89, 0x7f, 0x44, 0x69, 0xe7, 0x89, 0x42, 0xd6, 0x60, 0xb6,
+	0x4b, 0x69, 0xe7, 0x84, 0x38, 0x69, 0x25, 0xa1, 0xf9, 0x8d, 0x23, 0x6b, 0x00, 0x7d, 0xd4, 0x41,
+	0x8e, 0x13, 0x60, 0xc6, 0x92, 0xa1, 0xca, 0xbc, 0x64, 0x3a, 0x30, 0x9d, 0xed, 0x80, 0xfe, 0x3e,
+	0xac, 0x64, 0x32, 0x0b, 0x41, 0x07, 0xb0, 0xd0, 0x64, 0xee, 0xf7, 0x18, 0xf5, 0xf1, 0x33, 0x15,
+	0xe9, 0xab, 0x50, 0xca, 0x52, 0x08, 0xea, 0x2f, 0xa0, 0x18, 0x7e, 0x62, 0xff, 0x0c, 0x91, 0x67,
+	0xf1, 0xae, 0xc0, 0xb2, 0x88, 0x17, 0xa4, 0x36, 0x2c, 0x8a, 0xb9, 0x39, 0x42, 0x01, 0xf2, 0x98,
+	0xfc, 0x19, 0x14, 0x51, 0x8f, 0x9f, 0xd2, 0x70, 0x2d, 0x62, 0xf2, 0xba, 0xf2, 0xff, 0x3f, 0xb5,
+	0x52, 0xf2, 0x89, 0x0f, 0xe2, 0xc6, 0x1c, 0xf3, 0x80, 0xf8, 0xae, 0x75, 0x07, 0x0d, 0x25, 0x75,
+	0xd1, 0xa0, 0x43, 0x91, 0x93, 0x6e, 0x6d, 0x62, 0x26, 0xa7, 0x21, 0x9b, 0x24, 0xcd, 0xbf, 0xff,
+	0x6f, 0x01, 0xf2, 0x4d, 0xe6, 0xca, 0x0e, 0x2c, 0x0c, 0xdd, 0xb3, 0x6d, 0xe3, 0xb1, 0x5b, 0x6a,
+	0xdc, 0xbb, 0x30, 0x6a, 0x6d, 0x22, 0x58, 0x9a, 0x4d, 0x3e, 0x83, 0xf7, 0xee, 0x1d, 0xa1, 0x9d,
+	0x91, 0x04, 0xc3, 0x40, 0xd5, 0x9c, 0x10, 0x28, 0x72, 0x75, 0x61, 0xe9, 0xc1, 0x3d, 0xd8, 0x1d,
+	0x43, 0x72, 0x07, 0x55, 0xf7, 0x26, 0x86, 0x8a, 0x8c, 0xbf, 0x4b, 0xb0, 0x3a, 0x62, 0xc3, 0x47,
+	0xab, 0x7f, 0x3c, 0x40, 0xfd, 0xfc, 0x2d, 0x03, 0x84, 0x88, 0x9f, 0x60, 0x4e, 0xac, 0xe3, 0xd6,
+	0x48, 0x92, 0x14, 0xa2, 0xee, 0x8e, 0x85, 0x08, 0xe6, 0x5f, 0xa1, 0x78, 0xb7, 0x57, 0xfa, 0xc8,
+	0x38, 0x81, 0x51, 0x3f, 0x1a, 0x8f, 0x11, 0xe4, 0x16, 0x14, 0x92, 0xcd, 0xaa, 0x8c, 0x6e, 0x7c,
+	0x04, 0x50, 0x77, 0xc6, 0x00, 0x04, 0xa7, 0x03, 0x0b, 0x43, 0x8b, 0xb5, 0x3d, 0xe6, 0x93, 0xc6,
+	0x30, 0xb5, 0x36, 0x11, 0x2c, 0xcd, 0x52, 0xff, 0xfa, 0xf2, 0x46, 0x93, 0xae, 0x6e, 0x34, 0xe9,
+	0xf5, 0x8d, 0x26, 0xfd, 0x71, 0xab, 0xe5, 0xae, 0x6e, 0xb5, 0xdc, 0x8b, 0x5b, 0x2d, 0xf7, 0xcb,
+	0xc7, 0x99, 0x93, 0xfb, 0xdd, 0xcf, 0x3f, 0x7e, 0x75, 0x88, 0xf9, 0x39, 0x0d, 0xda, 0xa6, 0x7d,
+	0x8a, 0x88, 0x6f, 0x5e, 0x88, 0xdf, 0x31, 0xd1, 0xf1, 0x6d, 0x15, 0xa2, 0x1f, 0x17, 0x9f, 0xbe,
+	0x19, 0x00, 0xa8, 0xfd, 0xb3, 0xbb, 0xe4, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -816,6 +926,8 @@ type MsgClient interface {
 	JoinPool(ctx context.Context, in *MsgJoinPool, opts ...grpc.CallOption) (*MsgJoinPoolResponse, error)
 	// LeavePool ...
 	LeavePool(ctx context.Context, in *MsgLeavePool, opts ...grpc.CallOption) (*MsgLeavePoolResponse, error)
+	// Unjail ...
+	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
 	// UpdateParams defines a governance operation for updating the x/stakers module
 	// parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -883,6 +995,15 @@ func (c *msgClient) LeavePool(ctx context.Context, in *MsgLeavePool, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error) {
+	out := new(MsgUnjailResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/Unjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -906,6 +1027,8 @@ type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
 	// LeavePool ...
 	LeavePool(context.Context, *MsgLeavePool) (*MsgLeavePoolResponse, error)
+	// Unjail ...
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
 	// UpdateParams defines a governance operation for updating the x/stakers module
 	// parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -933,6 +1056,9 @@ func (*UnimplementedMsgServer) JoinPool(ctx context.Context, req *MsgJoinPool) (
 func (*UnimplementedMsgServer) LeavePool(ctx context.Context, req *MsgLeavePool) (*MsgLeavePoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeavePool not implemented")
 }
+func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1049,6 +1175,24 @@ func _Msg_LeavePool_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/Unjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unjail(ctx, req.(*MsgUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1095,6 +1239,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeavePool",
 			Handler:    _Msg_LeavePool_Handler,
 		},
+		{
+			MethodName: "Unjail",
+			Handler:    _Msg_Unjail_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1343,6 +1491,20 @@ func (m *MsgClaimCommissionRewards) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Amount != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
 		i--
@@ -1509,6 +1671,64 @@ func (m *MsgLeavePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1686,6 +1906,12 @@ func (m *MsgClaimCommissionRewards) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovTx(uint64(m.Amount))
 	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1755,6 +1981,31 @@ func (m *MsgLeavePoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2510,6 +2761,40 @@ func (m *MsgClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2934,6 +3219,157 @@ func (m *MsgLeavePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
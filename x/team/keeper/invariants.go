@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all team invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vesting-accounts", VestingAccountsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the team module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := VestingAccountsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ModuleAccountInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the team module account holds at least
+// the required module balance, which are all unclaimed allocations and rewards.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		info := k.GetTeamInfo(ctx)
+
+		broken := info.TeamModuleBalance < info.RequiredModuleBalance
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account",
+			fmt.Sprintf(
+				"\tteam module balance: %d\n\trequired module balance: %d\n",
+				info.TeamModuleBalance, info.RequiredModuleBalance,
+			),
+		), broken
+	}
+}
+
+// VestingAccountsInvariant checks that no vesting account and not the
+// authority claimed more than they were entitled to.
+func VestingAccountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		authority := k.GetAuthority(ctx)
+		if authority.RewardsClaimed > authority.TotalRewards {
+			broken = true
+			msg += fmt.Sprintf(
+				"\tauthority: rewards claimed %d, total rewards %d\n",
+				authority.RewardsClaimed, authority.TotalRewards,
+			)
+		}
+
+		for _, account := range k.GetTeamVestingAccounts(ctx) {
+			if account.RewardsClaimed > account.TotalRewards {
+				broken = true
+				msg += fmt.Sprintf(
+					"\taccount %d: rewards claimed %d, total rewards %d\n",
+					account.Id, account.RewardsClaimed, account.TotalRewards,
+				)
+			}
+
+			unlocked := getUnlockedAmount(account, uint64(ctx.BlockTime().Unix()))
+			if account.UnlockedClaimed > unlocked {
+				broken = true
+				msg += fmt.Sprintf(
+					"\taccount %d: unlocked claimed %d, unlocked amount %d\n",
+					account.Id, account.UnlockedClaimed, unlocked,
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "vesting-accounts", msg), broken
+	}
+}